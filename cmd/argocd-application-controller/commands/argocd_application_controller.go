@@ -2,7 +2,10 @@ package commands
 
 import (
 	"context"
+	"fmt"
 	"math"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/vathsalashetty96/pkg/stats"
@@ -14,6 +17,7 @@ import (
 
 	"github.com/vathsalashetty96/argo-cd/common"
 	"github.com/vathsalashetty96/argo-cd/controller"
+	"github.com/vathsalashetty96/argo-cd/controller/hydrator"
 	"github.com/vathsalashetty96/argo-cd/controller/sharding"
 	"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1"
 	appclientset "github.com/vathsalashetty96/argo-cd/pkg/client/clientset/versioned"
@@ -25,6 +29,7 @@ import (
 	"github.com/vathsalashetty96/argo-cd/util/errors"
 	kubeutil "github.com/vathsalashetty96/argo-cd/util/kube"
 	"github.com/vathsalashetty96/argo-cd/util/settings"
+	traceutil "github.com/vathsalashetty96/argo-cd/util/trace"
 )
 
 const (
@@ -48,6 +53,14 @@ func NewCommand() *cobra.Command {
 		glogLevel                int
 		metricsPort              int
 		kubectlParallelismLimit  int64
+		otlpAddress              string
+		leaderElect              bool
+		leaseDuration            time.Duration
+		renewDeadline            time.Duration
+		retryPeriod              time.Duration
+		imageUpdateInterval      time.Duration
+		hydratorConfig           hydrator.Config
+		repoServerWarmup         bool
 		cacheSrc                 func() (*appstatecache.Cache, error)
 		redisClient              *redis.Client
 	)
@@ -76,12 +89,30 @@ func NewCommand() *cobra.Command {
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
 
+			if otlpAddress != "" {
+				closeTracer, err := traceutil.InitTracer(ctx, cliName, otlpAddress)
+				errors.CheckError(err)
+				defer closeTracer()
+			}
+
 			cache, err := cacheSrc()
 			errors.CheckError(err)
 
 			settingsMgr := settings.NewSettingsManager(ctx, kubeClient, namespace)
 			kubectl := kubeutil.NewKubectl()
-			clusterFilter := getClusterFilter()
+			clusterFilter, shard := getClusterFilter()
+			var leaderElection *controller.LeaderElectionConfig
+			if leaderElect {
+				identity, err := os.Hostname()
+				errors.CheckError(err)
+				leaderElection = &controller.LeaderElectionConfig{
+					LeaseName:     fmt.Sprintf("%s-shard-%d", cliName, shard),
+					Identity:      identity,
+					LeaseDuration: leaseDuration,
+					RenewDeadline: renewDeadline,
+					RetryPeriod:   retryPeriod,
+				}
+			}
 			appController, err := controller.NewApplicationController(
 				namespace,
 				settingsMgr,
@@ -94,7 +125,11 @@ func NewCommand() *cobra.Command {
 				time.Duration(selfHealTimeoutSeconds)*time.Second,
 				metricsPort,
 				kubectlParallelismLimit,
-				clusterFilter)
+				clusterFilter,
+				leaderElection,
+				imageUpdateInterval,
+				hydratorConfig,
+				repoServerWarmup)
 			errors.CheckError(err)
 			cacheutil.CollectMetrics(redisClient, appController.GetMetricsServer())
 
@@ -104,10 +139,10 @@ func NewCommand() *cobra.Command {
 			stats.StartStatsTicker(10 * time.Minute)
 			stats.RegisterHeapDumper("memprofile")
 
-			go appController.Run(ctx, statusProcessors, operationProcessors)
-
-			// Wait forever
-			select {}
+			// Run returns only if the leader election is enabled and the lease is lost
+			appController.Run(ctx, statusProcessors, operationProcessors)
+			log.Info("Application controller stopped")
+			return nil
 		},
 	}
 
@@ -123,13 +158,24 @@ func NewCommand() *cobra.Command {
 	command.Flags().IntVar(&metricsPort, "metrics-port", common.DefaultPortArgoCDMetrics, "Start metrics server on given port")
 	command.Flags().IntVar(&selfHealTimeoutSeconds, "self-heal-timeout-seconds", 5, "Specifies timeout between application self heal attempts")
 	command.Flags().Int64Var(&kubectlParallelismLimit, "kubectl-parallelism-limit", 20, "Number of allowed concurrent kubectl fork/execs. Any value less the 1 means no limit.")
+	command.Flags().StringVar(&otlpAddress, "otlp-address", "", "OpenTelemetry collector address to send traces to")
+	command.Flags().BoolVar(&leaderElect, "leader-elect", false, "Process applications only while holding the lease of the shard, so replicas of the same shard don't process applications concurrently")
+	command.Flags().DurationVar(&leaseDuration, "leader-elect-lease-duration", 15*time.Second, "Duration standby replicas wait before taking over a lease which is not renewed")
+	command.Flags().DurationVar(&renewDeadline, "leader-elect-renew-deadline", 10*time.Second, "Duration the leader retries to renew the lease before it stops processing applications")
+	command.Flags().DurationVar(&retryPeriod, "leader-elect-retry-period", 2*time.Second, "Duration between attempts to acquire or renew the lease")
+	command.Flags().DurationVar(&imageUpdateInterval, "image-update-interval", 0, "Interval of checking the registries for new tags of the images of annotated applications. Image updates are disabled if zero.")
+	command.Flags().StringVar(&hydratorConfig.WorkDir, "hydrator-work-dir", filepath.Join(os.TempDir(), "_argocd-hydrator"), "Directory the hydration target repositories are cloned into")
+	command.Flags().StringVar(&hydratorConfig.CommitAuthorName, "hydrator-commit-author-name", hydrator.DefaultCommitAuthorName, "Name of the author of the commits of the hydrated manifests")
+	command.Flags().StringVar(&hydratorConfig.CommitAuthorEmail, "hydrator-commit-author-email", hydrator.DefaultCommitAuthorEmail, "Email of the author of the commits of the hydrated manifests")
+	command.Flags().BoolVar(&repoServerWarmup, "repo-server-warmup", false, "Request the sources of all applications from the repo server on start, so that it fetches their Git repositories and Helm charts into its disk cache")
 	cacheSrc = appstatecache.AddCacheFlagsToCmd(&command, func(client *redis.Client) {
 		redisClient = client
 	})
 	return &command
 }
 
-func getClusterFilter() func(cluster *v1alpha1.Cluster) bool {
+// getClusterFilter returns the filter of the clusters processed by the controller and the shard of the controller
+func getClusterFilter() (func(cluster *v1alpha1.Cluster) bool, int) {
 	replicas := env.ParseNumFromEnv(common.EnvControllerReplicas, 0, 0, math.MaxInt32)
 	shard := env.ParseNumFromEnv(common.EnvControllerShard, -1, -math.MaxInt32, math.MaxInt32)
 	var clusterFilter func(cluster *v1alpha1.Cluster) bool
@@ -143,6 +189,7 @@ func getClusterFilter() func(cluster *v1alpha1.Cluster) bool {
 		clusterFilter = sharding.GetClusterFilter(replicas, shard)
 	} else {
 		log.Info("Processing all cluster shards")
+		shard = 0
 	}
-	return clusterFilter
+	return clusterFilter, shard
 }
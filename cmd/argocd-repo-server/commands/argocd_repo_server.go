@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"net"
@@ -13,6 +14,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc/health/grpc_health_v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/vathsalashetty96/argo-cd/common"
 	"github.com/vathsalashetty96/argo-cd/reposerver"
@@ -28,6 +30,7 @@ import (
 	"github.com/vathsalashetty96/argo-cd/util/healthz"
 	ioutil "github.com/vathsalashetty96/argo-cd/util/io"
 	"github.com/vathsalashetty96/argo-cd/util/tls"
+	traceutil "github.com/vathsalashetty96/argo-cd/util/trace"
 )
 
 const (
@@ -67,6 +70,16 @@ func NewCommand() *cobra.Command {
 		parallelismLimit       int64
 		listenPort             int
 		metricsPort            int
+		otlpAddress            string
+		gitPartialClone        bool
+		gitObjectStore         string
+		gitWorktrees           int
+		gitSparseCheckout      bool
+		diskCachePath          string
+		diskCacheSize          string
+		maxManifestsSize       string
+		maxManifestFiles       int
+		maxJsonnetStack        int
 		cacheSrc               func() (*reposervercache.Cache, error)
 		tlsConfigCustomizerSrc func() (tls.ConfigCustomizer, error)
 		redisClient            *redis.Client
@@ -80,12 +93,24 @@ func NewCommand() *cobra.Command {
 			cli.SetLogFormat(logFormat)
 			cli.SetLogLevel(logLevel)
 
+			if otlpAddress != "" {
+				closeTracer, err := traceutil.InitTracer(context.Background(), cliName, otlpAddress)
+				errors.CheckError(err)
+				defer closeTracer()
+			}
+
 			tlsConfigCustomizer, err := tlsConfigCustomizerSrc()
 			errors.CheckError(err)
 
 			cache, err := cacheSrc()
 			errors.CheckError(err)
 
+			diskCacheQuantity, err := resource.ParseQuantity(diskCacheSize)
+			errors.CheckError(err)
+
+			maxManifestsQuantity, err := resource.ParseQuantity(maxManifestsSize)
+			errors.CheckError(err)
+
 			metricsServer := metrics.NewMetricsServer()
 			cacheutil.CollectMetrics(redisClient, metricsServer)
 			server, err := reposerver.NewServer(metricsServer, cache, tlsConfigCustomizer, repository.RepoServerInitConstants{
@@ -93,6 +118,17 @@ func NewCommand() *cobra.Command {
 				PauseGenerationAfterFailedGenerationAttempts: getPauseGenerationAfterFailedGenerationAttempts(),
 				PauseGenerationOnFailureForMinutes:           getPauseGenerationOnFailureForMinutes(),
 				PauseGenerationOnFailureForRequests:          getPauseGenerationOnFailureForRequests(),
+				GitPartialClone:                              gitPartialClone,
+				GitObjectStore:                               gitObjectStore,
+				GitWorktrees:                                 gitWorktrees,
+				GitSparseCheckout:                            gitSparseCheckout,
+				DiskCachePath:                                diskCachePath,
+				DiskCacheSize:                                diskCacheQuantity.Value(),
+				ManifestGenerationLimits: repository.ManifestGenerationLimits{
+					MaxManifestsSize: maxManifestsQuantity.Value(),
+					MaxFiles:         maxManifestFiles,
+					MaxJsonnetStack:  maxJsonnetStack,
+				},
 			})
 			errors.CheckError(err)
 
@@ -153,6 +189,18 @@ func NewCommand() *cobra.Command {
 	command.Flags().Int64Var(&parallelismLimit, "parallelismlimit", 0, "Limit on number of concurrent manifests generate requests. Any value less the 1 means no limit.")
 	command.Flags().IntVar(&listenPort, "port", common.DefaultPortRepoServer, "Listen on given port for incoming connections")
 	command.Flags().IntVar(&metricsPort, "metrics-port", common.DefaultPortRepoServerMetrics, "Start metrics server on given port")
+	command.Flags().StringVar(&otlpAddress, "otlp-address", "", "OpenTelemetry collector address to send traces to")
+	command.Flags().BoolVar(&gitPartialClone, "git-partial-clone", false, "Fetch commits and trees of Git repositories only and fetch the contents of files when they are checked out")
+	command.Flags().StringVar(&gitObjectStore, "git-object-store", "", "Path of a directory keeping the objects of Git repositories, which lets the clones of the same repository share them")
+	command.Flags().IntVar(&gitWorktrees, "git-worktrees", 0, "Number of worktrees per Git repository in which different revisions are checked out concurrently. Any value less than 1 checks out all revisions in the repository itself.")
+	command.Flags().BoolVar(&gitSparseCheckout, "git-sparse-checkout", false, "Check out only the path of the application and the directories of its Helm value files in worktrees. Requires --git-worktrees.")
+
+	command.Flags().StringVar(&diskCachePath, "disk-cache-path", "", "Path of the directory in which Git repositories and Helm charts are kept across restarts. Defaults to a directory in the temp dir.")
+	command.Flags().StringVar(&diskCacheSize, "disk-cache-size", "0", "Disk space used by Git repositories and Helm charts, e.g. 10Gi, before the least recently used ones are removed. Zero means no limit.")
+
+	command.Flags().StringVar(&maxManifestsSize, "max-manifests-size", "0", "Maximum total size of the manifests generated for an application, e.g. 10Mi. Zero means no limit.")
+	command.Flags().IntVar(&maxManifestFiles, "max-manifest-files", 0, "Maximum number of files read from a directory of plain manifests, including Jsonnet imports. Zero means no limit.")
+	command.Flags().IntVar(&maxJsonnetStack, "max-jsonnet-stack", 0, "Maximum number of stack frames of a Jsonnet evaluation. Zero means the Jsonnet default of 500.")
 
 	tlsConfigCustomizerSrc = tls.AddTLSFlagsToCmd(&command)
 	cacheSrc = reposervercache.AddCacheFlagsToCmd(&command, func(client *redis.Client) {
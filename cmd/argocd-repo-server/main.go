@@ -5,9 +5,18 @@ import (
 	"os"
 
 	"github.com/vathsalashetty96/argo-cd/cmd/argocd-repo-server/commands"
+	"github.com/vathsalashetty96/argo-cd/reposerver/repository"
 )
 
 func main() {
+	// the repo server runs itself to evaluate Jsonnet with limited resources
+	if repository.IsJsonnetEvaluator() {
+		if err := repository.RunJsonnetEvaluator(); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
 	if err := commands.NewCommand().Execute(); err != nil {
 		fmt.Println(err)
 		os.Exit(1)
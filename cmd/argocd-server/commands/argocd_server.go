@@ -22,6 +22,7 @@ import (
 	"github.com/vathsalashetty96/argo-cd/util/errors"
 	"github.com/vathsalashetty96/argo-cd/util/kube"
 	"github.com/vathsalashetty96/argo-cd/util/tls"
+	traceutil "github.com/vathsalashetty96/argo-cd/util/trace"
 )
 
 const (
@@ -61,6 +62,11 @@ func NewCommand() *cobra.Command {
 		tlsConfigCustomizerSrc   func() (tls.ConfigCustomizer, error)
 		cacheSrc                 func() (*servercache.Cache, error)
 		frameOptions             string
+		otlpAddress              string
+		admissionWebhook         bool
+		admissionWebhookPort     int
+		admissionWebhookClientCA string
+		admissionWebhookDryRun   bool
 	)
 	var command = &cobra.Command{
 		Use:               cliName,
@@ -72,6 +78,12 @@ func NewCommand() *cobra.Command {
 			cli.SetLogLevel(logLevel)
 			cli.SetGLogLevel(glogLevel)
 
+			if otlpAddress != "" {
+				closeTracer, err := traceutil.InitTracer(context.Background(), cliName, otlpAddress)
+				errors.CheckError(err)
+				defer closeTracer()
+			}
+
 			config, err := clientConfig.ClientConfig()
 			errors.CheckError(err)
 			errors.CheckError(v1alpha1.SetK8SConfigDefaults(config))
@@ -104,23 +116,27 @@ func NewCommand() *cobra.Command {
 			}
 
 			argoCDOpts := server.ArgoCDServerOpts{
-				Insecure:            insecure,
-				ListenPort:          listenPort,
-				MetricsPort:         metricsPort,
-				Namespace:           namespace,
-				StaticAssetsDir:     staticAssetsDir,
-				BaseHRef:            baseHRef,
-				RootPath:            rootPath,
-				KubeClientset:       kubeclientset,
-				AppClientset:        appclientset,
-				RepoClientset:       repoclientset,
-				DexServerAddr:       dexServerAddress,
-				DisableAuth:         disableAuth,
-				EnableGZip:          enableGZip,
-				TLSConfigCustomizer: tlsConfigCustomizer,
-				Cache:               cache,
-				XFrameOptions:       frameOptions,
-				RedisClient:         redisClient,
+				Insecure:                 insecure,
+				ListenPort:               listenPort,
+				MetricsPort:              metricsPort,
+				Namespace:                namespace,
+				StaticAssetsDir:          staticAssetsDir,
+				BaseHRef:                 baseHRef,
+				RootPath:                 rootPath,
+				KubeClientset:            kubeclientset,
+				AppClientset:             appclientset,
+				RepoClientset:            repoclientset,
+				DexServerAddr:            dexServerAddress,
+				DisableAuth:              disableAuth,
+				EnableGZip:               enableGZip,
+				TLSConfigCustomizer:      tlsConfigCustomizer,
+				Cache:                    cache,
+				XFrameOptions:            frameOptions,
+				RedisClient:              redisClient,
+				AdmissionWebhook:         admissionWebhook,
+				AdmissionWebhookPort:     admissionWebhookPort,
+				AdmissionWebhookClientCA: admissionWebhookClientCA,
+				AdmissionWebhookDryRun:   admissionWebhookDryRun,
 			}
 
 			stats.RegisterStackDumper()
@@ -154,6 +170,11 @@ func NewCommand() *cobra.Command {
 	command.Flags().IntVar(&metricsPort, "metrics-port", common.DefaultPortArgoCDAPIServerMetrics, "Start metrics on given port")
 	command.Flags().IntVar(&repoServerTimeoutSeconds, "repo-server-timeout-seconds", 60, "Repo server RPC call timeout seconds.")
 	command.Flags().StringVar(&frameOptions, "x-frame-options", "sameorigin", "Set X-Frame-Options header in HTTP responses to `value`. To disable, set to \"\".")
+	command.Flags().StringVar(&otlpAddress, "otlp-address", "", "OpenTelemetry collector address to send traces to")
+	command.Flags().BoolVar(&admissionWebhook, "admission-webhook", false, "Serve the validating admission webhook of the Application and AppProject resources at /api/admission on the admission webhook port")
+	command.Flags().IntVar(&admissionWebhookPort, "admission-webhook-port", common.DefaultPortAdmissionWebhook, "Serve the admission webhook using HTTPS on given port")
+	command.Flags().StringVar(&admissionWebhookClientCA, "admission-webhook-client-ca", "", "Path of the CA certificates which must have issued the client certificate of the Kubernetes API server calling the admission webhook, required by --admission-webhook")
+	command.Flags().BoolVar(&admissionWebhookDryRun, "admission-webhook-dry-run", false, "Admit invalid resources and only report the violations as warnings of the admission webhook")
 	tlsConfigCustomizerSrc = tls.AddTLSFlagsToCmd(command)
 	cacheSrc = servercache.AddCacheFlagsToCmd(command, func(client *redis.Client) {
 		redisClient = client
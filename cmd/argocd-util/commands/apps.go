@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"os"
+	"path/filepath"
 	"sort"
 	"time"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/vathsalashetty96/argo-cd/common"
 	"github.com/vathsalashetty96/argo-cd/controller"
 	"github.com/vathsalashetty96/argo-cd/controller/cache"
+	"github.com/vathsalashetty96/argo-cd/controller/hydrator"
 	"github.com/vathsalashetty96/argo-cd/controller/metrics"
 	"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1"
 	appclientset "github.com/vathsalashetty96/argo-cd/pkg/client/clientset/versioned"
@@ -292,7 +294,8 @@ func reconcileApplications(
 	}
 
 	appStateManager := controller.NewAppStateManager(
-		argoDB, appClientset, repoServerClient, namespace, kubeutil.NewKubectl(), settingsMgr, stateCache, projInformer, server)
+		argoDB, appClientset, repoServerClient, namespace, kubeutil.NewKubectl(), settingsMgr, stateCache, projInformer, server,
+		hydrator.NewHydrator(argoDB, hydrator.Config{WorkDir: filepath.Join(os.TempDir(), "_argocd-hydrator")}), nil)
 
 	appsList, err := appClientset.ArgoprojV1alpha1().Applications(namespace).List(context.Background(), v1.ListOptions{LabelSelector: selector})
 	if err != nil {
@@ -3,8 +3,10 @@ package commands
 import (
 	"context"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
+	"strings"
 
 	"github.com/ghodss/yaml"
 	log "github.com/sirupsen/logrus"
@@ -85,10 +87,12 @@ func NewRBACCanCommand() *cobra.Command {
 		useBuiltin   bool
 		strict       bool
 		quiet        bool
+		explain      bool
 		subject      string
 		action       string
 		resource     string
 		subResource  string
+		appResource  string
 		clientConfig clientcmd.ClientConfig
 	)
 	var command = &cobra.Command{
@@ -115,6 +119,17 @@ argocd-util rbac can some:role create application 'default/app' --namespace argo
 # You can override a possibly configured default role
 argocd-util rbac can someuser create application 'default/app' --default-role role:readonly
 
+# Check whether role some:role may delete the Secret 'my-secret' in namespace
+# 'default' which is managed by the application 'default/app'
+argocd-util rbac can some:role delete application 'default/app' --app-resource /Secret/default/my-secret --policy-file policy.csv
+
+# Resource actions are given as action/ACTION_NAME
+argocd-util rbac can some:role action/restart application 'default/app' --app-resource apps/Deployment/default/guestbook --policy-file policy.csv
+
+# Explain the result by printing the matching policy lines, the role
+# inheritance chain leading to them and the default role
+argocd-util rbac can someuser delete application 'default/app' --explain --policy-file policy.csv
+
 `,
 		Run: func(c *cobra.Command, args []string) {
 			if len(args) < 3 || len(args) > 4 {
@@ -127,6 +142,15 @@ argocd-util rbac can someuser create application 'default/app' --default-role ro
 			if len(args) > 3 {
 				subResource = args[3]
 			}
+			if appResource != "" {
+				if resolveRBACResourceName(resource) != rbacpolicy.ResourceApplications {
+					log.Fatalf("--app-resource can only be used with the %s resource", rbacpolicy.ResourceApplications)
+				}
+				var err error
+				if action, err = resourceLevelAction(action, appResource); err != nil {
+					log.Fatal(err)
+				}
+			}
 
 			userPolicy := ""
 			builtinPolicy := ""
@@ -166,16 +190,20 @@ argocd-util rbac can someuser create application 'default/app' --default-role ro
 				defaultRole = newDefaultRole
 			}
 
-			res := checkPolicy(subject, action, resource, subResource, builtinPolicy, userPolicy, defaultRole, strict)
-			if res {
-				if !quiet {
+			explanation := explainPolicy(subject, action, resource, subResource, builtinPolicy, userPolicy, defaultRole, strict)
+			if !quiet {
+				if explanation.Allowed {
 					fmt.Println("Yes")
+				} else {
+					fmt.Println("No")
+				}
+				if explain {
+					printExplanation(os.Stdout, explanation)
 				}
+			}
+			if explanation.Allowed {
 				os.Exit(0)
 			} else {
-				if !quiet {
-					fmt.Println("No")
-				}
 				os.Exit(1)
 			}
 		},
@@ -187,6 +215,8 @@ argocd-util rbac can someuser create application 'default/app' --default-role ro
 	command.Flags().BoolVar(&useBuiltin, "use-builtin-policy", true, "whether to also use builtin-policy")
 	command.Flags().BoolVar(&strict, "strict", true, "whether to perform strict check on action and resource names")
 	command.Flags().BoolVarP(&quiet, "quiet", "q", false, "quiet mode - do not print results to stdout")
+	command.Flags().BoolVar(&explain, "explain", false, "print the matching policy lines, their role inheritance chain and the default role")
+	command.Flags().StringVar(&appResource, "app-resource", "", "check the action on a resource of the application, given as GROUP/KIND/NAMESPACE/NAME")
 	return command
 }
 
@@ -194,6 +224,8 @@ argocd-util rbac can someuser create application 'default/app' --default-role ro
 func NewRBACValidateCommand() *cobra.Command {
 	var (
 		policyFile string
+		testFile   string
+		useBuiltin bool
 	)
 
 	var command = &cobra.Command{
@@ -202,29 +234,138 @@ func NewRBACValidateCommand() *cobra.Command {
 		Long: `
 Validates an RBAC policy for being syntactically correct. The policy must be
 a local file, and in either CSV or K8s ConfigMap format.
+
+Optionally runs the cases of a policy test file against the policy, which
+lists requests and whether they are expected to be allowed or denied.
+`,
+		Example: `
+# Validate the syntax of a policy
+argocd-util rbac validate --policy-file argocd-rbac-cm.yaml
+
+# Validate a policy and run the cases of a policy test file against it
+argocd-util rbac validate --policy-file argocd-rbac-cm.yaml --test-file rbac-tests.yaml
 `,
 		Run: func(c *cobra.Command, args []string) {
 			if policyFile == "" {
 				c.HelpFunc()(c, args)
 				log.Fatalf("Please specify policy to validate using --policy-file")
 			}
-			userPolicy, _ := getPolicy(policyFile, nil, "")
+			userPolicy, defaultRole := getPolicy(policyFile, nil, "")
 			if userPolicy != "" {
 				if err := rbac.ValidatePolicy(userPolicy); err == nil {
 					fmt.Printf("Policy is valid.\n")
-					os.Exit(0)
 				} else {
 					fmt.Printf("Policy is invalid: %v\n", err)
 					os.Exit(1)
 				}
 			}
+			if testFile == "" {
+				os.Exit(0)
+			}
+
+			builtinPolicy := ""
+			if useBuiltin {
+				builtinPolicy = assets.BuiltinPolicyCSV
+			}
+			tests, err := getPolicyTestsFromFile(testFile)
+			if err != nil {
+				log.Fatalf("could not read test file: %v", err)
+			}
+			failed, err := runPolicyTests(os.Stdout, tests, builtinPolicy, userPolicy, defaultRole)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if failed > 0 {
+				fmt.Printf("%d of %d tests failed.\n", failed, len(tests))
+				os.Exit(1)
+			}
+			fmt.Printf("All %d tests passed.\n", len(tests))
 		},
 	}
 
 	command.Flags().StringVar(&policyFile, "policy-file", "", "path to the policy file to use")
+	command.Flags().StringVar(&testFile, "test-file", "", "path to a policy test file to run against the policy")
+	command.Flags().BoolVar(&useBuiltin, "use-builtin-policy", true, "whether to also use builtin-policy when running tests")
 	return command
 }
 
+// policyTest is a case of a policy test file, i.e. a request and whether it is expected to be allowed or denied
+type policyTest struct {
+	Subject     string `json:"subject"`
+	Action      string `json:"action"`
+	Resource    string `json:"resource"`
+	Object      string `json:"object,omitempty"`
+	AppResource string `json:"appResource,omitempty"`
+	Expect      string `json:"expect"`
+}
+
+// policyTestFile is the format of a policy test file
+type policyTestFile struct {
+	Tests []policyTest `json:"tests"`
+}
+
+const (
+	policyTestExpectAllow = "allow"
+	policyTestExpectDeny  = "deny"
+)
+
+// getPolicyTestsFromFile loads the cases of a policy test file from given path
+func getPolicyTestsFromFile(testFile string) ([]policyTest, error) {
+	data, err := ioutil.ReadFile(testFile)
+	if err != nil {
+		return nil, err
+	}
+	var file policyTestFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, err
+	}
+	return file.Tests, nil
+}
+
+// runPolicyTests runs the given policy test cases against the policy, prints their results and returns the number of
+// failed cases
+func runPolicyTests(out io.Writer, tests []policyTest, builtinPolicy, userPolicy, defaultRole string) (int, error) {
+	failed := 0
+	for i, test := range tests {
+		if test.Expect != policyTestExpectAllow && test.Expect != policyTestExpectDeny {
+			return 0, fmt.Errorf("test %d: expect must be either '%s' or '%s'", i+1, policyTestExpectAllow, policyTestExpectDeny)
+		}
+		resource := resolveRBACResourceName(test.Resource)
+		if !isValidRBACResource(resource) {
+			return 0, fmt.Errorf("test %d: '%s' is not a valid resource name", i+1, test.Resource)
+		}
+		if !isValidRBACAction(test.Action) {
+			return 0, fmt.Errorf("test %d: '%s' is not a valid action name", i+1, test.Action)
+		}
+		action := test.Action
+		if test.AppResource != "" {
+			if resource != rbacpolicy.ResourceApplications {
+				return 0, fmt.Errorf("test %d: appResource can only be used with the %s resource", i+1, rbacpolicy.ResourceApplications)
+			}
+			var err error
+			if action, err = resourceLevelAction(action, test.AppResource); err != nil {
+				return 0, fmt.Errorf("test %d: %v", i+1, err)
+			}
+		}
+
+		result := policyTestExpectDeny
+		if checkPolicy(test.Subject, action, resource, test.Object, builtinPolicy, userPolicy, defaultRole, false) {
+			result = policyTestExpectAllow
+		}
+		status := "PASS"
+		if result != test.Expect {
+			status = "FAIL"
+			failed++
+		}
+		request := fmt.Sprintf("%s %s %s %s", test.Subject, test.Action, test.Resource, test.Object)
+		if test.AppResource != "" {
+			request = fmt.Sprintf("%s (%s)", request, test.AppResource)
+		}
+		_, _ = fmt.Fprintf(out, "%s\t%s: expected %s, got %s\n", status, strings.TrimSpace(request), test.Expect, result)
+	}
+	return failed, nil
+}
+
 // Load user policy file if requested or use Kubernetes client to get the
 // appropriate ConfigMap from the current context
 func getPolicy(policyFile string, kubeClient kubernetes.Interface, namespace string) (userPolicy string, defaultRole string) {
@@ -305,22 +446,28 @@ func getPolicyConfigMap(client kubernetes.Interface, namespace string) (*corev1.
 // checkPolicy checks whether given subject is allowed to execute specified
 // action against specified resource
 func checkPolicy(subject, action, resource, subResource, builtinPolicy, userPolicy, defaultRole string, strict bool) bool {
+	return explainPolicy(subject, action, resource, subResource, builtinPolicy, userPolicy, defaultRole, strict).Allowed
+}
+
+// explainPolicy checks whether given subject is allowed to execute specified
+// action against specified resource, and explains the result
+func explainPolicy(subject, action, resource, subResource, builtinPolicy, userPolicy, defaultRole string, strict bool) *rbac.Explanation {
 	enf := rbac.NewEnforcer(nil, "argocd", "argocd-rbac-cm", nil)
 	enf.SetDefaultRole(defaultRole)
 	if builtinPolicy != "" {
 		if err := enf.SetBuiltinPolicy(builtinPolicy); err != nil {
 			log.Fatalf("could not set built-in policy: %v", err)
-			return false
+			return nil
 		}
 	}
 	if userPolicy != "" {
 		if err := rbac.ValidatePolicy(userPolicy); err != nil {
 			log.Fatalf("invalid user policy: %v", err)
-			return false
+			return nil
 		}
 		if err := enf.SetUserPolicy(userPolicy); err != nil {
 			log.Fatalf("could not set user policy: %v", err)
-			return false
+			return nil
 		}
 	}
 
@@ -348,7 +495,22 @@ func checkPolicy(subject, action, resource, subResource, builtinPolicy, userPoli
 		}
 	}
 
-	return enf.Enforce(subject, realResource, action, subResource)
+	return enf.Explain(subject, realResource, action, subResource)
+}
+
+// printExplanation prints the default role and the policy lines matching a request
+func printExplanation(out io.Writer, explanation *rbac.Explanation) {
+	if explanation.DefaultRole != "" {
+		_, _ = fmt.Fprintf(out, "Default role: %s\n", explanation.DefaultRole)
+	}
+	if len(explanation.Matches) == 0 {
+		_, _ = fmt.Fprintln(out, "No matching policies")
+		return
+	}
+	_, _ = fmt.Fprintln(out, "Matching policies:")
+	for _, match := range explanation.Matches {
+		_, _ = fmt.Fprintf(out, "  [%s] %s (via %s)\n", match.Source, match.Line, strings.Join(match.Roles, " -> "))
+	}
 }
 
 // resolveRBACResourceName resolves a user supplied value to a valid RBAC
@@ -361,9 +523,23 @@ func resolveRBACResourceName(name string) string {
 	}
 }
 
-// isValidRBACAction checks whether a given action is a valid RBAC action
+// resourceLevelAction returns the resource-level RBAC action of the given action on a resource of an application, which
+// is given as GROUP/KIND/NAMESPACE/NAME. Resource actions are given as action/ACTION_NAME.
+func resourceLevelAction(action string, appResource string) (string, error) {
+	parts := strings.Split(appResource, "/")
+	if len(parts) != 4 {
+		return "", fmt.Errorf("application resource '%s' must be of the form GROUP/KIND/NAMESPACE/NAME", appResource)
+	}
+	if actionName := strings.TrimPrefix(action, rbacpolicy.ActionAction+"/"); actionName != action {
+		return rbacpolicy.RunAction(parts[0], parts[1], actionName, parts[2], parts[3]), nil
+	}
+	return rbacpolicy.ResourceAction(action, parts[0], parts[1], parts[2], parts[3]), nil
+}
+
+// isValidRBACAction checks whether a given action is a valid RBAC action. Resource-level actions are validated by the
+// action they are prefixed with.
 func isValidRBACAction(action string) bool {
-	_, ok := validRBACActions[action]
+	_, ok := validRBACActions[strings.SplitN(action, "/", 2)[0]]
 	return ok
 }
 
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"bytes"
 	"io/ioutil"
 	"testing"
 
@@ -24,6 +25,86 @@ func Test_isValidRBACAction(t *testing.T) {
 		ok := isValidRBACAction("invalid")
 		assert.False(t, ok)
 	})
+	t.Run("resource-level", func(t *testing.T) {
+		assert.True(t, isValidRBACAction("delete/apps/Deployment/default/guestbook"))
+		assert.True(t, isValidRBACAction("action/apps/Deployment/restart"))
+		assert.False(t, isValidRBACAction("invalid/apps/Deployment/default/guestbook"))
+	})
+}
+
+func Test_resourceLevelAction(t *testing.T) {
+	action, err := resourceLevelAction("delete", "/Secret/default/my-secret")
+	assert.NoError(t, err)
+	assert.Equal(t, "delete//Secret/default/my-secret", action)
+
+	action, err = resourceLevelAction("action/restart", "apps/Deployment/default/guestbook")
+	assert.NoError(t, err)
+	assert.Equal(t, "action/apps/Deployment/restart/default/guestbook", action)
+
+	_, err = resourceLevelAction("delete", "Secret/my-secret")
+	assert.Error(t, err)
+}
+
+func Test_checkPolicyResourceLevel(t *testing.T) {
+	policy := `p, role:user, applications, delete, */*, allow
+p, role:user, applications, delete/*/Secret/*/*, */*, deny
+p, role:user, applications, action/apps/Deployment/restart, */*, allow
+`
+	assert.True(t, checkPolicy("role:user", "delete//ConfigMap/default/my-cm", "applications", "default/app", "", policy, "", true))
+	assert.False(t, checkPolicy("role:user", "delete//Secret/default/my-secret", "applications", "default/app", "", policy, "", true))
+	assert.True(t, checkPolicy("role:user", "action/apps/Deployment/restart/default/guestbook", "applications", "default/app", "", policy, "", true))
+	assert.False(t, checkPolicy("role:user", "action/apps/StatefulSet/restart/default/guestbook", "applications", "default/app", "", policy, "", true))
+}
+
+func Test_explainPolicy(t *testing.T) {
+	policy := `p, role:user, applications, delete, */*, allow
+p, role:user, applications, delete, */guestbook, deny
+g, test, role:user
+`
+	explanation := explainPolicy("test", "delete", "applications", "default/guestbook", "", policy, "role:readonly", true)
+	assert.False(t, explanation.Allowed)
+	assert.Equal(t, "role:readonly", explanation.DefaultRole)
+	assert.Len(t, explanation.Matches, 2)
+
+	out := &bytes.Buffer{}
+	printExplanation(out, explanation)
+	assert.Equal(t, `Default role: role:readonly
+Matching policies:
+  [user-defined] p, role:user, applications, delete, */*, allow (via test -> role:user)
+  [user-defined] p, role:user, applications, delete, */guestbook, deny (via test -> role:user)
+`, out.String())
+
+	out.Reset()
+	printExplanation(out, explainPolicy("other", "delete", "applications", "default/guestbook", "", policy, "", true))
+	assert.Equal(t, "No matching policies\n", out.String())
+}
+
+func Test_runPolicyTests(t *testing.T) {
+	uPol, _, err := getPolicyFromFile("testdata/rbac/policy.csv")
+	require.NoError(t, err)
+	tests, err := getPolicyTestsFromFile("testdata/rbac/policy-tests.yaml")
+	require.NoError(t, err)
+	require.Len(t, tests, 5)
+
+	out := &bytes.Buffer{}
+	failed, err := runPolicyTests(out, tests, assets.BuiltinPolicyCSV, uPol, "")
+	require.NoError(t, err)
+	assert.Equal(t, 1, failed)
+	assert.Equal(t, `PASS	test get clusters https://some-cluster: expected allow, got allow
+PASS	test get clusters https://kubernetes.default.svc: expected deny, got deny
+PASS	role:user delete applications default/guestbook: expected deny, got deny
+PASS	role:user delete applications default/helm-guestbook (/Secret/default/my-secret): expected allow, got allow
+FAIL	role:user get certificates *: expected allow, got deny
+`, out.String())
+
+	failed, err = runPolicyTests(out, tests, assets.BuiltinPolicyCSV, uPol, "role:readonly")
+	require.NoError(t, err)
+	assert.Equal(t, 0, failed)
+
+	_, err = runPolicyTests(out, []policyTest{{Subject: "test", Action: "get", Resource: "clusters", Expect: "maybe"}}, "", uPol, "")
+	assert.EqualError(t, err, "test 1: expect must be either 'allow' or 'deny'")
+	_, err = runPolicyTests(out, []policyTest{{Subject: "test", Action: "get", Resource: "clusters", AppResource: "/Secret/default/my-secret", Expect: "allow"}}, "", uPol, "")
+	assert.EqualError(t, err, "test 1: appResource can only be used with the applications resource")
 }
 
 func Test_isValidRBACResource(t *testing.T) {
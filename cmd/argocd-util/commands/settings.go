@@ -25,6 +25,7 @@ import (
 
 	"github.com/vathsalashetty96/argo-cd/common"
 	"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/vathsalashetty96/argo-cd/util/argo"
 	"github.com/vathsalashetty96/argo-cd/util/argo/normalizers"
 	"github.com/vathsalashetty96/argo-cd/util/cli"
 	"github.com/vathsalashetty96/argo-cd/util/errors"
@@ -226,6 +227,9 @@ var validatorsByGroup = map[string]settingValidator{
 	}, func(manager *settings.SettingsManager) (string, error) {
 		_, err := manager.GetAppInstanceLabelKey()
 		return "", err
+	}, func(manager *settings.SettingsManager) (string, error) {
+		_, err := argo.GetTrackingMethod(manager)
+		return "", err
 	}, func(manager *settings.SettingsManager) (string, error) {
 		_, err := manager.GetHelp()
 		return "", err
@@ -43,6 +43,8 @@ func NewAccountCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewAccountGenerateTokenCommand(clientOpts))
 	command.AddCommand(NewAccountGetCommand(clientOpts))
 	command.AddCommand(NewAccountDeleteTokenCommand(clientOpts))
+	command.AddCommand(NewAccountListTokensCommand(clientOpts))
+	command.AddCommand(NewAccountRevokeSessionsCommand(clientOpts))
 	return command
 }
 
@@ -309,22 +311,96 @@ func printAccountDetails(acc *accountpkg.Account) {
 	if len(acc.Tokens) == 0 {
 		fmt.Println("NONE")
 	} else {
-		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintf(w, "ID\tISSUED AT\tEXPIRING AT\n")
-		for _, t := range acc.Tokens {
-			expiresAtFormatted := "never"
-			if t.ExpiresAt > 0 {
-				expiresAt := time.Unix(t.ExpiresAt, 0)
-				expiresAtFormatted = expiresAt.Format(time.RFC3339)
-				if expiresAt.Before(time.Now()) {
-					expiresAtFormatted = fmt.Sprintf("%s (expired)", expiresAtFormatted)
-				}
+		printTokensTable(acc.Tokens)
+	}
+}
+
+func printTokensTable(tokens []*accountpkg.Token) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "ID\tISSUED AT\tEXPIRING AT\tLAST USED\tSCOPES\n")
+	for _, t := range tokens {
+		expiresAtFormatted := "never"
+		if t.ExpiresAt > 0 {
+			expiresAt := time.Unix(t.ExpiresAt, 0)
+			expiresAtFormatted = expiresAt.Format(time.RFC3339)
+			if expiresAt.Before(time.Now()) {
+				expiresAtFormatted = fmt.Sprintf("%s (expired)", expiresAtFormatted)
 			}
+		}
+		lastUsedFormatted := "never"
+		if t.LastUsedAt > 0 {
+			lastUsedFormatted = time.Unix(t.LastUsedAt, 0).Format(time.RFC3339)
+		}
+		scopesFormatted := "*"
+		if len(t.Scopes) > 0 {
+			scopesFormatted = strings.Join(t.Scopes, ", ")
+		}
 
-			fmt.Fprintf(w, "%s\t%s\t%s\n", t.Id, time.Unix(t.IssuedAt, 0).Format(time.RFC3339), expiresAtFormatted)
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", t.Id, time.Unix(t.IssuedAt, 0).Format(time.RFC3339), expiresAtFormatted, lastUsedFormatted, scopesFormatted)
+	}
+	_ = w.Flush()
+}
+
+// filterStaleTokens returns the tokens which were not used, or were never used and issued, within the given duration
+func filterStaleTokens(tokens []*accountpkg.Token, stale time.Duration, now time.Time) []*accountpkg.Token {
+	var res []*accountpkg.Token
+	for _, t := range tokens {
+		lastActivity := time.Unix(t.IssuedAt, 0)
+		if t.LastUsedAt > 0 {
+			lastActivity = time.Unix(t.LastUsedAt, 0)
 		}
-		_ = w.Flush()
+		if now.Sub(lastActivity) >= stale {
+			res = append(res, t)
+		}
+	}
+	return res
+}
+
+func NewAccountListTokensCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		account string
+		stale   string
+		output  string
+	)
+	cmd := &cobra.Command{
+		Use:   "list-tokens",
+		Short: "List account tokens",
+		Example: `# List tokens of the currently logged in account
+argocd account list-tokens
+
+# List tokens of the account with the specified name which were not used for 30 days
+argocd account list-tokens --account <account-name> --stale 30d`,
+		Run: func(c *cobra.Command, args []string) {
+			clientset := argocdclient.NewClientOrDie(clientOpts)
+			conn, client := clientset.NewAccountClientOrDie()
+			defer io.Close(conn)
+			if account == "" {
+				account = getCurrentAccount(clientset).Username
+			}
+			acc, err := client.GetAccount(context.Background(), &accountpkg.GetAccountRequest{Name: account})
+			errors.CheckError(err)
+
+			tokens := acc.Tokens
+			if stale != "" {
+				staleDuration, err := timeutil.ParseDuration(stale)
+				errors.CheckError(err)
+				tokens = filterStaleTokens(tokens, *staleDuration, time.Now())
+			}
+			switch output {
+			case "yaml", "json":
+				err := PrintResourceList(tokens, output, false)
+				errors.CheckError(err)
+			case "wide", "":
+				printTokensTable(tokens)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
 	}
+	cmd.Flags().StringVarP(&account, "account", "a", "", "Account name. Defaults to the current account.")
+	cmd.Flags().StringVar(&stale, "stale", "", "Only list tokens which were not used for the given duration, e.g. 30d")
+	cmd.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return cmd
 }
 
 func NewAccountGenerateTokenCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
@@ -332,6 +408,7 @@ func NewAccountGenerateTokenCommand(clientOpts *argocdclient.ClientOptions) *cob
 		account   string
 		expiresIn string
 		id        string
+		scopes    []string
 	)
 	cmd := &cobra.Command{
 		Use:   "generate-token",
@@ -340,7 +417,10 @@ func NewAccountGenerateTokenCommand(clientOpts *argocdclient.ClientOptions) *cob
 argocd account generate-token
 
 # Generate token for the account with the specified name
-argocd account generate-token --account <account-name>`,
+argocd account generate-token --account <account-name>
+
+# Generate token which may only be used to sync applications of the project 'my-project'
+argocd account generate-token --account <account-name> --expires-in 30d --scope 'applications:sync:my-project/*'`,
 		Run: func(c *cobra.Command, args []string) {
 
 			clientset := argocdclient.NewClientOrDie(clientOpts)
@@ -355,6 +435,7 @@ argocd account generate-token --account <account-name>`,
 				Name:      account,
 				ExpiresIn: int64(expiresIn.Seconds()),
 				Id:        id,
+				Scopes:    scopes,
 			})
 			errors.CheckError(err)
 			fmt.Println(response.Token)
@@ -363,6 +444,7 @@ argocd account generate-token --account <account-name>`,
 	cmd.Flags().StringVarP(&account, "account", "a", "", "Account name. Defaults to the current account.")
 	cmd.Flags().StringVarP(&expiresIn, "expires-in", "e", "0s", "Duration before the token will expire. (Default: No expiration)")
 	cmd.Flags().StringVar(&id, "id", "", "Optional token id. Fallback to uuid if not value specified.")
+	cmd.Flags().StringArrayVar(&scopes, "scope", []string{}, "Restrict the token to an action on a resource and object of the form <resource>:<action>[:<object>], e.g. applications:sync:my-project/*. Can be repeated. (Default: No restriction)")
 	return cmd
 }
 
@@ -398,3 +480,32 @@ argocd account generate-token --account <account-name>`,
 	cmd.Flags().StringVarP(&account, "account", "a", "", "Account name. Defaults to the current account.")
 	return cmd
 }
+
+func NewAccountRevokeSessionsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		account string
+	)
+	cmd := &cobra.Command{
+		Use:   "revoke-sessions",
+		Short: "Revoke all login sessions of an account",
+		Long:  "Revoke all login sessions of a local account or SSO user on all API servers. API tokens are not affected, use delete-token to revoke them.",
+		Example: `# Revoke all sessions of the currently logged in account
+argocd account revoke-sessions
+
+# Revoke all sessions of the account with the specified name
+argocd account revoke-sessions --account <account-name>`,
+		Run: func(c *cobra.Command, args []string) {
+			clientset := argocdclient.NewClientOrDie(clientOpts)
+			conn, client := clientset.NewAccountClientOrDie()
+			defer io.Close(conn)
+			if account == "" {
+				account = getCurrentAccount(clientset).Username
+			}
+			_, err := client.RevokeSessions(context.Background(), &accountpkg.RevokeSessionsRequest{Name: account})
+			errors.CheckError(err)
+			fmt.Printf("Revoked all sessions of '%s'\n", account)
+		},
+	}
+	cmd.Flags().StringVarP(&account, "account", "a", "", "Account name. Defaults to the current account.")
+	return cmd
+}
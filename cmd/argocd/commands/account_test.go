@@ -0,0 +1,29 @@
+package commands
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	accountpkg "github.com/vathsalashetty96/argo-cd/pkg/apiclient/account"
+)
+
+func TestFilterStaleTokens(t *testing.T) {
+	now := time.Now()
+	daysAgo := func(days int) int64 {
+		return now.Add(-time.Duration(days) * 24 * time.Hour).Unix()
+	}
+	tokens := []*accountpkg.Token{
+		{Id: "unused", IssuedAt: daysAgo(40)},
+		{Id: "new", IssuedAt: daysAgo(1)},
+		{Id: "stale", IssuedAt: daysAgo(90), LastUsedAt: daysAgo(31)},
+		{Id: "active", IssuedAt: daysAgo(90), LastUsedAt: daysAgo(2)},
+	}
+
+	var ids []string
+	for _, token := range filterStaleTokens(tokens, 30*24*time.Hour, now) {
+		ids = append(ids, token.Id)
+	}
+	assert.Equal(t, []string{"unused", "stale"}, ids)
+}
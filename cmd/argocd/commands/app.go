@@ -47,7 +47,6 @@ import (
 	"github.com/vathsalashetty96/argo-cd/util/errors"
 	"github.com/vathsalashetty96/argo-cd/util/git"
 	argoio "github.com/vathsalashetty96/argo-cd/util/io"
-	argokube "github.com/vathsalashetty96/argo-cd/util/kube"
 	"github.com/vathsalashetty96/argo-cd/util/templates"
 	"github.com/vathsalashetty96/argo-cd/util/text/label"
 )
@@ -93,6 +92,7 @@ func NewApplicationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	command.AddCommand(NewApplicationPatchResourceCommand(clientOpts))
 	command.AddCommand(NewApplicationResourceActionsCommand(clientOpts))
 	command.AddCommand(NewApplicationListResourcesCommand(clientOpts))
+	command.AddCommand(NewApplicationLogsCommand(clientOpts))
 	return command
 }
 
@@ -227,6 +227,12 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 			case "wide", "":
 				aURL := appURL(acdClient, app.Name)
 				printAppSummaryTable(app, aURL, windows)
+				if len(app.Spec.DependsOn) > 0 {
+					fmt.Println()
+					w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+					printAppDependencies(w, appIf, app)
+					_ = w.Flush()
+				}
 
 				if len(app.Status.Conditions) > 0 {
 					fmt.Println()
@@ -352,6 +358,36 @@ func printAppSourceDetails(appSrc *argoappv1.ApplicationSource) {
 	}
 }
 
+// printAppDependencies prints the dependency chain of the application as a tree
+func printAppDependencies(w io.Writer, appIf applicationpkg.ApplicationServiceClient, app *argoappv1.Application) {
+	_, _ = fmt.Fprintf(w, "DEPENDENCY\tSYNC STATUS\tHEALTH STATUS\n")
+	path := map[string]bool{app.Name: true}
+	var printDeps func(deps []string, prefix string)
+	printDeps = func(deps []string, prefix string) {
+		for i, name := range deps {
+			branch, childPrefix := "├─ ", "│  "
+			if i == len(deps)-1 {
+				branch, childPrefix = "└─ ", "   "
+			}
+			if path[name] {
+				_, _ = fmt.Fprintf(w, "%s%s%s (cycle)\t\t\n", prefix, branch, name)
+				continue
+			}
+			depName := name
+			dep, err := appIf.Get(context.Background(), &applicationpkg.ApplicationQuery{Name: &depName})
+			if err != nil {
+				_, _ = fmt.Fprintf(w, "%s%s%s\t%s\t%s\n", prefix, branch, name, "Unknown", "Missing")
+				continue
+			}
+			_, _ = fmt.Fprintf(w, "%s%s%s\t%s\t%s\n", prefix, branch, name, dep.Status.Sync.Status, dep.Status.Health.Status)
+			path[name] = true
+			printDeps(dep.Spec.DependsOn, prefix+childPrefix)
+			delete(path, name)
+		}
+	}
+	printDeps(app.Spec.DependsOn, "")
+}
+
 func printAppConditions(w io.Writer, app *argoappv1.Application) {
 	_, _ = fmt.Fprintf(w, "CONDITION\tMESSAGE\tLAST TRANSITION\n")
 	for _, item := range app.Status.Conditions {
@@ -611,9 +647,9 @@ func liveObjects(resources []*argoappv1.ResourceDiff) ([]*unstructured.Unstructu
 	return objs, nil
 }
 
-func getLocalObjects(app *argoappv1.Application, local, localRepoRoot, appLabelKey, kubeVersion string, kustomizeOptions *argoappv1.KustomizeOptions,
+func getLocalObjects(app *argoappv1.Application, local, localRepoRoot, appLabelKey, trackingMethod, kubeVersion string, kustomizeOptions *argoappv1.KustomizeOptions,
 	configManagementPlugins []*argoappv1.ConfigManagementPlugin) []*unstructured.Unstructured {
-	manifestStrings := getLocalObjectsString(app, local, localRepoRoot, appLabelKey, kubeVersion, kustomizeOptions, configManagementPlugins)
+	manifestStrings := getLocalObjectsString(app, local, localRepoRoot, appLabelKey, trackingMethod, kubeVersion, kustomizeOptions, configManagementPlugins)
 	objs := make([]*unstructured.Unstructured, len(manifestStrings))
 	for i := range manifestStrings {
 		obj := unstructured.Unstructured{}
@@ -624,19 +660,20 @@ func getLocalObjects(app *argoappv1.Application, local, localRepoRoot, appLabelK
 	return objs
 }
 
-func getLocalObjectsString(app *argoappv1.Application, local, localRepoRoot, appLabelKey, kubeVersion string, kustomizeOptions *argoappv1.KustomizeOptions,
+func getLocalObjectsString(app *argoappv1.Application, local, localRepoRoot, appLabelKey, trackingMethod, kubeVersion string, kustomizeOptions *argoappv1.KustomizeOptions,
 	configManagementPlugins []*argoappv1.ConfigManagementPlugin) []string {
 
 	res, err := repository.GenerateManifests(local, localRepoRoot, app.Spec.Source.TargetRevision, &repoapiclient.ManifestRequest{
 		Repo:              &argoappv1.Repository{Repo: app.Spec.Source.RepoURL},
 		AppLabelKey:       appLabelKey,
+		TrackingMethod:    trackingMethod,
 		AppName:           app.Name,
 		Namespace:         app.Spec.Destination.Namespace,
 		ApplicationSource: &app.Spec.Source,
 		KustomizeOptions:  kustomizeOptions,
 		KubeVersion:       kubeVersion,
 		Plugins:           configManagementPlugins,
-	}, true)
+	}, nil, true, repository.ManifestGenerationLimits{})
 	errors.CheckError(err)
 
 	return res.Manifests
@@ -720,23 +757,10 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				defer argoio.Close(conn)
 				cluster, err := clusterIf.Get(context.Background(), &clusterpkg.ClusterQuery{Name: app.Spec.Destination.Name, Server: app.Spec.Destination.Server})
 				errors.CheckError(err)
-				localObjs := groupObjsByKey(getLocalObjects(app, local, localRepoRoot, argoSettings.AppLabelKey, cluster.ServerVersion, argoSettings.KustomizeOptions, argoSettings.ConfigManagementPlugins), liveObjs, app.Spec.Destination.Namespace)
+				localObjs := groupObjsByKey(getLocalObjects(app, local, localRepoRoot, argoSettings.AppLabelKey, argoSettings.TrackingMethod, cluster.ServerVersion, argoSettings.KustomizeOptions, argoSettings.ConfigManagementPlugins), liveObjs, app.Spec.Destination.Namespace)
 				items = groupObjsForDiff(resources, localObjs, items, argoSettings, appName)
 			} else if revision != "" {
-				var unstructureds []*unstructured.Unstructured
-				q := applicationpkg.ApplicationManifestQuery{
-					Name:     &appName,
-					Revision: revision,
-				}
-				res, err := appIf.GetManifests(context.Background(), &q)
-				errors.CheckError(err)
-				for _, mfst := range res.Manifests {
-					obj, err := argoappv1.UnmarshalToUnstructured(mfst)
-					errors.CheckError(err)
-					unstructureds = append(unstructureds, obj)
-				}
-				groupedObjs := groupObjsByKey(unstructureds, liveObjs, app.Spec.Destination.Namespace)
-				items = groupObjsForDiff(resources, groupedObjs, items, argoSettings, appName)
+				items = getRevisionDiffItems(appIf, app, revision, resources, liveObjs, argoSettings)
 			} else {
 				for i := range resources.Items {
 					res := resources.Items[i]
@@ -752,40 +776,7 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				}
 			}
 
-			foundDiffs := false
-			for _, item := range items {
-				if item.target != nil && hook.IsHook(item.target) || item.live != nil && hook.IsHook(item.live) {
-					continue
-				}
-				overrides := make(map[string]argoappv1.ResourceOverride)
-				for k := range argoSettings.ResourceOverrides {
-					val := argoSettings.ResourceOverrides[k]
-					overrides[k] = *val
-				}
-				normalizer, err := argo.NewDiffNormalizer(app.Spec.IgnoreDifferences, overrides)
-				errors.CheckError(err)
-
-				diffRes, err := diff.Diff(item.target, item.live, diff.WithNormalizer(normalizer))
-				errors.CheckError(err)
-
-				if diffRes.Modified || item.target == nil || item.live == nil {
-					fmt.Printf("===== %s/%s %s/%s ======\n", item.key.Group, item.key.Kind, item.key.Namespace, item.key.Name)
-					var live *unstructured.Unstructured
-					var target *unstructured.Unstructured
-					if item.target != nil && item.live != nil {
-						target = &unstructured.Unstructured{}
-						live = item.live
-						err = json.Unmarshal(diffRes.PredictedLive, target)
-						errors.CheckError(err)
-					} else {
-						live = item.live
-						target = item.target
-					}
-
-					foundDiffs = true
-					_ = cli.PrintDiff(item.key.Name, live, target)
-				}
-			}
+			foundDiffs := printManifestDiffs(app, items, argoSettings)
 			if foundDiffs {
 				os.Exit(1)
 			}
@@ -800,6 +791,64 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	return command
 }
 
+// getRevisionDiffItems returns the live objects paired with the manifests generated for the given revision
+func getRevisionDiffItems(appIf applicationpkg.ApplicationServiceClient, app *argoappv1.Application, revision string, resources *application.ManagedResourcesResponse, liveObjs []*unstructured.Unstructured, argoSettings *settings.Settings) []objKeyLiveTarget {
+	var unstructureds []*unstructured.Unstructured
+	q := applicationpkg.ApplicationManifestQuery{
+		Name:     &app.Name,
+		Revision: revision,
+	}
+	res, err := appIf.GetManifests(context.Background(), &q)
+	errors.CheckError(err)
+	for _, mfst := range res.Manifests {
+		obj, err := argoappv1.UnmarshalToUnstructured(mfst)
+		errors.CheckError(err)
+		unstructureds = append(unstructureds, obj)
+	}
+	groupedObjs := groupObjsByKey(unstructureds, liveObjs, app.Spec.Destination.Namespace)
+	return groupObjsForDiff(resources, groupedObjs, make([]objKeyLiveTarget, 0), argoSettings, app.Name)
+}
+
+// printManifestDiffs prints the normalized difference between the live and target state of each item and returns
+// true if any difference has been found
+func printManifestDiffs(app *argoappv1.Application, items []objKeyLiveTarget, argoSettings *settings.Settings) bool {
+	foundDiffs := false
+	for _, item := range items {
+		if item.target != nil && hook.IsHook(item.target) || item.live != nil && hook.IsHook(item.live) {
+			continue
+		}
+		overrides := make(map[string]argoappv1.ResourceOverride)
+		for k := range argoSettings.ResourceOverrides {
+			val := argoSettings.ResourceOverrides[k]
+			overrides[k] = *val
+		}
+		normalizer, err := argo.NewDiffNormalizer(app.Spec.IgnoreDifferences, overrides)
+		errors.CheckError(err)
+
+		diffRes, err := diff.Diff(item.target, item.live, diff.WithNormalizer(normalizer))
+		errors.CheckError(err)
+
+		if diffRes.Modified || item.target == nil || item.live == nil {
+			fmt.Printf("===== %s/%s %s/%s ======\n", item.key.Group, item.key.Kind, item.key.Namespace, item.key.Name)
+			var live *unstructured.Unstructured
+			var target *unstructured.Unstructured
+			if item.target != nil && item.live != nil {
+				target = &unstructured.Unstructured{}
+				live = item.live
+				err = json.Unmarshal(diffRes.PredictedLive, target)
+				errors.CheckError(err)
+			} else {
+				live = item.live
+				target = item.target
+			}
+
+			foundDiffs = true
+			_ = cli.PrintDiff(item.key.Name, live, target)
+		}
+	}
+	return foundDiffs
+}
+
 func groupObjsForDiff(resources *application.ManagedResourcesResponse, objs map[kube.ResourceKey]*unstructured.Unstructured, items []objKeyLiveTarget, argoSettings *settings.Settings, appName string) []objKeyLiveTarget {
 	for _, res := range resources.Items {
 		var live = &unstructured.Unstructured{}
@@ -814,7 +863,7 @@ func groupObjsForDiff(resources *application.ManagedResourcesResponse, objs map[
 		}
 		if local, ok := objs[key]; ok || live != nil {
 			if local != nil && !kube.IsCRD(local) {
-				err = argokube.SetAppInstanceLabel(local, argoSettings.AppLabelKey, appName)
+				err = argo.SetAppInstance(local, argoSettings.AppLabelKey, appName, key.Namespace, argo.TrackingMethod(argoSettings.TrackingMethod))
 				errors.CheckError(err)
 			}
 
@@ -1142,9 +1191,13 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		retryBackoffDuration    string
 		retryBackoffMaxDuration string
 		retryBackoffFactor      int64
+		retryOn                 []string
+		doNotRetryOn            []string
+		syncTimeout             string
 		local                   string
 		localRepoRoot           string
 		infos                   []string
+		preview                 bool
 	)
 	var command = &cobra.Command{
 		Use:   "sync [APPNAME... | -l selector]",
@@ -1163,7 +1216,10 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
   argocd app sync my-app --resource :Service:my-service
   argocd app sync my-app --resource vathsalashetty96.io:Rollout:my-rollout
   # Specify namespace if the application has resources with the same name in different namespaces
-  argocd app sync my-app --resource vathsalashetty96.io:Rollout:my-namespace/my-rollout`,
+  argocd app sync my-app --resource vathsalashetty96.io:Rollout:my-namespace/my-rollout
+
+  # Print the ordered list of sync tasks without syncing
+  argocd app sync my-app --prune --preview`,
 		Run: func(c *cobra.Command, args []string) {
 			if len(args) == 0 && selector == "" {
 				c.HelpFunc()(c, args)
@@ -1229,7 +1285,7 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				if local != "" {
 					app, err := appIf.Get(context.Background(), &applicationpkg.ApplicationQuery{Name: &appName})
 					errors.CheckError(err)
-					if app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.Automated != nil && !dryRun {
+					if app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.Automated != nil && !dryRun && !preview {
 						log.Fatal("Cannot use local sync when Automatic Sync Policy is enabled except with --dry-run")
 					}
 
@@ -1244,17 +1300,18 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 					cluster, err := clusterIf.Get(context.Background(), &clusterpkg.ClusterQuery{Name: app.Spec.Destination.Name, Server: app.Spec.Destination.Server})
 					errors.CheckError(err)
 					argoio.Close(conn)
-					localObjsStrings = getLocalObjectsString(app, local, localRepoRoot, argoSettings.AppLabelKey, cluster.ServerVersion, argoSettings.KustomizeOptions, argoSettings.ConfigManagementPlugins)
+					localObjsStrings = getLocalObjectsString(app, local, localRepoRoot, argoSettings.AppLabelKey, argoSettings.TrackingMethod, cluster.ServerVersion, argoSettings.KustomizeOptions, argoSettings.ConfigManagementPlugins)
 				}
 
 				syncReq := applicationpkg.ApplicationSyncRequest{
-					Name:      &appName,
-					DryRun:    dryRun,
-					Revision:  revision,
-					Resources: selectedResources,
-					Prune:     prune,
-					Manifests: localObjsStrings,
-					Infos:     getInfos(infos),
+					Name:        &appName,
+					DryRun:      dryRun,
+					Revision:    revision,
+					Resources:   selectedResources,
+					Prune:       prune,
+					Manifests:   localObjsStrings,
+					Infos:       getInfos(infos),
+					SyncTimeout: syncTimeout,
 				}
 				switch strategy {
 				case "apply":
@@ -1274,9 +1331,17 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 							MaxDuration: retryBackoffMaxDuration,
 							Factor:      pointer.Int64Ptr(retryBackoffFactor),
 						},
+						RetryOn:      toSyncFailureClasses(retryOn),
+						DoNotRetryOn: toSyncFailureClasses(doNotRetryOn),
 					}
 				}
 				ctx := context.Background()
+				if preview {
+					plan, err := appIf.SyncPlan(ctx, &syncReq)
+					errors.CheckError(err)
+					printSyncPlan(appName, plan)
+					continue
+				}
 				_, err := appIf.Sync(ctx, &syncReq)
 				errors.CheckError(err)
 
@@ -1310,15 +1375,38 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().StringVar(&retryBackoffDuration, "retry-backoff-duration", fmt.Sprintf("%ds", common.DefaultSyncRetryDuration/time.Second), "Retry backoff base duration. Default unit is seconds, but could also be a duration (e.g. 2m, 1h)")
 	command.Flags().StringVar(&retryBackoffMaxDuration, "retry-backoff-max-duration", fmt.Sprintf("%ds", common.DefaultSyncRetryMaxDuration/time.Second), "Max retry backoff duration. Default unit is seconds, but could also be a duration (e.g. 2m, 1h)")
 	command.Flags().Int64Var(&retryBackoffFactor, "retry-backoff-factor", common.DefaultSyncRetryFactor, "Factor multiplies the base duration after each failed retry")
+	command.Flags().StringArrayVar(&retryOn, "retry-on", []string{}, "Only retry failures of the given class (one of: Transient|Conflict|Validation|RBAC|HookFailed|Unknown). This option may be specified repeatedly")
+	command.Flags().StringArrayVar(&doNotRetryOn, "do-not-retry-on", []string{}, "Never retry failures of the given class (one of: Transient|Conflict|Validation|RBAC|HookFailed|Unknown). This option may be specified repeatedly")
+	command.Flags().StringVar(&syncTimeout, "sync-timeout", "", "Terminate the sync attempt if it takes longer than this duration. Default unit is seconds, but could also be a duration (e.g. 2m, 1h)")
 	command.Flags().StringVar(&strategy, "strategy", "", "Sync strategy (one of: apply|hook)")
 	command.Flags().BoolVar(&force, "force", false, "Use a force apply")
 	command.Flags().BoolVar(&async, "async", false, "Do not wait for application to sync before continuing")
 	command.Flags().StringVar(&local, "local", "", "Path to a local directory. When this flag is present no git queries will be made")
 	command.Flags().StringVar(&localRepoRoot, "local-repo-root", "/", "Path to the repository root. Used together with --local allows setting the repository root")
 	command.Flags().StringArrayVar(&infos, "info", []string{}, "A list of key-value pairs during sync process. These infos will be persisted in app.")
+	command.Flags().BoolVar(&preview, "preview", false, "Print the ordered list of tasks the sync would execute without syncing")
 	return command
 }
 
+// printSyncPlan prints the ordered list of sync tasks
+func printSyncPlan(appName string, plan *applicationpkg.ApplicationSyncPlanResponse) {
+	fmt.Printf("Name:               %s\n", appName)
+	if plan.Revision != "" {
+		fmt.Printf("Revision:           %s\n", plan.Revision)
+	}
+	fmt.Println()
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "PHASE\tWAVE\tHOOK\tACTION\tGROUP\tKIND\tNAMESPACE\tNAME\tSKIPPED\tMESSAGE\n")
+	for _, task := range plan.Tasks {
+		skipped := ""
+		if task.Skipped {
+			skipped = "Yes"
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", task.Phase, task.Wave, task.HookType, task.Action, task.Group, task.Kind, task.Namespace, task.Name, skipped, task.Message)
+	}
+	_ = w.Flush()
+}
+
 // ResourceDiff tracks the state of a resource when waiting on an application status.
 type resourceState struct {
 	Group     string
@@ -1685,42 +1773,78 @@ func NewApplicationHistoryCommand(clientOpts *argocdclient.ClientOptions) *cobra
 // NewApplicationRollbackCommand returns a new instance of an `argocd app rollback` command
 func NewApplicationRollbackCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		prune   bool
-		timeout uint
+		prune    bool
+		timeout  uint
+		revision string
+		showDiff bool
 	)
 	var command = &cobra.Command{
-		Use:   "rollback APPNAME ID",
-		Short: "Rollback application to a previous deployed version by History ID",
+		Use:   "rollback APPNAME [ID]",
+		Short: "Rollback application to a previous deployed version by History ID or to an arbitrary revision",
+		Example: `  # Rollback an app to a deployment from the history
+  argocd app rollback my-app 3
+
+  # Rollback an app to a git commit SHA, tag or Helm chart version
+  argocd app rollback my-app --revision v1.2.0
+
+  # Show the difference between the live state and the rollback target without rolling back
+  argocd app rollback my-app 3 --diff`,
 		Run: func(c *cobra.Command, args []string) {
-			if len(args) != 2 {
+			if len(args) < 1 || len(args) > 2 || (len(args) == 2) == (revision != "") {
 				c.HelpFunc()(c, args)
 				os.Exit(1)
 			}
 			appName := args[0]
-			depID, err := strconv.Atoi(args[1])
-			errors.CheckError(err)
 			acdClient := argocdclient.NewClientOrDie(clientOpts)
 			conn, appIf := acdClient.NewApplicationClientOrDie()
 			defer argoio.Close(conn)
 			ctx := context.Background()
 			app, err := appIf.Get(ctx, &applicationpkg.ApplicationQuery{Name: &appName})
 			errors.CheckError(err)
-			var depInfo *argoappv1.RevisionHistory
-			for _, di := range app.Status.History {
-				if di.ID == int64(depID) {
-					depInfo = &di
-					break
+
+			rollbackReq := applicationpkg.ApplicationRollbackRequest{
+				Name:     &appName,
+				Prune:    prune,
+				Revision: revision,
+			}
+			targetRevision := revision
+			if revision == "" {
+				depID, err := strconv.Atoi(args[1])
+				errors.CheckError(err)
+				var depInfo *argoappv1.RevisionHistory
+				for _, di := range app.Status.History {
+					if di.ID == int64(depID) {
+						depInfo = &di
+						break
+					}
+				}
+				if depInfo == nil {
+					log.Fatalf("Application '%s' does not have deployment id '%d' in history\n", app.ObjectMeta.Name, depID)
+				}
+				rollbackReq.ID = int64(depID)
+				targetRevision = depInfo.Revision
+				if showDiff && !depInfo.Source.Equals(app.Spec.Source) {
+					log.Warnf("Deployment '%d' used a different source, the diff is generated using the current source", depID)
 				}
 			}
-			if depInfo == nil {
-				log.Fatalf("Application '%s' does not have deployment id '%d' in history\n", app.ObjectMeta.Name, depID)
+
+			if showDiff {
+				resources, err := appIf.ManagedResources(ctx, &applicationpkg.ResourcesQuery{ApplicationName: &appName})
+				errors.CheckError(err)
+				liveObjs, err := liveObjects(resources.Items)
+				errors.CheckError(err)
+				conn, settingsIf := acdClient.NewSettingsClientOrDie()
+				defer argoio.Close(conn)
+				argoSettings, err := settingsIf.Get(ctx, &settingspkg.SettingsQuery{})
+				errors.CheckError(err)
+				items := getRevisionDiffItems(appIf, app, targetRevision, resources, liveObjs, argoSettings)
+				if !printManifestDiffs(app, items, argoSettings) {
+					fmt.Println("No differences found")
+				}
+				return
 			}
 
-			_, err = appIf.Rollback(ctx, &applicationpkg.ApplicationRollbackRequest{
-				Name:  &appName,
-				ID:    int64(depID),
-				Prune: prune,
-			})
+			_, err = appIf.Rollback(ctx, &rollbackReq)
 			errors.CheckError(err)
 
 			_, err = waitOnApplicationStatus(acdClient, appName, timeout, false, false, true, false, nil)
@@ -1729,6 +1853,8 @@ func NewApplicationRollbackCommand(clientOpts *argocdclient.ClientOptions) *cobr
 	}
 	command.Flags().BoolVar(&prune, "prune", false, "Allow deleting unexpected resources")
 	command.Flags().UintVar(&timeout, "timeout", defaultCheckTimeoutSeconds, "Time out after this many seconds")
+	command.Flags().StringVar(&revision, "revision", "", "Rollback to a git commit SHA, tag, branch or Helm chart version instead of a History ID")
+	command.Flags().BoolVar(&showDiff, "diff", false, "Show the difference between the live state and the rollback target without rolling back")
 	return command
 }
 
@@ -1756,6 +1882,34 @@ func printOperationResult(opState *argoappv1.OperationState) {
 	if opState.Message != "" {
 		fmt.Printf(printOpFmtStr, "Message:", opState.Message)
 	}
+	if len(opState.FailureClasses) > 0 {
+		fmt.Printf(printOpFmtStr, "Failure Classes:", formatFailureClasses(opState.FailureClasses))
+	}
+}
+
+// toSyncFailureClasses converts the failure classes given on the command line, exiting on unknown classes
+func toSyncFailureClasses(names []string) []argoappv1.SyncFailureClass {
+	var classes []argoappv1.SyncFailureClass
+	for _, name := range names {
+		class := argoappv1.SyncFailureClass(name)
+		switch class {
+		case argoappv1.SyncFailureClassTransient, argoappv1.SyncFailureClassConflict, argoappv1.SyncFailureClassValidation,
+			argoappv1.SyncFailureClassRBAC, argoappv1.SyncFailureClassHookFailed, argoappv1.SyncFailureClassUnknown:
+			classes = append(classes, class)
+		default:
+			log.Fatalf("Unknown failure class: '%s'", name)
+		}
+	}
+	return classes
+}
+
+// formatFailureClasses returns the comma separated failure classes
+func formatFailureClasses(classes []argoappv1.SyncFailureClass) string {
+	names := make([]string, len(classes))
+	for i, class := range classes {
+		names[i] = string(class)
+	}
+	return strings.Join(names, ", ")
 }
 
 // NewApplicationManifestsCommand returns a new instance of an `argocd app manifests` command
@@ -1924,6 +2078,93 @@ func NewApplicationListResourcesCommand(clientOpts *argocdclient.ClientOptions)
 	return command
 }
 
+// NewApplicationLogsCommand returns a new instance of an `argocd app logs` command
+func NewApplicationLogsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		podName       string
+		group         string
+		kind          string
+		resourceName  string
+		namespace     string
+		labelSelector string
+		container     string
+		follow        bool
+		previous      bool
+		sinceSeconds  int64
+		tailLines     int64
+		filter        string
+	)
+	var command = &cobra.Command{
+		Use:   "logs APPNAME",
+		Short: "Print the logs of the pods of an application",
+		Long:  "Print the merged logs of all containers of the pods of an application, optionally limited to the pods owned by a resource or matching a label selector. Every line is prefixed by the pod and container it was logged by.",
+		Example: `
+	# Print the logs of all pods of an application
+	argocd app logs guestbook
+
+	# Follow the logs of all pods of a Deployment, including pods which are created while following
+	argocd app logs guestbook --kind Deployment --name guestbook --follow
+
+	# Print the last 100 lines of the previous instance of the web containers of the pods matching a label selector
+	argocd app logs guestbook --selector tier=frontend --container web --tail 100 --previous
+
+	# Print the lines containing 'error' of a single pod
+	argocd app logs guestbook --pod guestbook-6f8b9c7d5-x2k4q --filter error`,
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName := args[0]
+			query := applicationpkg.ApplicationPodLogsQuery{
+				Name:          &appName,
+				Namespace:     namespace,
+				Group:         group,
+				Kind:          kind,
+				ResourceName:  resourceName,
+				LabelSelector: labelSelector,
+				Container:     container,
+				Follow:        follow,
+				Previous:      previous,
+				SinceSeconds:  sinceSeconds,
+				TailLines:     tailLines,
+				Filter:        filter,
+			}
+			if podName != "" {
+				query.PodName = &podName
+			}
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer argoio.Close(conn)
+			stream, err := appIf.PodLogs(context.Background(), &query)
+			errors.CheckError(err)
+			for {
+				entry, err := stream.Recv()
+				if err == io.EOF {
+					return
+				}
+				errors.CheckError(err)
+				if entry.Last {
+					return
+				}
+				fmt.Printf("[%s/%s] %s\n", entry.PodName, entry.Container, entry.Content)
+			}
+		},
+	}
+	command.Flags().StringVar(&podName, "pod", "", "Print the logs of the pod with the given name")
+	command.Flags().StringVar(&group, "group", "", "Group of the resource owning the pods")
+	command.Flags().StringVar(&kind, "kind", "", "Kind of the resource owning the pods, e.g. Deployment, StatefulSet or Job")
+	command.Flags().StringVar(&resourceName, "name", "", "Name of the resource owning the pods")
+	command.Flags().StringVar(&namespace, "namespace", "", "Namespace of the pods or the resource owning them")
+	command.Flags().StringVarP(&labelSelector, "selector", "l", "", "Label selector the pods need to match")
+	command.Flags().StringVarP(&container, "container", "c", "", "Print the logs of the container with the given name, defaults to all containers")
+	command.Flags().BoolVarP(&follow, "follow", "f", false, "Follow the logs")
+	command.Flags().BoolVarP(&previous, "previous", "p", false, "Print the logs of the previous instance of the containers")
+	command.Flags().Int64Var(&sinceSeconds, "since-seconds", 0, "Only print logs newer than a relative duration in seconds")
+	command.Flags().Int64Var(&tailLines, "tail", 0, "Number of lines to print from the end of the logs of every container, defaults to all lines")
+	command.Flags().StringVar(&filter, "filter", "", "Only print lines containing the given text, or not containing it if prefixed with '!'")
+	return command
+}
+
 func NewApplicationPatchCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var patch string
 	var patchType string
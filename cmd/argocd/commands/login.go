@@ -34,11 +34,12 @@ import (
 // NewLoginCommand returns a new instance of `argocd login` command
 func NewLoginCommand(globalClientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		ctxName  string
-		username string
-		password string
-		sso      bool
-		ssoPort  int
+		ctxName   string
+		username  string
+		password  string
+		sso       bool
+		ssoPort   int
+		ssoDevice bool
 	)
 	var command = &cobra.Command{
 		Use:   "login SERVER",
@@ -100,7 +101,7 @@ func NewLoginCommand(globalClientOpts *argocdclient.ClientOptions) *cobra.Comman
 			// Perform the login
 			var tokenString string
 			var refreshToken string
-			if !sso {
+			if !sso && !ssoDevice {
 				tokenString = passwordLogin(acdClient, username, password)
 			} else {
 				ctx := context.Background()
@@ -111,7 +112,11 @@ func NewLoginCommand(globalClientOpts *argocdclient.ClientOptions) *cobra.Comman
 				errors.CheckError(err)
 				oauth2conf, provider, err := acdClient.OIDCConfig(ctx, acdSet)
 				errors.CheckError(err)
-				tokenString, refreshToken = oauth2Login(ctx, ssoPort, acdSet.GetOIDCConfig(), oauth2conf, provider)
+				if ssoDevice {
+					tokenString, refreshToken = deviceCodeLogin(ctx, httpClient, oauth2conf, provider)
+				} else {
+					tokenString, refreshToken = oauth2Login(ctx, ssoPort, acdSet.GetOIDCConfig(), oauth2conf, provider)
+				}
 			}
 
 			parser := &jwt.Parser{
@@ -159,6 +164,7 @@ func NewLoginCommand(globalClientOpts *argocdclient.ClientOptions) *cobra.Comman
 	command.Flags().StringVar(&password, "password", "", "the password of an account to authenticate")
 	command.Flags().BoolVar(&sso, "sso", false, "perform SSO login")
 	command.Flags().IntVar(&ssoPort, "sso-port", DefaultSSOLocalPort, "port to run local OAuth2 login application")
+	command.Flags().BoolVar(&ssoDevice, "sso-device-code", false, "perform SSO login using the device authorization grant, which does not require a local browser (e.g. over SSH or in a container)")
 	return command
 }
 
@@ -308,6 +314,33 @@ func oauth2Login(ctx context.Context, port int, oidcSettings *settingspkg.OIDCCo
 	return tokenString, refreshToken
 }
 
+// deviceCodeLogin performs the OAuth2 device authorization grant (RFC 8628), which lets the user authorize the CLI using
+// a browser on any device, and returns the JWT token and a refresh token (if supported)
+func deviceCodeLogin(ctx context.Context, httpClient *http.Client, oauth2conf *oauth2.Config, provider *oidc.Provider) (string, string) {
+	oidcConf, err := oidcutil.ParseConfig(provider)
+	errors.CheckError(err)
+	if oidcConf.DeviceAuthorizationEndpoint == "" {
+		log.Fatalf("OIDC provider %s does not support the device authorization grant", oidcConf.Issuer)
+	}
+	auth, err := oidcutil.RequestDeviceAuthorization(ctx, httpClient, oidcConf.DeviceAuthorizationEndpoint, oauth2conf)
+	errors.CheckError(err)
+	if auth.VerificationURIComplete != "" {
+		fmt.Printf("To authenticate, visit %s and confirm the code %s\n", auth.VerificationURIComplete, auth.UserCode)
+	} else {
+		fmt.Printf("To authenticate, visit %s and enter the code %s\n", auth.VerificationURI, auth.UserCode)
+	}
+	tok, err := oidcutil.PollDeviceToken(ctx, httpClient, oauth2conf, auth)
+	errors.CheckError(err)
+	tokenString, ok := tok.Extra("id_token").(string)
+	if !ok || tokenString == "" {
+		log.Fatal("no id_token in token response")
+	}
+	fmt.Printf("Authentication successful\n")
+	log.Debugf("Token: %s", tokenString)
+	log.Debugf("Refresh Token: %s", tok.RefreshToken)
+	return tokenString, tok.RefreshToken
+}
+
 func passwordLogin(acdClient argocdclient.Client, username, password string) string {
 	username, password = cli.PromptCredentials(username, password)
 	sessConn, sessionIf := acdClient.NewSessionClientOrDie()
@@ -1,14 +1,18 @@
 package commands
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 
 	argocdclient "github.com/vathsalashetty96/argo-cd/pkg/apiclient"
+	sessionpkg "github.com/vathsalashetty96/argo-cd/pkg/apiclient/session"
 	"github.com/vathsalashetty96/argo-cd/util/errors"
+	"github.com/vathsalashetty96/argo-cd/util/io"
 	"github.com/vathsalashetty96/argo-cd/util/localconfig"
 )
 
@@ -17,13 +21,13 @@ func NewLogoutCommand(globalClientOpts *argocdclient.ClientOptions) *cobra.Comma
 	var command = &cobra.Command{
 		Use:   "logout CONTEXT",
 		Short: "Log out from Argo CD",
-		Long:  "Log out from Argo CD",
+		Long:  "Log out from Argo CD. The session is revoked on the Argo CD server and the token is removed from the local config.",
 		Run: func(c *cobra.Command, args []string) {
 			if len(args) == 0 {
 				c.HelpFunc()(c, args)
 				os.Exit(1)
 			}
-			context := args[0]
+			contextName := args[0]
 
 			localCfg, err := localconfig.ReadLocalConfig(globalClientOpts.ConfigPath)
 			errors.CheckError(err)
@@ -31,9 +35,12 @@ func NewLogoutCommand(globalClientOpts *argocdclient.ClientOptions) *cobra.Comma
 				log.Fatalf("Nothing to logout from")
 			}
 
-			ok := localCfg.RemoveToken(context)
+			if err := revokeSession(globalClientOpts, contextName); err != nil {
+				log.Warnf("Failed to revoke session on the server: %v", err)
+			}
+			ok := localCfg.RemoveToken(contextName)
 			if !ok {
-				log.Fatalf("Context %s does not exist", context)
+				log.Fatalf("Context %s does not exist", contextName)
 			}
 
 			err = localconfig.ValidateLocalConfig(*localCfg)
@@ -43,8 +50,28 @@ func NewLogoutCommand(globalClientOpts *argocdclient.ClientOptions) *cobra.Comma
 			err = localconfig.WriteLocalConfig(*localCfg, globalClientOpts.ConfigPath)
 			errors.CheckError(err)
 
-			fmt.Printf("Logged out from '%s'\n", context)
+			fmt.Printf("Logged out from '%s'\n", contextName)
 		},
 	}
 	return command
 }
+
+// revokeSession revokes the session of the given context on the server, so that its token cannot be used anymore
+// even if it was copied
+func revokeSession(globalClientOpts *argocdclient.ClientOptions, contextName string) error {
+	clientOpts := *globalClientOpts
+	clientOpts.Context = contextName
+	client, err := argocdclient.NewClient(&clientOpts)
+	if err != nil {
+		return err
+	}
+	closer, sessionIf, err := client.NewSessionClient()
+	if err != nil {
+		return err
+	}
+	defer io.Close(closer)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, err = sessionIf.Delete(ctx, &sessionpkg.SessionDeleteRequest{})
+	return err
+}
@@ -141,6 +141,8 @@ func NewProjectSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 					proj.Spec.SourceRepos = opts.Sources
 				case "signature-keys":
 					proj.Spec.SignatureKeys = opts.GetSignatureKeys()
+				case "helm-value-url":
+					proj.Spec.HelmValueURLs = opts.HelmValueURLs
 				case "orphaned-resources", "orphaned-resources-warn":
 					proj.Spec.OrphanedResources = cmdutil.GetOrphanedResourcesSettings(c, opts)
 				}
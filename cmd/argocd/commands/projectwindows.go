@@ -3,11 +3,15 @@ package commands
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"text/tabwriter"
+	"time"
 
+	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 
 	argocdclient "github.com/vathsalashetty96/argo-cd/pkg/apiclient"
@@ -32,6 +36,7 @@ func NewProjectWindowsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 	roleCommand.AddCommand(NewProjectWindowsAddWindowCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectWindowsDeleteCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectWindowsListCommand(clientOpts))
+	roleCommand.AddCommand(NewProjectWindowsPreviewCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectWindowsUpdateCommand(clientOpts))
 	return roleCommand
 }
@@ -116,6 +121,8 @@ func NewProjectWindowsAddWindowCommand(clientOpts *argocdclient.ClientOptions) *
 		namespaces   []string
 		clusters     []string
 		manualSync   bool
+		timeZone     string
+		calendarFile string
 	)
 	var command = &cobra.Command{
 		Use:   "add PROJECT",
@@ -132,7 +139,8 @@ func NewProjectWindowsAddWindowCommand(clientOpts *argocdclient.ClientOptions) *
 			proj, err := projIf.Get(context.Background(), &projectpkg.ProjectQuery{Name: projName})
 			errors.CheckError(err)
 
-			err = proj.Spec.AddWindow(kind, schedule, duration, applications, namespaces, clusters, manualSync)
+			calendar := readCalendarFile(calendarFile)
+			err = proj.Spec.AddWindow(kind, schedule, duration, applications, namespaces, clusters, manualSync, timeZone, calendar)
 			errors.CheckError(err)
 
 			_, err = projIf.Update(context.Background(), &projectpkg.ProjectUpdateRequest{Project: proj})
@@ -146,10 +154,22 @@ func NewProjectWindowsAddWindowCommand(clientOpts *argocdclient.ClientOptions) *
 	command.Flags().StringSliceVar(&namespaces, "namespaces", []string{}, "Namespaces that the schedule will be applied to. Comma separated, wildcards supported (e.g. --namespaces default,\\*-prod)")
 	command.Flags().StringSliceVar(&clusters, "clusters", []string{}, "Clusters that the schedule will be applied to. Comma separated, wildcards supported (e.g. --clusters prod,staging)")
 	command.Flags().BoolVar(&manualSync, "manual-sync", false, "Allow manual syncs for both deny and allow windows")
+	command.Flags().StringVar(&timeZone, "time-zone", "", "Time zone of the sync window schedule, defaults to UTC. (e.g. --time-zone Europe/Berlin)")
+	command.Flags().StringVar(&calendarFile, "calendar-file", "", "Path to an iCalendar (RFC 5545) file whose events define when the window is open. Replaces schedule and duration")
 
 	return command
 }
 
+// readCalendarFile returns the content of the iCalendar file, or an empty string if the path is empty
+func readCalendarFile(path string) string {
+	if path == "" {
+		return ""
+	}
+	data, err := ioutil.ReadFile(path)
+	errors.CheckError(err)
+	return string(data)
+}
+
 // NewProjectWindowsAddWindowCommand returns a new instance of an `argocd proj windows delete` command
 func NewProjectWindowsDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var command = &cobra.Command{
@@ -189,6 +209,8 @@ func NewProjectWindowsUpdateCommand(clientOpts *argocdclient.ClientOptions) *cob
 		applications []string
 		namespaces   []string
 		clusters     []string
+		timeZone     string
+		calendarFile string
 	)
 	var command = &cobra.Command{
 		Use:   "update PROJECT ID",
@@ -210,9 +232,10 @@ func NewProjectWindowsUpdateCommand(clientOpts *argocdclient.ClientOptions) *cob
 			proj, err := projIf.Get(context.Background(), &projectpkg.ProjectQuery{Name: projName})
 			errors.CheckError(err)
 
+			calendar := readCalendarFile(calendarFile)
 			for i, window := range proj.Spec.SyncWindows {
 				if id == i {
-					err := window.Update(schedule, duration, applications, namespaces, clusters)
+					err := window.Update(schedule, duration, applications, namespaces, clusters, timeZone, calendar)
 					if err != nil {
 						errors.CheckError(err)
 					}
@@ -228,6 +251,8 @@ func NewProjectWindowsUpdateCommand(clientOpts *argocdclient.ClientOptions) *cob
 	command.Flags().StringSliceVar(&applications, "applications", []string{}, "Applications that the schedule will be applied to. Comma separated, wildcards supported (e.g. --applications prod-\\*,website)")
 	command.Flags().StringSliceVar(&namespaces, "namespaces", []string{}, "Namespaces that the schedule will be applied to. Comma separated, wildcards supported (e.g. --namespaces default,\\*-prod)")
 	command.Flags().StringSliceVar(&clusters, "clusters", []string{}, "Clusters that the schedule will be applied to. Comma separated, wildcards supported (e.g. --clusters prod,staging)")
+	command.Flags().StringVar(&timeZone, "time-zone", "", "Time zone of the sync window schedule. (e.g. --time-zone Europe/Berlin)")
+	command.Flags().StringVar(&calendarFile, "calendar-file", "", "Path to an iCalendar (RFC 5545) file whose events define when the window is open. Replaces schedule and duration")
 	return command
 }
 
@@ -265,21 +290,94 @@ func NewProjectWindowsListCommand(clientOpts *argocdclient.ClientOptions) *cobra
 	return command
 }
 
+// NewProjectWindowsPreviewCommand returns a new instance of an `argocd proj windows preview` command
+func NewProjectWindowsPreviewCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		count int
+	)
+	var command = &cobra.Command{
+		Use:   "preview PROJECT",
+		Short: "Preview upcoming project sync windows",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			conn, projIf := argocdclient.NewClientOrDie(clientOpts).NewProjectClientOrDie()
+			defer io.Close(conn)
+
+			proj, err := projIf.Get(context.Background(), &projectpkg.ProjectQuery{Name: projName})
+			errors.CheckError(err)
+			printUpcomingSyncWindows(proj, time.Now(), count)
+		},
+	}
+	command.Flags().IntVar(&count, "count", 10, "Maximum number of upcoming windows to show")
+	return command
+}
+
+// Print table of upcoming sync window periods ordered by start time
+func printUpcomingSyncWindows(proj *v1alpha1.AppProject, currentTime time.Time, count int) {
+	type upcomingWindow struct {
+		id     int
+		window *v1alpha1.SyncWindow
+		start  time.Time
+		end    time.Time
+	}
+	var upcoming []upcomingWindow
+	for i, window := range proj.Spec.SyncWindows {
+		periods, err := window.Upcoming(currentTime, count)
+		if err != nil {
+			log.Warnf("Failed to evaluate sync window %d: %v", i, err)
+			continue
+		}
+		for _, period := range periods {
+			upcoming = append(upcoming, upcomingWindow{id: i, window: window, start: period.Start, end: period.End})
+		}
+	}
+	sort.SliceStable(upcoming, func(i, j int) bool {
+		return upcoming[i].start.Before(upcoming[j].start)
+	})
+	if len(upcoming) > count {
+		upcoming = upcoming[:count]
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmtStr := "%s\t%s\t%s\t%s\t%s\t%s\n"
+	fmt.Fprintf(w, fmtStr, "ID", "KIND", "START", "END", "APPLICATIONS", "MANUALSYNC")
+	for _, u := range upcoming {
+		fmt.Fprintf(w, fmtStr,
+			strconv.Itoa(u.id),
+			u.window.Kind,
+			u.start.Format("2006-01-02 15:04 MST"),
+			u.end.Format("2006-01-02 15:04 MST"),
+			formatListOutput(u.window.Applications),
+			formatManualOutput(u.window.ManualSync),
+		)
+	}
+	_ = w.Flush()
+}
+
 // Print table of sync window data
 func printSyncWindows(proj *v1alpha1.AppProject) {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 	var fmtStr string
-	headers := []interface{}{"ID", "STATUS", "KIND", "SCHEDULE", "DURATION", "APPLICATIONS", "NAMESPACES", "CLUSTERS", "MANUALSYNC"}
-	fmtStr = "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n"
+	headers := []interface{}{"ID", "STATUS", "KIND", "SCHEDULE", "DURATION", "TIMEZONE", "APPLICATIONS", "NAMESPACES", "CLUSTERS", "MANUALSYNC"}
+	fmtStr = "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n"
 	fmt.Fprintf(w, fmtStr, headers...)
 	if proj.Spec.SyncWindows.HasWindows() {
 		for i, window := range proj.Spec.SyncWindows {
+			schedule := window.Schedule
+			if window.Calendar != "" {
+				schedule = "calendar"
+			}
 			vals := []interface{}{
 				strconv.Itoa(i),
 				formatBoolOutput(window.Active()),
 				window.Kind,
-				window.Schedule,
-				window.Duration,
+				schedule,
+				formatStringOutput(window.Duration),
+				formatStringOutput(window.TimeZone),
 				formatListOutput(window.Applications),
 				formatListOutput(window.Namespaces),
 				formatListOutput(window.Clusters),
@@ -300,6 +398,12 @@ func formatListOutput(list []string) string {
 	}
 	return o
 }
+func formatStringOutput(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
 func formatBoolOutput(active bool) string {
 	var o string
 	if active {
@@ -8,6 +8,7 @@ import (
 	"github.com/coreos/go-oidc"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"golang.org/x/oauth2"
 
 	argocdclient "github.com/vathsalashetty96/argo-cd/pkg/apiclient"
 	settingspkg "github.com/vathsalashetty96/argo-cd/pkg/apiclient/settings"
@@ -20,13 +21,14 @@ import (
 // NewReloginCommand returns a new instance of `argocd relogin` command
 func NewReloginCommand(globalClientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		password string
-		ssoPort  int
+		password  string
+		ssoPort   int
+		ssoDevice bool
 	)
 	var command = &cobra.Command{
 		Use:   "relogin",
 		Short: "Refresh an expired authenticate token",
-		Long:  "Refresh an expired authenticate token",
+		Long:  "Refresh an expired authenticate token. SSO tokens are refreshed silently using the stored refresh token if possible, otherwise SSO login is reinitiated.",
 		Run: func(c *cobra.Command, args []string) {
 			if len(args) != 0 {
 				c.HelpFunc()(c, args)
@@ -58,7 +60,6 @@ func NewReloginCommand(globalClientOpts *argocdclient.ClientOptions) *cobra.Comm
 				fmt.Printf("Relogging in as '%s'\n", claims.Subject)
 				tokenString = passwordLogin(acdClient, claims.Subject, password)
 			} else {
-				fmt.Println("Reinitiating SSO login")
 				setConn, setIf := acdClient.NewSettingsClientOrDie()
 				defer argoio.Close(setConn)
 				ctx := context.Background()
@@ -69,7 +70,22 @@ func NewReloginCommand(globalClientOpts *argocdclient.ClientOptions) *cobra.Comm
 				errors.CheckError(err)
 				oauth2conf, provider, err := acdClient.OIDCConfig(ctx, acdSet)
 				errors.CheckError(err)
-				tokenString, refreshToken = oauth2Login(ctx, ssoPort, acdSet.GetOIDCConfig(), oauth2conf, provider)
+				if configCtx.User.RefreshToken != "" {
+					tokenString, refreshToken, err = refreshLogin(ctx, oauth2conf, configCtx.User.RefreshToken)
+					if err == nil {
+						fmt.Println("Refreshed SSO token")
+					} else {
+						log.Warnf("Unable to refresh token: %v", err)
+					}
+				}
+				if tokenString == "" {
+					fmt.Println("Reinitiating SSO login")
+					if ssoDevice {
+						tokenString, refreshToken = deviceCodeLogin(ctx, httpClient, oauth2conf, provider)
+					} else {
+						tokenString, refreshToken = oauth2Login(ctx, ssoPort, acdSet.GetOIDCConfig(), oauth2conf, provider)
+					}
+				}
 			}
 
 			localCfg.UpsertUser(localconfig.User{
@@ -84,5 +100,23 @@ func NewReloginCommand(globalClientOpts *argocdclient.ClientOptions) *cobra.Comm
 	}
 	command.Flags().StringVar(&password, "password", "", "the password of an account to authenticate")
 	command.Flags().IntVar(&ssoPort, "sso-port", DefaultSSOLocalPort, "port to run local OAuth2 login application")
+	command.Flags().BoolVar(&ssoDevice, "sso-device-code", false, "reinitiate SSO login using the device authorization grant, which does not require a local browser")
 	return command
 }
+
+// refreshLogin redeems the refresh token for a new JWT token and refresh token. The refresh token is kept if the
+// provider does not issue a new one.
+func refreshLogin(ctx context.Context, oauth2conf *oauth2.Config, refreshToken string) (string, string, error) {
+	token, err := oauth2conf.TokenSource(ctx, &oauth2.Token{RefreshToken: refreshToken}).Token()
+	if err != nil {
+		return "", "", err
+	}
+	tokenString, ok := token.Extra("id_token").(string)
+	if !ok || tokenString == "" {
+		return "", "", fmt.Errorf("no id_token in token response")
+	}
+	if token.RefreshToken != "" {
+		refreshToken = token.RefreshToken
+	}
+	return tokenString, refreshToken, nil
+}
@@ -39,9 +39,18 @@ type AppOptions struct {
 	helmSetStrings             []string
 	helmSetFiles               []string
 	helmVersion                string
+	helmSkipCrds               bool
+	helmPassCredentials        bool
+	ignoreMissingValueFiles    bool
+	helmKubeVersion            string
+	helmAPIVersions            []string
+	helmPostRendererKustomize  string
+	helmPostRendererPlugin     string
+	helmValuesRepos            []string
 	project                    string
 	syncPolicy                 string
 	syncOptions                []string
+	syncTimeout                string
 	autoPrune                  bool
 	selfHeal                   bool
 	allowEmpty                 bool
@@ -82,9 +91,18 @@ func AddAppFlags(command *cobra.Command, opts *AppOptions) {
 	command.Flags().StringArrayVar(&opts.helmSets, "helm-set", []string{}, "Helm set values on the command line (can be repeated to set several values: --helm-set key1=val1 --helm-set key2=val2)")
 	command.Flags().StringArrayVar(&opts.helmSetStrings, "helm-set-string", []string{}, "Helm set STRING values on the command line (can be repeated to set several values: --helm-set-string key1=val1 --helm-set-string key2=val2)")
 	command.Flags().StringArrayVar(&opts.helmSetFiles, "helm-set-file", []string{}, "Helm set values from respective files specified via the command line (can be repeated to set several values: --helm-set-file key1=path1 --helm-set-file key2=path2)")
+	command.Flags().BoolVar(&opts.helmSkipCrds, "helm-skip-crds", false, "Skip the custom resource definitions of the Helm chart")
+	command.Flags().BoolVar(&opts.helmPassCredentials, "helm-pass-credentials", false, "Pass the repository credentials to the repositories of the Helm chart dependencies")
+	command.Flags().BoolVar(&opts.ignoreMissingValueFiles, "ignore-missing-value-files", false, "Ignore Helm value files which don't exist")
+	command.Flags().StringVar(&opts.helmKubeVersion, "helm-kube-version", "", "Kubernetes version to render the Helm chart with instead of the version of the destination cluster")
+	command.Flags().StringArrayVar(&opts.helmAPIVersions, "helm-api-versions", []string{}, "Kubernetes API versions to render the Helm chart with instead of the versions of the destination cluster (can be repeated to set several versions: --helm-api-versions apps/v1 --helm-api-versions batch/v1)")
+	command.Flags().StringVar(&opts.helmPostRendererKustomize, "helm-post-renderer-kustomize", "", "Path in repository to a Kustomize overlay post-rendering the Helm chart")
+	command.Flags().StringVar(&opts.helmPostRendererPlugin, "helm-post-renderer-plugin", "", "Config management plugin post-rendering the Helm chart")
+	command.Flags().StringArrayVar(&opts.helmValuesRepos, "helm-values-repo", []string{}, "Git repository of Helm value files referenced as $alias/path (can be repeated to add several repositories: --helm-values-repo alias1=repoURL1 --helm-values-repo alias2=repoURL2,targetRevision)")
 	command.Flags().StringVar(&opts.project, "project", "", "Application project name")
 	command.Flags().StringVar(&opts.syncPolicy, "sync-policy", "", "Set the sync policy (one of: none, automated (aliases of automated: auto, automatic))")
 	command.Flags().StringArrayVar(&opts.syncOptions, "sync-option", []string{}, "Add or remove a sync options, e.g add `Prune=false`. Remove using `!` prefix, e.g. `!Prune=false`")
+	command.Flags().StringVar(&opts.syncTimeout, "sync-timeout", "", "Set the maximum duration of a sync attempt, e.g. 30m. Set to empty to remove the sync timeout")
 	command.Flags().BoolVar(&opts.autoPrune, "auto-prune", false, "Set automatic pruning when sync is automated")
 	command.Flags().BoolVar(&opts.selfHeal, "self-heal", false, "Set self healing when sync is automated")
 	command.Flags().BoolVar(&opts.allowEmpty, "allow-empty", false, "Set allow zero live resources when sync is automated")
@@ -149,6 +167,22 @@ func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, ap
 			setHelmOpt(&spec.Source, helmOpts{helmSetStrings: appOpts.helmSetStrings})
 		case "helm-set-file":
 			setHelmOpt(&spec.Source, helmOpts{helmSetFiles: appOpts.helmSetFiles})
+		case "helm-skip-crds":
+			setHelmOpt(&spec.Source, helmOpts{skipCrds: &appOpts.helmSkipCrds})
+		case "helm-pass-credentials":
+			setHelmOpt(&spec.Source, helmOpts{passCredentials: &appOpts.helmPassCredentials})
+		case "ignore-missing-value-files":
+			setHelmOpt(&spec.Source, helmOpts{ignoreMissingValueFiles: &appOpts.ignoreMissingValueFiles})
+		case "helm-kube-version":
+			setHelmOpt(&spec.Source, helmOpts{kubeVersion: &appOpts.helmKubeVersion})
+		case "helm-api-versions":
+			setHelmOpt(&spec.Source, helmOpts{apiVersions: appOpts.helmAPIVersions})
+		case "helm-post-renderer-kustomize":
+			setHelmOpt(&spec.Source, helmOpts{postRenderer: &argoappv1.HelmPostRenderer{Kustomize: appOpts.helmPostRendererKustomize}})
+		case "helm-post-renderer-plugin":
+			setHelmOpt(&spec.Source, helmOpts{postRenderer: &argoappv1.HelmPostRenderer{Plugin: appOpts.helmPostRendererPlugin}})
+		case "helm-values-repo":
+			setHelmOpt(&spec.Source, helmOpts{valuesRepos: appOpts.helmValuesRepos})
 		case "directory-recurse":
 			if spec.Source.Directory != nil {
 				spec.Source.Directory.Recurse = appOpts.directoryRecurse
@@ -238,6 +272,14 @@ func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, ap
 			if spec.SyncPolicy.IsZero() {
 				spec.SyncPolicy = nil
 			}
+		case "sync-timeout":
+			if spec.SyncPolicy == nil {
+				spec.SyncPolicy = &argoappv1.SyncPolicy{}
+			}
+			spec.SyncPolicy.SyncTimeout = appOpts.syncTimeout
+			if spec.SyncPolicy.IsZero() {
+				spec.SyncPolicy = nil
+			}
 		}
 	})
 	if flags.Changed("auto-prune") {
@@ -325,13 +367,20 @@ func setPluginOptEnvs(src *argoappv1.ApplicationSource, envs []string) {
 }
 
 type helmOpts struct {
-	valueFiles     []string
-	values         string
-	releaseName    string
-	version        string
-	helmSets       []string
-	helmSetStrings []string
-	helmSetFiles   []string
+	valueFiles              []string
+	values                  string
+	releaseName             string
+	version                 string
+	helmSets                []string
+	helmSetStrings          []string
+	helmSetFiles            []string
+	skipCrds                *bool
+	passCredentials         *bool
+	ignoreMissingValueFiles *bool
+	kubeVersion             *string
+	apiVersions             []string
+	postRenderer            *argoappv1.HelmPostRenderer
+	valuesRepos             []string
 }
 
 func setHelmOpt(src *argoappv1.ApplicationSource, opts helmOpts) {
@@ -371,6 +420,36 @@ func setHelmOpt(src *argoappv1.ApplicationSource, opts helmOpts) {
 		}
 		src.Helm.AddFileParameter(*p)
 	}
+	if opts.skipCrds != nil {
+		src.Helm.SkipCrds = *opts.skipCrds
+	}
+	if opts.passCredentials != nil {
+		src.Helm.PassCredentials = *opts.passCredentials
+	}
+	if opts.ignoreMissingValueFiles != nil {
+		src.Helm.IgnoreMissingValueFiles = *opts.ignoreMissingValueFiles
+	}
+	if opts.kubeVersion != nil {
+		src.Helm.KubeVersion = *opts.kubeVersion
+	}
+	if len(opts.apiVersions) > 0 {
+		src.Helm.APIVersions = opts.apiVersions
+	}
+	if opts.postRenderer != nil {
+		// a post-renderer is either a Kustomize overlay or a plugin, so setting one replaces the other
+		if opts.postRenderer.Kustomize == "" && opts.postRenderer.Plugin == "" {
+			src.Helm.PostRenderer = nil
+		} else {
+			src.Helm.PostRenderer = opts.postRenderer
+		}
+	}
+	for _, text := range opts.valuesRepos {
+		r, err := argoappv1.NewHelmValuesRepo(text)
+		if err != nil {
+			log.Fatal(err)
+		}
+		src.Helm.AddValuesRepo(*r)
+	}
 	if src.Helm.IsZero() {
 		src.Helm = nil
 	}
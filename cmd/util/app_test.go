@@ -45,6 +45,40 @@ func Test_setHelmOpt(t *testing.T) {
 		setHelmOpt(&src, helmOpts{version: "v3"})
 		assert.Equal(t, "v3", src.Helm.Version)
 	})
+	t.Run("SkipCrds", func(t *testing.T) {
+		src := v1alpha1.ApplicationSource{}
+		skipCrds := true
+		setHelmOpt(&src, helmOpts{skipCrds: &skipCrds})
+		assert.True(t, src.Helm.SkipCrds)
+		skipCrds = false
+		setHelmOpt(&src, helmOpts{skipCrds: &skipCrds})
+		assert.Nil(t, src.Helm)
+	})
+	t.Run("KubeVersion", func(t *testing.T) {
+		src := v1alpha1.ApplicationSource{}
+		kubeVersion := "1.20"
+		setHelmOpt(&src, helmOpts{kubeVersion: &kubeVersion, apiVersions: []string{"apps/v1"}})
+		assert.Equal(t, "1.20", src.Helm.KubeVersion)
+		assert.Equal(t, []string{"apps/v1"}, src.Helm.APIVersions)
+	})
+	t.Run("PostRenderer", func(t *testing.T) {
+		src := v1alpha1.ApplicationSource{}
+		setHelmOpt(&src, helmOpts{postRenderer: &v1alpha1.HelmPostRenderer{Kustomize: "overlay"}})
+		assert.Equal(t, &v1alpha1.HelmPostRenderer{Kustomize: "overlay"}, src.Helm.PostRenderer)
+		setHelmOpt(&src, helmOpts{postRenderer: &v1alpha1.HelmPostRenderer{Plugin: "my-plugin"}})
+		assert.Equal(t, &v1alpha1.HelmPostRenderer{Plugin: "my-plugin"}, src.Helm.PostRenderer)
+		setHelmOpt(&src, helmOpts{postRenderer: &v1alpha1.HelmPostRenderer{}})
+		assert.Nil(t, src.Helm)
+	})
+	t.Run("ValuesRepos", func(t *testing.T) {
+		src := v1alpha1.ApplicationSource{}
+		setHelmOpt(&src, helmOpts{valuesRepos: []string{"values=https://github.com/argoproj/values", "other=git@github.com:argoproj/other.git,main"}})
+		setHelmOpt(&src, helmOpts{valuesRepos: []string{"values=https://github.com/argoproj/values,v1.0.0"}})
+		assert.Equal(t, []v1alpha1.HelmValuesRepo{
+			{Alias: "values", RepoURL: "https://github.com/argoproj/values", TargetRevision: "v1.0.0"},
+			{Alias: "other", RepoURL: "git@github.com:argoproj/other.git", TargetRevision: "main"},
+		}, src.Helm.ValuesRepos)
+	})
 }
 
 func Test_setKustomizeOpt(t *testing.T) {
@@ -164,4 +198,11 @@ func Test_setAppSpecOptions(t *testing.T) {
 		assert.NoError(t, f.SetFlag("sync-option", "!a=1"))
 		assert.Nil(t, f.spec.SyncPolicy)
 	})
+	t.Run("SyncTimeout", func(t *testing.T) {
+		assert.NoError(t, f.SetFlag("sync-timeout", "30m"))
+		assert.Equal(t, "30m", f.spec.SyncPolicy.SyncTimeout)
+
+		assert.NoError(t, f.SetFlag("sync-timeout", ""))
+		assert.Nil(t, f.spec.SyncPolicy)
+	})
 }
@@ -108,6 +108,8 @@ type ClusterOptions struct {
 	AwsClusterName          string
 	SystemNamespace         string
 	Namespaces              []string
+	DiscoverNamespaces      bool
+	NamespaceSelector       string
 	Name                    string
 	Shard                   int64
 	ExecProviderCommand     string
@@ -122,6 +124,8 @@ func AddClusterFlags(command *cobra.Command, opts *ClusterOptions) {
 	command.Flags().StringVar(&opts.AwsClusterName, "aws-cluster-name", "", "AWS Cluster name if set then aws cli eks token command will be used to access cluster")
 	command.Flags().StringVar(&opts.AwsRoleArn, "aws-role-arn", "", "Optional AWS role arn. If set then AWS IAM Authenticator assume a role to perform cluster operations instead of the default AWS credential provider chain.")
 	command.Flags().StringArrayVar(&opts.Namespaces, "namespace", nil, "List of namespaces which are allowed to manage")
+	command.Flags().BoolVar(&opts.DiscoverNamespaces, "discover-namespaces", false, "Watch namespaces in which cluster credentials are allowed to list resources instead of the static list of namespaces")
+	command.Flags().StringVar(&opts.NamespaceSelector, "namespace-selector", "", "Watch namespaces matching the label selector instead of the static list of namespaces")
 	command.Flags().StringVar(&opts.Name, "name", "", "Overwrite the cluster name")
 	command.Flags().Int64Var(&opts.Shard, "shard", -1, "Cluster shard number; inferred from hostname if not set")
 	command.Flags().StringVar(&opts.ExecProviderCommand, "exec-command", "", "Command to run to provide client credentials to the cluster. You may need to build a custom ArgoCD image to ensure the command is available at runtime.")
@@ -130,3 +134,11 @@ func AddClusterFlags(command *cobra.Command, opts *ClusterOptions) {
 	command.Flags().StringVar(&opts.ExecProviderAPIVersion, "exec-command-api-version", "", "Preferred input version of the ExecInfo for the --exec-command")
 	command.Flags().StringVar(&opts.ExecProviderInstallHint, "exec-command-install-hint", "", "Text shown to the user when the --exec-command executable doesn't seem to be present")
 }
+
+// NamespaceDiscovery returns the namespace discovery settings configured by the cluster flags
+func (o *ClusterOptions) NamespaceDiscovery() *argoappv1.ClusterNamespaceDiscovery {
+	if !o.DiscoverNamespaces && o.NamespaceSelector == "" {
+		return nil
+	}
+	return &argoappv1.ClusterNamespaceDiscovery{RBAC: o.DiscoverNamespaces, LabelSelector: o.NamespaceSelector}
+}
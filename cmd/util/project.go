@@ -23,6 +23,7 @@ type ProjectOpts struct {
 	destinations             []string
 	Sources                  []string
 	SignatureKeys            []string
+	HelmValueURLs            []string
 	orphanedResourcesEnabled bool
 	orphanedResourcesWarn    bool
 }
@@ -33,6 +34,7 @@ func AddProjFlags(command *cobra.Command, opts *ProjectOpts) {
 		"Permitted destination server and namespace (e.g. https://192.168.99.100:8443,default)")
 	command.Flags().StringArrayVarP(&opts.Sources, "src", "s", []string{}, "Permitted source repository URL")
 	command.Flags().StringSliceVar(&opts.SignatureKeys, "signature-keys", []string{}, "GnuPG public key IDs for commit signature verification")
+	command.Flags().StringArrayVar(&opts.HelmValueURLs, "helm-value-url", []string{}, "Permitted HTTPS URL pattern of remote Helm value files")
 	command.Flags().BoolVar(&opts.orphanedResourcesEnabled, "orphaned-resources", false, "Enables orphaned resources monitoring")
 	command.Flags().BoolVar(&opts.orphanedResourcesWarn, "orphaned-resources-warn", false, "Specifies if applications should be a warning condition when orphaned resources detected")
 }
@@ -132,6 +134,7 @@ func ConstructAppProj(fileURL string, args []string, opts ProjectOpts, c *cobra.
 				Destinations:      opts.GetDestinations(),
 				SourceRepos:       opts.Sources,
 				SignatureKeys:     opts.GetSignatureKeys(),
+				HelmValueURLs:     opts.HelmValueURLs,
 				OrphanedResources: GetOrphanedResourcesSettings(c, opts),
 			},
 		}
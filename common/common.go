@@ -41,6 +41,7 @@ const (
 	DefaultPortArgoCDMetrics          = 8082
 	DefaultPortArgoCDAPIServerMetrics = 8083
 	DefaultPortRepoServerMetrics      = 8084
+	DefaultPortAdmissionWebhook       = 8085
 )
 
 // Default paths on the pod's file system
@@ -112,9 +113,17 @@ const (
 	LabelKeySecretType = "argocd.vathsalashetty96.io/secret-type"
 	// LabelValueSecretTypeCluster indicates a secret type of cluster
 	LabelValueSecretTypeCluster = "cluster"
+	// LabelValueSecretTypeImagePull indicates a secret type of image pull secret, which the image updater may read
+	LabelValueSecretTypeImagePull = "image-pull"
+	// AnnotationKeyAppInstance is the annotation key which tracks the resources of an application if the annotation
+	// tracking method is configured. The value is the tracking id <application>:<group>/<kind>:<namespace>/<name>.
+	AnnotationKeyAppInstance = "argocd.vathsalashetty96.io/tracking-id"
 
 	// AnnotationCompareOptions is a comma-separated list of options for comparison
 	AnnotationCompareOptions = "argocd.vathsalashetty96.io/compare-options"
+	// AnnotationSyncTimeout is the maximum duration a resource or hook may be in progress during a sync before the sync
+	// is terminated and fails, e.g. 5m
+	AnnotationSyncTimeout = "argocd.vathsalashetty96.io/sync-timeout"
 
 	// AnnotationKeyRefresh is the annotation key which indicates that app needs to be refreshed. Removed by application controller after app is refreshed.
 	// Might take values 'normal'/'hard'. Value 'hard' means manifest cache and target cluster state cache should be invalidated before refresh.
@@ -126,6 +135,10 @@ const (
 	// ResourcesFinalizerName the finalizer value which we inject to finalize deletion of an application
 	ResourcesFinalizerName = "resources-finalizer.argocd.vathsalashetty96.io"
 
+	// AnnotationKeyStatusBadge enables ('true') or disables ('false') the status badge of an application regardless of
+	// the 'statusbadge.enabled' setting
+	AnnotationKeyStatusBadge = "argocd.vathsalashetty96.io/status-badge"
+
 	// AnnotationKeyManifestGeneratePaths is an annotation that contains a list of semicolon-separated paths in the
 	// manifests repository that affects the manifest generation. Paths might be either relative or absolute. The
 	// absolute path means an absolute path within the repository and the relative path is relative to the application
@@ -141,6 +154,18 @@ const (
 	// Ex: "http://grafana.example.com/d/yu5UH4MMz/deployments"
 	// Ex: "Go to Dashboard|http://grafana.example.com/d/yu5UH4MMz/deployments"
 	AnnotationKeyLinkPrefix = "link.argocd.vathsalashetty96.io/"
+
+	// AnnotationKeyImageList is a comma-separated list of <alias>=<image>[:<constraint>] entries of the images which
+	// are updated automatically by the application controller
+	AnnotationKeyImageList = "argocd.vathsalashetty96.io/image-list"
+	// AnnotationKeyImagePrefix is the prefix of the annotations configuring the update of an image, followed by the
+	// alias of the image and the name of the setting. Ex: argocd.vathsalashetty96.io/image.web.update-strategy
+	AnnotationKeyImagePrefix = "argocd.vathsalashetty96.io/image."
+	// AnnotationKeyImageWriteBackMethod is the method used to persist the updated images, either 'argocd' or 'git'
+	AnnotationKeyImageWriteBackMethod = "argocd.vathsalashetty96.io/image-write-back-method"
+	// AnnotationKeyImageWriteBackBranch is the branch the updated images are committed to by the 'git' write back
+	// method. Defaults to the target revision of the application source.
+	AnnotationKeyImageWriteBackBranch = "argocd.vathsalashetty96.io/image-write-back-branch"
 )
 
 // Environment variables for tuning and debugging Argo CD
@@ -166,6 +191,8 @@ const (
 	EnvK8sClientBurst = "ARGOCD_K8S_CLIENT_BURST"
 	// EnvClusterCacheResyncDuration is the env variable that holds cluster cache re-sync duration
 	EnvClusterCacheResyncDuration = "ARGOCD_CLUSTER_CACHE_RESYNC_DURATION"
+	// EnvClusterNamespaceDiscoveryInterval is the env variable that holds the period of watched namespaces re-discovery
+	EnvClusterNamespaceDiscoveryInterval = "ARGOCD_CLUSTER_NAMESPACE_DISCOVERY_INTERVAL"
 	// EnvK8sClientMaxIdleConnections is the number of max idle connections in K8s REST client HTTP transport (default: 500)
 	EnvK8sClientMaxIdleConnections = "ARGOCD_K8S_CLIENT_MAX_IDLE_CONNECTIONS"
 	// EnvGnuPGHome is the path to ArgoCD's GnuPG keyring for signature verification
@@ -214,6 +241,8 @@ var (
 	K8sMaxIdleConnections = 500
 	// K8sMaxIdleConnections controls the duration of cluster cache refresh
 	K8SClusterResyncDuration = 12 * time.Hour
+	// ClusterNamespaceDiscoveryInterval controls how often watched namespaces are re-discovered in clusters with enabled namespace discovery
+	ClusterNamespaceDiscoveryInterval = 3 * time.Minute
 )
 
 func init() {
@@ -240,4 +269,9 @@ func init() {
 			K8SClusterResyncDuration = duration
 		}
 	}
+	if namespaceDiscoveryIntervalStr := os.Getenv(EnvClusterNamespaceDiscoveryInterval); namespaceDiscoveryIntervalStr != "" {
+		if duration, err := time.ParseDuration(namespaceDiscoveryIntervalStr); err == nil && duration > 0 {
+			ClusterNamespaceDiscoveryInterval = duration
+		}
+	}
 }
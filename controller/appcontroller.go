@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"math"
 	"net/http"
+	"os"
+	"path/filepath"
 	"reflect"
 	"runtime/debug"
 	"sort"
@@ -41,6 +43,8 @@ import (
 
 	"github.com/vathsalashetty96/argo-cd/common"
 	statecache "github.com/vathsalashetty96/argo-cd/controller/cache"
+	"github.com/vathsalashetty96/argo-cd/controller/hydrator"
+	"github.com/vathsalashetty96/argo-cd/controller/imageupdater"
 	"github.com/vathsalashetty96/argo-cd/controller/metrics"
 	"github.com/vathsalashetty96/argo-cd/pkg/apis/application"
 	appv1 "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1"
@@ -61,6 +65,8 @@ const (
 	updateOperationStateTimeout = 1 * time.Second
 	// orphanedIndex contains application which monitor orphaned resources by namespace
 	orphanedIndex = "orphaned"
+	// dependsOnIndex contains applications by names of the applications they depend on
+	dependsOnIndex = "dependsOn"
 )
 
 type CompareWith int
@@ -111,6 +117,12 @@ type ApplicationController struct {
 	metricsServer                 *metrics.MetricsServer
 	kubectlSemaphore              *semaphore.Weighted
 	clusterFilter                 func(cluster *appv1.Cluster) bool
+	leaderElection                *LeaderElectionConfig
+	imageUpdater                  *imageupdater.Updater
+	imageUpdateInterval           time.Duration
+	syncSchedules                 *syncScheduleCache
+	hydrator                      *hydrator.Hydrator
+	repoServerWarmup              bool
 }
 
 // NewApplicationController creates new instance of ApplicationController.
@@ -127,6 +139,10 @@ func NewApplicationController(
 	metricsPort int,
 	kubectlParallelismLimit int64,
 	clusterFilter func(cluster *appv1.Cluster) bool,
+	leaderElection *LeaderElectionConfig,
+	imageUpdateInterval time.Duration,
+	hydratorConfig hydrator.Config,
+	repoServerWarmup bool,
 ) (*ApplicationController, error) {
 	log.Infof("appResyncPeriod=%v", appResyncPeriod)
 	db := db.NewDB(namespace, settingsMgr, kubeClientset)
@@ -149,6 +165,13 @@ func NewApplicationController(
 		settingsMgr:                   settingsMgr,
 		selfHealTimeout:               selfHealTimeout,
 		clusterFilter:                 clusterFilter,
+		leaderElection:                leaderElection,
+		imageUpdateInterval:           imageUpdateInterval,
+		syncSchedules:                 newSyncScheduleCache(),
+		repoServerWarmup:              repoServerWarmup,
+	}
+	if imageUpdateInterval > 0 {
+		ctrl.imageUpdater = imageupdater.NewUpdater(namespace, applicationClientset, kubeClientset, db, filepath.Join(os.TempDir(), "_argocd-image-updater"))
 	}
 	if kubectlParallelismLimit > 0 {
 		ctrl.kubectlSemaphore = semaphore.NewWeighted(kubectlParallelismLimit)
@@ -183,7 +206,10 @@ func NewApplicationController(
 		return nil, err
 	}
 	stateCache := statecache.NewLiveStateCache(db, appInformer, ctrl.settingsMgr, kubectl, ctrl.metricsServer, ctrl.handleObjectUpdated, clusterFilter)
-	appStateManager := NewAppStateManager(db, applicationClientset, repoClientset, namespace, kubectl, ctrl.settingsMgr, stateCache, projInformer, ctrl.metricsServer)
+	ctrl.hydrator = hydrator.NewHydrator(db, hydratorConfig)
+	appStateManager := NewAppStateManager(db, applicationClientset, repoClientset, namespace, kubectl, ctrl.settingsMgr, stateCache, projInformer, ctrl.metricsServer, ctrl.hydrator, func(appName string) {
+		ctrl.requestAppRefresh(appName, CompareWithLatest.Pointer(), nil)
+	})
 	ctrl.appInformer = appInformer
 	ctrl.appLister = appLister
 	ctrl.projInformer = projInformer
@@ -563,30 +589,74 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 	go func() { errors.CheckError(ctrl.stateCache.Run(ctx)) }()
 	go func() { errors.CheckError(ctrl.metricsServer.ListenAndServe()) }()
 
-	for i := 0; i < statusProcessors; i++ {
-		go wait.Until(func() {
-			for ctrl.processAppRefreshQueueItem() {
-			}
-		}, time.Second, ctx.Done())
+	if ctrl.leaderElection != nil {
+		ctrl.runWithLeaderElection(ctx, statusProcessors, operationProcessors)
+	} else {
+		ctrl.runProcessors(ctx, statusProcessors, operationProcessors)
+	}
+}
+
+// runProcessors processes the queues until the context is done. The queues are shut down once the context is done and
+// the method returns after the items which are already being processed are done.
+func (ctrl *ApplicationController) runProcessors(ctx context.Context, statusProcessors int, operationProcessors int) {
+	var wg sync.WaitGroup
+	runProcessor := func(processItem func() bool) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			wait.Until(func() {
+				for processItem() {
+				}
+			}, time.Second, ctx.Done())
+		}()
 	}
 
+	for i := 0; i < statusProcessors; i++ {
+		runProcessor(ctrl.processAppRefreshQueueItem)
+	}
 	for i := 0; i < operationProcessors; i++ {
-		go wait.Until(func() {
-			for ctrl.processAppOperationQueueItem() {
-			}
-		}, time.Second, ctx.Done())
+		runProcessor(ctrl.processAppOperationQueueItem)
+	}
+	runProcessor(ctrl.processAppComparisonTypeQueueItem)
+	runProcessor(ctrl.processProjectQueueItem)
+	if ctrl.repoServerWarmup {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctrl.warmupRepoServer(ctx)
+		}()
+	}
+	if ctrl.imageUpdater != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			wait.Until(func() { ctrl.updateImages(ctx) }, ctrl.imageUpdateInterval, ctx.Done())
+		}()
 	}
 
-	go wait.Until(func() {
-		for ctrl.processAppComparisonTypeQueueItem() {
-		}
-	}, time.Second, ctx.Done())
+	<-ctx.Done()
+	// processors are blocked until the next item is queued, so the queues are shut down to unblock them
+	ctrl.appRefreshQueue.ShutDown()
+	ctrl.appComparisonTypeRefreshQueue.ShutDown()
+	ctrl.appOperationQueue.ShutDown()
+	ctrl.projectRefreshQueue.ShutDown()
+	wg.Wait()
+}
 
-	go wait.Until(func() {
-		for ctrl.processProjectQueueItem() {
+// updateImages updates the images of the applications processed by the controller which have the image list annotation
+func (ctrl *ApplicationController) updateImages(ctx context.Context) {
+	apps, err := ctrl.appLister.Applications(ctrl.namespace).List(labels.Everything())
+	if err != nil {
+		log.Warnf("Failed to list applications: %v", err)
+		return
+	}
+	var processedApps []*appv1.Application
+	for _, app := range apps {
+		if ctrl.canProcessApp(app) {
+			processedApps = append(processedApps, app)
 		}
-	}, time.Second, ctx.Done())
-	<-ctx.Done()
+	}
+	ctrl.imageUpdater.UpdateApplications(ctx, processedApps)
 }
 
 func (ctrl *ApplicationController) requestAppRefresh(appName string, compareWith *CompareWith, after *time.Duration) {
@@ -898,6 +968,11 @@ func (ctrl *ApplicationController) setAppCondition(app *appv1.Application, condi
 	}
 }
 
+// getDependenciesCondition returns DependencyNotReady condition if the application dependencies are not synced and healthy
+func (ctrl *ApplicationController) getDependenciesCondition(app *appv1.Application) *appv1.ApplicationCondition {
+	return argo.GetDependenciesCondition(app, ctrl.appLister.Applications(app.Namespace))
+}
+
 func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Application) {
 	logCtx := log.WithField("application", app.Name)
 	var state *appv1.OperationState
@@ -966,6 +1041,10 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 	if err := argo.ValidateDestination(context.Background(), &app.Spec.Destination, ctrl.db); err != nil {
 		state.Phase = synccommon.OperationFailed
 		state.Message = err.Error()
+	} else if dependenciesCond := ctrl.getDependenciesCondition(app); dependenciesCond != nil && !terminating && state.SyncResult == nil && state.Operation.Sync != nil && !state.Operation.Sync.DryRun {
+		// dependencies are checked only before the sync is started, so that in-progress syncs are not interrupted
+		state.Phase = synccommon.OperationFailed
+		state.Message = dependenciesCond.Message
 	} else {
 		ctrl.appStateManager.SyncAppState(app, state)
 	}
@@ -984,7 +1063,12 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 			}
 		}
 	} else if state.Phase == synccommon.OperationFailed || state.Phase == synccommon.OperationError {
-		if !terminating && (state.RetryCount < state.Operation.Retry.Limit || state.Operation.Retry.Limit < 0) {
+		retry := !terminating && (state.RetryCount < state.Operation.Retry.Limit || state.Operation.Retry.Limit < 0)
+		if retry && !state.Operation.Retry.ShouldRetry(state.FailureClasses) {
+			retry = false
+			state.Message = fmt.Sprintf("%s (not retried due to failure class %s)", state.Message, formatFailureClasses(state.FailureClasses))
+		}
+		if retry {
 			now := metav1.Now()
 			state.FinishedAt = &now
 			if retryAt, err := state.Operation.Retry.NextRetryAt(now.Time, state.RetryCount); err != nil {
@@ -1014,6 +1098,18 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 	}
 }
 
+// formatFailureClasses returns the comma separated failure classes of a failed sync attempt
+func formatFailureClasses(classes []appv1.SyncFailureClass) string {
+	if len(classes) == 0 {
+		return string(appv1.SyncFailureClassUnknown)
+	}
+	names := make([]string, len(classes))
+	for i, class := range classes {
+		names[i] = string(class)
+	}
+	return strings.Join(names, ", ")
+}
+
 func (ctrl *ApplicationController) setOperationState(app *appv1.Application, state *appv1.OperationState) {
 	kube.RetryUntilSucceed(context.Background(), updateOperationStateTimeout, "Update application operation state", logutils.NewLogrusLogger(log.New()), func() error {
 		if state.Phase == "" {
@@ -1186,8 +1282,28 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		app.Status.Summary = tree.GetSummary()
 	}
 
-	if project.Spec.SyncWindows.Matches(app).CanSync(false) {
-		syncErrCond := ctrl.autoSync(app, compareResult.syncStatus, compareResult.resources)
+	dependenciesCond := ctrl.getDependenciesCondition(app)
+	if dependenciesCond != nil {
+		app.Status.SetConditions(
+			[]appv1.ApplicationCondition{*dependenciesCond},
+			map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionDependencyNotReady: true},
+		)
+	} else {
+		app.Status.SetConditions(
+			[]appv1.ApplicationCondition{},
+			map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionDependencyNotReady: true},
+		)
+	}
+
+	if dependenciesCond != nil {
+		if app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.Automated != nil {
+			logCtx.Infof("Skipping auto-sync: %s", dependenciesCond.Message)
+		}
+	} else if project.Spec.SyncWindows.Matches(app).CanSync(false) {
+		initiated, syncErrCond := ctrl.scheduledSync(app, project, compareResult.syncStatus)
+		if !initiated && syncErrCond == nil {
+			syncErrCond = ctrl.autoSync(app, compareResult.syncStatus, compareResult.resources)
+		}
 		if syncErrCond != nil {
 			app.Status.SetConditions(
 				[]appv1.ApplicationCondition{*syncErrCond},
@@ -1213,6 +1329,10 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		return resourceStatusKey(app.Status.Resources[i]) < resourceStatusKey(app.Status.Resources[j])
 	})
 	app.Status.SourceType = compareResult.appSourceType
+	// keep the status of the last successful hydration if the manifests could not be committed
+	if compareResult.hydratorStatus != nil || app.Spec.HydrateTo == nil {
+		app.Status.Hydrator = compareResult.hydratorStatus
+	}
 	ctrl.persistAppStatus(origApp, &app.Status)
 	return
 }
@@ -1399,6 +1519,7 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 		},
 		InitiatedBy: appv1.OperationInitiator{Automated: true},
 		Retry:       appv1.RetryStrategy{Limit: 5},
+		SyncTimeout: app.Spec.SyncPolicy.SyncTimeout,
 	}
 	if app.Spec.SyncPolicy.Retry != nil {
 		op.Retry = *app.Spec.SyncPolicy.Retry
@@ -1546,6 +1667,13 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				}
 				return nil, nil
 			},
+			dependsOnIndex: func(obj interface{}) ([]string, error) {
+				app, ok := obj.(*appv1.Application)
+				if !ok {
+					return nil, nil
+				}
+				return app.Spec.DependsOn, nil
+			},
 		},
 	)
 	lister := applisters.NewApplicationLister(informer.GetIndexer())
@@ -1579,6 +1707,9 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				}
 				ctrl.requestAppRefresh(newApp.Name, compareWith, nil)
 				ctrl.appOperationQueue.Add(key)
+				if oldOK && newOK && dependencyReadinessChanged(oldApp, newApp) {
+					ctrl.refreshDependentApps(newApp.Name)
+				}
 			},
 			DeleteFunc: func(obj interface{}) {
 				if !ctrl.canProcessApp(obj) {
@@ -1589,6 +1720,10 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
 				if err == nil {
 					ctrl.appRefreshQueue.Add(key)
+					if _, name, err := cache.SplitMetaNamespaceKey(key); err == nil {
+						ctrl.syncSchedules.delete(name)
+						ctrl.hydrator.Forget(name)
+					}
 				}
 			},
 		},
@@ -1596,6 +1731,25 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 	return informer, lister
 }
 
+// refreshDependentApps requests refresh of applications which depend on the given application
+func (ctrl *ApplicationController) refreshDependentApps(appName string) {
+	objs, err := ctrl.appInformer.GetIndexer().ByIndex(dependsOnIndex, appName)
+	if err != nil {
+		log.Warnf("Failed to get applications depending on %s: %v", appName, err)
+		return
+	}
+	for _, obj := range objs {
+		if app, ok := obj.(*appv1.Application); ok {
+			ctrl.requestAppRefresh(app.Name, CompareWithRecent.Pointer(), nil)
+		}
+	}
+}
+
+// dependencyReadinessChanged returns true if application sync or health status has changed
+func dependencyReadinessChanged(oldApp *appv1.Application, newApp *appv1.Application) bool {
+	return oldApp.Status.Sync.Status != newApp.Status.Sync.Status || oldApp.Status.Health.Status != newApp.Status.Health.Status
+}
+
 func (ctrl *ApplicationController) RegisterClusterSecretUpdater(ctx context.Context) {
 	updater := NewClusterInfoUpdater(ctrl.stateCache, ctrl.db, ctrl.appLister.Applications(ctrl.namespace), ctrl.cache, ctrl.clusterFilter)
 	go updater.Run(ctx)
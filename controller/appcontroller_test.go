@@ -11,6 +11,7 @@ import (
 	clustercache "github.com/vathsalashetty96/gitops-engine/pkg/cache"
 
 	statecache "github.com/vathsalashetty96/argo-cd/controller/cache"
+	"github.com/vathsalashetty96/argo-cd/controller/hydrator"
 
 	"github.com/vathsalashetty96/gitops-engine/pkg/cache/mocks"
 	synccommon "github.com/vathsalashetty96/gitops-engine/pkg/sync/common"
@@ -49,6 +50,7 @@ type namespacedResource struct {
 type fakeData struct {
 	apps                []runtime.Object
 	manifestResponse    *apiclient.ManifestResponse
+	manifestError       error
 	managedLiveObjs     map[kube.ResourceKey]*unstructured.Unstructured
 	namespacedResources map[kube.ResourceKey]namespacedResource
 	configMapData       map[string]string
@@ -63,7 +65,7 @@ func newFakeController(data *fakeData) *ApplicationController {
 
 	// Mock out call to GenerateManifest
 	mockRepoClient := mockrepoclient.RepoServerServiceClient{}
-	mockRepoClient.On("GenerateManifest", mock.Anything, mock.Anything).Return(data.manifestResponse, nil)
+	mockRepoClient.On("GenerateManifest", mock.Anything, mock.Anything).Return(data.manifestResponse, data.manifestError)
 	mockRepoClientset := mockrepoclient.Clientset{RepoServerServiceClient: &mockRepoClient}
 
 	secret := corev1.Secret{
@@ -105,6 +107,10 @@ func newFakeController(data *fakeData) *ApplicationController {
 		common.DefaultPortArgoCDMetrics,
 		0,
 		nil,
+		nil,
+		0,
+		hydrator.Config{},
+		false,
 	)
 	if err != nil {
 		panic(err)
@@ -1102,6 +1108,33 @@ func TestProcessRequestedAppOperation_InvalidDestination(t *testing.T) {
 	assert.Contains(t, message, "application destination can't have both name and server defined: another-cluster https://localhost:6443")
 }
 
+func TestProcessRequestedAppOperation_DependenciesNotReady(t *testing.T) {
+	dependency := newFakeApp()
+	dependency.Name = "dependency"
+	dependency.Status.Sync.Status = argoappv1.SyncStatusCodeOutOfSync
+	app := newFakeApp()
+	app.Spec.DependsOn = []string{"dependency"}
+	app.Operation = &argoappv1.Operation{
+		Sync: &argoappv1.SyncOperation{},
+	}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, dependency}})
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	receivedPatch := map[string]interface{}{}
+	fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+			assert.NoError(t, json.Unmarshal(patchAction.GetPatch(), &receivedPatch))
+		}
+		return true, nil, nil
+	})
+
+	ctrl.processRequestedAppOperation(app)
+
+	phase, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+	assert.Equal(t, string(synccommon.OperationFailed), phase)
+	message, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "message")
+	assert.Contains(t, message, "Dependencies are not synced and healthy: dependency (OutOfSync")
+}
+
 func TestProcessRequestedAppOperation_FailedHasRetries(t *testing.T) {
 	app := newFakeApp()
 	app.Spec.Project = "invalid-project"
@@ -1129,6 +1162,33 @@ func TestProcessRequestedAppOperation_FailedHasRetries(t *testing.T) {
 	assert.Equal(t, float64(1), retryCount)
 }
 
+func TestProcessRequestedAppOperation_FailedNotRetriedByClass(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.Project = "invalid-project"
+	app.Operation = &argoappv1.Operation{
+		Sync:  &argoappv1.SyncOperation{},
+		Retry: argoappv1.RetryStrategy{Limit: 1, RetryOn: []argoappv1.SyncFailureClass{argoappv1.SyncFailureClassTransient}},
+	}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	receivedPatch := map[string]interface{}{}
+	fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+			assert.NoError(t, json.Unmarshal(patchAction.GetPatch(), &receivedPatch))
+		}
+		return true, nil, nil
+	})
+
+	ctrl.processRequestedAppOperation(app)
+
+	phase, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "phase")
+	assert.Equal(t, string(synccommon.OperationError), phase)
+	message, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "message")
+	assert.Contains(t, message, "not retried due to failure class Unknown")
+	_, hasRetryCount, _ := unstructured.NestedFloat64(receivedPatch, "status", "operationState", "retryCount")
+	assert.False(t, hasRetryCount)
+}
+
 func TestProcessRequestedAppOperation_RunningPreviouslyFailed(t *testing.T) {
 	app := newFakeApp()
 	app.Operation = &argoappv1.Operation{
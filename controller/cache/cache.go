@@ -95,14 +95,16 @@ func NewLiveStateCache(
 		settingsMgr:     settingsMgr,
 		metricsServer:   metricsServer,
 		// The default limit of 50 is chosen based on experiments.
-		listSemaphore: semaphore.NewWeighted(50),
-		clusterFilter: clusterFilter,
+		listSemaphore:      semaphore.NewWeighted(50),
+		clusterFilter:      clusterFilter,
+		discoverNamespaces: discoverClusterNamespaces,
 	}
 }
 
 type cacheSettings struct {
 	clusterSettings     clustercache.Settings
 	appInstanceLabelKey string
+	trackingMethod      argo.TrackingMethod
 }
 
 type liveStateCache struct {
@@ -113,6 +115,8 @@ type liveStateCache struct {
 	settingsMgr     *settings.SettingsManager
 	metricsServer   *metrics.MetricsServer
 	clusterFilter   func(cluster *appv1.Cluster) bool
+	// discoverNamespaces is used to discover watched namespaces of clusters with enabled namespace discovery
+	discoverNamespaces namespacesDiscoverer
 
 	// listSemaphore is used to limit the number of concurrent memory consuming operations on the
 	// k8s list queries results across all clusters to avoid memory spikes during cache initialization.
@@ -128,6 +132,10 @@ func (c *liveStateCache) loadCacheSettings() (*cacheSettings, error) {
 	if err != nil {
 		return nil, err
 	}
+	trackingMethod, err := argo.GetTrackingMethod(c.settingsMgr)
+	if err != nil {
+		return nil, err
+	}
 	resourcesFilter, err := c.settingsMgr.GetResourcesFilter()
 	if err != nil {
 		return nil, err
@@ -140,7 +148,26 @@ func (c *liveStateCache) loadCacheSettings() (*cacheSettings, error) {
 		ResourceHealthOverride: lua.ResourceHealthOverrides(resourceOverrides),
 		ResourcesFilter:        resourcesFilter,
 	}
-	return &cacheSettings{clusterSettings, appInstanceLabelKey}, nil
+	return &cacheSettings{clusterSettings, appInstanceLabelKey, trackingMethod}, nil
+}
+
+// populateResourceInfoHandler returns the handler which populates the resource info and associates the root resources
+// with the application using the configured tracking method
+func populateResourceInfoHandler(cacheSettings cacheSettings) clustercache.OnPopulateResourceInfoHandler {
+	return func(un *unstructured.Unstructured, isRoot bool) (interface{}, bool) {
+		res := &ResourceInfo{}
+		populateNodeInfo(un, res)
+		res.Health, _ = health.GetResourceHealth(un, cacheSettings.clusterSettings.ResourceHealthOverride)
+		appName := argo.GetAppName(un, cacheSettings.appInstanceLabelKey, cacheSettings.trackingMethod)
+		if isRoot && appName != "" {
+			res.AppName = appName
+		}
+		gvk := un.GroupVersionKind()
+
+		// edge case. we do not label CRDs, so they miss the tracking label we inject. But we still
+		// want the full resource to be available in our cache (to diff), so we store all CRDs
+		return res, res.AppName != "" || gvk.Kind == kube.CustomResourceDefinitionKind
+	}
 }
 
 func asResourceNode(r *clustercache.Resource) appv1.ResourceNode {
@@ -240,21 +267,12 @@ func skipAppRequeuing(key kube.ResourceKey) bool {
 func (c *liveStateCache) getCluster(server string) (clustercache.ClusterCache, error) {
 	c.lock.RLock()
 	clusterCache, ok := c.clusters[server]
-	cacheSettings := c.cacheSettings
 	c.lock.RUnlock()
 
 	if ok {
 		return clusterCache, nil
 	}
 
-	c.lock.Lock()
-	defer c.lock.Unlock()
-
-	clusterCache, ok = c.clusters[server]
-	if ok {
-		return clusterCache, nil
-	}
-
 	cluster, err := c.db.GetCluster(context.Background(), server)
 	if err != nil {
 		return nil, err
@@ -264,25 +282,43 @@ func (c *liveStateCache) getCluster(server string) (clustercache.ClusterCache, e
 		return nil, fmt.Errorf("controller is configured to ignore cluster %s", cluster.Server)
 	}
 
-	clusterCache = clustercache.NewClusterCache(cluster.RESTConfig(),
+	if cluster.NamespaceDiscovery.IsEnabled() {
+		// namespaces are discovered before acquiring the lock since discovery queries the cluster API
+		clusterCache = newNamespacedClusterCache(c.getClusterNamespaces(cluster), func(namespace string) clustercache.ClusterCache {
+			var namespaces []string
+			if namespace != "" {
+				namespaces = []string{namespace}
+			}
+			return c.newClusterCache(cluster, namespaces)
+		})
+	} else {
+		clusterCache = c.newClusterCache(cluster, cluster.Namespaces)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if existing, ok := c.clusters[server]; ok {
+		return existing, nil
+	}
+	c.clusters[cluster.Server] = clusterCache
+
+	return clusterCache, nil
+}
+
+// newClusterCache creates the cache of the given cluster which watches the given namespaces or the whole cluster if
+// namespaces are empty
+func (c *liveStateCache) newClusterCache(cluster *appv1.Cluster, namespaces []string) clustercache.ClusterCache {
+	c.lock.RLock()
+	cacheSettings := c.cacheSettings
+	c.lock.RUnlock()
+
+	clusterCache := clustercache.NewClusterCache(cluster.RESTConfig(),
 		clustercache.SetListSemaphore(c.listSemaphore),
 		clustercache.SetResyncTimeout(common.K8SClusterResyncDuration),
 		clustercache.SetSettings(cacheSettings.clusterSettings),
-		clustercache.SetNamespaces(cluster.Namespaces),
-		clustercache.SetPopulateResourceInfoHandler(func(un *unstructured.Unstructured, isRoot bool) (interface{}, bool) {
-			res := &ResourceInfo{}
-			populateNodeInfo(un, res)
-			res.Health, _ = health.GetResourceHealth(un, cacheSettings.clusterSettings.ResourceHealthOverride)
-			appName := kube.GetAppInstanceLabel(un, cacheSettings.appInstanceLabelKey)
-			if isRoot && appName != "" {
-				res.AppName = appName
-			}
-			gvk := un.GroupVersionKind()
-
-			// edge case. we do not label CRDs, so they miss the tracking label we inject. But we still
-			// want the full resource to be available in our cache (to diff), so we store all CRDs
-			return res, res.AppName != "" || gvk.Kind == kube.CustomResourceDefinitionKind
-		}),
+		clustercache.SetNamespaces(namespaces),
+		clustercache.SetPopulateResourceInfoHandler(populateResourceInfoHandler(cacheSettings)),
 		clustercache.SetLogr(logutils.NewLogrusLogger(log.WithField("server", cluster.Server))),
 	)
 
@@ -312,9 +348,7 @@ func (c *liveStateCache) getCluster(server string) (clustercache.ClusterCache, e
 		c.metricsServer.IncClusterEventsCount(cluster.Server, gvk.Group, gvk.Kind)
 	})
 
-	c.clusters[cluster.Server] = clusterCache
-
-	return clusterCache, nil
+	return clusterCache
 }
 
 func (c *liveStateCache) getSyncedCluster(server string) (clustercache.ClusterCache, error) {
@@ -336,7 +370,7 @@ func (c *liveStateCache) invalidate(cacheSettings cacheSettings) {
 
 	c.cacheSettings = cacheSettings
 	for _, clust := range c.clusters {
-		clust.Invalidate(clustercache.SetSettings(cacheSettings.clusterSettings))
+		clust.Invalidate(clustercache.SetSettings(cacheSettings.clusterSettings), clustercache.SetPopulateResourceInfoHandler(populateResourceInfoHandler(cacheSettings)))
 	}
 	log.Info("live state cache invalidated")
 }
@@ -467,6 +501,7 @@ func (c *liveStateCache) Init() error {
 // Run watches for resource changes annotated with application label on all registered clusters and schedule corresponding app refresh.
 func (c *liveStateCache) Run(ctx context.Context) error {
 	go c.watchSettings(ctx)
+	go c.watchNamespaces(ctx)
 
 	kube.RetryUntilSucceed(ctx, clustercache.ClusterRetryTimeout, "watch clusters", logutils.NewLogrusLogger(log.New()), func() error {
 		return c.db.WatchClusters(ctx, c.handleAddEvent, c.handleModEvent, c.handleDeleteEvent)
@@ -508,6 +543,7 @@ func (c *liveStateCache) handleModEvent(oldCluster *appv1.Cluster, newCluster *a
 	cluster, ok := c.clusters[newCluster.Server]
 	c.lock.Unlock()
 	if ok {
+		_, namespaced := cluster.(*namespacedClusterCache)
 		if !c.canHandleCluster(newCluster) {
 			cluster.Invalidate()
 			c.lock.Lock()
@@ -515,13 +551,33 @@ func (c *liveStateCache) handleModEvent(oldCluster *appv1.Cluster, newCluster *a
 			c.lock.Unlock()
 			return
 		}
+		if namespaced || newCluster.NamespaceDiscovery.IsEnabled() {
+			if !reflect.DeepEqual(oldCluster.NamespaceDiscovery, newCluster.NamespaceDiscovery) || !reflect.DeepEqual(oldCluster.Config, newCluster.Config) {
+				// the cache is re-created with the new discovery settings and credentials
+				cluster.Invalidate()
+				c.lock.Lock()
+				delete(c.clusters, newCluster.Server)
+				c.lock.Unlock()
+				go func() {
+					// warm up cluster cache
+					_, _ = c.getSyncedCluster(newCluster.Server)
+				}()
+				return
+			}
+			if !reflect.DeepEqual(oldCluster.Namespaces, newCluster.Namespaces) {
+				// configured namespaces are used as discovery candidates
+				c.updateNamespaces(newCluster.Server, c.getClusterNamespaces(newCluster))
+			}
+		}
 
 		var updateSettings []clustercache.UpdateSettingsFunc
-		if !reflect.DeepEqual(oldCluster.Config, newCluster.Config) {
-			updateSettings = append(updateSettings, clustercache.SetConfig(newCluster.RESTConfig()))
-		}
-		if !reflect.DeepEqual(oldCluster.Namespaces, newCluster.Namespaces) {
-			updateSettings = append(updateSettings, clustercache.SetNamespaces(newCluster.Namespaces))
+		if !namespaced {
+			if !reflect.DeepEqual(oldCluster.Config, newCluster.Config) {
+				updateSettings = append(updateSettings, clustercache.SetConfig(newCluster.RESTConfig()))
+			}
+			if !reflect.DeepEqual(oldCluster.Namespaces, newCluster.Namespaces) {
+				updateSettings = append(updateSettings, clustercache.SetNamespaces(newCluster.Namespaces))
+			}
 		}
 		forceInvalidate := false
 		if newCluster.RefreshRequestedAt != nil &&
@@ -1,15 +1,29 @@
 package cache
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 
 	"github.com/vathsalashetty96/gitops-engine/pkg/cache"
 	"github.com/vathsalashetty96/gitops-engine/pkg/cache/mocks"
+	"github.com/vathsalashetty96/gitops-engine/pkg/utils/kube"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/vathsalashetty96/argo-cd/common"
 	appv1 "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/vathsalashetty96/argo-cd/util/argo"
 )
 
 func TestHandleModEvent_HasChanges(t *testing.T) {
@@ -93,3 +107,171 @@ func TestHandleAddEvent_ClusterExcluded(t *testing.T) {
 
 	assert.Len(t, clustersCache.clusters, 0)
 }
+
+func TestUpdateNamespaces_NoChanges(t *testing.T) {
+	clusterCache := newNamespacedClusterCache([]string{"default", "team-a"}, func(namespace string) cache.ClusterCache {
+		nsCache := &mocks.ClusterCache{}
+		nsCache.On("Invalidate", mock.Anything).Panic("should not invalidate")
+		nsCache.On("EnsureSynced").Return(nil).Panic("should not re-sync")
+		return nsCache
+	})
+
+	clustersCache := liveStateCache{
+		clusters: map[string]cache.ClusterCache{
+			"https://mycluster": clusterCache,
+		},
+	}
+
+	clustersCache.updateNamespaces("https://mycluster", []string{"default", "team-a"})
+}
+
+func TestUpdateNamespaces_HasChanges(t *testing.T) {
+	created := map[string]*mocks.ClusterCache{}
+	clusterCache := newNamespacedClusterCache([]string{"default", "team-a"}, func(namespace string) cache.ClusterCache {
+		nsCache := &mocks.ClusterCache{}
+		nsCache.On("Invalidate").Return(nil).Maybe()
+		nsCache.On("EnsureSynced").Return(nil).Maybe()
+		created[namespace] = nsCache
+		return nsCache
+	})
+	defaultCache := created["default"]
+
+	clustersCache := liveStateCache{
+		clusters: map[string]cache.ClusterCache{
+			"https://mycluster": clusterCache,
+		},
+	}
+
+	clustersCache.updateNamespaces("https://mycluster", []string{"default", "team-b"})
+
+	assert.Equal(t, []string{"default", "team-b"}, clusterCache.getNamespaces())
+	assert.Same(t, defaultCache, created["default"])
+	assert.Contains(t, created, "team-b")
+	created["team-a"].AssertCalled(t, "Invalidate")
+	defaultCache.AssertNotCalled(t, "Invalidate")
+}
+
+func TestNamespacedClusterCache_FindResources(t *testing.T) {
+	teamA := &cache.Resource{Ref: corev1.ObjectReference{Kind: "Pod", Namespace: "team-a", Name: "a"}}
+	teamB := &cache.Resource{Ref: corev1.ObjectReference{Kind: "Pod", Namespace: "team-b", Name: "b"}}
+	clusterCache := newNamespacedClusterCache([]string{"team-a", "team-b"}, func(namespace string) cache.ClusterCache {
+		nsCache := &mocks.ClusterCache{}
+		res := teamA
+		if namespace == "team-b" {
+			res = teamB
+		}
+		nsCache.On("FindResources", mock.Anything).Return(map[kube.ResourceKey]*cache.Resource{res.ResourceKey(): res})
+		return nsCache
+	})
+
+	assert.Equal(t, map[kube.ResourceKey]*cache.Resource{teamA.ResourceKey(): teamA, teamB.ResourceKey(): teamB}, clusterCache.FindResources(""))
+	assert.Equal(t, map[kube.ResourceKey]*cache.Resource{teamB.ResourceKey(): teamB}, clusterCache.FindResources("team-b"))
+	assert.Empty(t, clusterCache.FindResources("kube-system"))
+}
+
+func TestGetClusterNamespaces(t *testing.T) {
+	clustersCache := liveStateCache{
+		discoverNamespaces: func(cluster *appv1.Cluster, candidates []string) ([]string, error) {
+			return []string{"team-a", "team-b"}, nil
+		},
+	}
+
+	assert.Equal(t, []string{"default"}, clustersCache.getClusterNamespaces(&appv1.Cluster{
+		Server:     "https://mycluster",
+		Namespaces: []string{"default"},
+	}))
+	assert.Equal(t, []string{"team-a", "team-b"}, clustersCache.getClusterNamespaces(&appv1.Cluster{
+		Server:             "https://mycluster",
+		Namespaces:         []string{"default"},
+		NamespaceDiscovery: &appv1.ClusterNamespaceDiscovery{RBAC: true},
+	}))
+}
+
+func TestDiscoverNamespaces(t *testing.T) {
+	newClientset := func() *fake.Clientset {
+		clientset := fake.NewSimpleClientset(
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"team": "a"}}},
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b"}},
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "kube-system"}},
+		)
+		clientset.PrependReactor("create", "selfsubjectrulesreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectRulesReview)
+			switch review.Spec.Namespace {
+			case "team-a":
+				// role binding which grants access to explicit resources
+				review.Status.ResourceRules = []authorizationv1.ResourceRule{
+					{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"secrets"}},
+					{Verbs: []string{"get", "list", "watch"}, APIGroups: []string{"", "apps"}, Resources: []string{"configmaps", "deployments"}},
+				}
+			case "team-b":
+				review.Status.Incomplete = true
+			default:
+				review.Status.ResourceRules = []authorizationv1.ResourceRule{{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"pods"}}}
+			}
+			return true, review, nil
+		})
+		clientset.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+			attrs := review.Spec.ResourceAttributes
+			review.Status.Allowed = attrs.Namespace == "team-b" && attrs.Verb == "list" && attrs.Resource == "*"
+			return true, review, nil
+		})
+		return clientset
+	}
+
+	t.Run("RBAC", func(t *testing.T) {
+		namespaces, err := discoverNamespaces(context.Background(), newClientset(), &appv1.ClusterNamespaceDiscovery{RBAC: true}, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"team-a", "team-b"}, namespaces)
+	})
+
+	t.Run("LabelSelector", func(t *testing.T) {
+		namespaces, err := discoverNamespaces(context.Background(), newClientset(), &appv1.ClusterNamespaceDiscovery{LabelSelector: "team=a"}, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"team-a"}, namespaces)
+	})
+
+	t.Run("CandidatesIfNamespacesListForbidden", func(t *testing.T) {
+		clientset := newClientset()
+		clientset.PrependReactor("list", "namespaces", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, apierr.NewForbidden(schema.GroupResource{Resource: "namespaces"}, "", errors.New("forbidden"))
+		})
+		namespaces, err := discoverNamespaces(context.Background(), clientset, &appv1.ClusterNamespaceDiscovery{RBAC: true}, []string{"team-b", "kube-system", "team-b"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"team-b"}, namespaces)
+	})
+}
+
+func TestPopulateResourceInfoHandler(t *testing.T) {
+	newConfigMap := func(namespace string, name string, labels map[string]string, annotations map[string]string) *unstructured.Unstructured {
+		un := &unstructured.Unstructured{}
+		un.SetAPIVersion("v1")
+		un.SetKind("ConfigMap")
+		un.SetNamespace(namespace)
+		un.SetName(name)
+		un.SetLabels(labels)
+		un.SetAnnotations(annotations)
+		return un
+	}
+	getAppName := func(handler cache.OnPopulateResourceInfoHandler, un *unstructured.Unstructured) string {
+		info, _ := handler(un, true)
+		return info.(*ResourceInfo).AppName
+	}
+
+	t.Run("Label", func(t *testing.T) {
+		handler := populateResourceInfoHandler(cacheSettings{appInstanceLabelKey: common.LabelKeyAppInstance, trackingMethod: argo.TrackingMethodLabel})
+		assert.Equal(t, "my-app", getAppName(handler, newConfigMap("default", "my-cm", map[string]string{common.LabelKeyAppInstance: "my-app"}, nil)))
+		assert.Equal(t, "", getAppName(handler, newConfigMap("default", "my-cm", nil, map[string]string{common.AnnotationKeyAppInstance: "my-app:/ConfigMap:default/my-cm"})))
+	})
+	t.Run("Annotation", func(t *testing.T) {
+		handler := populateResourceInfoHandler(cacheSettings{appInstanceLabelKey: common.LabelKeyAppInstance, trackingMethod: argo.TrackingMethodAnnotation})
+		assert.Equal(t, "my-app", getAppName(handler, newConfigMap("default", "my-cm", nil, map[string]string{common.AnnotationKeyAppInstance: "my-app:/ConfigMap:default/my-cm"})))
+		assert.Equal(t, "", getAppName(handler, newConfigMap("default", "my-cm", map[string]string{common.LabelKeyAppInstance: "my-app"}, nil)))
+	})
+	t.Run("CopiedResource", func(t *testing.T) {
+		handler := populateResourceInfoHandler(cacheSettings{appInstanceLabelKey: common.LabelKeyAppInstance, trackingMethod: argo.TrackingMethodAnnotation})
+		annotations := map[string]string{common.AnnotationKeyAppInstance: "my-app:/ConfigMap:default/my-cm"}
+		assert.Equal(t, "", getAppName(handler, newConfigMap("other", "my-cm", nil, annotations)))
+		assert.Equal(t, "", getAppName(handler, newConfigMap("default", "my-cm-copy", nil, annotations)))
+	})
+}
@@ -0,0 +1,447 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	clustercache "github.com/vathsalashetty96/gitops-engine/pkg/cache"
+	"github.com/vathsalashetty96/gitops-engine/pkg/utils/kube"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/vathsalashetty96/argo-cd/common"
+	appv1 "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+// namespacesDiscoverer returns namespaces which should be watched in the given cluster. The candidates are used
+// as the namespaces to choose from if the cluster credentials are not allowed to list namespaces.
+type namespacesDiscoverer func(cluster *appv1.Cluster, candidates []string) ([]string, error)
+
+func discoverClusterNamespaces(cluster *appv1.Cluster, candidates []string) ([]string, error) {
+	clientset, err := kubernetes.NewForConfig(cluster.RESTConfig())
+	if err != nil {
+		return nil, err
+	}
+	return discoverNamespaces(context.Background(), clientset, cluster.NamespaceDiscovery, candidates)
+}
+
+// discoverNamespaces returns sorted list of namespaces which match the discovery label selector and, if RBAC discovery is
+// enabled, in which the client is allowed to list resources.
+func discoverNamespaces(ctx context.Context, clientset kubernetes.Interface, discovery *appv1.ClusterNamespaceDiscovery, candidates []string) ([]string, error) {
+	names := make(map[string]bool)
+	nsList, err := clientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{LabelSelector: discovery.LabelSelector})
+	switch {
+	case err == nil:
+		for _, ns := range nsList.Items {
+			names[ns.Name] = true
+		}
+	case apierr.IsForbidden(err) && discovery.LabelSelector == "":
+		// namespace level access only: the candidates are the only namespaces we know about
+		for _, ns := range candidates {
+			names[ns] = true
+		}
+	default:
+		return nil, fmt.Errorf("failed to list namespaces: %v", err)
+	}
+
+	namespaces := make([]string, 0, len(names))
+	for ns := range names {
+		if discovery.RBAC {
+			allowed, err := canListResources(ctx, clientset, ns)
+			if err != nil {
+				return nil, err
+			}
+			if !allowed {
+				continue
+			}
+		}
+		namespaces = append(namespaces, ns)
+	}
+	sort.Strings(namespaces)
+	return namespaces, nil
+}
+
+// canListResources checks if the client is allowed to list resources within the namespace, which is required to watch
+// them. The rules of the client in the namespace are reviewed with a SelfSubjectRulesReview, so role bindings which
+// grant access to explicit resources rather than all resources are enough for the check to pass. If the authorizer
+// can't list all rules, the client needs to be allowed to list all resources of the namespace.
+func canListResources(ctx context.Context, clientset kubernetes.Interface, namespace string) (bool, error) {
+	rules, err := clientset.AuthorizationV1().SelfSubjectRulesReviews().Create(ctx, &authorizationv1.SelfSubjectRulesReview{
+		Spec: authorizationv1.SelfSubjectRulesReviewSpec{Namespace: namespace},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return false, fmt.Errorf("failed to review rules in namespace %s: %v", namespace, err)
+	}
+	for _, rule := range rules.Status.ResourceRules {
+		if len(rule.Resources) > 0 && (containsString(rule.Verbs, "list") || containsString(rule.Verbs, "*")) {
+			return true, nil
+		}
+	}
+	if !rules.Status.Incomplete {
+		return false, nil
+	}
+	review, err := clientset.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: namespace,
+				Verb:      "list",
+				Group:     "*",
+				Resource:  "*",
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return false, fmt.Errorf("failed to review access to namespace %s: %v", namespace, err)
+	}
+	return review.Status.Allowed, nil
+}
+
+func containsString(items []string, item string) bool {
+	for _, i := range items {
+		if i == item {
+			return true
+		}
+	}
+	return false
+}
+
+// getAppNamespaces returns namespaces of applications deployed to the given cluster
+func (c *liveStateCache) getAppNamespaces(cluster *appv1.Cluster) []string {
+	var namespaces []string
+	if c.appInformer == nil {
+		return namespaces
+	}
+	for _, obj := range c.appInformer.GetStore().List() {
+		app, ok := obj.(*appv1.Application)
+		if !ok || app.Spec.Destination.Namespace == "" {
+			continue
+		}
+		if app.Spec.Destination.Server == cluster.Server || (app.Spec.Destination.Name != "" && app.Spec.Destination.Name == cluster.Name) {
+			namespaces = append(namespaces, app.Spec.Destination.Namespace)
+		}
+	}
+	return namespaces
+}
+
+// getClusterNamespaces returns namespaces which should be watched in the given cluster. Falls back to the static list of
+// cluster namespaces if discovery is disabled or fails.
+func (c *liveStateCache) getClusterNamespaces(cluster *appv1.Cluster) []string {
+	if !cluster.NamespaceDiscovery.IsEnabled() {
+		return cluster.Namespaces
+	}
+	namespaces, err := c.discoverNamespaces(cluster, append(c.getAppNamespaces(cluster), cluster.Namespaces...))
+	if err != nil {
+		log.Warnf("Failed to discover namespaces of cluster %s, using configured namespaces: %v", cluster.Server, err)
+		return cluster.Namespaces
+	}
+	if len(namespaces) == 0 {
+		log.Warnf("No namespaces discovered in cluster %s, using configured namespaces", cluster.Server)
+		return cluster.Namespaces
+	}
+	return namespaces
+}
+
+// updateNamespaces updates the watched namespaces of the cluster cache. Only watches of the added and removed namespaces
+// are started and stopped, the resources of the namespaces which are still watched are not re-listed.
+func (c *liveStateCache) updateNamespaces(server string, namespaces []string) {
+	c.lock.RLock()
+	clusterCache, ok := c.clusters[server].(*namespacedClusterCache)
+	c.lock.RUnlock()
+	if !ok {
+		return
+	}
+
+	added, removed := clusterCache.setNamespaces(namespaces)
+	if len(added) == 0 && len(removed) == 0 {
+		return
+	}
+	log.WithField("server", server).Infof("Watched namespaces changed: added %v, removed %v", added, removed)
+	go func() {
+		// warm up caches of the added namespaces
+		_ = clusterCache.EnsureSynced()
+	}()
+}
+
+// refreshNamespaces re-discovers watched namespaces of clusters with enabled namespace discovery
+func (c *liveStateCache) refreshNamespaces(ctx context.Context) {
+	c.lock.RLock()
+	servers := make([]string, 0, len(c.clusters))
+	for server := range c.clusters {
+		servers = append(servers, server)
+	}
+	c.lock.RUnlock()
+
+	for _, server := range servers {
+		cluster, err := c.db.GetCluster(ctx, server)
+		if err != nil {
+			log.Warnf("Failed to get cluster %s: %v", server, err)
+			continue
+		}
+		if !cluster.NamespaceDiscovery.IsEnabled() {
+			continue
+		}
+		namespaces, err := c.discoverNamespaces(cluster, append(c.getAppNamespaces(cluster), cluster.Namespaces...))
+		if err != nil {
+			log.Warnf("Failed to discover namespaces of cluster %s: %v", server, err)
+			continue
+		}
+		if len(namespaces) == 0 {
+			log.Warnf("No namespaces discovered in cluster %s, keeping watched namespaces", server)
+			continue
+		}
+		c.updateNamespaces(server, namespaces)
+	}
+}
+
+func (c *liveStateCache) watchNamespaces(ctx context.Context) {
+	ticker := time.NewTicker(common.ClusterNamespaceDiscoveryInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("shutting down namespaces discovery")
+			return
+		case <-ticker.C:
+			c.refreshNamespaces(ctx)
+		}
+	}
+}
+
+// namespacedClusterCache is the cache of a cluster with enabled namespace discovery. It consists of a separate cluster
+// cache per watched namespace, so the set of watched namespaces can change without invalidating the whole cluster cache.
+// An empty namespace key is used for the cache of the whole cluster if no namespaces are watched.
+type namespacedClusterCache struct {
+	// ClusterCache is embedded to satisfy the interface, it is never set. Event handlers are registered on the per
+	// namespace caches when they are created.
+	clustercache.ClusterCache
+
+	newCache func(namespace string) clustercache.ClusterCache
+	lock     sync.RWMutex
+	caches   map[string]clustercache.ClusterCache
+}
+
+func newNamespacedClusterCache(namespaces []string, newCache func(namespace string) clustercache.ClusterCache) *namespacedClusterCache {
+	c := &namespacedClusterCache{newCache: newCache, caches: make(map[string]clustercache.ClusterCache)}
+	c.setNamespaces(namespaces)
+	return c
+}
+
+// setNamespaces creates caches of the added namespaces and invalidates caches of the removed ones, which stops their
+// watches. Caches of the namespaces which are still watched are kept as is.
+func (c *namespacedClusterCache) setNamespaces(namespaces []string) (added []string, removed []string) {
+	wanted := map[string]bool{}
+	for _, ns := range namespaces {
+		wanted[ns] = true
+	}
+	if len(wanted) == 0 {
+		wanted[""] = true
+	}
+
+	c.lock.RLock()
+	for ns := range wanted {
+		if _, ok := c.caches[ns]; !ok {
+			added = append(added, ns)
+		}
+	}
+	c.lock.RUnlock()
+	// new caches are created outside of the lock since the factory might acquire the live state cache lock
+	addedCaches := make(map[string]clustercache.ClusterCache, len(added))
+	for _, ns := range added {
+		addedCaches[ns] = c.newCache(ns)
+	}
+
+	var removedCaches []clustercache.ClusterCache
+	c.lock.Lock()
+	for ns, cache := range c.caches {
+		if !wanted[ns] {
+			removed = append(removed, ns)
+			removedCaches = append(removedCaches, cache)
+			delete(c.caches, ns)
+		}
+	}
+	for ns, cache := range addedCaches {
+		c.caches[ns] = cache
+	}
+	c.lock.Unlock()
+
+	for _, cache := range removedCaches {
+		cache.Invalidate()
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return added, removed
+}
+
+// getNamespaces returns sorted list of the watched namespaces
+func (c *namespacedClusterCache) getNamespaces() []string {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	namespaces := make([]string, 0, len(c.caches))
+	for ns := range c.caches {
+		if ns != "" {
+			namespaces = append(namespaces, ns)
+		}
+	}
+	sort.Strings(namespaces)
+	return namespaces
+}
+
+// getCaches returns the per namespace caches sorted by namespace
+func (c *namespacedClusterCache) getCaches() []clustercache.ClusterCache {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	namespaces := make([]string, 0, len(c.caches))
+	for ns := range c.caches {
+		namespaces = append(namespaces, ns)
+	}
+	sort.Strings(namespaces)
+	caches := make([]clustercache.ClusterCache, len(namespaces))
+	for i, ns := range namespaces {
+		caches[i] = c.caches[ns]
+	}
+	return caches
+}
+
+// getCache returns the cache which holds resources of the given namespace. Cluster level resources are served by the
+// first cache.
+func (c *namespacedClusterCache) getCache(namespace string) (clustercache.ClusterCache, bool) {
+	c.lock.RLock()
+	cache, ok := c.caches[namespace]
+	if !ok {
+		cache, ok = c.caches[""]
+	}
+	c.lock.RUnlock()
+	if !ok && namespace == "" {
+		if caches := c.getCaches(); len(caches) > 0 {
+			return caches[0], true
+		}
+	}
+	return cache, ok
+}
+
+func (c *namespacedClusterCache) EnsureSynced() error {
+	caches := c.getCaches()
+	errs := make([]error, len(caches))
+	var wg sync.WaitGroup
+	for i := range caches {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = caches[i].EnsureSynced()
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *namespacedClusterCache) Invalidate(opts ...clustercache.UpdateSettingsFunc) {
+	for _, cache := range c.getCaches() {
+		cache.Invalidate(opts...)
+	}
+}
+
+func (c *namespacedClusterCache) GetServerVersion() string {
+	if cache, ok := c.getCache(""); ok {
+		return cache.GetServerVersion()
+	}
+	return ""
+}
+
+func (c *namespacedClusterCache) GetAPIGroups() []metav1.APIGroup {
+	if cache, ok := c.getCache(""); ok {
+		return cache.GetAPIGroups()
+	}
+	return nil
+}
+
+func (c *namespacedClusterCache) IsNamespaced(gk schema.GroupKind) (bool, error) {
+	cache, ok := c.getCache("")
+	if !ok {
+		return false, fmt.Errorf("no namespaces are watched")
+	}
+	return cache.IsNamespaced(gk)
+}
+
+func (c *namespacedClusterCache) FindResources(namespace string, predicates ...func(r *clustercache.Resource) bool) map[kube.ResourceKey]*clustercache.Resource {
+	if namespace != "" {
+		if cache, ok := c.getCache(namespace); ok {
+			return cache.FindResources(namespace, predicates...)
+		}
+		return map[kube.ResourceKey]*clustercache.Resource{}
+	}
+	res := make(map[kube.ResourceKey]*clustercache.Resource)
+	for _, cache := range c.getCaches() {
+		for key, r := range cache.FindResources(namespace, predicates...) {
+			res[key] = r
+		}
+	}
+	return res
+}
+
+func (c *namespacedClusterCache) IterateHierarchy(key kube.ResourceKey, action func(resource *clustercache.Resource, namespaceResources map[kube.ResourceKey]*clustercache.Resource)) {
+	if cache, ok := c.getCache(key.Namespace); ok {
+		cache.IterateHierarchy(key, action)
+	}
+}
+
+// GetManagedLiveObjs passes each target object to the cache of its namespace. Objects of namespaces which are not watched
+// are passed to the first cache, which reports them as not managed.
+func (c *namespacedClusterCache) GetManagedLiveObjs(targetObjs []*unstructured.Unstructured, isManaged func(r *clustercache.Resource) bool) (map[kube.ResourceKey]*unstructured.Unstructured, error) {
+	caches := c.getCaches()
+	if len(caches) == 0 {
+		return nil, fmt.Errorf("no namespaces are watched")
+	}
+	targetsByCache := make(map[clustercache.ClusterCache][]*unstructured.Unstructured)
+	for _, obj := range targetObjs {
+		cache, ok := c.getCache(obj.GetNamespace())
+		if !ok {
+			cache = caches[0]
+		}
+		targetsByCache[cache] = append(targetsByCache[cache], obj)
+	}
+	res := make(map[kube.ResourceKey]*unstructured.Unstructured)
+	for _, cache := range caches {
+		liveObjs, err := cache.GetManagedLiveObjs(targetsByCache[cache], isManaged)
+		if err != nil {
+			return nil, err
+		}
+		for key, obj := range liveObjs {
+			res[key] = obj
+		}
+	}
+	return res, nil
+}
+
+// GetClusterInfo returns statistics of all per namespace caches. The last sync time is the sync time of the cache which
+// was synced least recently.
+func (c *namespacedClusterCache) GetClusterInfo() clustercache.ClusterInfo {
+	var info clustercache.ClusterInfo
+	for i, cache := range c.getCaches() {
+		cacheInfo := cache.GetClusterInfo()
+		if i == 0 {
+			info = cacheInfo
+			continue
+		}
+		info.ResourcesCount += cacheInfo.ResourcesCount
+		if cacheInfo.LastCacheSyncTime == nil || (info.LastCacheSyncTime != nil && cacheInfo.LastCacheSyncTime.Before(*info.LastCacheSyncTime)) {
+			info.LastCacheSyncTime = cacheInfo.LastCacheSyncTime
+		}
+		if info.SyncError == nil {
+			info.SyncError = cacheInfo.SyncError
+		}
+	}
+	return info
+}
@@ -0,0 +1,292 @@
+package hydrator
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	gosync "sync"
+	"time"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/ghodss/yaml"
+	log "github.com/sirupsen/logrus"
+	"github.com/vathsalashetty96/pkg/sync"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/vathsalashetty96/argo-cd/util/db"
+	"github.com/vathsalashetty96/argo-cd/util/git"
+)
+
+const (
+	// ManifestFileName is the name of the file containing the rendered manifests of the application
+	ManifestFileName = "manifest.yaml"
+
+	// DefaultCommitAuthorName is the default name of the author of the hydration commits
+	DefaultCommitAuthorName = "Argo CD"
+	// DefaultCommitAuthorEmail is the default email of the author of the hydration commits
+	DefaultCommitAuthorEmail = "argo-cd@localhost"
+
+	// hydrationRetryMinBackoff is the time after which a failed commit is retried the first time. The backoff doubles
+	// with each failure of the same commit up to hydrationRetryMaxBackoff.
+	hydrationRetryMinBackoff = 30 * time.Second
+	hydrationRetryMaxBackoff = 10 * time.Minute
+)
+
+// Config holds the settings of the hydrator
+type Config struct {
+	// WorkDir is the directory the target repositories are cloned into
+	WorkDir string
+	// CommitAuthorName is the name of the author of the hydration commits
+	CommitAuthorName string
+	// CommitAuthorEmail is the email of the author of the hydration commits
+	CommitAuthorEmail string
+}
+
+// Hydrator commits the manifests rendered by the repo server to the git repository configured in the application spec
+type Hydrator struct {
+	db       db.ArgoDB
+	config   Config
+	repoLock sync.KeyLock
+	// newGitClient creates the write capable git client; replaced in tests
+	newGitClient func(repoURL string, root string, creds git.Creds, insecure bool, enableLfs bool, opts ...git.ClientOpts) (git.Client, error)
+
+	hydrationsLock gosync.Mutex
+	// hydrations are the last background commits by application name
+	hydrations map[string]*hydration
+}
+
+// hydration is a commit of the manifests rendered from a source revision to a hydration target, which runs in the
+// background
+type hydration struct {
+	key    string
+	done   bool
+	status *v1alpha1.HydratorStatus
+	err    error
+	// failures is the number of consecutive failures of the commit and retryAt the time after which it is retried
+	failures int
+	retryAt  time.Time
+}
+
+// NewHydrator returns a hydrator which clones the target repositories into the work directory of the config
+func NewHydrator(db db.ArgoDB, config Config) *Hydrator {
+	if config.CommitAuthorName == "" {
+		config.CommitAuthorName = DefaultCommitAuthorName
+	}
+	if config.CommitAuthorEmail == "" {
+		config.CommitAuthorEmail = DefaultCommitAuthorEmail
+	}
+	return &Hydrator{
+		db:           db,
+		config:       config,
+		repoLock:     sync.NewKeyLock(),
+		newGitClient: git.NewClientExt,
+		hydrations:   map[string]*hydration{},
+	}
+}
+
+// HydrateAsync commits the manifests rendered from the given source revision to the hydration target of the
+// application in the background, so that the refresh of the application does not wait for the push. Returns the status
+// of the commit once it is finished, or nil while it is in progress. onDone is called when a commit started by the call
+// finishes, so that the application is refreshed to pick up the result. The result is kept until the source revision or
+// the hydration target changes, unless force is set, which commits the manifests again. A failed commit is retried after
+// a backoff.
+func (h *Hydrator) HydrateAsync(app *v1alpha1.Application, source v1alpha1.ApplicationSource, drySHA string, manifests []string, force bool, onDone func()) (*v1alpha1.HydratorStatus, error) {
+	if app.Spec.HydrateTo == nil {
+		return nil, fmt.Errorf("application %s does not specify the hydration target", app.Name)
+	}
+	target := app.Spec.HydrateTo.Target(source)
+	key := strings.Join([]string{target.RepoURL, target.TargetBranch, target.Path, drySHA}, "|")
+
+	h.hydrationsLock.Lock()
+	defer h.hydrationsLock.Unlock()
+	failures := 0
+	if prev, ok := h.hydrations[app.Name]; ok && prev.key == key {
+		if !prev.done || (!force && (prev.err == nil || time.Now().Before(prev.retryAt))) {
+			return prev.status, prev.err
+		}
+		failures = prev.failures
+	}
+	hyd := &hydration{key: key, failures: failures}
+	h.hydrations[app.Name] = hyd
+	app = app.DeepCopy()
+	go func() {
+		hydratedSHA, err := h.Hydrate(context.Background(), app, source, drySHA, manifests)
+		if err != nil {
+			log.WithField("application", app.Name).Warnf("Failed to hydrate manifests of revision %s: %v", drySHA, err)
+		}
+		h.hydrationsLock.Lock()
+		hyd.done = true
+		if err == nil {
+			now := metav1.Now()
+			hyd.status = &v1alpha1.HydratorStatus{DrySHA: drySHA, HydratedSHA: hydratedSHA, HydratedAt: &now,
+				RepoURL: target.RepoURL, TargetBranch: target.TargetBranch, Path: target.Path}
+			hyd.failures = 0
+		} else {
+			hyd.failures++
+			hyd.retryAt = time.Now().Add(hydrationRetryBackoff(hyd.failures))
+		}
+		hyd.err = err
+		h.hydrationsLock.Unlock()
+		onDone()
+	}()
+	return nil, nil
+}
+
+// hydrationRetryBackoff returns the time after which a commit which failed the given number of times is retried
+func hydrationRetryBackoff(failures int) time.Duration {
+	backoff := hydrationRetryMinBackoff
+	for i := 1; i < failures && backoff < hydrationRetryMaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > hydrationRetryMaxBackoff {
+		backoff = hydrationRetryMaxBackoff
+	}
+	return backoff
+}
+
+// Forget drops the result of the last commit of the given application, e.g. once the application is deleted
+func (h *Hydrator) Forget(appName string) {
+	h.hydrationsLock.Lock()
+	defer h.hydrationsLock.Unlock()
+	delete(h.hydrations, appName)
+}
+
+// Hydrate commits the manifests rendered from the given source revision to the branch and path configured in the
+// hydrateTo field of the application. The commit message contains trailers referencing the source revision. Returns the
+// revision of the commit containing the manifests, which is the current revision of the branch if the manifests did not
+// change.
+func (h *Hydrator) Hydrate(ctx context.Context, app *v1alpha1.Application, source v1alpha1.ApplicationSource, drySHA string, manifests []string) (string, error) {
+	hydrateTo := app.Spec.HydrateTo
+	if hydrateTo == nil {
+		return "", fmt.Errorf("application %s does not specify the hydration target", app.Name)
+	}
+	if hydrateTo.TargetBranch == "" {
+		return "", fmt.Errorf("hydration target branch is not specified")
+	}
+	repoURL := hydrateTo.GetRepoURL(source)
+	path := filepath.Clean(hydrateTo.GetPath(source))
+	if path == "." || filepath.IsAbs(path) || path == ".." || strings.HasPrefix(path, "../") {
+		return "", fmt.Errorf("hydration path '%s' must be a subdirectory of the repository", hydrateTo.GetPath(source))
+	}
+	// the manifests must not replace the source they are rendered from
+	sameSource := git.SameURL(repoURL, source.RepoURL) && pathsOverlap(path, source.Path)
+	if sameSource && strings.TrimPrefix(source.TargetRevision, "refs/heads/") == hydrateTo.TargetBranch {
+		return "", fmt.Errorf("cannot commit the rendered manifests to the source branch %s", source.TargetRevision)
+	}
+
+	repo, err := h.db.GetRepository(ctx, repoURL)
+	if err != nil {
+		return "", err
+	}
+
+	h.repoLock.Lock(repoURL)
+	defer h.repoLock.Unlock(repoURL)
+
+	root := filepath.Join(h.config.WorkDir, strings.Replace(git.NormalizeGitURL(repoURL), "/", "_", -1))
+	client, err := h.newGitClient(repo.Repo, root, repo.GetGitCreds(), repo.IsInsecure(), repo.IsLFSEnabled())
+	if err != nil {
+		return "", err
+	}
+	if err := client.Init(); err != nil {
+		return "", err
+	}
+	if sameSource {
+		isSource, err := isSourceBranch(client, hydrateTo.TargetBranch, source.TargetRevision, drySHA)
+		if err != nil {
+			return "", err
+		}
+		if isSource {
+			return "", fmt.Errorf("cannot commit the rendered manifests to the branch %s, which is the source revision %s", hydrateTo.TargetBranch, source.TargetRevision)
+		}
+	}
+	if err := client.SetAuthor(h.config.CommitAuthorName, h.config.CommitAuthorEmail); err != nil {
+		return "", err
+	}
+	if err := client.Fetch(""); err != nil {
+		return "", err
+	}
+	if err := client.CheckoutOrOrphan(hydrateTo.TargetBranch); err != nil {
+		return "", err
+	}
+	if err := writeManifests(client.Root(), path, manifests); err != nil {
+		return "", err
+	}
+
+	message := fmt.Sprintf("Hydrate %s from %s\n\nArgocd-source-repo: %s\nArgocd-source-path: %s\nArgocd-source-commit: %s\n",
+		app.Name, drySHA, source.RepoURL, source.Path, drySHA)
+	hydratedSHA, err := client.CommitAndPush(hydrateTo.TargetBranch, message)
+	if err != nil {
+		return "", err
+	}
+	log.WithField("application", app.Name).Infof("Hydrated manifests of revision %s to %s (branch: %s, path: %s, revision: %s)",
+		drySHA, repoURL, hydrateTo.TargetBranch, path, hydratedSHA)
+	return hydratedSHA, nil
+}
+
+// pathsOverlap returns whether one of the given repository paths contains the other
+func pathsOverlap(a string, b string) bool {
+	a, b = filepath.Clean(a), filepath.Clean(b)
+	return a == "." || b == "." || a == b || strings.HasPrefix(a, b+"/") || strings.HasPrefix(b, a+"/")
+}
+
+// isSourceBranch returns whether the branch is the revision the source is rendered from. Since the source revision may
+// reference the branch symbolically, e.g. as HEAD, or by its commit SHA, the branch is compared with both the rendered
+// commit and the commit the source revision currently resolves to.
+func isSourceBranch(client git.Client, branch string, sourceRevision string, drySHA string) (bool, error) {
+	refs, err := client.LsRefs()
+	if err != nil {
+		return false, err
+	}
+	exists := false
+	for _, b := range refs.Branches {
+		exists = exists || b == branch
+	}
+	if !exists {
+		// the branch is created by the commit
+		return false, nil
+	}
+	branchSHA, err := client.LsRemote("refs/heads/" + branch)
+	if err != nil {
+		return false, err
+	}
+	if branchSHA == drySHA {
+		return true, nil
+	}
+	sourceSHA, err := client.LsRemote(sourceRevision)
+	if err != nil {
+		return false, err
+	}
+	return sourceSHA == branchSHA, nil
+}
+
+// writeManifests writes the given JSON manifests as YAML to the manifest file of the directory at the given path of the
+// repository. The manifest file is the only file written by the hydrator, so it is the only file replaced; other files
+// of the directory are kept. Symlinks committed to the repository are resolved within the repository root, so the
+// manifests are never written outside of it.
+func writeManifests(root string, path string, manifests []string) error {
+	dir, err := securejoin.SecureJoin(root, path)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	docs := make([]string, len(manifests))
+	for i, manifest := range manifests {
+		doc, err := yaml.JSONToYAML([]byte(manifest))
+		if err != nil {
+			return fmt.Errorf("failed to convert manifest to YAML: %v", err)
+		}
+		docs[i] = string(doc)
+	}
+	manifestPath := filepath.Join(dir, ManifestFileName)
+	// the previous file is removed rather than overwritten, so a symlink committed in its place is not followed
+	if err := os.Remove(manifestPath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return ioutil.WriteFile(manifestPath, []byte(strings.Join(docs, "---\n")), 0644)
+}
@@ -0,0 +1,298 @@
+package hydrator
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1"
+	dbmocks "github.com/vathsalashetty96/argo-cd/util/db/mocks"
+)
+
+const (
+	sourceRepoURL = "https://github.com/argoproj/argocd-example-apps.git"
+	drySHA        = "53cbd7b1b2fc9d1b3b1ab3d9e9b0b3b4e4c2e5a1"
+)
+
+func runGit(t *testing.T, dir string, args ...string) string {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if !assert.NoError(t, err, string(out)) {
+		t.FailNow()
+	}
+	return strings.TrimSpace(string(out))
+}
+
+func newTestHydrator(t *testing.T) (*Hydrator, string, func()) {
+	tmpDir, err := ioutil.TempDir("", "hydrator")
+	assert.NoError(t, err)
+	remote := filepath.Join(tmpDir, "remote.git")
+	runGit(t, tmpDir, "init", "--quiet", "--bare", remote)
+	remoteURL := "file://" + remote
+
+	db := &dbmocks.ArgoDB{}
+	db.On("GetRepository", mock.Anything, remoteURL).Return(&v1alpha1.Repository{Repo: remoteURL}, nil)
+	return NewHydrator(db, Config{WorkDir: filepath.Join(tmpDir, "work"), CommitAuthorName: "test", CommitAuthorEmail: "test@localhost"}), remoteURL, func() {
+		_ = os.RemoveAll(tmpDir)
+	}
+}
+
+func newTestApp(repoURL string) *v1alpha1.Application {
+	return &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"},
+		Spec: v1alpha1.ApplicationSpec{
+			Source:    v1alpha1.ApplicationSource{RepoURL: sourceRepoURL, Path: "guestbook", TargetRevision: "HEAD"},
+			HydrateTo: &v1alpha1.HydrateTo{RepoURL: repoURL, TargetBranch: "hydrated"},
+		},
+	}
+}
+
+func TestHydrate(t *testing.T) {
+	hydrator, remoteURL, cleanup := newTestHydrator(t)
+	defer cleanup()
+	app := newTestApp(remoteURL)
+	manifests := []string{
+		`{"apiVersion":"v1","kind":"Service","metadata":{"name":"guestbook-ui"}}`,
+		`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"guestbook-ui"}}`,
+	}
+
+	hydratedSHA, err := hydrator.Hydrate(context.Background(), app, app.Spec.Source, drySHA, manifests)
+	assert.NoError(t, err)
+	assert.Len(t, hydratedSHA, 40)
+
+	remote := strings.TrimPrefix(remoteURL, "file://")
+	assert.Equal(t, hydratedSHA, runGit(t, remote, "rev-parse", "hydrated"))
+	assert.Equal(t, `apiVersion: v1
+kind: Service
+metadata:
+  name: guestbook-ui
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: guestbook-ui
+`, runGit(t, remote, "show", "hydrated:guestbook/manifest.yaml")+"\n")
+	assert.Equal(t, drySHA, runGit(t, remote, "log", "-1", "--format=%(trailers:key=Argocd-source-commit,valueonly)", "hydrated"))
+	assert.Equal(t, "test <test@localhost>", runGit(t, remote, "log", "-1", "--format=%an <%ae>", "hydrated"))
+
+	t.Run("Unchanged", func(t *testing.T) {
+		sha, err := hydrator.Hydrate(context.Background(), app, app.Spec.Source, drySHA, manifests)
+		assert.NoError(t, err)
+		assert.Equal(t, hydratedSHA, sha)
+	})
+
+	t.Run("Changed", func(t *testing.T) {
+		sha, err := hydrator.Hydrate(context.Background(), app, app.Spec.Source, "b1a5c0d2e3f4a5b6c7d8e9f0a1b2c3d4e5f6a7b8", manifests[:1])
+		assert.NoError(t, err)
+		assert.NotEqual(t, hydratedSHA, sha)
+		assert.Equal(t, hydratedSHA, runGit(t, remote, "rev-parse", "hydrated^"))
+		assert.Equal(t, "guestbook/manifest.yaml", runGit(t, remote, "ls-tree", "-r", "--name-only", "hydrated"))
+	})
+
+	t.Run("OtherFilesKept", func(t *testing.T) {
+		work := remote + "-work"
+		runGit(t, filepath.Dir(remote), "clone", "--quiet", "--branch", "hydrated", remoteURL, work)
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(work, "guestbook", "README.md"), []byte("rendered by Argo CD\n"), 0644))
+		runGit(t, work, "add", ".")
+		runGit(t, work, "-c", "user.name=test", "-c", "user.email=test@localhost", "commit", "--quiet", "-m", "readme")
+		runGit(t, work, "push", "--quiet", "origin", "hydrated")
+
+		_, err := hydrator.Hydrate(context.Background(), app, app.Spec.Source, drySHA, manifests)
+		assert.NoError(t, err)
+		assert.Equal(t, "guestbook/README.md\nguestbook/manifest.yaml", runGit(t, remote, "ls-tree", "-r", "--name-only", "hydrated"))
+	})
+}
+
+func TestHydrate_InvalidTarget(t *testing.T) {
+	hydrator, remoteURL, cleanup := newTestHydrator(t)
+	defer cleanup()
+
+	app := newTestApp("")
+	app.Spec.HydrateTo.TargetBranch = "HEAD"
+	_, err := hydrator.Hydrate(context.Background(), app, app.Spec.Source, drySHA, nil)
+	assert.EqualError(t, err, "cannot commit the rendered manifests to the source branch HEAD")
+
+	app = newTestApp(remoteURL)
+	app.Spec.HydrateTo.Path = "."
+	_, err = hydrator.Hydrate(context.Background(), app, app.Spec.Source, drySHA, nil)
+	assert.EqualError(t, err, "hydration path '.' must be a subdirectory of the repository")
+
+	app.Spec.HydrateTo.Path = "../guestbook"
+	_, err = hydrator.Hydrate(context.Background(), app, app.Spec.Source, drySHA, nil)
+	assert.Error(t, err)
+}
+
+func TestHydrate_SymlinkedPath(t *testing.T) {
+	hydrator, remoteURL, cleanup := newTestHydrator(t)
+	defer cleanup()
+	remote := strings.TrimPrefix(remoteURL, "file://")
+	outside := filepath.Join(filepath.Dir(remote), "outside")
+	assert.NoError(t, os.MkdirAll(outside, 0755))
+
+	// the target branch contains a symlink in place of the hydration path, which points outside of the repository
+	work := remote + "-work"
+	runGit(t, filepath.Dir(remote), "init", "--quiet", work)
+	assert.NoError(t, os.Symlink(outside, filepath.Join(work, "guestbook")))
+	runGit(t, work, "add", ".")
+	runGit(t, work, "-c", "user.name=test", "-c", "user.email=test@localhost", "commit", "--quiet", "-m", "symlink")
+	runGit(t, work, "push", "--quiet", remoteURL, "HEAD:refs/heads/hydrated")
+
+	app := newTestApp(remoteURL)
+	_, _ = hydrator.Hydrate(context.Background(), app, app.Spec.Source, drySHA, []string{`{"apiVersion":"v1","kind":"Service","metadata":{"name":"guestbook-ui"}}`})
+	_, err := os.Stat(filepath.Join(outside, ManifestFileName))
+	assert.True(t, os.IsNotExist(err), "manifests were written outside of the repository")
+}
+
+// pushSource pushes a commit containing the source path to the default branch of the remote and returns its SHA
+func pushSource(t *testing.T, remoteURL string) string {
+	remote := strings.TrimPrefix(remoteURL, "file://")
+	work := remote + "-work"
+	runGit(t, filepath.Dir(remote), "init", "--quiet", work)
+	assert.NoError(t, os.MkdirAll(filepath.Join(work, "guestbook"), 0755))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(work, "guestbook", "values.yaml"), []byte("replicas: 1\n"), 0644))
+	runGit(t, work, "add", ".")
+	runGit(t, work, "-c", "user.name=test", "-c", "user.email=test@localhost", "commit", "--quiet", "-m", "source")
+	runGit(t, work, "push", "--quiet", remoteURL, "HEAD:refs/heads/main")
+	runGit(t, remote, "symbolic-ref", "HEAD", "refs/heads/main")
+	return runGit(t, work, "rev-parse", "HEAD")
+}
+
+func TestHydrate_SourceBranch(t *testing.T) {
+	hydrator, remoteURL, cleanup := newTestHydrator(t)
+	defer cleanup()
+	sourceSHA := pushSource(t, remoteURL)
+	app := newTestApp(remoteURL)
+	app.Spec.Source.RepoURL = remoteURL
+	app.Spec.HydrateTo.TargetBranch = "main"
+
+	for _, revision := range []string{"HEAD", "", "refs/heads/main", sourceSHA} {
+		app.Spec.Source.TargetRevision = revision
+		_, err := hydrator.Hydrate(context.Background(), app, app.Spec.Source, sourceSHA, nil)
+		assert.Error(t, err, revision)
+	}
+	// the branch moved since the revision was rendered
+	app.Spec.Source.TargetRevision = "HEAD"
+	_, err := hydrator.Hydrate(context.Background(), app, app.Spec.Source, drySHA, nil)
+	assert.EqualError(t, err, "cannot commit the rendered manifests to the branch main, which is the source revision HEAD")
+
+	t.Run("DifferentPath", func(t *testing.T) {
+		app.Spec.Source.TargetRevision = "HEAD"
+		app.Spec.HydrateTo.Path = "rendered/guestbook"
+		_, err := hydrator.Hydrate(context.Background(), app, app.Spec.Source, sourceSHA, nil)
+		assert.NoError(t, err)
+		remote := strings.TrimPrefix(remoteURL, "file://")
+		assert.Equal(t, "guestbook/values.yaml\nrendered/guestbook/manifest.yaml", runGit(t, remote, "ls-tree", "-r", "--name-only", "main"))
+	})
+
+	t.Run("DifferentBranch", func(t *testing.T) {
+		app.Spec.HydrateTo.Path = ""
+		app.Spec.HydrateTo.TargetBranch = "hydrated"
+		_, err := hydrator.Hydrate(context.Background(), app, app.Spec.Source, sourceSHA, nil)
+		assert.NoError(t, err)
+	})
+}
+
+func TestHydrationRetryBackoff(t *testing.T) {
+	assert.Equal(t, 30*time.Second, hydrationRetryBackoff(1))
+	assert.Equal(t, time.Minute, hydrationRetryBackoff(2))
+	assert.Equal(t, 8*time.Minute, hydrationRetryBackoff(5))
+	assert.Equal(t, 10*time.Minute, hydrationRetryBackoff(6))
+	assert.Equal(t, 10*time.Minute, hydrationRetryBackoff(100))
+}
+
+func TestPathsOverlap(t *testing.T) {
+	assert.True(t, pathsOverlap("guestbook", "guestbook/"))
+	assert.True(t, pathsOverlap("guestbook", "guestbook/base"))
+	assert.True(t, pathsOverlap("apps/guestbook", "apps"))
+	assert.True(t, pathsOverlap("guestbook", ""))
+	assert.True(t, pathsOverlap("guestbook", "."))
+	assert.False(t, pathsOverlap("guestbook", "guestbook-rendered"))
+	assert.False(t, pathsOverlap("rendered/guestbook", "guestbook"))
+}
+
+func TestHydrateAsync(t *testing.T) {
+	hydrator, remoteURL, cleanup := newTestHydrator(t)
+	defer cleanup()
+	app := newTestApp(remoteURL)
+	manifests := []string{`{"apiVersion":"v1","kind":"Service","metadata":{"name":"guestbook-ui"}}`}
+	done := make(chan struct{}, 1)
+	onDone := func() {
+		done <- struct{}{}
+	}
+
+	status, err := hydrator.HydrateAsync(app, app.Spec.Source, drySHA, manifests, false, onDone)
+	assert.NoError(t, err)
+	assert.Nil(t, status)
+	<-done
+
+	status, err = hydrator.HydrateAsync(app, app.Spec.Source, drySHA, manifests, false, onDone)
+	assert.NoError(t, err)
+	if assert.NotNil(t, status) {
+		remote := strings.TrimPrefix(remoteURL, "file://")
+		assert.Equal(t, runGit(t, remote, "rev-parse", "hydrated"), status.HydratedSHA)
+		assert.Equal(t, drySHA, status.DrySHA)
+		assert.True(t, status.IsHydratedTo(v1alpha1.HydrateTo{RepoURL: remoteURL, TargetBranch: "hydrated", Path: "guestbook"}))
+	}
+
+	t.Run("Force", func(t *testing.T) {
+		status, err := hydrator.HydrateAsync(app, app.Spec.Source, drySHA, manifests, true, onDone)
+		assert.NoError(t, err)
+		assert.Nil(t, status)
+		<-done
+	})
+
+	t.Run("TargetChanged", func(t *testing.T) {
+		app.Spec.HydrateTo.Path = "rendered"
+		status, err := hydrator.HydrateAsync(app, app.Spec.Source, drySHA, manifests, false, onDone)
+		assert.NoError(t, err)
+		assert.Nil(t, status)
+		<-done
+		status, err = hydrator.HydrateAsync(app, app.Spec.Source, drySHA, manifests, false, onDone)
+		assert.NoError(t, err)
+		if assert.NotNil(t, status) {
+			assert.Equal(t, "rendered", status.Path)
+		}
+	})
+
+	t.Run("RetryFailed", func(t *testing.T) {
+		failing := app.DeepCopy()
+		failing.Name = "failing"
+		failing.Spec.HydrateTo.TargetBranch = ""
+		status, err := hydrator.HydrateAsync(failing, failing.Spec.Source, drySHA, manifests, false, onDone)
+		assert.NoError(t, err)
+		assert.Nil(t, status)
+		<-done
+		// the failure is kept until the backoff passed
+		_, err = hydrator.HydrateAsync(failing, failing.Spec.Source, drySHA, manifests, false, onDone)
+		assert.EqualError(t, err, "hydration target branch is not specified")
+
+		hydrator.hydrationsLock.Lock()
+		hydrator.hydrations[failing.Name].retryAt = time.Now()
+		hydrator.hydrationsLock.Unlock()
+		status, err = hydrator.HydrateAsync(failing, failing.Spec.Source, drySHA, manifests, false, onDone)
+		assert.NoError(t, err)
+		assert.Nil(t, status)
+		<-done
+		hydrator.hydrationsLock.Lock()
+		assert.Equal(t, 2, hydrator.hydrations[failing.Name].failures)
+		hydrator.hydrationsLock.Unlock()
+	})
+
+	t.Run("Forget", func(t *testing.T) {
+		hydrator.Forget(app.Name)
+		status, err := hydrator.HydrateAsync(app, app.Spec.Source, drySHA, manifests, false, onDone)
+		assert.NoError(t, err)
+		assert.Nil(t, status)
+		<-done
+	})
+}
@@ -0,0 +1,99 @@
+package imageupdater
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// defaultRegistry is the registry of images which don't specify a registry host
+	defaultRegistry = "docker.io"
+	// dockerHubAPIHost is the host serving the registry API of Docker Hub
+	dockerHubAPIHost = "registry-1.docker.io"
+)
+
+var aliasRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]*$`)
+
+// Image is an image which is updated automatically, configured by an entry of the image list annotation
+type Image struct {
+	// Alias references the image in the annotations configuring its update
+	Alias string
+	// Name is the name of the image as it is referenced in the manifests, e.g. nginx or quay.io/argoproj/argocd
+	Name string
+	// Constraint is the semantic version constraint of the semver update strategy, e.g. ~1.19
+	Constraint string
+}
+
+// ParseImageList parses the value of the image list annotation, a comma-separated list of
+// <alias>=<image>[:<constraint>] entries
+func ParseImageList(val string) ([]Image, error) {
+	var images []Image
+	aliases := map[string]bool{}
+	for _, entry := range strings.Split(val, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 || parts[1] == "" {
+			return nil, fmt.Errorf("invalid image list entry '%s': expected <alias>=<image>[:<constraint>]", entry)
+		}
+		image := Image{Alias: parts[0], Name: parts[1]}
+		if !aliasRegex.MatchString(image.Alias) {
+			return nil, fmt.Errorf("invalid image alias '%s': must consist of alphanumeric characters, '-' or '_'", image.Alias)
+		}
+		if aliases[image.Alias] {
+			return nil, fmt.Errorf("duplicate image alias '%s'", image.Alias)
+		}
+		aliases[image.Alias] = true
+		image.Name, image.Constraint = splitTag(image.Name)
+		images = append(images, image)
+	}
+	return images, nil
+}
+
+// RegistryAndRepository returns the registry host and the repository of the image, taking the defaults of Docker Hub
+// into account
+func (i Image) RegistryAndRepository() (string, string) {
+	parts := strings.SplitN(i.Name, "/", 2)
+	if len(parts) == 2 && (strings.ContainsAny(parts[0], ".:") || parts[0] == "localhost") {
+		return normalizeRegistry(parts[0]), parts[1]
+	}
+	if len(parts) == 1 {
+		return defaultRegistry, "library/" + i.Name
+	}
+	return defaultRegistry, i.Name
+}
+
+// Matches returns true if the given image reference, e.g. docker.io/library/nginx:1.19, references the same
+// repository as the image
+func (i Image) Matches(ref string) bool {
+	name, _ := splitTag(ref)
+	registry, repository := Image{Name: name}.RegistryAndRepository()
+	expectedRegistry, expectedRepository := i.RegistryAndRepository()
+	return registry == expectedRegistry && repository == expectedRepository
+}
+
+// splitTag splits the given image reference into the name and the tag, ignoring the digest
+func splitTag(ref string) (string, string) {
+	if i := strings.Index(ref, "@"); i >= 0 {
+		ref = ref[:i]
+	}
+	if i := strings.LastIndex(ref, ":"); i > strings.LastIndex(ref, "/") {
+		return ref[:i], ref[i+1:]
+	}
+	return ref, ""
+}
+
+// normalizeRegistry returns the registry host of the given registry URL or host, mapping the hosts of Docker Hub to
+// docker.io
+func normalizeRegistry(registry string) string {
+	registry = strings.TrimPrefix(strings.TrimPrefix(registry, "https://"), "http://")
+	registry = strings.SplitN(registry, "/", 2)[0]
+	switch registry {
+	case "index.docker.io", dockerHubAPIHost:
+		return defaultRegistry
+	}
+	return registry
+}
@@ -0,0 +1,49 @@
+package imageupdater
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseImageList(t *testing.T) {
+	images, err := ParseImageList("web=nginx:~1.19, app=quay.io/argoproj/argocd,local=localhost:5000/guestbook:^0.1,")
+	assert.NoError(t, err)
+	assert.Equal(t, []Image{
+		{Alias: "web", Name: "nginx", Constraint: "~1.19"},
+		{Alias: "app", Name: "quay.io/argoproj/argocd"},
+		{Alias: "local", Name: "localhost:5000/guestbook", Constraint: "^0.1"},
+	}, images)
+
+	_, err = ParseImageList("nginx")
+	assert.EqualError(t, err, "invalid image list entry 'nginx': expected <alias>=<image>[:<constraint>]")
+
+	_, err = ParseImageList("web app=nginx")
+	assert.EqualError(t, err, "invalid image alias 'web app': must consist of alphanumeric characters, '-' or '_'")
+
+	_, err = ParseImageList("web=nginx,web=httpd")
+	assert.EqualError(t, err, "duplicate image alias 'web'")
+}
+
+func TestImage_RegistryAndRepository(t *testing.T) {
+	for name, expected := range map[string][2]string{
+		"nginx":                         {"docker.io", "library/nginx"},
+		"bitnami/nginx":                 {"docker.io", "bitnami/nginx"},
+		"index.docker.io/bitnami/nginx": {"docker.io", "bitnami/nginx"},
+		"quay.io/argoproj/argocd":       {"quay.io", "argoproj/argocd"},
+		"localhost:5000/guestbook":      {"localhost:5000", "guestbook"},
+		"localhost/guestbook":           {"localhost", "guestbook"},
+	} {
+		registry, repository := Image{Name: name}.RegistryAndRepository()
+		assert.Equal(t, expected, [2]string{registry, repository}, name)
+	}
+}
+
+func TestImage_Matches(t *testing.T) {
+	image := Image{Name: "nginx"}
+	assert.True(t, image.Matches("nginx:1.19.0"))
+	assert.True(t, image.Matches("docker.io/library/nginx:1.19.0"))
+	assert.True(t, image.Matches("nginx@sha256:cd5239a0906a6ccf0562354852fae04bc5b52d72a2aff9a871ddb6bd57553569"))
+	assert.False(t, image.Matches("bitnami/nginx:1.19.0"))
+	assert.False(t, image.Matches("quay.io/nginx:1.19.0"))
+}
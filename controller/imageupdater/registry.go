@@ -0,0 +1,296 @@
+package imageupdater
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	gocache "github.com/patrickmn/go-cache"
+)
+
+var (
+	challengeParamRegex = regexp.MustCompile(`(\w+)="([^"]*)"`)
+	nextLinkRegex       = regexp.MustCompile(`<([^>]+)>\s*;\s*rel="?next"?`)
+
+	manifestMediaTypes = []string{
+		"application/vnd.oci.image.index.v1+json",
+		"application/vnd.oci.image.manifest.v1+json",
+		"application/vnd.docker.distribution.manifest.list.v2+json",
+		"application/vnd.docker.distribution.manifest.v2+json",
+	}
+
+	// createdTimes caches the creation times of the images by the digest of their manifest, which is immutable, so
+	// the images are not fetched again on every update
+	createdTimes = gocache.New(24*time.Hour, time.Hour)
+)
+
+// RegistryClient queries the tags of images using the OCI distribution API
+type RegistryClient interface {
+	// ListTags returns all tags of the repository
+	ListTags(ctx context.Context, repository string) ([]string, error)
+	// GetCreated returns the creation time of the image with the given tag
+	GetCreated(ctx context.Context, repository string, tag string) (time.Time, error)
+}
+
+// Credentials are the credentials used to authenticate to a registry
+type Credentials struct {
+	Username string
+	Password string
+}
+
+type registryClient struct {
+	baseURL   string
+	creds     *Credentials
+	client    *http.Client
+	token     string
+	basicAuth bool
+}
+
+// NewRegistryClient returns a client of the given registry, using the given credentials if not nil
+func NewRegistryClient(registry string, creds *Credentials) RegistryClient {
+	host := registry
+	if host == defaultRegistry {
+		host = dockerHubAPIHost
+	}
+	return &registryClient{
+		baseURL: "https://" + host,
+		creds:   creds,
+		client:  &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+type manifest struct {
+	Config struct {
+		Digest string `json:"digest"`
+	} `json:"config"`
+	Manifests []struct {
+		Digest   string `json:"digest"`
+		Platform struct {
+			Architecture string `json:"architecture"`
+			OS           string `json:"os"`
+		} `json:"platform"`
+	} `json:"manifests"`
+}
+
+func (c *registryClient) ListTags(ctx context.Context, repository string) ([]string, error) {
+	var tags []string
+	next := fmt.Sprintf("%s/v2/%s/tags/list", c.baseURL, repository)
+	for next != "" {
+		var list struct {
+			Tags []string `json:"tags"`
+		}
+		header, err := c.getJSON(ctx, next, &list)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, list.Tags...)
+		next, err = nextLink(next, header.Get("Link"))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return tags, nil
+}
+
+func (c *registryClient) GetCreated(ctx context.Context, repository string, tag string) (time.Time, error) {
+	// the tag is resolved to the digest of its manifest, so only the tags moved to another image since the previous
+	// update are fetched
+	digest, err := c.getDigest(ctx, repository, tag)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if digest == "" {
+		return c.getCreated(ctx, repository, tag)
+	}
+	key := fmt.Sprintf("%s/%s@%s", c.baseURL, repository, digest)
+	if created, ok := createdTimes.Get(key); ok {
+		return created.(time.Time), nil
+	}
+	created, err := c.getCreated(ctx, repository, digest)
+	if err != nil {
+		return time.Time{}, err
+	}
+	createdTimes.Set(key, created, gocache.DefaultExpiration)
+	return created, nil
+}
+
+// getDigest returns the digest of the manifest referenced by the tag, or an empty string if the registry does not
+// report it
+func (c *registryClient) getDigest(ctx context.Context, repository string, tag string) (string, error) {
+	resp, err := c.do(ctx, http.MethodHead, fmt.Sprintf("%s/v2/%s/manifests/%s", c.baseURL, repository, tag), manifestMediaTypes...)
+	if err != nil {
+		return "", err
+	}
+	_ = resp.Body.Close()
+	return resp.Header.Get("Docker-Content-Digest"), nil
+}
+
+// getCreated returns the creation time of the image with the given manifest reference, which is a tag or a digest
+func (c *registryClient) getCreated(ctx context.Context, repository string, reference string) (time.Time, error) {
+	var m manifest
+	if _, err := c.getJSON(ctx, fmt.Sprintf("%s/v2/%s/manifests/%s", c.baseURL, repository, reference), &m, manifestMediaTypes...); err != nil {
+		return time.Time{}, err
+	}
+	// the configs of the images referenced by an index are expected to have the same creation time, so the
+	// linux/amd64 image or the first one is used
+	if len(m.Manifests) > 0 {
+		digest := m.Manifests[0].Digest
+		for _, ref := range m.Manifests {
+			if ref.Platform.OS == "linux" && ref.Platform.Architecture == "amd64" {
+				digest = ref.Digest
+				break
+			}
+		}
+		m = manifest{}
+		if _, err := c.getJSON(ctx, fmt.Sprintf("%s/v2/%s/manifests/%s", c.baseURL, repository, digest), &m, manifestMediaTypes...); err != nil {
+			return time.Time{}, err
+		}
+	}
+	if m.Config.Digest == "" {
+		return time.Time{}, fmt.Errorf("manifest of %s:%s does not reference an image config", repository, reference)
+	}
+	var config struct {
+		Created *time.Time `json:"created"`
+	}
+	if _, err := c.getJSON(ctx, fmt.Sprintf("%s/v2/%s/blobs/%s", c.baseURL, repository, m.Config.Digest), &config); err != nil {
+		return time.Time{}, err
+	}
+	if config.Created == nil {
+		return time.Time{}, fmt.Errorf("image config of %s:%s does not specify the creation time", repository, reference)
+	}
+	return *config.Created, nil
+}
+
+// getJSON gets the given URL and decodes the JSON response body
+func (c *registryClient) getJSON(ctx context.Context, url string, v interface{}, accept ...string) (http.Header, error) {
+	resp, err := c.do(ctx, http.MethodGet, url, accept...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return nil, fmt.Errorf("failed to decode response of %s: %v", url, err)
+	}
+	return resp.Header, nil
+}
+
+// do sends a request to the given URL and returns the response if it is 200 OK. Authenticates and retries once if the
+// registry responds with 401 Unauthorized.
+func (c *registryClient) do(ctx context.Context, method string, url string, accept ...string) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequest(method, url, nil)
+		if err != nil {
+			return nil, err
+		}
+		req = req.WithContext(ctx)
+		for _, mediaType := range accept {
+			req.Header.Add("Accept", mediaType)
+		}
+		if c.token != "" {
+			req.Header.Set("Authorization", "Bearer "+c.token)
+		} else if c.basicAuth {
+			req.SetBasicAuth(c.creds.Username, c.creds.Password)
+		}
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode == http.StatusUnauthorized && attempt == 0 {
+			_ = resp.Body.Close()
+			if err := c.authenticate(ctx, resp.Header.Get("WWW-Authenticate")); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if resp.StatusCode != http.StatusOK {
+			_ = resp.Body.Close()
+			return nil, fmt.Errorf("failed to get %s: %s", url, resp.Status)
+		}
+		return resp, nil
+	}
+}
+
+// authenticate handles the authentication challenge of the registry, either by requesting a bearer token from the
+// token server of the registry or by using basic authentication
+func (c *registryClient) authenticate(ctx context.Context, challenge string) error {
+	scheme := strings.ToLower(strings.SplitN(challenge, " ", 2)[0])
+	params := map[string]string{}
+	for _, match := range challengeParamRegex.FindAllStringSubmatch(challenge, -1) {
+		params[strings.ToLower(match[1])] = match[2]
+	}
+	switch scheme {
+	case "basic":
+		if c.creds == nil {
+			return fmt.Errorf("registry %s requires credentials", c.baseURL)
+		}
+		c.basicAuth = true
+		return nil
+	case "bearer":
+		tokenURL, err := url.Parse(params["realm"])
+		if err != nil || params["realm"] == "" {
+			return fmt.Errorf("registry %s responded with invalid authentication challenge '%s'", c.baseURL, challenge)
+		}
+		query := tokenURL.Query()
+		for _, key := range []string{"service", "scope"} {
+			if val, ok := params[key]; ok {
+				query.Set(key, val)
+			}
+		}
+		tokenURL.RawQuery = query.Encode()
+		req, err := http.NewRequest(http.MethodGet, tokenURL.String(), nil)
+		if err != nil {
+			return err
+		}
+		req = req.WithContext(ctx)
+		if c.creds != nil {
+			req.SetBasicAuth(c.creds.Username, c.creds.Password)
+		}
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = resp.Body.Close() }()
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("failed to get token of registry %s: %s", c.baseURL, resp.Status)
+		}
+		var token struct {
+			Token       string `json:"token"`
+			AccessToken string `json:"access_token"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+			return fmt.Errorf("failed to decode token of registry %s: %v", c.baseURL, err)
+		}
+		c.token = token.Token
+		if c.token == "" {
+			c.token = token.AccessToken
+		}
+		if c.token == "" {
+			return fmt.Errorf("token server of registry %s did not return a token", c.baseURL)
+		}
+		return nil
+	}
+	return fmt.Errorf("registry %s requires unsupported authentication '%s'", c.baseURL, challenge)
+}
+
+// nextLink returns the absolute URL of the next page referenced by the Link header, or an empty string if the header
+// doesn't reference a next page
+func nextLink(current string, link string) (string, error) {
+	match := nextLinkRegex.FindStringSubmatch(link)
+	if match == nil {
+		return "", nil
+	}
+	base, err := url.Parse(current)
+	if err != nil {
+		return "", err
+	}
+	next, err := base.Parse(match[1])
+	if err != nil {
+		return "", err
+	}
+	return next.String(), nil
+}
@@ -0,0 +1,127 @@
+package imageupdater
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRegistry(t *testing.T) (*httptest.Server, RegistryClient) {
+	server, client, _ := newCountingTestRegistry(t)
+	return server, client
+}
+
+// newCountingTestRegistry returns a test registry and a function returning the number of requests it served by method
+// and path
+func newCountingTestRegistry(t *testing.T) (*httptest.Server, RegistryClient, func(string) int) {
+	var server *httptest.Server
+	var lock sync.Mutex
+	requests := map[string]int{}
+	writeJSON := func(w http.ResponseWriter, v interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(v))
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "admin" || password != "password" || r.URL.Query().Get("scope") != "repository:argoproj/guestbook:pull" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		writeJSON(w, map[string]string{"token": "guestbook-token"})
+	})
+	mux.HandleFunc("/v2/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer guestbook-token" {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry",scope="repository:argoproj/guestbook:pull"`, server.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		lock.Lock()
+		requests[r.Method+" "+r.URL.Path]++
+		lock.Unlock()
+		switch r.URL.Path {
+		case "/v2/argoproj/guestbook/tags/list":
+			if r.URL.Query().Get("last") == "" {
+				w.Header().Set("Link", `</v2/argoproj/guestbook/tags/list?last=v0.1&n=2>; rel="next"`)
+				writeJSON(w, map[string]interface{}{"tags": []string{"latest", "v0.1"}})
+			} else {
+				writeJSON(w, map[string]interface{}{"tags": []string{"v0.2"}})
+			}
+		case "/v2/argoproj/guestbook/manifests/v0.2", "/v2/argoproj/guestbook/manifests/sha256:index":
+			w.Header().Set("Docker-Content-Digest", "sha256:index")
+			writeJSON(w, map[string]interface{}{"manifests": []interface{}{
+				map[string]interface{}{"digest": "sha256:arm", "platform": map[string]string{"os": "linux", "architecture": "arm64"}},
+				map[string]interface{}{"digest": "sha256:amd", "platform": map[string]string{"os": "linux", "architecture": "amd64"}},
+			}})
+		case "/v2/argoproj/guestbook/manifests/sha256:amd":
+			writeJSON(w, map[string]interface{}{"config": map[string]string{"digest": "sha256:config"}})
+		case "/v2/argoproj/guestbook/blobs/sha256:config":
+			writeJSON(w, map[string]string{"created": "2021-05-01T10:00:00Z"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	server = httptest.NewTLSServer(mux)
+	return server, &registryClient{
+		baseURL: server.URL,
+		creds:   &Credentials{Username: "admin", Password: "password"},
+		client:  server.Client(),
+	}, func(request string) int {
+		lock.Lock()
+		defer lock.Unlock()
+		return requests[request]
+	}
+}
+
+func TestRegistryClient_ListTags(t *testing.T) {
+	server, client := newTestRegistry(t)
+	defer server.Close()
+
+	tags, err := client.ListTags(context.Background(), "argoproj/guestbook")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"latest", "v0.1", "v0.2"}, tags)
+
+	_, err = client.ListTags(context.Background(), "argoproj/unknown")
+	assert.Error(t, err)
+}
+
+func TestRegistryClient_GetCreated(t *testing.T) {
+	server, client := newTestRegistry(t)
+	defer server.Close()
+
+	created, err := client.GetCreated(context.Background(), "argoproj/guestbook", "v0.2")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2021, 5, 1, 10, 0, 0, 0, time.UTC), created.UTC())
+}
+
+func TestRegistryClient_GetCreated_Cached(t *testing.T) {
+	server, client, countRequests := newCountingTestRegistry(t)
+	defer server.Close()
+
+	for i := 0; i < 2; i++ {
+		created, err := client.GetCreated(context.Background(), "argoproj/guestbook", "v0.2")
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2021, 5, 1, 10, 0, 0, 0, time.UTC), created.UTC())
+	}
+	// the tag is resolved on every call, the image is fetched only once
+	assert.Equal(t, 2, countRequests("HEAD /v2/argoproj/guestbook/manifests/v0.2"))
+	assert.Equal(t, 0, countRequests("GET /v2/argoproj/guestbook/manifests/v0.2"))
+	assert.Equal(t, 1, countRequests("GET /v2/argoproj/guestbook/manifests/sha256:index"))
+	assert.Equal(t, 1, countRequests("GET /v2/argoproj/guestbook/blobs/sha256:config"))
+}
+
+func TestRegistryClient_InvalidCredentials(t *testing.T) {
+	server, client := newTestRegistry(t)
+	defer server.Close()
+	client.(*registryClient).creds = &Credentials{Username: "admin", Password: "wrong"}
+
+	_, err := client.ListTags(context.Background(), "argoproj/guestbook")
+	assert.EqualError(t, err, fmt.Sprintf("failed to get token of registry %s: 401 Unauthorized", server.URL))
+}
@@ -0,0 +1,139 @@
+package imageupdater
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/Masterminds/semver"
+
+	"github.com/vathsalashetty96/argo-cd/common"
+)
+
+// UpdateStrategy determines the tag an image is updated to
+type UpdateStrategy string
+
+const (
+	// UpdateStrategySemver updates to the highest semantic version satisfying the constraint of the image
+	UpdateStrategySemver UpdateStrategy = "semver"
+	// UpdateStrategyRegex updates to the lexically greatest tag matching the allowed tags expression
+	UpdateStrategyRegex UpdateStrategy = "regex"
+	// UpdateStrategyLatest updates to the most recently built tag
+	UpdateStrategyLatest UpdateStrategy = "latest"
+)
+
+// Suffixes of the annotations configuring the update of an image, following the image annotation prefix and the alias
+const (
+	settingUpdateStrategy = "update-strategy"
+	settingAllowTags      = "allow-tags"
+	settingHelmImageTag   = "helm-image-tag"
+	settingHelmImageName  = "helm-image-name"
+	settingPullSecret     = "pull-secret"
+
+	defaultHelmImageTag = "image.tag"
+)
+
+// imageSettings holds the settings of an image configured by the annotations of the application
+type imageSettings struct {
+	strategy      UpdateStrategy
+	allowTags     *regexp.Regexp
+	helmImageTag  string
+	helmImageName string
+	pullSecret    string
+}
+
+// getImageSettings returns the settings of the image with the given alias
+func getImageSettings(annotations map[string]string, alias string) (*imageSettings, error) {
+	get := func(setting string) string {
+		return annotations[common.AnnotationKeyImagePrefix+alias+"."+setting]
+	}
+	settings := &imageSettings{
+		strategy:      UpdateStrategy(get(settingUpdateStrategy)),
+		helmImageTag:  get(settingHelmImageTag),
+		helmImageName: get(settingHelmImageName),
+		pullSecret:    get(settingPullSecret),
+	}
+	switch settings.strategy {
+	case "":
+		settings.strategy = UpdateStrategySemver
+	case UpdateStrategySemver, UpdateStrategyRegex, UpdateStrategyLatest:
+	default:
+		return nil, fmt.Errorf("unknown update strategy '%s' of image %s", settings.strategy, alias)
+	}
+	if expr := get(settingAllowTags); expr != "" {
+		var err error
+		if settings.allowTags, err = regexp.Compile(expr); err != nil {
+			return nil, fmt.Errorf("invalid allowed tags expression of image %s: %v", alias, err)
+		}
+	} else if settings.strategy == UpdateStrategyRegex {
+		return nil, fmt.Errorf("update strategy regex of image %s requires the %s annotation", alias, common.AnnotationKeyImagePrefix+alias+"."+settingAllowTags)
+	}
+	if settings.helmImageTag == "" {
+		settings.helmImageTag = defaultHelmImageTag
+	}
+	return settings, nil
+}
+
+// selectTag returns the tag the image should be updated to according to the update strategy, or an empty string if
+// none of the tags is eligible
+func selectTag(ctx context.Context, client RegistryClient, image Image, settings *imageSettings, tags []string) (string, error) {
+	var candidates []string
+	for _, tag := range tags {
+		if settings.allowTags == nil || settings.allowTags.MatchString(tag) {
+			candidates = append(candidates, tag)
+		}
+	}
+
+	switch settings.strategy {
+	case UpdateStrategySemver:
+		var constraint *semver.Constraints
+		if image.Constraint != "" {
+			var err error
+			if constraint, err = semver.NewConstraint(image.Constraint); err != nil {
+				return "", fmt.Errorf("invalid version constraint '%s' of image %s: %v", image.Constraint, image.Alias, err)
+			}
+		}
+		var selected string
+		var selectedVersion *semver.Version
+		for _, tag := range candidates {
+			version, err := semver.NewVersion(tag)
+			if err != nil {
+				continue
+			}
+			if constraint != nil && !constraint.Check(version) || constraint == nil && version.Prerelease() != "" {
+				continue
+			}
+			if selectedVersion == nil || version.GreaterThan(selectedVersion) {
+				selected, selectedVersion = tag, version
+			}
+		}
+		return selected, nil
+	case UpdateStrategyRegex:
+		var selected string
+		for _, tag := range candidates {
+			if tag > selected {
+				selected = tag
+			}
+		}
+		return selected, nil
+	case UpdateStrategyLatest:
+		_, repository := image.RegistryAndRepository()
+		var selected string
+		var selectedCreated int64
+		for _, tag := range candidates {
+			// the latest tag is moved by every build and would never be updated
+			if tag == "latest" {
+				continue
+			}
+			created, err := client.GetCreated(ctx, repository, tag)
+			if err != nil {
+				return "", err
+			}
+			if selected == "" || created.UnixNano() > selectedCreated || created.UnixNano() == selectedCreated && tag > selected {
+				selected, selectedCreated = tag, created.UnixNano()
+			}
+		}
+		return selected, nil
+	}
+	return "", fmt.Errorf("unknown update strategy '%s' of image %s", settings.strategy, image.Alias)
+}
@@ -0,0 +1,405 @@
+package imageupdater
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/ghodss/yaml"
+	log "github.com/sirupsen/logrus"
+	"github.com/vathsalashetty96/pkg/sync"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/vathsalashetty96/argo-cd/common"
+	"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1"
+	appclientset "github.com/vathsalashetty96/argo-cd/pkg/client/clientset/versioned"
+	"github.com/vathsalashetty96/argo-cd/util/db"
+	"github.com/vathsalashetty96/argo-cd/util/git"
+	"github.com/vathsalashetty96/argo-cd/util/security"
+)
+
+// WriteBackMethod is the method used to persist the updated images
+type WriteBackMethod string
+
+const (
+	// WriteBackArgoCD updates the parameters of the application source using the Kubernetes API
+	WriteBackArgoCD WriteBackMethod = "argocd"
+	// WriteBackGit commits the parameters to the .argocd-source-<application>.yaml file in the source path
+	WriteBackGit WriteBackMethod = "git"
+)
+
+const (
+	// appSourceFile is the file of the application specific parameter overrides, merged by the repo server
+	appSourceFile = ".argocd-source-%s.yaml"
+
+	commitAuthorName  = "Argo CD"
+	commitAuthorEmail = "argo-cd@localhost"
+)
+
+// ImageUpdate is an image which is updated to a new tag
+type ImageUpdate struct {
+	Image  Image
+	OldTag string
+	NewTag string
+
+	settings *imageSettings
+}
+
+// Updater updates the images of the applications listed in the image list annotation to the tags selected by the
+// update strategy of each image
+type Updater struct {
+	namespace     string
+	appClientset  appclientset.Interface
+	kubeClientset kubernetes.Interface
+	db            db.ArgoDB
+	rootDir       string
+	repoLock      sync.KeyLock
+	// newRegistryClient and newGitClient are replaced in tests
+	newRegistryClient func(registry string, creds *Credentials) RegistryClient
+	newGitClient      func(repoURL string, root string, creds git.Creds, insecure bool, enableLfs bool, opts ...git.ClientOpts) (git.Client, error)
+}
+
+// NewUpdater returns an updater which clones the repositories of the git write back method into the given directory
+func NewUpdater(namespace string, appClientset appclientset.Interface, kubeClientset kubernetes.Interface, db db.ArgoDB, rootDir string) *Updater {
+	return &Updater{
+		namespace:         namespace,
+		appClientset:      appClientset,
+		kubeClientset:     kubeClientset,
+		db:                db,
+		rootDir:           rootDir,
+		repoLock:          sync.NewKeyLock(),
+		newRegistryClient: NewRegistryClient,
+		newGitClient:      git.NewClientExt,
+	}
+}
+
+// UpdateApplications updates the images of the given applications which have the image list annotation. Errors are
+// logged and don't prevent the update of the other applications.
+func (u *Updater) UpdateApplications(ctx context.Context, apps []*v1alpha1.Application) {
+	for _, app := range apps {
+		if _, ok := app.Annotations[common.AnnotationKeyImageList]; !ok || app.DeletionTimestamp != nil {
+			continue
+		}
+		logCtx := log.WithField("application", app.Name)
+		updates, err := u.UpdateApplication(ctx, app)
+		if err != nil {
+			logCtx.Warnf("Failed to update images: %v", err)
+			continue
+		}
+		for _, update := range updates {
+			logCtx.Infof("Updated image %s from tag '%s' to '%s'", update.Image.Name, update.OldTag, update.NewTag)
+		}
+	}
+}
+
+// UpdateApplication queries the registries for the tags of the images listed in the image list annotation of the
+// application and persists the tags selected by the update strategies using the write back method of the application.
+// Returns the updated images.
+func (u *Updater) UpdateApplication(ctx context.Context, app *v1alpha1.Application) ([]ImageUpdate, error) {
+	images, err := ParseImageList(app.Annotations[common.AnnotationKeyImageList])
+	if err != nil {
+		return nil, err
+	}
+	method := WriteBackMethod(app.Annotations[common.AnnotationKeyImageWriteBackMethod])
+	switch method {
+	case "":
+		method = WriteBackArgoCD
+	case WriteBackArgoCD, WriteBackGit:
+	default:
+		return nil, fmt.Errorf("unknown write back method '%s'", method)
+	}
+	sourceType, err := getSourceType(app)
+	if err != nil {
+		return nil, err
+	}
+
+	var updates []ImageUpdate
+	clients := map[string]RegistryClient{}
+	for _, image := range images {
+		settings, err := getImageSettings(app.Annotations, image.Alias)
+		if err != nil {
+			return nil, err
+		}
+		registry, repository := image.RegistryAndRepository()
+		client, ok := clients[registry+"/"+settings.pullSecret]
+		if !ok {
+			creds, err := u.getCredentials(ctx, registry, settings.pullSecret)
+			if err != nil {
+				return nil, err
+			}
+			client = u.newRegistryClient(registry, creds)
+			clients[registry+"/"+settings.pullSecret] = client
+		}
+		tags, err := client.ListTags(ctx, repository)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list tags of image %s: %v", image.Name, err)
+		}
+		tag, err := selectTag(ctx, client, image, settings, tags)
+		if err != nil {
+			return nil, err
+		}
+		currentTag := getCurrentTag(app, sourceType, image, settings)
+		if tag == "" {
+			log.WithField("application", app.Name).Debugf("No tag of image %s is eligible for an update", image.Name)
+			continue
+		}
+		if tag != currentTag {
+			updates = append(updates, ImageUpdate{Image: image, OldTag: currentTag, NewTag: tag, settings: settings})
+		}
+	}
+	if len(updates) == 0 {
+		return nil, nil
+	}
+
+	switch method {
+	case WriteBackGit:
+		err = u.commitUpdates(ctx, app, sourceType, updates)
+	default:
+		err = u.patchApplication(ctx, app, sourceType, updates)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return updates, nil
+}
+
+// getSourceType returns the type of the application source, which must be either Helm or Kustomize
+func getSourceType(app *v1alpha1.Application) (v1alpha1.ApplicationSourceType, error) {
+	explicitType, err := app.Spec.Source.ExplicitType()
+	if err != nil {
+		return "", err
+	}
+	sourceType := app.Status.SourceType
+	if explicitType != nil {
+		sourceType = *explicitType
+	}
+	if sourceType != v1alpha1.ApplicationSourceTypeHelm && sourceType != v1alpha1.ApplicationSourceTypeKustomize {
+		return "", fmt.Errorf("images can only be updated for Helm and Kustomize applications")
+	}
+	return sourceType, nil
+}
+
+// getCurrentTag returns the tag of the image overridden by the application source, or else the tag of the image
+// deployed by the application
+func getCurrentTag(app *v1alpha1.Application, sourceType v1alpha1.ApplicationSourceType, image Image, settings *imageSettings) string {
+	source := app.Spec.Source
+	switch {
+	case sourceType == v1alpha1.ApplicationSourceTypeKustomize && source.Kustomize != nil:
+		if i := source.Kustomize.Images.Find(v1alpha1.KustomizeImage(image.Name + ":")); i >= 0 {
+			_, tag := splitTag(string(source.Kustomize.Images[i]))
+			return tag
+		}
+	case sourceType == v1alpha1.ApplicationSourceTypeHelm && source.Helm != nil:
+		for _, param := range source.Helm.Parameters {
+			if param.Name == settings.helmImageTag {
+				return param.Value
+			}
+		}
+	}
+	for _, ref := range app.Status.Summary.Images {
+		if image.Matches(ref) {
+			_, tag := splitTag(ref)
+			return tag
+		}
+	}
+	return ""
+}
+
+// applyUpdates sets the Kustomize image overrides or the Helm parameters of the updated images
+func applyUpdates(source *v1alpha1.ApplicationSource, sourceType v1alpha1.ApplicationSourceType, updates []ImageUpdate) {
+	for _, update := range updates {
+		switch sourceType {
+		case v1alpha1.ApplicationSourceTypeKustomize:
+			if source.Kustomize == nil {
+				source.Kustomize = &v1alpha1.ApplicationSourceKustomize{}
+			}
+			source.Kustomize.MergeImage(v1alpha1.KustomizeImage(fmt.Sprintf("%s:%s", update.Image.Name, update.NewTag)))
+		case v1alpha1.ApplicationSourceTypeHelm:
+			if source.Helm == nil {
+				source.Helm = &v1alpha1.ApplicationSourceHelm{}
+			}
+			if update.settings.helmImageName != "" {
+				source.Helm.AddParameter(v1alpha1.HelmParameter{Name: update.settings.helmImageName, Value: update.Image.Name})
+			}
+			source.Helm.AddParameter(v1alpha1.HelmParameter{Name: update.settings.helmImageTag, Value: update.NewTag, ForceString: true})
+		}
+	}
+}
+
+// sourcePatch returns the field of the application source holding the image overrides of the given source type
+func sourcePatch(source *v1alpha1.ApplicationSource, sourceType v1alpha1.ApplicationSourceType) (string, string, interface{}) {
+	if sourceType == v1alpha1.ApplicationSourceTypeKustomize {
+		return "kustomize", "images", source.Kustomize.Images
+	}
+	return "helm", "parameters", source.Helm.Parameters
+}
+
+// patchApplication updates the parameters of the application source using the Kubernetes API
+func (u *Updater) patchApplication(ctx context.Context, app *v1alpha1.Application, sourceType v1alpha1.ApplicationSourceType, updates []ImageUpdate) error {
+	source := app.Spec.Source.DeepCopy()
+	applyUpdates(source, sourceType, updates)
+	section, field, value := sourcePatch(source, sourceType)
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"source": map[string]interface{}{
+				section: map[string]interface{}{field: value},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = u.appClientset.ArgoprojV1alpha1().Applications(app.Namespace).Patch(ctx, app.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	return err
+}
+
+// commitUpdates commits the parameters of the updated images to the .argocd-source-<application>.yaml file in the path
+// of the application source, keeping the other overrides of the file
+func (u *Updater) commitUpdates(ctx context.Context, app *v1alpha1.Application, sourceType v1alpha1.ApplicationSourceType, updates []ImageUpdate) error {
+	source := app.Spec.Source
+	if source.IsHelm() {
+		return fmt.Errorf("the git write back method requires a git repository source")
+	}
+	branch := app.Annotations[common.AnnotationKeyImageWriteBackBranch]
+	if branch == "" {
+		branch = source.TargetRevision
+	}
+	if branch == "" || branch == "HEAD" {
+		return fmt.Errorf("the branch must be specified using the %s annotation if the application tracks HEAD", common.AnnotationKeyImageWriteBackBranch)
+	}
+
+	repo, err := u.db.GetRepository(ctx, source.RepoURL)
+	if err != nil {
+		return err
+	}
+
+	u.repoLock.Lock(source.RepoURL)
+	defer u.repoLock.Unlock(source.RepoURL)
+
+	root := filepath.Join(u.rootDir, strings.Replace(git.NormalizeGitURL(source.RepoURL), "/", "_", -1))
+	client, err := u.newGitClient(repo.Repo, root, repo.GetGitCreds(), repo.IsInsecure(), repo.IsLFSEnabled())
+	if err != nil {
+		return err
+	}
+	if err := client.Init(); err != nil {
+		return err
+	}
+	if err := client.SetAuthor(commitAuthorName, commitAuthorEmail); err != nil {
+		return err
+	}
+	if err := client.Fetch(""); err != nil {
+		return err
+	}
+	if err := client.Checkout("origin/" + branch); err != nil {
+		return err
+	}
+
+	// the source path must be in the repository and symlinks committed to it are resolved within the repository, so
+	// that the file is neither read nor written outside of the clone
+	if _, err := security.EnforceToCurrentRoot(client.Root(), filepath.Join(client.Root(), source.Path)); err != nil {
+		return err
+	}
+	dir, err := securejoin.SecureJoin(client.Root(), source.Path)
+	if err != nil {
+		return err
+	}
+	fileName := filepath.Join(dir, fmt.Sprintf(appSourceFile, app.Name))
+	if info, err := os.Lstat(fileName); err == nil && info.Mode()&os.ModeSymlink != 0 {
+		return fmt.Errorf("%s: refusing to follow symlink", filepath.Base(fileName))
+	}
+	override := map[string]interface{}{}
+	merged := source.DeepCopy()
+	data, err := ioutil.ReadFile(fileName)
+	if err == nil {
+		if err := yaml.Unmarshal(data, &override); err != nil {
+			return fmt.Errorf("%s: %v", filepath.Base(fileName), err)
+		}
+		if err := yaml.Unmarshal(data, merged); err != nil {
+			return fmt.Errorf("%s: %v", filepath.Base(fileName), err)
+		}
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+	applyUpdates(merged, sourceType, updates)
+	section, field, value := sourcePatch(merged, sourceType)
+	sectionOverride, _ := override[section].(map[string]interface{})
+	if sectionOverride == nil {
+		sectionOverride = map[string]interface{}{}
+	}
+	sectionOverride[field] = value
+	override[section] = sectionOverride
+	if data, err = yaml.Marshal(override); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(fileName), 0755); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(fileName, data, 0644); err != nil {
+		return err
+	}
+
+	message := fmt.Sprintf("Update images of application %s\n\n", app.Name)
+	for _, update := range updates {
+		message += fmt.Sprintf("- %s: %s\n", update.Image.Name, update.NewTag)
+	}
+	_, err = client.CommitAndPush(branch, message)
+	return err
+}
+
+// getCredentials returns the credentials of the registry from the docker config of the given pull secret in the
+// namespace of Argo CD. Since the secret is referenced by an application annotation, only secrets labelled as image pull
+// secrets are read, so that applications can't reference any other secret of the namespace.
+func (u *Updater) getCredentials(ctx context.Context, registry string, secretName string) (*Credentials, error) {
+	if secretName == "" {
+		return nil, nil
+	}
+	secret, err := u.kubeClientset.CoreV1().Secrets(u.namespace).Get(ctx, secretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if secret.Labels[common.LabelKeySecretType] != common.LabelValueSecretTypeImagePull {
+		return nil, fmt.Errorf("secret %s is not labelled with %s=%s", secretName, common.LabelKeySecretType, common.LabelValueSecretTypeImagePull)
+	}
+	data, ok := secret.Data[corev1.DockerConfigJsonKey]
+	if !ok {
+		return nil, fmt.Errorf("secret %s does not contain the key %s", secretName, corev1.DockerConfigJsonKey)
+	}
+	var config struct {
+		Auths map[string]struct {
+			Username string `json:"username"`
+			Password string `json:"password"`
+			Auth     string `json:"auth"`
+		} `json:"auths"`
+	}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("secret %s contains invalid docker config: %v", secretName, err)
+	}
+	for host, auth := range config.Auths {
+		if normalizeRegistry(host) != registry {
+			continue
+		}
+		creds := &Credentials{Username: auth.Username, Password: auth.Password}
+		if auth.Auth != "" && creds.Username == "" {
+			decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+			if err != nil {
+				return nil, fmt.Errorf("secret %s contains invalid auth of registry %s: %v", secretName, host, err)
+			}
+			parts := strings.SplitN(string(decoded), ":", 2)
+			if len(parts) != 2 {
+				return nil, fmt.Errorf("secret %s contains invalid auth of registry %s", secretName, host)
+			}
+			creds.Username, creds.Password = parts[0], parts[1]
+		}
+		return creds, nil
+	}
+	return nil, fmt.Errorf("secret %s does not contain credentials of registry %s", secretName, registry)
+}
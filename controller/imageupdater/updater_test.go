@@ -0,0 +1,346 @@
+package imageupdater
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/vathsalashetty96/argo-cd/common"
+	"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1"
+	appclientset "github.com/vathsalashetty96/argo-cd/pkg/client/clientset/versioned/fake"
+	dbmocks "github.com/vathsalashetty96/argo-cd/util/db/mocks"
+)
+
+const testNamespace = "argocd"
+
+type fakeRegistryClient struct {
+	tags    map[string][]string
+	created map[string]time.Time
+}
+
+func (c *fakeRegistryClient) ListTags(_ context.Context, repository string) ([]string, error) {
+	tags, ok := c.tags[repository]
+	if !ok {
+		return nil, fmt.Errorf("repository %s not found", repository)
+	}
+	return tags, nil
+}
+
+func (c *fakeRegistryClient) GetCreated(_ context.Context, repository string, tag string) (time.Time, error) {
+	created, ok := c.created[repository+":"+tag]
+	if !ok {
+		return time.Time{}, fmt.Errorf("tag %s:%s not found", repository, tag)
+	}
+	return created, nil
+}
+
+func newFakeRegistryClient() *fakeRegistryClient {
+	now := time.Now()
+	return &fakeRegistryClient{
+		tags: map[string][]string{
+			"library/nginx":      {"latest", "1.18.0", "1.19.0", "1.19.2", "1.20.0-rc1", "1.20.0-alpine"},
+			"argoproj/guestbook": {"latest", "main-20210501", "main-20210502", "feature-20210503"},
+		},
+		created: map[string]time.Time{
+			"argoproj/guestbook:latest":           now,
+			"argoproj/guestbook:main-20210501":    now.Add(-time.Hour),
+			"argoproj/guestbook:main-20210502":    now.Add(-2 * time.Hour),
+			"argoproj/guestbook:feature-20210503": now.Add(-3 * time.Hour),
+		},
+	}
+}
+
+func TestGetImageSettings(t *testing.T) {
+	settings, err := getImageSettings(map[string]string{}, "web")
+	assert.NoError(t, err)
+	assert.Equal(t, &imageSettings{strategy: UpdateStrategySemver, helmImageTag: "image.tag"}, settings)
+
+	settings, err = getImageSettings(map[string]string{
+		common.AnnotationKeyImagePrefix + "web.update-strategy": "latest",
+		common.AnnotationKeyImagePrefix + "web.allow-tags":      "^main-",
+		common.AnnotationKeyImagePrefix + "web.helm-image-tag":  "web.image.tag",
+		common.AnnotationKeyImagePrefix + "web.helm-image-name": "web.image.name",
+		common.AnnotationKeyImagePrefix + "web.pull-secret":     "registry-creds",
+		common.AnnotationKeyImagePrefix + "app.update-strategy": "semver",
+	}, "web")
+	assert.NoError(t, err)
+	assert.Equal(t, UpdateStrategyLatest, settings.strategy)
+	assert.Equal(t, "^main-", settings.allowTags.String())
+	assert.Equal(t, "web.image.tag", settings.helmImageTag)
+	assert.Equal(t, "web.image.name", settings.helmImageName)
+	assert.Equal(t, "registry-creds", settings.pullSecret)
+
+	_, err = getImageSettings(map[string]string{common.AnnotationKeyImagePrefix + "web.update-strategy": "newest"}, "web")
+	assert.EqualError(t, err, "unknown update strategy 'newest' of image web")
+
+	_, err = getImageSettings(map[string]string{common.AnnotationKeyImagePrefix + "web.update-strategy": "regex"}, "web")
+	assert.EqualError(t, err, "update strategy regex of image web requires the argocd.vathsalashetty96.io/image.web.allow-tags annotation")
+}
+
+func TestSelectTag(t *testing.T) {
+	client := newFakeRegistryClient()
+	selectTestTag := func(image Image, annotations map[string]string) string {
+		settings, err := getImageSettings(annotations, image.Alias)
+		assert.NoError(t, err)
+		_, repository := image.RegistryAndRepository()
+		tag, err := selectTag(context.Background(), client, image, settings, client.tags[repository])
+		assert.NoError(t, err)
+		return tag
+	}
+	nginx := Image{Alias: "web", Name: "nginx"}
+	guestbook := Image{Alias: "app", Name: "argoproj/guestbook"}
+
+	t.Run("Semver", func(t *testing.T) {
+		assert.Equal(t, "1.19.2", selectTestTag(nginx, nil))
+		assert.Equal(t, "1.18.0", selectTestTag(Image{Alias: "web", Name: "nginx", Constraint: "~1.18"}, nil))
+		assert.Equal(t, "1.20.0-rc1", selectTestTag(Image{Alias: "web", Name: "nginx", Constraint: ">=1.20.0-0"}, map[string]string{
+			common.AnnotationKeyImagePrefix + "web.allow-tags": "-rc",
+		}))
+		assert.Equal(t, "", selectTestTag(Image{Alias: "web", Name: "nginx", Constraint: "~2"}, nil))
+	})
+	t.Run("Regex", func(t *testing.T) {
+		assert.Equal(t, "main-20210502", selectTestTag(guestbook, map[string]string{
+			common.AnnotationKeyImagePrefix + "app.update-strategy": "regex",
+			common.AnnotationKeyImagePrefix + "app.allow-tags":      "^main-",
+		}))
+	})
+	t.Run("Latest", func(t *testing.T) {
+		assert.Equal(t, "main-20210501", selectTestTag(guestbook, map[string]string{
+			common.AnnotationKeyImagePrefix + "app.update-strategy": "latest",
+		}))
+		assert.Equal(t, "feature-20210503", selectTestTag(guestbook, map[string]string{
+			common.AnnotationKeyImagePrefix + "app.update-strategy": "latest",
+			common.AnnotationKeyImagePrefix + "app.allow-tags":      "^feature-",
+		}))
+	})
+	t.Run("InvalidConstraint", func(t *testing.T) {
+		settings, err := getImageSettings(nil, "web")
+		assert.NoError(t, err)
+		_, err = selectTag(context.Background(), client, Image{Alias: "web", Name: "nginx", Constraint: "~a"}, settings, client.tags["library/nginx"])
+		assert.Error(t, err)
+	})
+}
+
+func newTestApp(annotations map[string]string, source v1alpha1.ApplicationSource, images ...string) *v1alpha1.Application {
+	return &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: testNamespace, Annotations: annotations},
+		Spec:       v1alpha1.ApplicationSpec{Source: source},
+		Status:     v1alpha1.ApplicationStatus{Summary: v1alpha1.ApplicationSummary{Images: images}},
+	}
+}
+
+func newTestUpdater(app *v1alpha1.Application) *Updater {
+	db := &dbmocks.ArgoDB{}
+	u := NewUpdater(testNamespace, appclientset.NewSimpleClientset(app), kubefake.NewSimpleClientset(), db, "")
+	u.newRegistryClient = func(registry string, creds *Credentials) RegistryClient {
+		return newFakeRegistryClient()
+	}
+	return u
+}
+
+func getApp(t *testing.T, u *Updater) *v1alpha1.Application {
+	app, err := u.appClientset.ArgoprojV1alpha1().Applications(testNamespace).Get(context.Background(), "guestbook", metav1.GetOptions{})
+	assert.NoError(t, err)
+	return app
+}
+
+func TestUpdateApplication_Kustomize(t *testing.T) {
+	app := newTestApp(map[string]string{common.AnnotationKeyImageList: "web=nginx:~1.19"}, v1alpha1.ApplicationSource{
+		Kustomize: &v1alpha1.ApplicationSourceKustomize{Images: v1alpha1.KustomizeImages{"redis:6.2"}},
+	}, "nginx:1.19.0", "redis:6.2")
+	u := newTestUpdater(app)
+
+	updates, err := u.UpdateApplication(context.Background(), app)
+	assert.NoError(t, err)
+	if assert.Len(t, updates, 1) {
+		assert.Equal(t, "1.19.0", updates[0].OldTag)
+		assert.Equal(t, "1.19.2", updates[0].NewTag)
+	}
+	app = getApp(t, u)
+	assert.Equal(t, v1alpha1.KustomizeImages{"redis:6.2", "nginx:1.19.2"}, app.Spec.Source.Kustomize.Images)
+
+	t.Run("UpToDate", func(t *testing.T) {
+		updates, err := u.UpdateApplication(context.Background(), app)
+		assert.NoError(t, err)
+		assert.Empty(t, updates)
+	})
+}
+
+func TestUpdateApplication_Helm(t *testing.T) {
+	app := newTestApp(map[string]string{
+		common.AnnotationKeyImageList:                           "web=nginx:~1.19",
+		common.AnnotationKeyImagePrefix + "web.helm-image-name": "image.repository",
+	}, v1alpha1.ApplicationSource{
+		Helm: &v1alpha1.ApplicationSourceHelm{Parameters: []v1alpha1.HelmParameter{{Name: "replicaCount", Value: "2"}}},
+	})
+	u := newTestUpdater(app)
+
+	updates, err := u.UpdateApplication(context.Background(), app)
+	assert.NoError(t, err)
+	if assert.Len(t, updates, 1) {
+		assert.Equal(t, "", updates[0].OldTag)
+		assert.Equal(t, "1.19.2", updates[0].NewTag)
+	}
+	assert.Equal(t, []v1alpha1.HelmParameter{
+		{Name: "replicaCount", Value: "2"},
+		{Name: "image.repository", Value: "nginx"},
+		{Name: "image.tag", Value: "1.19.2", ForceString: true},
+	}, getApp(t, u).Spec.Source.Helm.Parameters)
+}
+
+func TestUpdateApplication_InvalidApplication(t *testing.T) {
+	app := newTestApp(map[string]string{common.AnnotationKeyImageList: "web=nginx"}, v1alpha1.ApplicationSource{
+		Directory: &v1alpha1.ApplicationSourceDirectory{},
+	})
+	_, err := newTestUpdater(app).UpdateApplication(context.Background(), app)
+	assert.EqualError(t, err, "images can only be updated for Helm and Kustomize applications")
+
+	app = newTestApp(map[string]string{
+		common.AnnotationKeyImageList:            "web=nginx",
+		common.AnnotationKeyImageWriteBackMethod: "kubectl",
+	}, v1alpha1.ApplicationSource{})
+	_, err = newTestUpdater(app).UpdateApplication(context.Background(), app)
+	assert.EqualError(t, err, "unknown write back method 'kubectl'")
+
+	app = newTestApp(map[string]string{common.AnnotationKeyImageList: "web=unknown/nginx"}, v1alpha1.ApplicationSource{
+		Kustomize: &v1alpha1.ApplicationSourceKustomize{},
+	})
+	_, err = newTestUpdater(app).UpdateApplication(context.Background(), app)
+	assert.EqualError(t, err, "failed to list tags of image unknown/nginx: repository unknown/nginx not found")
+}
+
+func runGit(t *testing.T, dir string, args ...string) string {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@localhost", "GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@localhost")
+	out, err := cmd.CombinedOutput()
+	if !assert.NoError(t, err, string(out)) {
+		t.FailNow()
+	}
+	return strings.TrimSpace(string(out))
+}
+
+func TestUpdateApplication_GitWriteBack(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "imageupdater")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(tmpDir) }()
+
+	remote := filepath.Join(tmpDir, "remote.git")
+	runGit(t, tmpDir, "init", "--quiet", "--bare", remote)
+	work := filepath.Join(tmpDir, "init")
+	runGit(t, tmpDir, "clone", "--quiet", remote, work)
+	assert.NoError(t, os.MkdirAll(filepath.Join(work, "guestbook"), 0755))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(work, "guestbook", ".argocd-source-guestbook.yaml"), []byte("kustomize:\n  namePrefix: dev-\n"), 0644))
+	runGit(t, work, "add", "--all")
+	runGit(t, work, "commit", "--quiet", "--message", "Initial commit")
+	runGit(t, work, "push", "--quiet", "origin", "HEAD:refs/heads/main")
+
+	remoteURL := "file://" + remote
+	app := newTestApp(map[string]string{
+		common.AnnotationKeyImageList:            "web=nginx:~1.19",
+		common.AnnotationKeyImageWriteBackMethod: "git",
+	}, v1alpha1.ApplicationSource{
+		RepoURL:        remoteURL,
+		Path:           "guestbook",
+		TargetRevision: "HEAD",
+		Kustomize:      &v1alpha1.ApplicationSourceKustomize{Images: v1alpha1.KustomizeImages{"redis:6.2"}},
+	}, "nginx:1.19.0")
+	u := newTestUpdater(app)
+	u.rootDir = filepath.Join(tmpDir, "work")
+	u.db.(*dbmocks.ArgoDB).On("GetRepository", mock.Anything, remoteURL).Return(&v1alpha1.Repository{Repo: remoteURL}, nil)
+
+	_, err = u.UpdateApplication(context.Background(), app)
+	assert.EqualError(t, err, "the branch must be specified using the argocd.vathsalashetty96.io/image-write-back-branch annotation if the application tracks HEAD")
+
+	app.Annotations[common.AnnotationKeyImageWriteBackBranch] = "main"
+	updates, err := u.UpdateApplication(context.Background(), app)
+	assert.NoError(t, err)
+	assert.Len(t, updates, 1)
+	assert.Equal(t, `kustomize:
+  images:
+  - redis:6.2
+  - nginx:1.19.2
+  namePrefix: dev-
+`, runGit(t, remote, "show", "main:guestbook/.argocd-source-guestbook.yaml")+"\n")
+	assert.Equal(t, "Update images of application guestbook", runGit(t, remote, "log", "-1", "--format=%s", "main"))
+	// the application spec is not modified by the git write back method
+	assert.Equal(t, v1alpha1.KustomizeImages{"redis:6.2"}, getApp(t, u).Spec.Source.Kustomize.Images)
+
+	t.Run("PathOutsideRepository", func(t *testing.T) {
+		outsideApp := app.DeepCopy()
+		outsideApp.Spec.Source.Path = "../.."
+		_, err := u.UpdateApplication(context.Background(), outsideApp)
+		assert.Error(t, err)
+		_, err = os.Stat(filepath.Join(u.rootDir, "..", ".argocd-source-guestbook.yaml"))
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("SymlinkedFile", func(t *testing.T) {
+		outside := filepath.Join(tmpDir, "outside.yaml")
+		assert.NoError(t, ioutil.WriteFile(outside, []byte("kustomize: {}\n"), 0644))
+		runGit(t, work, "pull", "--quiet", "origin", "main")
+		fileName := filepath.Join(work, "guestbook", ".argocd-source-guestbook.yaml")
+		assert.NoError(t, os.Remove(fileName))
+		assert.NoError(t, os.Symlink(outside, fileName))
+		runGit(t, work, "add", "--all")
+		runGit(t, work, "commit", "--quiet", "--message", "Symlink")
+		runGit(t, work, "push", "--quiet", "origin", "HEAD:refs/heads/main")
+
+		_, err := u.UpdateApplication(context.Background(), app)
+		assert.EqualError(t, err, ".argocd-source-guestbook.yaml: refusing to follow symlink")
+		data, err := ioutil.ReadFile(outside)
+		assert.NoError(t, err)
+		assert.Equal(t, "kustomize: {}\n", string(data))
+	})
+}
+
+func TestGetCredentials(t *testing.T) {
+	auth := base64.StdEncoding.EncodeToString([]byte("admin:password"))
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "registry-creds",
+			Namespace: testNamespace,
+			Labels:    map[string]string{common.LabelKeySecretType: common.LabelValueSecretTypeImagePull},
+		},
+		Data: map[string][]byte{
+			corev1.DockerConfigJsonKey: []byte(fmt.Sprintf(`{"auths":{"https://index.docker.io/v1/":{"auth":"%s"},"quay.io":{"username":"robot","password":"token"}}}`, auth)),
+		},
+	}
+	otherSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "argocd-secret", Namespace: testNamespace},
+		Data:       secret.Data,
+	}
+	u := NewUpdater(testNamespace, appclientset.NewSimpleClientset(), kubefake.NewSimpleClientset(secret, otherSecret), &dbmocks.ArgoDB{}, "")
+
+	creds, err := u.getCredentials(context.Background(), "docker.io", "registry-creds")
+	assert.NoError(t, err)
+	assert.Equal(t, &Credentials{Username: "admin", Password: "password"}, creds)
+
+	creds, err = u.getCredentials(context.Background(), "quay.io", "registry-creds")
+	assert.NoError(t, err)
+	assert.Equal(t, &Credentials{Username: "robot", Password: "token"}, creds)
+
+	creds, err = u.getCredentials(context.Background(), "quay.io", "")
+	assert.NoError(t, err)
+	assert.Nil(t, creds)
+
+	_, err = u.getCredentials(context.Background(), "ghcr.io", "registry-creds")
+	assert.EqualError(t, err, "secret registry-creds does not contain credentials of registry ghcr.io")
+
+	// secrets which are not labelled as image pull secrets are not read
+	_, err = u.getCredentials(context.Background(), "quay.io", "argocd-secret")
+	assert.EqualError(t, err, "secret argocd-secret is not labelled with argocd.vathsalashetty96.io/secret-type=image-pull")
+}
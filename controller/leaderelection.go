@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+// LeaderElectionConfig holds the settings of the lease based leader election between the controller replicas which
+// process the same shard
+type LeaderElectionConfig struct {
+	// LeaseName is the name of the lease in the controller namespace
+	LeaseName string
+	// Identity uniquely identifies the controller replica, e.g. the pod name
+	Identity string
+	// LeaseDuration is the duration the standby replicas wait before taking over the lease which is not renewed
+	LeaseDuration time.Duration
+	// RenewDeadline is the duration the leader retries to renew the lease before giving it up
+	RenewDeadline time.Duration
+	// RetryPeriod is the duration between the attempts to acquire or renew the lease
+	RetryPeriod time.Duration
+}
+
+// runWithLeaderElection processes the queues only while the controller holds the lease. The method returns when the
+// context is done. If the lease is lost the process exits with a non-zero code, so the replica is restarted as a
+// standby. In both cases the processors are stopped before the lease is released, so another replica never processes
+// the same shard concurrently.
+func (ctrl *ApplicationController) runWithLeaderElection(ctx context.Context, statusProcessors int, operationProcessors int) {
+	cfg := ctrl.leaderElection
+	processorsCtx, cancelProcessors := context.WithCancel(ctx)
+	defer cancelProcessors()
+	electorCtx, cancelElector := context.WithCancel(context.Background())
+	defer cancelElector()
+
+	acquired := make(chan struct{})
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock: &resourcelock.LeaseLock{
+			LeaseMeta:  metav1.ObjectMeta{Name: cfg.LeaseName, Namespace: ctrl.namespace},
+			Client:     ctrl.kubeClientset.CoordinationV1(),
+			LockConfig: resourcelock.ResourceLockConfig{Identity: cfg.Identity},
+		},
+		LeaseDuration:   cfg.LeaseDuration,
+		RenewDeadline:   cfg.RenewDeadline,
+		RetryPeriod:     cfg.RetryPeriod,
+		ReleaseOnCancel: true,
+		Name:            cfg.LeaseName,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(_ context.Context) {
+				close(acquired)
+			},
+			OnStoppedLeading: func() {
+				cancelProcessors()
+			},
+			OnNewLeader: func(identity string) {
+				if identity != cfg.Identity {
+					log.Infof("Lease %s is held by %s", cfg.LeaseName, identity)
+				}
+			},
+		},
+	})
+	if err != nil {
+		log.Errorf("Failed to configure leader election: %v", err)
+		return
+	}
+
+	electorDone := make(chan struct{})
+	go func() {
+		defer close(electorDone)
+		elector.Run(electorCtx)
+	}()
+
+	log.Infof("Waiting to acquire lease %s as %s", cfg.LeaseName, cfg.Identity)
+	leaseLost := false
+	select {
+	case <-acquired:
+		log.Infof("Acquired lease %s, starting processors", cfg.LeaseName)
+		ctrl.metricsServer.SetLeader(cfg.LeaseName, true)
+		ctrl.runProcessors(processorsCtx, statusProcessors, operationProcessors)
+		ctrl.metricsServer.SetLeader(cfg.LeaseName, false)
+		// the queues are shut down once the processors stop, so they can't be restarted after the lease is lost
+		leaseLost = ctx.Err() == nil
+		if !leaseLost {
+			log.Infof("Stopped processors, releasing lease %s", cfg.LeaseName)
+		}
+	case <-processorsCtx.Done():
+	}
+	cancelElector()
+	<-electorDone
+	if leaseLost {
+		log.Fatalf("Lost lease %s, stopped processors", cfg.LeaseName)
+	}
+}
@@ -0,0 +1,91 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/vathsalashetty96/argo-cd/test"
+)
+
+func newLeaderElectionConfig() *LeaderElectionConfig {
+	return &LeaderElectionConfig{
+		LeaseName:     "argocd-application-controller-shard-0",
+		Identity:      "argocd-application-controller-0",
+		LeaseDuration: 2 * time.Second,
+		RenewDeadline: time.Second,
+		RetryPeriod:   100 * time.Millisecond,
+	}
+}
+
+func getLeaseHolder(t *testing.T, ctrl *ApplicationController) string {
+	lease, err := ctrl.kubeClientset.CoordinationV1().Leases(test.FakeArgoCDNamespace).Get(context.Background(), "argocd-application-controller-shard-0", metav1.GetOptions{})
+	if !assert.NoError(t, err) || lease.Spec.HolderIdentity == nil {
+		return ""
+	}
+	return *lease.Spec.HolderIdentity
+}
+
+func runWithLeaderElection(ctrl *ApplicationController) (context.CancelFunc, chan struct{}) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ctrl.runWithLeaderElection(ctx, 1, 1)
+	}()
+	return cancel, done
+}
+
+func TestRunWithLeaderElection(t *testing.T) {
+	t.Run("AcquireAndRelease", func(t *testing.T) {
+		ctrl := newFakeController(&fakeData{})
+		ctrl.leaderElection = newLeaderElectionConfig()
+		cancel, done := runWithLeaderElection(ctrl)
+
+		assert.Eventually(t, func() bool {
+			return getLeaseHolder(t, ctrl) == "argocd-application-controller-0"
+		}, 5*time.Second, 50*time.Millisecond)
+
+		cancel()
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("controller did not stop")
+		}
+		assert.Equal(t, "", getLeaseHolder(t, ctrl))
+		assert.True(t, ctrl.appRefreshQueue.ShuttingDown())
+	})
+
+	t.Run("LeaseHeldByOtherReplica", func(t *testing.T) {
+		ctrl := newFakeController(&fakeData{})
+		ctrl.leaderElection = newLeaderElectionConfig()
+		now := metav1.NewMicroTime(time.Now())
+		_, err := ctrl.kubeClientset.CoordinationV1().Leases(test.FakeArgoCDNamespace).Create(context.Background(), &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{Name: "argocd-application-controller-shard-0", Namespace: test.FakeArgoCDNamespace},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       pointer.StringPtr("argocd-application-controller-1"),
+				LeaseDurationSeconds: pointer.Int32Ptr(60),
+				AcquireTime:          &now,
+				RenewTime:            &now,
+			},
+		}, metav1.CreateOptions{})
+		assert.NoError(t, err)
+
+		cancel, done := runWithLeaderElection(ctrl)
+		time.Sleep(500 * time.Millisecond)
+		assert.False(t, ctrl.appRefreshQueue.ShuttingDown())
+
+		cancel()
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("controller did not stop")
+		}
+		assert.Equal(t, "argocd-application-controller-1", getLeaseHolder(t, ctrl))
+	})
+}
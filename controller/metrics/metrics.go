@@ -29,6 +29,8 @@ type MetricsServer struct {
 	redisRequestCounter     *prometheus.CounterVec
 	reconcileHistogram      *prometheus.HistogramVec
 	redisRequestHistogram   *prometheus.HistogramVec
+	leaderGauge             *prometheus.GaugeVec
+	leaderTransitionCounter *prometheus.CounterVec
 	registry                *prometheus.Registry
 	hostname                string
 }
@@ -130,6 +132,16 @@ var (
 		},
 		[]string{"hostname", "initiator"},
 	)
+
+	leaderGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "argocd_app_controller_leader",
+		Help: "Whether the controller holds the lease of its shard.",
+	}, []string{"hostname", "lease"})
+
+	leaderTransitionCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "argocd_app_controller_leader_transitions_total",
+		Help: "Number of times the controller acquired or released the lease of its shard.",
+	}, []string{"hostname", "lease", "transition"})
 )
 
 // NewMetricsServer returns a new prometheus server which collects application metrics
@@ -156,6 +168,8 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFil
 	registry.MustRegister(clusterEventsCounter)
 	registry.MustRegister(redisRequestCounter)
 	registry.MustRegister(redisRequestHistogram)
+	registry.MustRegister(leaderGauge)
+	registry.MustRegister(leaderTransitionCounter)
 
 	return &MetricsServer{
 		registry: registry,
@@ -171,6 +185,8 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFil
 		clusterEventsCounter:    clusterEventsCounter,
 		redisRequestCounter:     redisRequestCounter,
 		redisRequestHistogram:   redisRequestHistogram,
+		leaderGauge:             leaderGauge,
+		leaderTransitionCounter: leaderTransitionCounter,
 		hostname:                hostname,
 	}, nil
 }
@@ -229,6 +245,16 @@ func (m *MetricsServer) ObserveRedisRequestDuration(duration time.Duration) {
 	m.redisRequestHistogram.WithLabelValues(m.hostname, "argocd-application-controller").Observe(duration.Seconds())
 }
 
+// SetLeader records whether the controller holds the given lease and counts the leadership transition
+func (m *MetricsServer) SetLeader(lease string, leader bool) {
+	transition := "released"
+	if leader {
+		transition = "acquired"
+	}
+	m.leaderGauge.WithLabelValues(m.hostname, lease).Set(boolFloat64(leader))
+	m.leaderTransitionCounter.WithLabelValues(m.hostname, lease, transition).Inc()
+}
+
 // IncReconcile increments the reconcile counter for an application
 func (m *MetricsServer) IncReconcile(app *argoappv1.Application, duration time.Duration) {
 	m.reconcileHistogram.WithLabelValues(app.Namespace, app.Spec.Destination.Server).Observe(duration.Seconds())
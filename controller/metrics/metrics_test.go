@@ -292,3 +292,30 @@ argocd_app_reconcile_count{dest_server="https://localhost:6443",namespace="argoc
 	log.Println(body)
 	assertMetricsPrinted(t, appReconcileMetrics, body)
 }
+
+func TestLeaderMetrics(t *testing.T) {
+	cancel, appLister := newFakeLister()
+	defer cancel()
+	metricsServ, err := NewMetricsServer("localhost:8082", appLister, appFilter, noOpHealthCheck)
+	assert.NoError(t, err)
+
+	metricsServ.SetLeader("argocd-application-controller-shard-0", true)
+	metricsServ.SetLeader("argocd-application-controller-shard-0", false)
+	metricsServ.SetLeader("argocd-application-controller-shard-0", true)
+
+	leaderMetrics := `
+# HELP argocd_app_controller_leader Whether the controller holds the lease of its shard.
+# TYPE argocd_app_controller_leader gauge
+argocd_app_controller_leader{hostname="` + metricsServ.hostname + `",lease="argocd-application-controller-shard-0"} 1
+# HELP argocd_app_controller_leader_transitions_total Number of times the controller acquired or released the lease of its shard.
+# TYPE argocd_app_controller_leader_transitions_total counter
+argocd_app_controller_leader_transitions_total{hostname="` + metricsServ.hostname + `",lease="argocd-application-controller-shard-0",transition="acquired"} 2
+argocd_app_controller_leader_transitions_total{hostname="` + metricsServ.hostname + `",lease="argocd-application-controller-shard-0",transition="released"} 1
+`
+	req, err := http.NewRequest("GET", "/metrics", nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, rr.Code, http.StatusOK)
+	assertMetricsPrinted(t, leaderMetrics, rr.Body.String())
+}
@@ -0,0 +1,179 @@
+package controller
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+
+	appv1 "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/vathsalashetty96/argo-cd/util/argo"
+)
+
+// getSyncSchedules returns the application sync schedules and the sync schedules of the project which apply to the app
+func getSyncSchedules(app *appv1.Application, project *appv1.AppProject) []appv1.SyncSchedule {
+	var schedules []appv1.SyncSchedule
+	if app.Spec.SyncPolicy != nil {
+		schedules = append(schedules, app.Spec.SyncPolicy.Schedules...)
+	}
+	return append(schedules, project.Spec.MatchingSyncSchedules(app)...)
+}
+
+// syncScheduleCache holds the parsed sync schedules of applications and the time each schedule was added. Schedules are
+// re-parsed only if the application or the project generation changes.
+type syncScheduleCache struct {
+	lock    sync.Mutex
+	entries map[string]*appSyncSchedules
+}
+
+type appSyncSchedules struct {
+	appGeneration     int64
+	projectGeneration int64
+	schedules         []parsedSyncSchedule
+}
+
+type parsedSyncSchedule struct {
+	appv1.SyncSchedule
+	next func(t time.Time) time.Time
+	err  error
+	// addedAt is the time the controller first saw the schedule
+	addedAt time.Time
+}
+
+func (s *parsedSyncSchedule) key() string {
+	return s.Schedule + "|" + s.TimeZone
+}
+
+func newSyncScheduleCache() *syncScheduleCache {
+	return &syncScheduleCache{entries: make(map[string]*appSyncSchedules)}
+}
+
+// get returns the parsed sync schedules which apply to the application. Schedules of an application which is seen for
+// the first time since the controller start might have been added while the controller was not running, so they are
+// considered to be added at the most recent sync: schedules missed since then result in a single sync.
+func (c *syncScheduleCache) get(app *appv1.Application, project *appv1.AppProject, now time.Time) []parsedSyncSchedule {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	entry, ok := c.entries[app.Name]
+	if ok && entry.appGeneration == app.Generation && entry.projectGeneration == project.Generation {
+		return entry.schedules
+	}
+	addedAt := now
+	previous := make(map[string]time.Time)
+	if ok {
+		for _, schedule := range entry.schedules {
+			previous[schedule.key()] = schedule.addedAt
+		}
+	} else if lastSync := lastSyncTime(app); !lastSync.IsZero() {
+		addedAt = lastSync
+	}
+
+	var schedules []parsedSyncSchedule
+	for _, schedule := range getSyncSchedules(app, project) {
+		parsed := parsedSyncSchedule{SyncSchedule: schedule, addedAt: addedAt}
+		if t, ok := previous[parsed.key()]; ok {
+			parsed.addedAt = t
+		}
+		parsed.next, parsed.err = schedule.Parse()
+		schedules = append(schedules, parsed)
+	}
+	c.entries[app.Name] = &appSyncSchedules{appGeneration: app.Generation, projectGeneration: project.Generation, schedules: schedules}
+	return schedules
+}
+
+func (c *syncScheduleCache) delete(appName string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.entries, appName)
+}
+
+// lastSyncTime returns the time the most recent operation finished, or zero time if the application was never synced
+func lastSyncTime(app *appv1.Application) time.Time {
+	opState := app.Status.OperationState
+	if opState == nil {
+		return time.Time{}
+	}
+	if opState.FinishedAt != nil {
+		return opState.FinishedAt.Time
+	}
+	return opState.StartedAt.Time
+}
+
+// scheduledSync initiates a sync operation if any of the sync schedules fired since the most recent sync operation
+// finished and since the schedule was added. Missed schedules result in a single sync. Returns true if the sync has been
+// initiated, and SyncError condition if a schedule is invalid or the sync could not be initiated.
+func (ctrl *ApplicationController) scheduledSync(app *appv1.Application, project *appv1.AppProject, syncStatus *appv1.SyncStatus) (bool, *appv1.ApplicationCondition) {
+	now := time.Now()
+	schedules := ctrl.syncSchedules.get(app, project, now)
+	if len(schedules) == 0 {
+		return false, nil
+	}
+	logCtx := log.WithFields(log.Fields{"application": app.Name})
+	if app.Operation != nil {
+		logCtx.Infof("Skipping scheduled sync: another operation is in progress")
+		return false, nil
+	}
+	if app.DeletionTimestamp != nil && !app.DeletionTimestamp.IsZero() {
+		logCtx.Infof("Skipping scheduled sync: deletion in progress")
+		return false, nil
+	}
+
+	lastSync := lastSyncTime(app)
+	var due *parsedSyncSchedule
+	var nextRun time.Time
+	for i := range schedules {
+		if schedules[i].err != nil {
+			return false, &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: fmt.Sprintf("Invalid sync schedule: %v", schedules[i].err)}
+		}
+		since := lastSync
+		if schedules[i].addedAt.After(since) {
+			since = schedules[i].addedAt
+		}
+		next := schedules[i].next(since)
+		switch {
+		case next.IsZero():
+		case !next.After(now):
+			if due == nil || (schedules[i].Prune && !due.Prune) {
+				due = &schedules[i]
+			}
+		case nextRun.IsZero() || next.Before(nextRun):
+			nextRun = next
+		}
+	}
+	if due == nil {
+		if !nextRun.IsZero() {
+			after := nextRun.Sub(now)
+			ctrl.requestAppRefresh(app.Name, CompareWithLatest.Pointer(), &after)
+		}
+		return false, nil
+	}
+
+	op := appv1.Operation{
+		Sync: &appv1.SyncOperation{
+			Revision: syncStatus.Revision,
+			Prune:    due.Prune,
+		},
+		InitiatedBy: appv1.OperationInitiator{Automated: true},
+		Retry:       appv1.RetryStrategy{Limit: 5},
+	}
+	if app.Spec.SyncPolicy != nil {
+		op.Sync.SyncOptions = app.Spec.SyncPolicy.SyncOptions
+		op.SyncTimeout = app.Spec.SyncPolicy.SyncTimeout
+		if app.Spec.SyncPolicy.Retry != nil {
+			op.Retry = *app.Spec.SyncPolicy.Retry
+		}
+	}
+	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
+	_, err := argo.SetAppOperation(appIf, app.Name, &op)
+	if err != nil {
+		logCtx.Errorf("Failed to initiate scheduled sync to %s: %v", syncStatus.Revision, err)
+		return false, &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: err.Error()}
+	}
+	message := fmt.Sprintf("Initiated scheduled sync to '%s' (schedule '%s')", syncStatus.Revision, due.Schedule)
+	ctrl.auditLogger.LogAppEvent(app, argo.EventInfo{Reason: argo.EventReasonOperationStarted, Type: v1.EventTypeNormal}, message)
+	logCtx.Info(message)
+	return true, nil
+}
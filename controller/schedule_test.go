@@ -0,0 +1,100 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	argoappv1 "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/vathsalashetty96/argo-cd/test"
+)
+
+func TestScheduledSync(t *testing.T) {
+	syncStatus := argoappv1.SyncStatus{
+		Status:   argoappv1.SyncStatusCodeSynced,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	getOperation := func(t *testing.T, ctrl *ApplicationController) *argoappv1.Operation {
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(context.Background(), "my-app", metav1.GetOptions{})
+		assert.NoError(t, err)
+		return app.Operation
+	}
+
+	t.Run("ApplicationSchedule", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Schedules = []argoappv1.SyncSchedule{{Schedule: "0 2 * * *", Prune: true}}
+		app.Spec.SyncPolicy.SyncTimeout = "30m"
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		initiated, cond := ctrl.scheduledSync(app, &argoappv1.AppProject{}, &syncStatus)
+		assert.True(t, initiated)
+		assert.Nil(t, cond)
+		op := getOperation(t, ctrl)
+		if assert.NotNil(t, op) && assert.NotNil(t, op.Sync) {
+			assert.Equal(t, syncStatus.Revision, op.Sync.Revision)
+			assert.True(t, op.Sync.Prune)
+			assert.True(t, op.InitiatedBy.Automated)
+			assert.Equal(t, "30m", op.SyncTimeout)
+		}
+	})
+	t.Run("ProjectSchedule", func(t *testing.T) {
+		app := newFakeApp()
+		proj := &argoappv1.AppProject{Spec: argoappv1.AppProjectSpec{
+			SyncSchedules: []argoappv1.SyncSchedule{{Schedule: "0 2 * * *", TimeZone: "Europe/Berlin", Applications: []string{"my-*"}}},
+		}}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		initiated, cond := ctrl.scheduledSync(app, proj, &syncStatus)
+		assert.True(t, initiated)
+		assert.Nil(t, cond)
+		assert.NotNil(t, getOperation(t, ctrl))
+	})
+	t.Run("NotDue", func(t *testing.T) {
+		app := newFakeApp()
+		now := metav1.Now()
+		app.Status.OperationState.FinishedAt = &now
+		app.Spec.SyncPolicy.Schedules = []argoappv1.SyncSchedule{{Schedule: "0 0 29 2 *"}}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		initiated, cond := ctrl.scheduledSync(app, &argoappv1.AppProject{}, &syncStatus)
+		assert.False(t, initiated)
+		assert.Nil(t, cond)
+		assert.Nil(t, getOperation(t, ctrl))
+	})
+	t.Run("ScheduleAddedToExistingApp", func(t *testing.T) {
+		app := newFakeApp()
+		app.Generation = 1
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		initiated, cond := ctrl.scheduledSync(app, &argoappv1.AppProject{}, &syncStatus)
+		assert.False(t, initiated)
+		assert.Nil(t, cond)
+
+		app = app.DeepCopy()
+		app.Generation = 2
+		app.Spec.SyncPolicy.Schedules = []argoappv1.SyncSchedule{{Schedule: "0 2 * * *"}}
+		initiated, cond = ctrl.scheduledSync(app, &argoappv1.AppProject{}, &syncStatus)
+		assert.False(t, initiated)
+		assert.Nil(t, cond)
+		assert.Nil(t, getOperation(t, ctrl))
+	})
+	t.Run("OperationInProgress", func(t *testing.T) {
+		app := newFakeApp()
+		app.Operation = &argoappv1.Operation{Sync: &argoappv1.SyncOperation{}}
+		app.Spec.SyncPolicy.Schedules = []argoappv1.SyncSchedule{{Schedule: "0 2 * * *"}}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		initiated, cond := ctrl.scheduledSync(app, &argoappv1.AppProject{}, &syncStatus)
+		assert.False(t, initiated)
+		assert.Nil(t, cond)
+	})
+	t.Run("InvalidSchedule", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Schedules = []argoappv1.SyncSchedule{{Schedule: "0 2 * * *", TimeZone: "Mars/Olympus"}}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+		initiated, cond := ctrl.scheduledSync(app, &argoappv1.AppProject{}, &syncStatus)
+		assert.False(t, initiated)
+		if assert.NotNil(t, cond) {
+			assert.Equal(t, argoappv1.ApplicationConditionSyncError, cond.Type)
+		}
+		assert.Nil(t, getOperation(t, ctrl))
+	})
+}
@@ -14,6 +14,10 @@ import (
 	resourceutil "github.com/vathsalashetty96/gitops-engine/pkg/sync/resource"
 	kubeutil "github.com/vathsalashetty96/gitops-engine/pkg/utils/kube"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -22,6 +26,7 @@ import (
 
 	"github.com/vathsalashetty96/argo-cd/common"
 	statecache "github.com/vathsalashetty96/argo-cd/controller/cache"
+	"github.com/vathsalashetty96/argo-cd/controller/hydrator"
 	"github.com/vathsalashetty96/argo-cd/controller/metrics"
 	"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1"
 	appv1 "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1"
@@ -34,6 +39,7 @@ import (
 	"github.com/vathsalashetty96/argo-cd/util/io"
 	"github.com/vathsalashetty96/argo-cd/util/settings"
 	"github.com/vathsalashetty96/argo-cd/util/stats"
+	"github.com/vathsalashetty96/argo-cd/util/trace"
 )
 
 type resourceInfoProviderStub struct {
@@ -83,6 +89,8 @@ type comparisonResult struct {
 	reconciliationResult sync.ReconciliationResult
 	diffNormalizer       diff.Normalizer
 	appSourceType        v1alpha1.ApplicationSourceType
+	// hydratorStatus is set if the rendered manifests were committed to the hydration target of the application
+	hydratorStatus *v1alpha1.HydratorStatus
 	// timings maps phases of comparison to the duration it took to complete (for statistical purposes)
 	timings map[string]time.Duration
 }
@@ -106,9 +114,12 @@ type appStateManager struct {
 	repoClientset  apiclient.Clientset
 	liveStateCache statecache.LiveStateCache
 	namespace      string
+	hydrator       *hydrator.Hydrator
+	// onHydrated is called with the name of an application once its manifests were committed in the background
+	onHydrated func(appName string)
 }
 
-func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, source v1alpha1.ApplicationSource, appLabelKey, revision string, noCache, verifySignature bool) ([]*unstructured.Unstructured, *apiclient.ManifestResponse, error) {
+func (m *appStateManager) getRepoObjs(ctx context.Context, app *v1alpha1.Application, project *appv1.AppProject, source v1alpha1.ApplicationSource, appLabelKey string, trackingMethod argo.TrackingMethod, revision string, noCache, verifySignature bool) ([]*unstructured.Unstructured, *apiclient.ManifestResponse, error) {
 	ts := stats.NewTimingStats()
 	helmRepos, err := m.db.ListHelmRepositories(context.Background())
 	if err != nil {
@@ -120,6 +131,10 @@ func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, source v1alpha1
 		return nil, nil, err
 	}
 	ts.AddCheckpoint("repo_ms")
+	valuesRepos, err := argo.GetValuesRepos(context.Background(), &source, m.db)
+	if err != nil {
+		return nil, nil, err
+	}
 	conn, repoClient, err := m.repoClientset.NewRepoServerClient()
 	if err != nil {
 		return nil, nil, err
@@ -154,12 +169,13 @@ func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, source v1alpha1
 		return nil, nil, err
 	}
 	ts.AddCheckpoint("version_ms")
-	manifestInfo, err := repoClient.GenerateManifest(context.Background(), &apiclient.ManifestRequest{
+	manifestInfo, err := repoClient.GenerateManifest(ctx, &apiclient.ManifestRequest{
 		Repo:              repo,
 		Repos:             helmRepos,
 		Revision:          revision,
 		NoCache:           noCache,
 		AppLabelKey:       appLabelKey,
+		TrackingMethod:    string(trackingMethod),
 		AppName:           app.Name,
 		Namespace:         app.Spec.Destination.Namespace,
 		ApplicationSource: &source,
@@ -168,6 +184,8 @@ func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, source v1alpha1
 		KubeVersion:       serverVersion,
 		ApiVersions:       argo.APIGroupsToVersions(apiGroups),
 		VerifySignature:   verifySignature,
+		ValuesRepos:       valuesRepos,
+		HelmValueURLs:     project.Spec.HelmValueURLs,
 	})
 	if err != nil {
 		return nil, nil, err
@@ -241,24 +259,28 @@ func DeduplicateTargetObjects(
 	return result, conditions, nil
 }
 
-func (m *appStateManager) getComparisonSettings(app *appv1.Application) (string, map[string]v1alpha1.ResourceOverride, diff.Normalizer, *settings.ResourcesFilter, error) {
+func (m *appStateManager) getComparisonSettings(app *appv1.Application) (string, argo.TrackingMethod, map[string]v1alpha1.ResourceOverride, diff.Normalizer, *settings.ResourcesFilter, error) {
 	resourceOverrides, err := m.settingsMgr.GetResourceOverrides()
 	if err != nil {
-		return "", nil, nil, nil, err
+		return "", "", nil, nil, nil, err
 	}
 	appLabelKey, err := m.settingsMgr.GetAppInstanceLabelKey()
 	if err != nil {
-		return "", nil, nil, nil, err
+		return "", "", nil, nil, nil, err
+	}
+	trackingMethod, err := argo.GetTrackingMethod(m.settingsMgr)
+	if err != nil {
+		return "", "", nil, nil, nil, err
 	}
 	diffNormalizer, err := argo.NewDiffNormalizer(app.Spec.IgnoreDifferences, resourceOverrides)
 	if err != nil {
-		return "", nil, nil, nil, err
+		return "", "", nil, nil, nil, err
 	}
 	resFilter, err := m.settingsMgr.GetResourcesFilter()
 	if err != nil {
-		return "", nil, nil, nil, err
+		return "", "", nil, nil, nil, err
 	}
-	return appLabelKey, resourceOverrides, diffNormalizer, resFilter, nil
+	return appLabelKey, trackingMethod, resourceOverrides, diffNormalizer, resFilter, nil
 }
 
 // verifyGnuPGSignature verifies the result of a GnuPG operation for a given git
@@ -309,8 +331,16 @@ func verifyGnuPGSignature(revision string, project *appv1.AppProject, manifestIn
 // revision and supplied source. If revision or overrides are empty, then compares against
 // revision and overrides in the app spec.
 func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *appv1.AppProject, revision string, source v1alpha1.ApplicationSource, noCache bool, localManifests []string) *comparisonResult {
+	return m.compareAppState(context.Background(), app, project, revision, source, noCache, localManifests)
+}
+
+// compareAppState compares the application live and target state. The comparison is traced as a child of the span in the
+// given context.
+func (m *appStateManager) compareAppState(ctx context.Context, app *v1alpha1.Application, project *appv1.AppProject, revision string, source v1alpha1.ApplicationSource, noCache bool, localManifests []string) *comparisonResult {
+	ctx, span := trace.StartSpan(ctx, "CompareAppState", oteltrace.WithAttributes(attribute.String("application", app.Name), attribute.String("revision", revision)))
+	defer span.End()
 	ts := stats.NewTimingStats()
-	appLabelKey, resourceOverrides, diffNormalizer, resFilter, err := m.getComparisonSettings(app)
+	appLabelKey, trackingMethod, resourceOverrides, diffNormalizer, resFilter, err := m.getComparisonSettings(app)
 	ts.AddCheckpoint("settings_ms")
 
 	// return unknown comparison result if basic comparison settings cannot be loaded
@@ -339,14 +369,31 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 
 	var targetObjs []*unstructured.Unstructured
 	var manifestInfo *apiclient.ManifestResponse
+	var hydratorStatus *v1alpha1.HydratorStatus
 	now := metav1.Now()
 
 	if len(localManifests) == 0 {
-		targetObjs, manifestInfo, err = m.getRepoObjs(app, source, appLabelKey, revision, noCache, verifySignature)
+		targetObjs, manifestInfo, err = m.getRepoObjs(ctx, app, project, source, appLabelKey, trackingMethod, revision, noCache, verifySignature)
 		if err != nil {
 			targetObjs = make([]*unstructured.Unstructured, 0)
-			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error(), LastTransitionTime: &now})
+			conditionType := v1alpha1.ApplicationConditionComparisonError
+			if status.Code(err) == codes.ResourceExhausted {
+				conditionType = v1alpha1.ApplicationConditionManifestLimitError
+			}
+			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: conditionType, Message: err.Error(), LastTransitionTime: &now})
 			failedToLoadObjs = true
+		} else if app.Spec.HydrateTo != nil {
+			var hydratedObjs []*unstructured.Unstructured
+			hydratorStatus, hydratedObjs, err = m.hydrate(ctx, app, project, source, appLabelKey, trackingMethod, manifestInfo, noCache)
+			if err != nil {
+				conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionHydrationError, Message: err.Error(), LastTransitionTime: &now})
+				if app.Spec.HydrateTo.SyncFromHydrated {
+					targetObjs = make([]*unstructured.Unstructured, 0)
+					failedToLoadObjs = true
+				}
+			} else if app.Spec.HydrateTo.SyncFromHydrated {
+				targetObjs = hydratedObjs
+			}
 		}
 	} else {
 		// Prevent applying local manifests for now when signature verification is enabled
@@ -409,7 +456,7 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 
 	for _, liveObj := range liveObjByKey {
 		if liveObj != nil {
-			appInstanceName := kubeutil.GetAppInstanceLabel(liveObj, appLabelKey)
+			appInstanceName := argo.GetAppName(liveObj, appLabelKey, trackingMethod)
 			if appInstanceName != "" && appInstanceName != app.Name {
 				conditions = append(conditions, v1alpha1.ApplicationCondition{
 					Type:               v1alpha1.ApplicationConditionSharedResourceWarning,
@@ -554,21 +601,61 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 		managedResources:     managedResources,
 		reconciliationResult: reconciliation,
 		diffNormalizer:       diffNormalizer,
+		hydratorStatus:       hydratorStatus,
 	}
 	if manifestInfo != nil {
 		compRes.appSourceType = v1alpha1.ApplicationSourceType(manifestInfo.SourceType)
 	}
 	app.Status.SetConditions(conditions, map[appv1.ApplicationConditionType]bool{
 		appv1.ApplicationConditionComparisonError:         true,
+		appv1.ApplicationConditionManifestLimitError:      true,
 		appv1.ApplicationConditionSharedResourceWarning:   true,
 		appv1.ApplicationConditionRepeatedResourceWarning: true,
 		appv1.ApplicationConditionExcludedResourceWarning: true,
+		appv1.ApplicationConditionHydrationError:          true,
 	})
 	ts.AddCheckpoint("health_ms")
 	compRes.timings = ts.Timings()
 	return &compRes
 }
 
+// hydrate commits the manifests rendered from the source to the hydration target of the application, unless the
+// source revision has already been committed to the target. The commit is pushed in the background and the application
+// is refreshed once it is finished, so until then the previous hydrator status is returned. If the application is synced
+// from the hydrated manifests, the manifests are loaded from the hydrated commit.
+func (m *appStateManager) hydrate(ctx context.Context, app *v1alpha1.Application, project *appv1.AppProject, source v1alpha1.ApplicationSource, appLabelKey string, trackingMethod argo.TrackingMethod, manifestInfo *apiclient.ManifestResponse, noCache bool) (*v1alpha1.HydratorStatus, []*unstructured.Unstructured, error) {
+	status := app.Status.Hydrator
+	target := app.Spec.HydrateTo.Target(source)
+	if noCache || status == nil || status.DrySHA != manifestInfo.Revision || status.HydratedSHA == "" || !status.IsHydratedTo(target) {
+		appName := app.Name
+		hydratedStatus, err := m.hydrator.HydrateAsync(app, source, manifestInfo.Revision, manifestInfo.Manifests, noCache, func() {
+			if m.onHydrated != nil {
+				m.onHydrated(appName)
+			}
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to commit rendered manifests: %v", err)
+		}
+		if hydratedStatus != nil {
+			status = hydratedStatus
+		} else if status == nil || !status.IsHydratedTo(target) {
+			if !app.Spec.HydrateTo.SyncFromHydrated {
+				return nil, nil, nil
+			}
+			return nil, nil, fmt.Errorf("rendered manifests of revision %s are being committed", manifestInfo.Revision)
+		}
+	}
+	if !app.Spec.HydrateTo.SyncFromHydrated {
+		return status, nil, nil
+	}
+	hydratedSource := app.Spec.HydrateTo.HydratedSource(source, status.HydratedSHA)
+	hydratedObjs, _, err := m.getRepoObjs(ctx, app, project, hydratedSource, appLabelKey, trackingMethod, status.HydratedSHA, noCache, false)
+	if err != nil {
+		return status, nil, fmt.Errorf("failed to load hydrated manifests: %v", err)
+	}
+	return status, hydratedObjs, nil
+}
+
 func (m *appStateManager) persistRevisionHistory(app *v1alpha1.Application, revision string, source v1alpha1.ApplicationSource, startedAt metav1.Time) error {
 	var nextID int64
 	if len(app.Status.History) > 0 {
@@ -607,6 +694,8 @@ func NewAppStateManager(
 	liveStateCache statecache.LiveStateCache,
 	projInformer cache.SharedIndexInformer,
 	metricsServer *metrics.MetricsServer,
+	appHydrator *hydrator.Hydrator,
+	onHydrated func(appName string),
 ) AppStateManager {
 	return &appStateManager{
 		liveStateCache: liveStateCache,
@@ -618,5 +707,7 @@ func NewAppStateManager(
 		settingsMgr:    settingsMgr,
 		projInformer:   projInformer,
 		metricsServer:  metricsServer,
+		hydrator:       appHydrator,
+		onHydrated:     onHydrated,
 	}
 }
@@ -12,6 +12,8 @@ import (
 	"github.com/vathsalashetty96/gitops-engine/pkg/utils/kube"
 	. "github.com/vathsalashetty96/gitops-engine/pkg/utils/testing"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -68,6 +70,21 @@ func TestCompareAppStateMissing(t *testing.T) {
 	assert.Len(t, app.Status.Conditions, 0)
 }
 
+// TestCompareAppStateManifestLimitError tests that exceeded manifest generation limits are reported as such
+func TestCompareAppStateManifestLimitError(t *testing.T) {
+	app := newFakeApp()
+	data := fakeData{
+		apps:            []runtime.Object{app},
+		manifestError:   status.Error(codes.ResourceExhausted, "manifest generation limit exceeded: generated manifests exceed 1Mi"),
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
+	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, "", app.Spec.Source, false, nil)
+	assert.NotNil(t, compRes)
+	assert.Len(t, app.Status.Conditions, 1)
+	assert.Equal(t, argoappv1.ApplicationConditionManifestLimitError, app.Status.Conditions[0].Type)
+}
+
 // TestCompareAppStateExtra tests when there is an extra object in live but not defined in git
 func TestCompareAppStateExtra(t *testing.T) {
 	pod := NewPod()
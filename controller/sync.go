@@ -5,25 +5,33 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"sync/atomic"
 	"time"
 
 	"github.com/vathsalashetty96/gitops-engine/pkg/sync"
 	"github.com/vathsalashetty96/gitops-engine/pkg/sync/common"
+	"github.com/vathsalashetty96/gitops-engine/pkg/sync/hook"
 	"github.com/vathsalashetty96/gitops-engine/pkg/utils/kube"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
 
 	cdcommon "github.com/vathsalashetty96/argo-cd/common"
 	"github.com/vathsalashetty96/argo-cd/controller/metrics"
 	"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1"
 	listersv1alpha1 "github.com/vathsalashetty96/argo-cd/pkg/client/listers/application/v1alpha1"
 	"github.com/vathsalashetty96/argo-cd/util/argo"
+	kubeutil "github.com/vathsalashetty96/argo-cd/util/kube"
 	logutils "github.com/vathsalashetty96/argo-cd/util/log"
 	"github.com/vathsalashetty96/argo-cd/util/lua"
 	"github.com/vathsalashetty96/argo-cd/util/rand"
+	"github.com/vathsalashetty96/argo-cd/util/trace"
 )
 
 var syncIdPrefix uint64 = 0
@@ -35,6 +43,8 @@ const (
 )
 
 func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha1.OperationState) {
+	ctx, span := trace.StartSpan(context.Background(), "SyncAppState", oteltrace.WithAttributes(attribute.String("application", app.Name)))
+	defer span.End()
 	// Sync requests might be requested with ambiguous revisions (e.g. master, HEAD, v1.2.3).
 	// This can change meaning when resuming operations (e.g a hook sync). After calculating a
 	// concrete git commit SHA, the SHA is remembered in the status.operationState.syncResult field.
@@ -51,6 +61,13 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		return
 	}
 	syncOp = *state.Operation.Sync
+	state.FailureClasses = nil
+	syncTimeout, err := state.Operation.GetSyncTimeout()
+	if err != nil {
+		state.Phase = common.OperationFailed
+		state.Message = err.Error()
+		return
+	}
 	if syncOp.Source == nil {
 		// normal sync case (where source is taken from app.spec.source)
 		source = app.Spec.Source
@@ -63,7 +80,8 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		syncRes = state.SyncResult
 		revision = state.SyncResult.Revision
 	} else {
-		syncRes = &v1alpha1.SyncOperationResult{}
+		now := v1.Now()
+		syncRes = &v1alpha1.SyncOperationResult{StartedAt: &now}
 		// status.operationState.syncResult.source. must be set properly since auto-sync relies
 		// on this information to decide if it should sync (if source is different than the last
 		// sync attempt)
@@ -85,15 +103,17 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		return
 	}
 
-	compareResult := m.CompareAppState(app, proj, revision, source, false, syncOp.Manifests)
+	compareResult := m.compareAppState(ctx, app, proj, revision, source, false, syncOp.Manifests)
 	// We now have a concrete commit SHA. Save this in the sync result revision so that we remember
 	// what we should be syncing to when resuming operations.
 	syncRes.Revision = compareResult.syncStatus.Revision
 
 	// If there are any comparison or spec errors error conditions do not perform the operation
 	if errConditions := app.Status.GetConditions(map[v1alpha1.ApplicationConditionType]bool{
-		v1alpha1.ApplicationConditionComparisonError:  true,
-		v1alpha1.ApplicationConditionInvalidSpecError: true,
+		v1alpha1.ApplicationConditionComparisonError:    true,
+		v1alpha1.ApplicationConditionManifestLimitError: true,
+		v1alpha1.ApplicationConditionInvalidSpecError:   true,
+		v1alpha1.ApplicationConditionHydrationError:     app.Spec.HydrateTo != nil && app.Spec.HydrateTo.SyncFromHydrated,
 	}); len(errConditions) > 0 {
 		state.Phase = common.OperationError
 		state.Message = argo.FormatAppConditions(errConditions)
@@ -140,32 +160,18 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		compareResult.reconciliationResult,
 		restConfig,
 		rawConfig,
-		m.kubectl,
+		kubeutil.WithTraceContext(ctx, m.kubectl),
 		app.Spec.Destination.Namespace,
 		sync.WithLogr(logutils.NewLogrusLogger(logEntry)),
 		sync.WithHealthOverride(lua.ResourceHealthOverrides(resourceOverrides)),
-		sync.WithPermissionValidator(func(un *unstructured.Unstructured, res *v1.APIResource) error {
-			if !proj.IsGroupKindPermitted(un.GroupVersionKind().GroupKind(), res.Namespaced) {
-				return fmt.Errorf("Resource %s:%s is not permitted in project %s.", un.GroupVersionKind().Group, un.GroupVersionKind().Kind, proj.Name)
-			}
-			if res.Namespaced && !proj.IsDestinationPermitted(v1alpha1.ApplicationDestination{Namespace: un.GetNamespace(), Server: app.Spec.Destination.Server}) {
-				return fmt.Errorf("namespace %v is not permitted in project '%s'", un.GetNamespace(), proj.Name)
-			}
-			return nil
-		}),
+		sync.WithPermissionValidator(argo.ProjectPermissionValidator(proj, app.Spec.Destination.Server)),
 		sync.WithOperationSettings(syncOp.DryRun, syncOp.Prune, syncOp.SyncStrategy.Force(), syncOp.IsApplyStrategy() || len(syncOp.Resources) > 0),
 		sync.WithInitialState(state.Phase, state.Message, initialResourcesRes, state.StartedAt),
 		sync.WithResourcesFilter(func(key kube.ResourceKey, target *unstructured.Unstructured, live *unstructured.Unstructured) bool {
 			return len(syncOp.Resources) == 0 || argo.ContainsSyncResource(key.Name, key.Namespace, schema.GroupVersionKind{Kind: key.Kind, Group: key.Group}, syncOp.Resources)
 		}),
 		sync.WithManifestValidation(!syncOp.SyncOptions.HasOption("Validate=false")),
-		sync.WithNamespaceCreation(syncOp.SyncOptions.HasOption("CreateNamespace=true"), func(un *unstructured.Unstructured) bool {
-			if un != nil && kube.GetAppInstanceLabel(un, cdcommon.LabelKeyAppInstance) != "" {
-				kube.UnsetLabel(un, cdcommon.LabelKeyAppInstance)
-				return true
-			}
-			return false
-		}),
+		sync.WithNamespaceCreation(syncOp.SyncOptions.HasOption("CreateNamespace=true"), argo.UnsetManagedNamespaceTracking),
 		sync.WithSyncWaveHook(delayBetweenSyncWaves),
 		sync.WithPruneLast(syncOp.SyncOptions.HasOption("PruneLast=true")),
 	)
@@ -177,16 +183,22 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 
 	start := time.Now()
 
-	if state.Phase == common.OperationTerminating {
+	var timeoutMessage string
+	if state.Phase != common.OperationTerminating && !syncOp.DryRun {
+		timeoutMessage = getSyncTimeoutMessage(syncRes, state.StartedAt, syncTimeout, compareResult.reconciliationResult, logEntry)
+	}
+	if state.Phase == common.OperationTerminating || timeoutMessage != "" {
 		syncCtx.Terminate()
 	} else {
 		syncCtx.Sync()
 	}
 	var resState []common.ResourceSyncResult
 	state.Phase, state.Message, resState = syncCtx.GetState()
+	previousResources := state.SyncResult.Resources
 	state.SyncResult.Resources = nil
+	now := v1.Now()
 	for _, res := range resState {
-		state.SyncResult.Resources = append(state.SyncResult.Resources, &v1alpha1.ResourceResult{
+		result := &v1alpha1.ResourceResult{
 			HookType:  res.HookType,
 			Group:     res.ResourceKey.Group,
 			Kind:      res.ResourceKey.Kind,
@@ -197,7 +209,26 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 			HookPhase: res.HookPhase,
 			Status:    res.Status,
 			Message:   res.Message,
-		})
+		}
+		// remember when the resource was first applied, which is the start of its sync timeout
+		if _, previous := previousResources.Find(result.Group, result.Kind, result.Namespace, result.Name, result.SyncPhase); previous != nil && previous.StartedAt != nil {
+			result.StartedAt = previous.StartedAt
+		} else if result.HookPhase != "" || result.Status != "" {
+			result.StartedAt = &now
+		}
+		result.FailureClass = classifySyncFailure(result)
+		state.SyncResult.Resources = append(state.SyncResult.Resources, result)
+	}
+
+	if timeoutMessage != "" {
+		logEntry.Info(timeoutMessage)
+		state.Phase = common.OperationFailed
+		state.Message = timeoutMessage
+		m.deleteFailedHooks(restConfig, compareResult.reconciliationResult.Hooks, state.SyncResult.Resources, logEntry)
+	}
+
+	if state.Phase == common.OperationFailed || state.Phase == common.OperationError {
+		state.FailureClasses = state.SyncResult.Resources.FailureClasses()
 	}
 
 	logEntry.WithField("duration", time.Since(start)).Info("sync/terminate complete")
@@ -211,6 +242,119 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 	}
 }
 
+// getSyncTimeoutMessage returns the reason the sync attempt must be terminated if the sync timeout of the operation is
+// exceeded or a resource or hook with the sync timeout annotation is in progress for longer than its timeout. Returns
+// an empty string otherwise.
+func getSyncTimeoutMessage(syncRes *v1alpha1.SyncOperationResult, operationStartedAt v1.Time, timeout time.Duration, reconciliationResult sync.ReconciliationResult, logEntry *log.Entry) string {
+	now := time.Now()
+	startedAt := operationStartedAt
+	if syncRes.StartedAt != nil {
+		startedAt = *syncRes.StartedAt
+	}
+	if timeout > 0 && now.Sub(startedAt.Time) > timeout {
+		return fmt.Sprintf("sync attempt exceeded the sync timeout of %v", timeout)
+	}
+	// hooks are not part of the targets, but may have a sync timeout as well
+	targets := append(append([]*unstructured.Unstructured{}, reconciliationResult.Target...), reconciliationResult.Hooks...)
+	for _, target := range targets {
+		if target == nil {
+			continue
+		}
+		val, ok := target.GetAnnotations()[cdcommon.AnnotationSyncTimeout]
+		if !ok {
+			continue
+		}
+		resourceTimeout, err := v1alpha1.ParseSyncTimeout(val)
+		if err != nil {
+			logEntry.Warnf("Ignoring invalid sync timeout '%s' of %s %s: %v", val, target.GetKind(), target.GetName(), err)
+			continue
+		}
+		for _, res := range syncRes.Resources {
+			if res.HookPhase != common.OperationRunning || res.StartedAt == nil || !isSyncTarget(target, res) {
+				continue
+			}
+			if now.Sub(res.StartedAt.Time) > resourceTimeout {
+				return fmt.Sprintf("%s %s exceeded its sync timeout of %v", res.Kind, res.Name, resourceTimeout)
+			}
+		}
+	}
+	return ""
+}
+
+// syncFailureMatchers classify failures by the lower case error message of the resource, in order of precedence
+var syncFailureMatchers = []struct {
+	class    v1alpha1.SyncFailureClass
+	messages []string
+}{
+	{v1alpha1.SyncFailureClassValidation, []string{"denied the request", "is invalid", "error validating", "unable to recognize", "unknown field", "could not find the requested resource", "field is immutable", "cannot be handled as"}},
+	{v1alpha1.SyncFailureClassRBAC, []string{"forbidden", "unauthorized", "not permitted in project"}},
+	{v1alpha1.SyncFailureClassConflict, []string{"the object has been modified", "operation cannot be fulfilled", "already exists", "conflict"}},
+	{v1alpha1.SyncFailureClassTransient, []string{"timeout", "timed out", "deadline exceeded", "connection refused", "connection reset", "no such host", ": eof", "unexpected eof", "tls handshake", "failed calling webhook", "the server is currently unable", "too many requests", "service unavailable", "internal error occurred"}},
+}
+
+// classifySyncFailure returns the class of the failure of the resource or hook, or an empty string if it did not fail
+func classifySyncFailure(res *v1alpha1.ResourceResult) v1alpha1.SyncFailureClass {
+	syncFailed := res.Status == common.ResultCodeSyncFailed
+	if !syncFailed && res.HookPhase != common.OperationFailed && res.HookPhase != common.OperationError {
+		return ""
+	}
+	// the hook was created but did not complete successfully
+	if !syncFailed && res.HookType != "" {
+		return v1alpha1.SyncFailureClassHookFailed
+	}
+	message := strings.ToLower(res.Message)
+	for _, matcher := range syncFailureMatchers {
+		for _, m := range matcher.messages {
+			if strings.Contains(message, m) {
+				return matcher.class
+			}
+		}
+	}
+	return v1alpha1.SyncFailureClassUnknown
+}
+
+// isSyncTarget returns true if the result belongs to the given target resource. The names of hooks using generateName
+// are matched by prefix.
+func isSyncTarget(target *unstructured.Unstructured, res *v1alpha1.ResourceResult) bool {
+	gvk := target.GroupVersionKind()
+	if gvk.Group != res.Group || gvk.Kind != res.Kind || target.GetNamespace() != "" && target.GetNamespace() != res.Namespace {
+		return false
+	}
+	if target.GetName() == "" {
+		return target.GetGenerateName() != "" && strings.HasPrefix(res.Name, target.GetGenerateName())
+	}
+	return target.GetName() == res.Name
+}
+
+// deleteFailedHooks deletes the failed hook instances of the terminated sync attempt if their hook has the HookFailed
+// delete policy. The instances are found in the results of the attempt, since the names of hooks using generateName
+// are only known once they are created.
+func (m *appStateManager) deleteFailedHooks(config *rest.Config, hooks []*unstructured.Unstructured, results v1alpha1.ResourceResults, logEntry *log.Entry) {
+	for _, res := range results {
+		if res.HookPhase != common.OperationFailed && res.HookPhase != common.OperationError {
+			continue
+		}
+		for _, obj := range hooks {
+			if obj == nil || !isSyncTarget(obj, res) {
+				continue
+			}
+			deleteOnFailure := false
+			for _, policy := range hook.DeletePolicies(obj) {
+				deleteOnFailure = deleteOnFailure || policy == common.HookDeletePolicyHookFailed
+			}
+			if !deleteOnFailure {
+				continue
+			}
+			gvk := schema.GroupVersionKind{Group: res.Group, Version: res.Version, Kind: res.Kind}
+			// hooks which were still running are already deleted by the termination
+			if err := m.kubectl.DeleteResource(context.Background(), config, gvk, res.Name, res.Namespace, false); err != nil && !apierr.IsNotFound(err) {
+				logEntry.Warnf("Failed to delete hook %s %s: %v", res.Kind, res.Name, err)
+			}
+			break
+		}
+	}
+}
+
 // delayBetweenSyncWaves is a gitops-engine SyncWaveHook which introduces an artificial delay
 // between each sync wave. We introduce an artificial delay in order give other controllers a
 // _chance_ to react to the spec change that we just applied. This is important because without
@@ -2,15 +2,25 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"os"
 	"testing"
+	"time"
 
-	"github.com/vathsalashetty96/gitops-engine/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/vathsalashetty96/gitops-engine/pkg/sync"
+	"github.com/vathsalashetty96/gitops-engine/pkg/sync/common"
+	"github.com/vathsalashetty96/gitops-engine/pkg/utils/kube"
+	. "github.com/vathsalashetty96/gitops-engine/pkg/utils/testing"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
 
+	cdcommon "github.com/vathsalashetty96/argo-cd/common"
 	"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/vathsalashetty96/argo-cd/reposerver/apiclient"
 	"github.com/vathsalashetty96/argo-cd/test"
@@ -142,3 +152,172 @@ func TestSyncComparisonError(t *testing.T) {
 	assert.NotEmpty(t, conditions)
 	assert.Equal(t, "abc123", opState.SyncResult.Revision)
 }
+
+func TestSyncInvalidSyncTimeout(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}})
+
+	opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+		Sync:        &v1alpha1.SyncOperation{},
+		SyncTimeout: "abc",
+	}}
+	ctrl.appStateManager.SyncAppState(app, opState)
+
+	assert.Equal(t, common.OperationFailed, opState.Phase)
+	assert.Contains(t, opState.Message, "invalid sync timeout")
+}
+
+func TestGetSyncTimeoutMessage(t *testing.T) {
+	logEntry := log.WithField("application", "test")
+	hourAgo := v1.NewTime(time.Now().Add(-time.Hour))
+	now := v1.Now()
+
+	t.Run("NoTimeout", func(t *testing.T) {
+		syncRes := &v1alpha1.SyncOperationResult{StartedAt: &hourAgo}
+		assert.Empty(t, getSyncTimeoutMessage(syncRes, hourAgo, 0, sync.ReconciliationResult{}, logEntry))
+	})
+	t.Run("OperationTimeout", func(t *testing.T) {
+		syncRes := &v1alpha1.SyncOperationResult{StartedAt: &hourAgo}
+		assert.Equal(t, "sync attempt exceeded the sync timeout of 30m0s", getSyncTimeoutMessage(syncRes, hourAgo, 30*time.Minute, sync.ReconciliationResult{}, logEntry))
+	})
+	t.Run("RetriedAttempt", func(t *testing.T) {
+		syncRes := &v1alpha1.SyncOperationResult{StartedAt: &now}
+		assert.Empty(t, getSyncTimeoutMessage(syncRes, hourAgo, 30*time.Minute, sync.ReconciliationResult{}, logEntry))
+	})
+	t.Run("ResourceTimeout", func(t *testing.T) {
+		hook := NewPod()
+		hook.SetName("")
+		hook.SetGenerateName("migrate-")
+		hook.SetAnnotations(map[string]string{cdcommon.AnnotationSyncTimeout: "5m"})
+		syncRes := &v1alpha1.SyncOperationResult{StartedAt: &now, Resources: []*v1alpha1.ResourceResult{{
+			Kind:      "Pod",
+			Namespace: hook.GetNamespace(),
+			Name:      "migrate-abc12",
+			HookPhase: common.OperationRunning,
+			StartedAt: &hourAgo,
+		}}}
+		assert.Equal(t, "Pod migrate-abc12 exceeded its sync timeout of 5m0s", getSyncTimeoutMessage(syncRes, now, 0, sync.ReconciliationResult{Target: []*unstructured.Unstructured{hook}}, logEntry))
+
+		hook.SetAnnotations(map[string]string{cdcommon.AnnotationSyncTimeout: "300"})
+		assert.Equal(t, "Pod migrate-abc12 exceeded its sync timeout of 5m0s", getSyncTimeoutMessage(syncRes, now, 0, sync.ReconciliationResult{Target: []*unstructured.Unstructured{hook}}, logEntry))
+
+		syncRes.Resources[0].HookPhase = common.OperationSucceeded
+		assert.Empty(t, getSyncTimeoutMessage(syncRes, now, 0, sync.ReconciliationResult{Target: []*unstructured.Unstructured{hook}}, logEntry))
+	})
+	t.Run("InvalidResourceTimeout", func(t *testing.T) {
+		pod := NewPod()
+		pod.SetAnnotations(map[string]string{cdcommon.AnnotationSyncTimeout: "abc"})
+		syncRes := &v1alpha1.SyncOperationResult{StartedAt: &now, Resources: []*v1alpha1.ResourceResult{{
+			Kind:      "Pod",
+			Namespace: pod.GetNamespace(),
+			Name:      pod.GetName(),
+			HookPhase: common.OperationRunning,
+			StartedAt: &hourAgo,
+		}}}
+		assert.Empty(t, getSyncTimeoutMessage(syncRes, now, 0, sync.ReconciliationResult{Target: []*unstructured.Unstructured{pod}}, logEntry))
+	})
+}
+
+// deleteRecordingKubectl records the resources deleted using it
+type deleteRecordingKubectl struct {
+	kube.Kubectl
+	deleted []string
+}
+
+func (k *deleteRecordingKubectl) DeleteResource(_ context.Context, _ *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, _ bool) error {
+	k.deleted = append(k.deleted, fmt.Sprintf("%s/%s/%s", gvk.Kind, namespace, name))
+	return nil
+}
+
+func TestSyncTimeout_Hook(t *testing.T) {
+	logEntry := log.WithField("application", "test")
+	hook := NewPod()
+	hook.SetName("")
+	hook.SetGenerateName("migrate-")
+	hook.SetAnnotations(map[string]string{
+		common.AnnotationKeyHook:             string(common.HookTypePreSync),
+		common.AnnotationKeyHookDeletePolicy: string(common.HookDeletePolicyHookFailed),
+		cdcommon.AnnotationSyncTimeout:       "5m",
+	})
+	hookBytes, _ := json.Marshal(hook)
+	app := newFakeApp()
+	data := fakeData{
+		apps: []runtime.Object{app},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{string(hookBytes)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
+	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, "", app.Spec.Source, false, nil)
+	assert.Len(t, compRes.reconciliationResult.Target, 0)
+	if !assert.Len(t, compRes.reconciliationResult.Hooks, 1) {
+		return
+	}
+	namespace := compRes.reconciliationResult.Hooks[0].GetNamespace()
+
+	hourAgo := v1.NewTime(time.Now().Add(-time.Hour))
+	now := v1.Now()
+	syncRes := &v1alpha1.SyncOperationResult{StartedAt: &now, Resources: []*v1alpha1.ResourceResult{{
+		Version:   "v1",
+		Kind:      "Pod",
+		Namespace: namespace,
+		Name:      "migrate-abc12",
+		HookType:  common.HookTypePreSync,
+		HookPhase: common.OperationRunning,
+		StartedAt: &hourAgo,
+	}, {
+		Version:   "v1",
+		Kind:      "Pod",
+		Namespace: namespace,
+		Name:      "other-abc12",
+		HookType:  common.HookTypePreSync,
+		HookPhase: common.OperationFailed,
+		StartedAt: &hourAgo,
+	}}}
+	assert.Equal(t, "Pod migrate-abc12 exceeded its sync timeout of 5m0s", getSyncTimeoutMessage(syncRes, now, 0, compRes.reconciliationResult, logEntry))
+
+	kubectl := &deleteRecordingKubectl{}
+	manager := ctrl.appStateManager.(*appStateManager)
+	manager.kubectl = kubectl
+	syncRes.Resources[0].HookPhase = common.OperationFailed
+	manager.deleteFailedHooks(nil, compRes.reconciliationResult.Hooks, syncRes.Resources, logEntry)
+	assert.Equal(t, []string{"Pod/" + namespace + "/migrate-abc12"}, kubectl.deleted)
+}
+
+func TestIsSyncTarget(t *testing.T) {
+	pod := NewPod()
+	assert.True(t, isSyncTarget(pod, &v1alpha1.ResourceResult{Kind: "Pod", Namespace: pod.GetNamespace(), Name: pod.GetName()}))
+	assert.False(t, isSyncTarget(pod, &v1alpha1.ResourceResult{Kind: "Pod", Namespace: pod.GetNamespace(), Name: "other"}))
+	assert.False(t, isSyncTarget(pod, &v1alpha1.ResourceResult{Group: "apps", Kind: "Pod", Namespace: pod.GetNamespace(), Name: pod.GetName()}))
+
+	pod.SetName("")
+	pod.SetGenerateName("migrate-")
+	assert.True(t, isSyncTarget(pod, &v1alpha1.ResourceResult{Kind: "Pod", Namespace: pod.GetNamespace(), Name: "migrate-abc12"}))
+	assert.False(t, isSyncTarget(pod, &v1alpha1.ResourceResult{Kind: "Pod", Namespace: pod.GetNamespace(), Name: "other-abc12"}))
+}
+
+func TestClassifySyncFailure(t *testing.T) {
+	for _, tc := range []struct {
+		message string
+		class   v1alpha1.SyncFailureClass
+	}{
+		{`Internal error occurred: failed calling webhook "validate.example.com": Post "https://webhook.svc:443/validate": context deadline exceeded`, v1alpha1.SyncFailureClassTransient},
+		{`Get "https://10.0.0.1/api": dial tcp 10.0.0.1:443: connect: connection refused`, v1alpha1.SyncFailureClassTransient},
+		{`Operation cannot be fulfilled on deployments.apps "guestbook": the object has been modified; please apply your changes to the latest version`, v1alpha1.SyncFailureClassConflict},
+		{`Deployment.apps "guestbook" is invalid: spec.template.metadata.labels: Invalid value`, v1alpha1.SyncFailureClassValidation},
+		{`admission webhook "validate.example.com" denied the request: image is not allowed`, v1alpha1.SyncFailureClassValidation},
+		{`deployments.apps is forbidden: User "system:serviceaccount:argocd:argocd-application-controller" cannot create resource "deployments"`, v1alpha1.SyncFailureClassRBAC},
+		{`Resource apps:Deployment is not permitted in project default.`, v1alpha1.SyncFailureClassRBAC},
+		{`something went wrong`, v1alpha1.SyncFailureClassUnknown},
+	} {
+		assert.Equal(t, tc.class, classifySyncFailure(&v1alpha1.ResourceResult{Status: common.ResultCodeSyncFailed, Message: tc.message}), tc.message)
+	}
+
+	assert.Equal(t, v1alpha1.SyncFailureClass(""), classifySyncFailure(&v1alpha1.ResourceResult{Status: common.ResultCodeSynced, Message: "deployment.apps/guestbook configured"}))
+	assert.Equal(t, v1alpha1.SyncFailureClassHookFailed, classifySyncFailure(&v1alpha1.ResourceResult{HookType: common.HookTypePreSync, HookPhase: common.OperationFailed, Message: "Job has reached the specified backoff limit"}))
+	assert.Equal(t, v1alpha1.SyncFailureClassRBAC, classifySyncFailure(&v1alpha1.ResourceResult{HookType: common.HookTypePreSync, Status: common.ResultCodeSyncFailed, HookPhase: common.OperationFailed, Message: `jobs.batch is forbidden`}))
+}
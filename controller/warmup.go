@@ -0,0 +1,59 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/vathsalashetty96/argo-cd/reposerver/apiclient"
+	"github.com/vathsalashetty96/argo-cd/util/io"
+)
+
+// warmupRepoServer requests the repo server to fetch the source of every application of the controller into its disk
+// cache, one source per repository, revision and chart after the other. The repository credentials are passed along
+// with each request, the same way as for manifest generation. Failures are logged only, so that an inaccessible
+// repository does not stop the warmup.
+func (ctrl *ApplicationController) warmupRepoServer(ctx context.Context) {
+	apps, err := ctrl.appLister.Applications(ctrl.namespace).List(labels.Everything())
+	if err != nil {
+		log.Warnf("Failed to warm up repo server: %v", err)
+		return
+	}
+	conn, repoClient, err := ctrl.repoClientset.NewRepoServerClient()
+	if err != nil {
+		log.Warnf("Failed to warm up repo server: %v", err)
+		return
+	}
+	defer io.Close(conn)
+
+	start := time.Now()
+	done := map[string]bool{}
+	failed := 0
+	for _, app := range apps {
+		if ctx.Err() != nil {
+			return
+		}
+		source := app.Spec.Source
+		key := strings.Join([]string{source.RepoURL, source.Chart, source.TargetRevision}, "|")
+		if done[key] || !ctrl.canProcessApp(app) {
+			continue
+		}
+		done[key] = true
+		err := func() error {
+			repo, err := ctrl.db.GetRepository(ctx, source.RepoURL)
+			if err != nil {
+				return err
+			}
+			_, err = repoClient.Warmup(ctx, &apiclient.WarmupRequest{Repo: repo, Source: &source})
+			return err
+		}()
+		if err != nil {
+			failed++
+			log.WithField("application", app.Name).Warnf("Failed to warm up repo server with %s (%s): %v", source.RepoURL, source.TargetRevision, err)
+		}
+	}
+	log.Infof("Warmed up repo server with %d sources (%d failed) in %v", len(done), failed, time.Since(start))
+}
@@ -0,0 +1,51 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vathsalashetty96/argo-cd/reposerver/apiclient"
+	mockrepoclient "github.com/vathsalashetty96/argo-cd/reposerver/apiclient/mocks"
+)
+
+func TestWarmupRepoServer(t *testing.T) {
+	app := newFakeApp()
+	sameRevision := newFakeApp()
+	sameRevision.Name = "same-revision"
+	sameRevision.Spec.Source.Path = "other/path"
+	failing := newFakeApp()
+	failing.Name = "failing"
+	failing.Spec.Source.RepoURL = "https://github.com/argoproj/inaccessible.git"
+	otherRevision := newFakeApp()
+	otherRevision.Name = "other-revision"
+	otherRevision.Spec.Source.TargetRevision = "v1.0.0"
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, sameRevision, failing, otherRevision}})
+
+	repoClient := ctrl.repoClientset.(*mockrepoclient.Clientset).RepoServerServiceClient.(*mockrepoclient.RepoServerServiceClient)
+	repoClient.On("Warmup", mock.Anything, mock.MatchedBy(func(q *apiclient.WarmupRequest) bool {
+		return q.Source.RepoURL == failing.Spec.Source.RepoURL
+	})).Return(nil, errors.New("authentication required"))
+	repoClient.On("Warmup", mock.Anything, mock.Anything).Return(&apiclient.WarmupResponse{}, nil)
+
+	ctrl.warmupRepoServer(context.Background())
+
+	var requested []string
+	for _, call := range repoClient.Calls {
+		if call.Method == "Warmup" {
+			q := call.Arguments.Get(1).(*apiclient.WarmupRequest)
+			requested = append(requested, q.Source.RepoURL+"@"+q.Source.TargetRevision)
+		}
+	}
+
+	// a failed source does not stop the warmup
+	assert.ElementsMatch(t, []string{
+		app.Spec.Source.RepoURL + "@",
+		failing.Spec.Source.RepoURL + "@",
+		app.Spec.Source.RepoURL + "@v1.0.0",
+	}, requested)
+}
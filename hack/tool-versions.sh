@@ -2,7 +2,7 @@
 # The checksum of this file is used as cache key in our integration toolchain
 # 
 helm2_version=2.17.0
-helm3_version=3.4.1
+helm3_version=3.6.3
 jq_version=1.6
 ksonnet_version=0.13.1
 kubectl_version=1.17.8
@@ -406,9 +406,13 @@ func (m *AccountsList) GetItems() []*Account {
 }
 
 type Token struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	IssuedAt             int64    `protobuf:"varint,2,opt,name=issuedAt,proto3" json:"issuedAt,omitempty"`
-	ExpiresAt            int64    `protobuf:"varint,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IssuedAt  int64  `protobuf:"varint,2,opt,name=issuedAt,proto3" json:"issuedAt,omitempty"`
+	ExpiresAt int64  `protobuf:"varint,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	// scopes restrict the requests the token may be used for, e.g. 'applications:sync:proj/*'
+	Scopes []string `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// lastUsedAt is the time the token was last used to authenticate a request, in seconds since the epoch
+	LastUsedAt           int64    `protobuf:"varint,5,opt,name=lastUsedAt,proto3" json:"lastUsedAt,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -468,6 +472,20 @@ func (m *Token) GetExpiresAt() int64 {
 	return 0
 }
 
+func (m *Token) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+func (m *Token) GetLastUsedAt() int64 {
+	if m != nil {
+		return m.LastUsedAt
+	}
+	return 0
+}
+
 type TokensList struct {
 	Items                []*Token `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -518,8 +536,11 @@ func (m *TokensList) GetItems() []*Token {
 type CreateTokenRequest struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// expiresIn represents a duration in seconds
-	ExpiresIn            int64    `protobuf:"varint,2,opt,name=expiresIn,proto3" json:"expiresIn,omitempty"`
-	Id                   string   `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	ExpiresIn int64  `protobuf:"varint,2,opt,name=expiresIn,proto3" json:"expiresIn,omitempty"`
+	Id        string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	// scopes restrict the requests the token may be used for to actions on resources and objects of the form
+	// '<resource>:<action>[:<object>]', e.g. 'applications:sync:proj/*'
+	Scopes               []string `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -579,6 +600,13 @@ func (m *CreateTokenRequest) GetId() string {
 	return ""
 }
 
+func (m *CreateTokenRequest) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
 type CreateTokenResponse struct {
 	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -720,6 +748,54 @@ func (m *ListAccountRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ListAccountRequest proto.InternalMessageInfo
 
+type RevokeSessionsRequest struct {
+	// name is the name of a local account or the subject of an SSO user
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeSessionsRequest) Reset()         { *m = RevokeSessionsRequest{} }
+func (m *RevokeSessionsRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeSessionsRequest) ProtoMessage()    {}
+func (*RevokeSessionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56d089a9b5e998c0, []int{13}
+}
+func (m *RevokeSessionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevokeSessionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevokeSessionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RevokeSessionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeSessionsRequest.Merge(m, src)
+}
+func (m *RevokeSessionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevokeSessionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeSessionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeSessionsRequest proto.InternalMessageInfo
+
+func (m *RevokeSessionsRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 type EmptyResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -730,7 +806,7 @@ func (m *EmptyResponse) Reset()         { *m = EmptyResponse{} }
 func (m *EmptyResponse) String() string { return proto.CompactTextString(m) }
 func (*EmptyResponse) ProtoMessage()    {}
 func (*EmptyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56d089a9b5e998c0, []int{13}
+	return fileDescriptor_56d089a9b5e998c0, []int{14}
 }
 func (m *EmptyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -773,60 +849,65 @@ func init() {
 	proto.RegisterType((*CreateTokenResponse)(nil), "account.CreateTokenResponse")
 	proto.RegisterType((*DeleteTokenRequest)(nil), "account.DeleteTokenRequest")
 	proto.RegisterType((*ListAccountRequest)(nil), "account.ListAccountRequest")
+	proto.RegisterType((*RevokeSessionsRequest)(nil), "account.RevokeSessionsRequest")
 	proto.RegisterType((*EmptyResponse)(nil), "account.EmptyResponse")
 }
 
 func init() { proto.RegisterFile("server/account/account.proto", fileDescriptor_56d089a9b5e998c0) }
 
 var fileDescriptor_56d089a9b5e998c0 = []byte{
-	// 742 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xdd, 0x6e, 0xd3, 0x4a,
-	0x10, 0x96, 0x93, 0xa6, 0x3f, 0x93, 0x9c, 0xf4, 0x74, 0x4f, 0x9a, 0x63, 0xf9, 0xe4, 0x84, 0x74,
-	0x5b, 0xb5, 0xa1, 0xa8, 0xb5, 0x5a, 0x24, 0xfe, 0x6e, 0xaa, 0x52, 0x10, 0xaa, 0xc4, 0x05, 0x84,
-	0x9f, 0x8b, 0x72, 0xb5, 0x71, 0x46, 0x61, 0x69, 0x62, 0xbb, 0xde, 0x75, 0x0a, 0x8a, 0x72, 0x03,
-	0x8f, 0xc0, 0x15, 0x6f, 0xc4, 0x25, 0x12, 0x2f, 0x80, 0x2a, 0x1e, 0x04, 0x79, 0xbd, 0x76, 0x9c,
-	0x9f, 0x22, 0xae, 0xbc, 0x33, 0xb3, 0x3b, 0xdf, 0x37, 0xb3, 0xdf, 0x78, 0xa1, 0x26, 0x30, 0x18,
-	0x60, 0x60, 0x33, 0xc7, 0xf1, 0x42, 0x57, 0x26, 0xdf, 0x7d, 0x3f, 0xf0, 0xa4, 0x47, 0x96, 0xb4,
-	0x69, 0x55, 0xba, 0x5e, 0xd7, 0x53, 0x3e, 0x3b, 0x5a, 0xc5, 0x61, 0xab, 0xd6, 0xf5, 0xbc, 0x6e,
-	0x0f, 0x6d, 0xe6, 0x73, 0x9b, 0xb9, 0xae, 0x27, 0x99, 0xe4, 0x9e, 0x2b, 0xe2, 0x28, 0xbd, 0x84,
-	0xf5, 0x57, 0x7e, 0x87, 0x49, 0x7c, 0xc6, 0x84, 0xb8, 0xf4, 0x82, 0x4e, 0x0b, 0x2f, 0x42, 0x14,
-	0x92, 0x34, 0xa0, 0xe8, 0xe2, 0x65, 0xe2, 0x35, 0x8d, 0x86, 0xd1, 0x5c, 0x69, 0x65, 0x5d, 0xa4,
-	0x09, 0xab, 0x4e, 0x18, 0x04, 0xe8, 0xca, 0x74, 0x57, 0x4e, 0xed, 0x9a, 0x76, 0x13, 0x02, 0x0b,
-	0x2e, 0xeb, 0xa3, 0x99, 0x57, 0x61, 0xb5, 0xa6, 0x26, 0x54, 0xa7, 0x81, 0x85, 0xef, 0xb9, 0x02,
-	0xa9, 0x03, 0xc5, 0x13, 0xe6, 0x9e, 0x26, 0x44, 0x2c, 0x58, 0x0e, 0x50, 0x78, 0x61, 0xe0, 0xa0,
-	0x66, 0x91, 0xda, 0xa4, 0x0a, 0x8b, 0xcc, 0x89, 0xca, 0xd1, 0xc8, 0xda, 0x8a, 0xc8, 0x8b, 0xb0,
-	0x9d, 0x1e, 0x8b, 0x71, 0xb3, 0x2e, 0xba, 0x05, 0xa5, 0x18, 0x24, 0x06, 0x25, 0x15, 0x28, 0x0c,
-	0x58, 0x2f, 0x4c, 0x20, 0x62, 0x83, 0xee, 0xc0, 0xda, 0x13, 0x94, 0xc7, 0x71, 0x7f, 0x13, 0x42,
-	0x49, 0x35, 0x46, 0xa6, 0x9a, 0x4f, 0x06, 0x2c, 0xe9, 0x6d, 0xf3, 0xe2, 0xc4, 0x84, 0x25, 0x74,
-	0x59, 0xbb, 0x87, 0x71, 0x8f, 0x96, 0x5b, 0x89, 0x49, 0x28, 0x94, 0x1c, 0xe6, 0xb3, 0x36, 0xef,
-	0x71, 0xc9, 0x51, 0x98, 0xf9, 0x46, 0xbe, 0xb9, 0xd2, 0x9a, 0xf0, 0x91, 0x6d, 0x58, 0x94, 0xde,
-	0x39, 0xba, 0xc2, 0x5c, 0x68, 0xe4, 0x9b, 0xc5, 0xc3, 0xf2, 0x7e, 0xa2, 0x80, 0x97, 0x91, 0xbb,
-	0xa5, 0xa3, 0xf4, 0x0e, 0x94, 0x34, 0x09, 0xf1, 0x94, 0x0b, 0x49, 0xb6, 0xa1, 0xc0, 0x25, 0xf6,
-	0x85, 0x69, 0xa8, 0x63, 0x7f, 0xa7, 0xc7, 0x92, 0x8a, 0xe2, 0x30, 0x7d, 0x0e, 0x05, 0x95, 0x88,
-	0x94, 0x21, 0xc7, 0x93, 0xbb, 0xce, 0xf1, 0x4e, 0xd4, 0x7b, 0x2e, 0x44, 0x88, 0x9d, 0x63, 0xa9,
-	0x78, 0xe7, 0x5b, 0xa9, 0x4d, 0x6a, 0xb0, 0x82, 0xef, 0x7d, 0x1e, 0xa0, 0x38, 0x96, 0xaa, 0xc3,
-	0xf9, 0xd6, 0xd8, 0x41, 0x0f, 0x01, 0x54, 0xca, 0x98, 0xc8, 0xd6, 0x24, 0x91, 0x69, 0xfe, 0x9a,
-	0xc6, 0x6b, 0x20, 0x27, 0x01, 0x32, 0x89, 0xb1, 0xf7, 0xfa, 0x76, 0x67, 0xb0, 0x4f, 0x5d, 0x4d,
-	0x6c, 0xec, 0xd0, 0x55, 0xe4, 0x93, 0x2a, 0xe8, 0x2d, 0xf8, 0x67, 0x22, 0xef, 0xf8, 0xca, 0x55,
-	0xdf, 0x92, 0x2b, 0x57, 0x06, 0xbd, 0x07, 0xe4, 0x11, 0xf6, 0xf0, 0x0f, 0x48, 0xc4, 0x30, 0xb9,
-	0x14, 0xa6, 0x02, 0x24, 0x2a, 0x76, 0x52, 0x2d, 0x74, 0x15, 0xfe, 0x7a, 0xdc, 0xf7, 0xe5, 0x87,
-	0x04, 0xf6, 0xf0, 0x4b, 0x01, 0xca, 0x7a, 0xcf, 0x0b, 0x0c, 0x06, 0xdc, 0x41, 0x22, 0x61, 0x21,
-	0x12, 0x23, 0xa9, 0xa4, 0x7d, 0xc9, 0x0c, 0x80, 0xb5, 0x3e, 0xe5, 0xd5, 0x63, 0x72, 0xf4, 0xf1,
-	0xfb, 0xcf, 0xcf, 0xb9, 0xfb, 0xe4, 0xae, 0x9a, 0xec, 0xc1, 0x41, 0xfa, 0x77, 0x70, 0x98, 0xbb,
-	0xc7, 0xed, 0x61, 0x22, 0xf5, 0x91, 0x3d, 0x8c, 0xa7, 0x62, 0x64, 0x0f, 0x33, 0x13, 0x30, 0x22,
-	0x03, 0x28, 0x4f, 0x4e, 0x20, 0xa9, 0xa7, 0x48, 0x73, 0xff, 0x09, 0xd6, 0x8d, 0x6b, 0xe3, 0x9a,
-	0xd3, 0xa6, 0xe2, 0xf4, 0xbf, 0x65, 0x4e, 0x73, 0xf2, 0xf5, 0xce, 0x07, 0xc6, 0x2e, 0x79, 0x03,
-	0xa5, 0x4c, 0x9f, 0x04, 0xf9, 0x2f, 0xcd, 0x3a, 0xdb, 0xbe, 0x4c, 0xf1, 0x59, 0x65, 0xd3, 0x7f,
-	0x15, 0xd0, 0x1a, 0x59, 0x9d, 0x02, 0x22, 0x67, 0x00, 0xe3, 0x89, 0x25, 0x56, 0x7a, 0x7a, 0x66,
-	0x8c, 0xad, 0x99, 0x69, 0xa0, 0x75, 0x95, 0xd4, 0x24, 0xd5, 0x69, 0xf6, 0xc3, 0xe8, 0xbe, 0x47,
-	0xe4, 0x02, 0x8a, 0x19, 0x1d, 0x65, 0x78, 0xcf, 0xaa, 0xd6, 0xaa, 0xcd, 0x0f, 0xea, 0x3e, 0xed,
-	0x28, 0xa4, 0x0d, 0x5a, 0x9b, 0x8f, 0x64, 0x2b, 0x29, 0x46, 0xbd, 0xea, 0x43, 0x31, 0xa3, 0xc6,
-	0x0c, 0xe4, 0xac, 0x46, 0xad, 0x6a, 0x1a, 0x9c, 0x10, 0x1c, 0xbd, 0xa9, 0xc0, 0x36, 0x77, 0x37,
-	0x7e, 0x07, 0x66, 0x0f, 0x79, 0x67, 0xf4, 0xf0, 0xe8, 0xeb, 0x55, 0xdd, 0xf8, 0x76, 0x55, 0x37,
-	0x7e, 0x5c, 0xd5, 0x8d, 0xb3, 0x83, 0x2e, 0x97, 0x6f, 0xc3, 0xf6, 0xbe, 0xe3, 0xf5, 0x6d, 0x16,
-	0xa8, 0xa7, 0xe5, 0x9d, 0x5a, 0xec, 0x39, 0x1d, 0xdb, 0x3f, 0xef, 0x46, 0xf9, 0x9c, 0x1e, 0xc7,
-	0xf1, 0x8b, 0xd4, 0x5e, 0x54, 0xaf, 0xca, 0xed, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xcc, 0xa8,
-	0x25, 0x6b, 0xb2, 0x06, 0x00, 0x00,
+	// 806 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x4d, 0x6f, 0xeb, 0x44,
+	0x14, 0x95, 0x93, 0x26, 0x7d, 0xb9, 0x09, 0x29, 0x6f, 0x48, 0x83, 0x65, 0x42, 0x5e, 0xde, 0xbc,
+	0xa7, 0xd7, 0xd0, 0xaa, 0xb5, 0x5a, 0x24, 0xbe, 0x36, 0x55, 0x29, 0x08, 0x55, 0x62, 0x81, 0x5c,
+	0xba, 0x29, 0xab, 0x89, 0x73, 0x15, 0x86, 0x26, 0x1e, 0xd7, 0x33, 0x4e, 0x41, 0x51, 0x36, 0xb0,
+	0x42, 0x62, 0xc7, 0x9f, 0x62, 0x89, 0xc4, 0x1f, 0x40, 0x15, 0x3f, 0x04, 0x79, 0x3c, 0x76, 0x9c,
+	0xaf, 0xea, 0xad, 0x92, 0x7b, 0xef, 0xcc, 0x9c, 0x73, 0xee, 0x9c, 0x3b, 0x86, 0x8e, 0xc4, 0x68,
+	0x8a, 0x91, 0xcb, 0x7c, 0x5f, 0xc4, 0x81, 0xca, 0x7e, 0x4f, 0xc2, 0x48, 0x28, 0x41, 0x76, 0x4d,
+	0xe8, 0xb4, 0x46, 0x62, 0x24, 0x74, 0xce, 0x4d, 0xfe, 0xa5, 0x65, 0xa7, 0x33, 0x12, 0x62, 0x34,
+	0x46, 0x97, 0x85, 0xdc, 0x65, 0x41, 0x20, 0x14, 0x53, 0x5c, 0x04, 0x32, 0xad, 0xd2, 0x07, 0xd8,
+	0xbf, 0x09, 0x87, 0x4c, 0xe1, 0x77, 0x4c, 0xca, 0x07, 0x11, 0x0d, 0x3d, 0xbc, 0x8f, 0x51, 0x2a,
+	0xd2, 0x83, 0x7a, 0x80, 0x0f, 0x59, 0xd6, 0xb6, 0x7a, 0x56, 0xbf, 0xe6, 0x15, 0x53, 0xa4, 0x0f,
+	0x7b, 0x7e, 0x1c, 0x45, 0x18, 0xa8, 0x7c, 0x55, 0x49, 0xaf, 0x5a, 0x4d, 0x13, 0x02, 0x3b, 0x01,
+	0x9b, 0xa0, 0x5d, 0xd6, 0x65, 0xfd, 0x9f, 0xda, 0xd0, 0x5e, 0x05, 0x96, 0xa1, 0x08, 0x24, 0x52,
+	0x1f, 0xea, 0x97, 0x2c, 0xb8, 0xca, 0x88, 0x38, 0xf0, 0x2c, 0x42, 0x29, 0xe2, 0xc8, 0x47, 0xc3,
+	0x22, 0x8f, 0x49, 0x1b, 0xaa, 0xcc, 0x4f, 0xe4, 0x18, 0x64, 0x13, 0x25, 0xe4, 0x65, 0x3c, 0xc8,
+	0xb7, 0xa5, 0xb8, 0xc5, 0x14, 0x7d, 0x0d, 0x8d, 0x14, 0x24, 0x05, 0x25, 0x2d, 0xa8, 0x4c, 0xd9,
+	0x38, 0xce, 0x20, 0xd2, 0x80, 0x1e, 0xc0, 0xf3, 0x6f, 0x50, 0x5d, 0xa4, 0xfd, 0xcd, 0x08, 0x65,
+	0x6a, 0xac, 0x82, 0x9a, 0xdf, 0x2c, 0xd8, 0x35, 0xcb, 0x36, 0xd5, 0x89, 0x0d, 0xbb, 0x18, 0xb0,
+	0xc1, 0x18, 0xd3, 0x1e, 0x3d, 0xf3, 0xb2, 0x90, 0x50, 0x68, 0xf8, 0x2c, 0x64, 0x03, 0x3e, 0xe6,
+	0x8a, 0xa3, 0xb4, 0xcb, 0xbd, 0x72, 0xbf, 0xe6, 0x2d, 0xe5, 0xc8, 0x1b, 0xa8, 0x2a, 0x71, 0x87,
+	0x81, 0xb4, 0x77, 0x7a, 0xe5, 0x7e, 0xfd, 0xac, 0x79, 0x92, 0x39, 0xe0, 0xfb, 0x24, 0xed, 0x99,
+	0x2a, 0xfd, 0x04, 0x1a, 0x86, 0x84, 0xfc, 0x96, 0x4b, 0x45, 0xde, 0x40, 0x85, 0x2b, 0x9c, 0x48,
+	0xdb, 0xd2, 0xdb, 0xde, 0xcd, 0xb7, 0x65, 0x8a, 0xd2, 0x32, 0xfd, 0xdd, 0x82, 0x8a, 0x3e, 0x89,
+	0x34, 0xa1, 0xc4, 0xb3, 0xcb, 0x2e, 0xf1, 0x61, 0xd2, 0x7c, 0x2e, 0x65, 0x8c, 0xc3, 0x0b, 0xa5,
+	0x89, 0x97, 0xbd, 0x3c, 0x26, 0x1d, 0xa8, 0xe1, 0xcf, 0x21, 0x8f, 0x50, 0x5e, 0x28, 0xdd, 0xe2,
+	0xb2, 0xb7, 0x48, 0x24, 0x57, 0x23, 0x7d, 0x11, 0x62, 0xca, 0xb9, 0xe6, 0x99, 0x88, 0x74, 0x01,
+	0xc6, 0x4c, 0xaa, 0x1b, 0xa9, 0xcf, 0xac, 0xe8, 0x6d, 0x85, 0x0c, 0x3d, 0x03, 0xd0, 0x54, 0x52,
+	0x05, 0xaf, 0x97, 0x15, 0xac, 0x0a, 0x37, 0xfc, 0x03, 0x20, 0x97, 0x11, 0x32, 0x85, 0x69, 0x76,
+	0xfb, 0x3d, 0x15, 0x38, 0x5f, 0x05, 0x46, 0xd0, 0x22, 0x61, 0xd4, 0x97, 0x73, 0xf5, 0x5b, 0x34,
+	0xd0, 0x23, 0x78, 0x6f, 0x09, 0x6f, 0xe1, 0x21, 0x7d, 0x11, 0x99, 0x87, 0x74, 0x40, 0x3f, 0x03,
+	0xf2, 0x15, 0x8e, 0xf1, 0x2d, 0xc8, 0xa5, 0xf0, 0xa5, 0x0c, 0x9e, 0xb6, 0x80, 0x24, 0x4d, 0x58,
+	0xb6, 0x1f, 0x3d, 0x82, 0x7d, 0x0f, 0xa7, 0xe2, 0x0e, 0xaf, 0x51, 0xca, 0x64, 0x92, 0x9f, 0xf2,
+	0xe5, 0x1e, 0xbc, 0xf3, 0xf5, 0x24, 0x54, 0xbf, 0x64, 0x1c, 0xcf, 0xfe, 0xa8, 0x42, 0xd3, 0x1c,
+	0x78, 0x8d, 0xd1, 0x94, 0xfb, 0x48, 0x14, 0xec, 0x24, 0xa3, 0x40, 0x5a, 0x79, 0x73, 0x0b, 0xe3,
+	0xe7, 0xec, 0xaf, 0x64, 0xcd, 0x90, 0x9e, 0xff, 0xfa, 0xcf, 0x7f, 0x7f, 0x96, 0x3e, 0x27, 0x9f,
+	0xea, 0x77, 0x65, 0x7a, 0x9a, 0xbf, 0x4d, 0x3e, 0x0b, 0x8e, 0xb9, 0x3b, 0xcb, 0x06, 0x6d, 0xee,
+	0xce, 0xd2, 0x99, 0x9c, 0xbb, 0xb3, 0xc2, 0xfc, 0xcd, 0xc9, 0x14, 0x9a, 0xcb, 0xf3, 0x4f, 0xba,
+	0x39, 0xd2, 0xc6, 0x17, 0xc9, 0x79, 0xb1, 0xb5, 0x6e, 0x38, 0xbd, 0xd2, 0x9c, 0x3e, 0x74, 0xec,
+	0x55, 0x4e, 0xa1, 0x59, 0xf9, 0x85, 0x75, 0x48, 0x7e, 0x80, 0x46, 0xa1, 0xa9, 0x92, 0x7c, 0x90,
+	0x9f, 0xba, 0xde, 0xeb, 0x82, 0xf8, 0xe2, 0x5c, 0xd1, 0xf7, 0x35, 0xd0, 0x73, 0xb2, 0xb7, 0x02,
+	0x44, 0x6e, 0x01, 0x16, 0xef, 0x05, 0x71, 0xf2, 0xdd, 0x6b, 0x8f, 0x88, 0xb3, 0x36, 0x8b, 0xb4,
+	0xab, 0x0f, 0xb5, 0x49, 0x7b, 0x95, 0xfd, 0x2c, 0xb9, 0xc9, 0x39, 0xb9, 0x87, 0x7a, 0xc1, 0x74,
+	0x05, 0xde, 0xeb, 0xd6, 0x77, 0x3a, 0x9b, 0x8b, 0xa6, 0x4f, 0x07, 0x1a, 0xe9, 0x25, 0xed, 0x6c,
+	0x46, 0x72, 0xb5, 0x6f, 0x93, 0x5e, 0x4d, 0xa0, 0x5e, 0xb0, 0x6e, 0x01, 0x72, 0xdd, 0xd0, 0x4e,
+	0x3b, 0x2f, 0x2e, 0x19, 0x8e, 0x7e, 0xa4, 0xc1, 0x5e, 0x1d, 0xbe, 0x7c, 0x0a, 0xcc, 0x9d, 0xf1,
+	0x61, 0xa2, 0xb0, 0xb9, 0xec, 0xec, 0x82, 0x25, 0x36, 0x5a, 0x7e, 0x2b, 0xa8, 0x51, 0x78, 0xf8,
+	0x62, 0x0b, 0xa8, 0x34, 0xe7, 0x7c, 0x79, 0xfe, 0xd7, 0x63, 0xd7, 0xfa, 0xfb, 0xb1, 0x6b, 0xfd,
+	0xfb, 0xd8, 0xb5, 0x6e, 0x4f, 0x47, 0x5c, 0xfd, 0x18, 0x0f, 0x4e, 0x7c, 0x31, 0x71, 0x59, 0xa4,
+	0xbf, 0xa5, 0x3f, 0xe9, 0x3f, 0xc7, 0xfe, 0xd0, 0x0d, 0xef, 0x46, 0xc9, 0x69, 0xfe, 0x98, 0xe3,
+	0xe2, 0x13, 0x3c, 0xa8, 0xea, 0xcf, 0xe8, 0xc7, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x5c, 0x97,
+	0xfc, 0x0f, 0xa3, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -853,6 +934,8 @@ type AccountServiceClient interface {
 	CreateToken(ctx context.Context, in *CreateTokenRequest, opts ...grpc.CallOption) (*CreateTokenResponse, error)
 	// DeleteToken deletes a token
 	DeleteToken(ctx context.Context, in *DeleteTokenRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// RevokeSessions revokes all login sessions of a user on all API servers. API tokens are not affected.
+	RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 }
 
 type accountServiceClient struct {
@@ -917,6 +1000,15 @@ func (c *accountServiceClient) DeleteToken(ctx context.Context, in *DeleteTokenR
 	return out, nil
 }
 
+func (c *accountServiceClient) RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/account.AccountService/RevokeSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AccountServiceServer is the server API for AccountService service.
 type AccountServiceServer interface {
 	// CanI checks if the current account has permission to perform an action
@@ -931,6 +1023,8 @@ type AccountServiceServer interface {
 	CreateToken(context.Context, *CreateTokenRequest) (*CreateTokenResponse, error)
 	// DeleteToken deletes a token
 	DeleteToken(context.Context, *DeleteTokenRequest) (*EmptyResponse, error)
+	// RevokeSessions revokes all login sessions of a user on all API servers. API tokens are not affected.
+	RevokeSessions(context.Context, *RevokeSessionsRequest) (*EmptyResponse, error)
 }
 
 // UnimplementedAccountServiceServer can be embedded to have forward compatible implementations.
@@ -955,6 +1049,9 @@ func (*UnimplementedAccountServiceServer) CreateToken(ctx context.Context, req *
 func (*UnimplementedAccountServiceServer) DeleteToken(ctx context.Context, req *DeleteTokenRequest) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteToken not implemented")
 }
+func (*UnimplementedAccountServiceServer) RevokeSessions(ctx context.Context, req *RevokeSessionsRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSessions not implemented")
+}
 
 func RegisterAccountServiceServer(s *grpc.Server, srv AccountServiceServer) {
 	s.RegisterService(&_AccountService_serviceDesc, srv)
@@ -1068,6 +1165,24 @@ func _AccountService_DeleteToken_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccountService_RevokeSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).RevokeSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.AccountService/RevokeSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).RevokeSessions(ctx, req.(*RevokeSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AccountService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "account.AccountService",
 	HandlerType: (*AccountServiceServer)(nil),
@@ -1096,6 +1211,10 @@ var _AccountService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteToken",
 			Handler:    _AccountService_DeleteToken_Handler,
 		},
+		{
+			MethodName: "RevokeSessions",
+			Handler:    _AccountService_RevokeSessions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "server/account/account.proto",
@@ -1424,6 +1543,20 @@ func (m *Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.LastUsedAt != 0 {
+		i = encodeVarintAccount(dAtA, i, uint64(m.LastUsedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Scopes) > 0 {
+		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Scopes[iNdEx])
+			copy(dAtA[i:], m.Scopes[iNdEx])
+			i = encodeVarintAccount(dAtA, i, uint64(len(m.Scopes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.ExpiresAt != 0 {
 		i = encodeVarintAccount(dAtA, i, uint64(m.ExpiresAt))
 		i--
@@ -1509,6 +1642,15 @@ func (m *CreateTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Scopes) > 0 {
+		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Scopes[iNdEx])
+			copy(dAtA[i:], m.Scopes[iNdEx])
+			i = encodeVarintAccount(dAtA, i, uint64(len(m.Scopes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Id) > 0 {
 		i -= len(m.Id)
 		copy(dAtA[i:], m.Id)
@@ -1633,6 +1775,40 @@ func (m *ListAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RevokeSessionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevokeSessionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevokeSessionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EmptyResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1828,6 +2004,15 @@ func (m *Token) Size() (n int) {
 	if m.ExpiresAt != 0 {
 		n += 1 + sovAccount(uint64(m.ExpiresAt))
 	}
+	if len(m.Scopes) > 0 {
+		for _, s := range m.Scopes {
+			l = len(s)
+			n += 1 + l + sovAccount(uint64(l))
+		}
+	}
+	if m.LastUsedAt != 0 {
+		n += 1 + sovAccount(uint64(m.LastUsedAt))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1869,6 +2054,12 @@ func (m *CreateTokenRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAccount(uint64(l))
 	}
+	if len(m.Scopes) > 0 {
+		for _, s := range m.Scopes {
+			l = len(s)
+			n += 1 + l + sovAccount(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1923,6 +2114,22 @@ func (m *ListAccountRequest) Size() (n int) {
 	return n
 }
 
+func (m *RevokeSessionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *EmptyResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2826,6 +3033,57 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUsedAt", wireType)
+			}
+			m.LastUsedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUsedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAccount(dAtA[iNdEx:])
@@ -3051,6 +3309,38 @@ func (m *CreateTokenRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAccount(dAtA[iNdEx:])
@@ -3334,6 +3624,92 @@ func (m *ListAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RevokeSessionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevokeSessionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevokeSessionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EmptyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -578,6 +578,7 @@ type ApplicationSyncRequest struct {
 	Manifests            []string                         `protobuf:"bytes,8,rep,name=manifests" json:"manifests,omitempty"`
 	Infos                []*v1alpha1.Info                 `protobuf:"bytes,9,rep,name=infos" json:"infos,omitempty"`
 	RetryStrategy        *v1alpha1.RetryStrategy          `protobuf:"bytes,10,opt,name=retryStrategy" json:"retryStrategy,omitempty"`
+	SyncTimeout          string                           `protobuf:"bytes,11,opt,name=syncTimeout" json:"syncTimeout"`
 	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
 	XXX_unrecognized     []byte                           `json:"-"`
 	XXX_sizecache        int32                            `json:"-"`
@@ -679,6 +680,189 @@ func (m *ApplicationSyncRequest) GetRetryStrategy() *v1alpha1.RetryStrategy {
 	return nil
 }
 
+func (m *ApplicationSyncRequest) GetSyncTimeout() string {
+	if m != nil {
+		return m.SyncTimeout
+	}
+	return ""
+}
+
+// ApplicationSyncPlanResponse contains the ordered list of tasks a sync operation would execute
+type ApplicationSyncPlanResponse struct {
+	Revision             string                 `protobuf:"bytes,1,req,name=revision" json:"revision"`
+	Tasks                []*ApplicationSyncTask `protobuf:"bytes,2,rep,name=tasks" json:"tasks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ApplicationSyncPlanResponse) Reset()         { *m = ApplicationSyncPlanResponse{} }
+func (m *ApplicationSyncPlanResponse) String() string { return proto.CompactTextString(m) }
+func (*ApplicationSyncPlanResponse) ProtoMessage()    {}
+func (*ApplicationSyncPlanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{10}
+}
+func (m *ApplicationSyncPlanResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSyncPlanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationSyncPlanResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationSyncPlanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSyncPlanResponse.Merge(m, src)
+}
+func (m *ApplicationSyncPlanResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSyncPlanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSyncPlanResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSyncPlanResponse proto.InternalMessageInfo
+
+func (m *ApplicationSyncPlanResponse) GetRevision() string {
+	if m != nil {
+		return m.Revision
+	}
+	return ""
+}
+
+func (m *ApplicationSyncPlanResponse) GetTasks() []*ApplicationSyncTask {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
+// ApplicationSyncTask is a single task of a sync plan
+type ApplicationSyncTask struct {
+	Phase                string   `protobuf:"bytes,1,req,name=phase" json:"phase"`
+	Wave                 int64    `protobuf:"varint,2,req,name=wave" json:"wave"`
+	HookType             string   `protobuf:"bytes,3,opt,name=hookType" json:"hookType"`
+	Action               string   `protobuf:"bytes,4,req,name=action" json:"action"`
+	Group                string   `protobuf:"bytes,5,opt,name=group" json:"group"`
+	Kind                 string   `protobuf:"bytes,6,req,name=kind" json:"kind"`
+	Namespace            string   `protobuf:"bytes,7,opt,name=namespace" json:"namespace"`
+	Name                 string   `protobuf:"bytes,8,req,name=name" json:"name"`
+	Skipped              bool     `protobuf:"varint,9,req,name=skipped" json:"skipped"`
+	Message              string   `protobuf:"bytes,10,opt,name=message" json:"message"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationSyncTask) Reset()         { *m = ApplicationSyncTask{} }
+func (m *ApplicationSyncTask) String() string { return proto.CompactTextString(m) }
+func (*ApplicationSyncTask) ProtoMessage()    {}
+func (*ApplicationSyncTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{11}
+}
+func (m *ApplicationSyncTask) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSyncTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationSyncTask.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationSyncTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSyncTask.Merge(m, src)
+}
+func (m *ApplicationSyncTask) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSyncTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSyncTask.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSyncTask proto.InternalMessageInfo
+
+func (m *ApplicationSyncTask) GetPhase() string {
+	if m != nil {
+		return m.Phase
+	}
+	return ""
+}
+
+func (m *ApplicationSyncTask) GetWave() int64 {
+	if m != nil {
+		return m.Wave
+	}
+	return 0
+}
+
+func (m *ApplicationSyncTask) GetHookType() string {
+	if m != nil {
+		return m.HookType
+	}
+	return ""
+}
+
+func (m *ApplicationSyncTask) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *ApplicationSyncTask) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *ApplicationSyncTask) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *ApplicationSyncTask) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ApplicationSyncTask) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ApplicationSyncTask) GetSkipped() bool {
+	if m != nil {
+		return m.Skipped
+	}
+	return false
+}
+
+func (m *ApplicationSyncTask) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
 // ApplicationUpdateSpecRequest is a request to update application spec
 type ApplicationUpdateSpecRequest struct {
 	Name                 *string                  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
@@ -693,7 +877,7 @@ func (m *ApplicationUpdateSpecRequest) Reset()         { *m = ApplicationUpdateS
 func (m *ApplicationUpdateSpecRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationUpdateSpecRequest) ProtoMessage()    {}
 func (*ApplicationUpdateSpecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{10}
+	return fileDescriptor_df6e82b174b5eaec, []int{12}
 }
 func (m *ApplicationUpdateSpecRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -757,7 +941,7 @@ func (m *ApplicationPatchRequest) Reset()         { *m = ApplicationPatchRequest
 func (m *ApplicationPatchRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationPatchRequest) ProtoMessage()    {}
 func (*ApplicationPatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{11}
+	return fileDescriptor_df6e82b174b5eaec, []int{13}
 }
 func (m *ApplicationPatchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -808,10 +992,12 @@ func (m *ApplicationPatchRequest) GetPatchType() string {
 }
 
 type ApplicationRollbackRequest struct {
-	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-	ID                   int64    `protobuf:"varint,2,req,name=id" json:"id"`
-	DryRun               bool     `protobuf:"varint,3,opt,name=dryRun" json:"dryRun"`
-	Prune                bool     `protobuf:"varint,4,opt,name=prune" json:"prune"`
+	Name   *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	ID     int64   `protobuf:"varint,2,opt,name=id" json:"id"`
+	DryRun bool    `protobuf:"varint,3,opt,name=dryRun" json:"dryRun"`
+	Prune  bool    `protobuf:"varint,4,opt,name=prune" json:"prune"`
+	// revision is a git commit SHA, tag, branch or Helm chart version to rollback to. The history ID is ignored if the revision is specified
+	Revision             string   `protobuf:"bytes,5,opt,name=revision" json:"revision"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -821,7 +1007,7 @@ func (m *ApplicationRollbackRequest) Reset()         { *m = ApplicationRollbackR
 func (m *ApplicationRollbackRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationRollbackRequest) ProtoMessage()    {}
 func (*ApplicationRollbackRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{12}
+	return fileDescriptor_df6e82b174b5eaec, []int{14}
 }
 func (m *ApplicationRollbackRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -878,6 +1064,13 @@ func (m *ApplicationRollbackRequest) GetPrune() bool {
 	return false
 }
 
+func (m *ApplicationRollbackRequest) GetRevision() string {
+	if m != nil {
+		return m.Revision
+	}
+	return ""
+}
+
 type ApplicationResourceRequest struct {
 	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
 	Namespace            string   `protobuf:"bytes,2,req,name=namespace" json:"namespace"`
@@ -894,7 +1087,7 @@ func (m *ApplicationResourceRequest) Reset()         { *m = ApplicationResourceR
 func (m *ApplicationResourceRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourceRequest) ProtoMessage()    {}
 func (*ApplicationResourceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{13}
+	return fileDescriptor_df6e82b174b5eaec, []int{15}
 }
 func (m *ApplicationResourceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -983,7 +1176,7 @@ func (m *ApplicationResourcePatchRequest) Reset()         { *m = ApplicationReso
 func (m *ApplicationResourcePatchRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourcePatchRequest) ProtoMessage()    {}
 func (*ApplicationResourcePatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{14}
+	return fileDescriptor_df6e82b174b5eaec, []int{16}
 }
 func (m *ApplicationResourcePatchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1085,7 +1278,7 @@ func (m *ApplicationResourceDeleteRequest) Reset()         { *m = ApplicationRes
 func (m *ApplicationResourceDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourceDeleteRequest) ProtoMessage()    {}
 func (*ApplicationResourceDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{15}
+	return fileDescriptor_df6e82b174b5eaec, []int{17}
 }
 func (m *ApplicationResourceDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1180,7 +1373,7 @@ func (m *ResourceActionRunRequest) Reset()         { *m = ResourceActionRunReque
 func (m *ResourceActionRunRequest) String() string { return proto.CompactTextString(m) }
 func (*ResourceActionRunRequest) ProtoMessage()    {}
 func (*ResourceActionRunRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{16}
+	return fileDescriptor_df6e82b174b5eaec, []int{18}
 }
 func (m *ResourceActionRunRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1269,7 +1462,7 @@ func (m *ResourceActionsListResponse) Reset()         { *m = ResourceActionsList
 func (m *ResourceActionsListResponse) String() string { return proto.CompactTextString(m) }
 func (*ResourceActionsListResponse) ProtoMessage()    {}
 func (*ResourceActionsListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{17}
+	return fileDescriptor_df6e82b174b5eaec, []int{19}
 }
 func (m *ResourceActionsListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1316,7 +1509,7 @@ func (m *ApplicationResourceResponse) Reset()         { *m = ApplicationResource
 func (m *ApplicationResourceResponse) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourceResponse) ProtoMessage()    {}
 func (*ApplicationResourceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{18}
+	return fileDescriptor_df6e82b174b5eaec, []int{20}
 }
 func (m *ApplicationResourceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1352,15 +1545,25 @@ func (m *ApplicationResourceResponse) GetManifest() string {
 	return ""
 }
 
+// ApplicationPodLogsQuery is a query for the logs of the pods of an application. The logs of a single pod are streamed
+// if podName is given, otherwise of all pods which are (transitively) owned by the given resource and match the label
+// selector, or of all pods of the application if neither is given.
 type ApplicationPodLogsQuery struct {
-	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-	Namespace            string   `protobuf:"bytes,2,req,name=namespace" json:"namespace"`
-	PodName              *string  `protobuf:"bytes,3,req,name=podName" json:"podName,omitempty"`
-	Container            string   `protobuf:"bytes,4,req,name=container" json:"container"`
-	SinceSeconds         int64    `protobuf:"varint,5,req,name=sinceSeconds" json:"sinceSeconds"`
-	SinceTime            *v1.Time `protobuf:"bytes,6,opt,name=sinceTime" json:"sinceTime,omitempty"`
-	TailLines            int64    `protobuf:"varint,7,req,name=tailLines" json:"tailLines"`
-	Follow               bool     `protobuf:"varint,8,req,name=follow" json:"follow"`
+	Name          *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Namespace     string   `protobuf:"bytes,2,req,name=namespace" json:"namespace"`
+	PodName       *string  `protobuf:"bytes,3,opt,name=podName" json:"podName,omitempty"`
+	Container     string   `protobuf:"bytes,4,req,name=container" json:"container"`
+	SinceSeconds  int64    `protobuf:"varint,5,req,name=sinceSeconds" json:"sinceSeconds"`
+	SinceTime     *v1.Time `protobuf:"bytes,6,opt,name=sinceTime" json:"sinceTime,omitempty"`
+	TailLines     int64    `protobuf:"varint,7,req,name=tailLines" json:"tailLines"`
+	Follow        bool     `protobuf:"varint,8,req,name=follow" json:"follow"`
+	Group         string   `protobuf:"bytes,9,opt,name=group" json:"group"`
+	Kind          string   `protobuf:"bytes,10,opt,name=kind" json:"kind"`
+	ResourceName  string   `protobuf:"bytes,11,opt,name=resourceName" json:"resourceName"`
+	LabelSelector string   `protobuf:"bytes,12,opt,name=labelSelector" json:"labelSelector"`
+	Previous      bool     `protobuf:"varint,13,opt,name=previous" json:"previous"`
+	// filter only returns log lines containing the given text, or not containing it if prefixed with '!'
+	Filter               string   `protobuf:"bytes,14,opt,name=filter" json:"filter"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1370,7 +1573,7 @@ func (m *ApplicationPodLogsQuery) Reset()         { *m = ApplicationPodLogsQuery
 func (m *ApplicationPodLogsQuery) String() string { return proto.CompactTextString(m) }
 func (*ApplicationPodLogsQuery) ProtoMessage()    {}
 func (*ApplicationPodLogsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{19}
+	return fileDescriptor_df6e82b174b5eaec, []int{21}
 }
 func (m *ApplicationPodLogsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1455,10 +1658,54 @@ func (m *ApplicationPodLogsQuery) GetFollow() bool {
 	return false
 }
 
+func (m *ApplicationPodLogsQuery) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *ApplicationPodLogsQuery) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *ApplicationPodLogsQuery) GetResourceName() string {
+	if m != nil {
+		return m.ResourceName
+	}
+	return ""
+}
+
+func (m *ApplicationPodLogsQuery) GetLabelSelector() string {
+	if m != nil {
+		return m.LabelSelector
+	}
+	return ""
+}
+
+func (m *ApplicationPodLogsQuery) GetPrevious() bool {
+	if m != nil {
+		return m.Previous
+	}
+	return false
+}
+
+func (m *ApplicationPodLogsQuery) GetFilter() string {
+	if m != nil {
+		return m.Filter
+	}
+	return ""
+}
+
 type LogEntry struct {
 	Content              string   `protobuf:"bytes,1,req,name=content" json:"content"`
 	TimeStamp            v1.Time  `protobuf:"bytes,2,req,name=timeStamp" json:"timeStamp"`
 	Last                 bool     `protobuf:"varint,3,req,name=last" json:"last"`
+	PodName              string   `protobuf:"bytes,4,opt,name=podName" json:"podName"`
+	Container            string   `protobuf:"bytes,5,opt,name=container" json:"container"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1468,7 +1715,7 @@ func (m *LogEntry) Reset()         { *m = LogEntry{} }
 func (m *LogEntry) String() string { return proto.CompactTextString(m) }
 func (*LogEntry) ProtoMessage()    {}
 func (*LogEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{20}
+	return fileDescriptor_df6e82b174b5eaec, []int{22}
 }
 func (m *LogEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1518,6 +1765,20 @@ func (m *LogEntry) GetLast() bool {
 	return false
 }
 
+func (m *LogEntry) GetPodName() string {
+	if m != nil {
+		return m.PodName
+	}
+	return ""
+}
+
+func (m *LogEntry) GetContainer() string {
+	if m != nil {
+		return m.Container
+	}
+	return ""
+}
+
 type OperationTerminateRequest struct {
 	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1529,7 +1790,7 @@ func (m *OperationTerminateRequest) Reset()         { *m = OperationTerminateReq
 func (m *OperationTerminateRequest) String() string { return proto.CompactTextString(m) }
 func (*OperationTerminateRequest) ProtoMessage()    {}
 func (*OperationTerminateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{21}
+	return fileDescriptor_df6e82b174b5eaec, []int{23}
 }
 func (m *OperationTerminateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1576,7 +1837,7 @@ func (m *ApplicationSyncWindowsQuery) Reset()         { *m = ApplicationSyncWind
 func (m *ApplicationSyncWindowsQuery) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSyncWindowsQuery) ProtoMessage()    {}
 func (*ApplicationSyncWindowsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{22}
+	return fileDescriptor_df6e82b174b5eaec, []int{24}
 }
 func (m *ApplicationSyncWindowsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1625,7 +1886,7 @@ func (m *ApplicationSyncWindowsResponse) Reset()         { *m = ApplicationSyncW
 func (m *ApplicationSyncWindowsResponse) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSyncWindowsResponse) ProtoMessage()    {}
 func (*ApplicationSyncWindowsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{23}
+	return fileDescriptor_df6e82b174b5eaec, []int{25}
 }
 func (m *ApplicationSyncWindowsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1689,7 +1950,7 @@ func (m *ApplicationSyncWindow) Reset()         { *m = ApplicationSyncWindow{} }
 func (m *ApplicationSyncWindow) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSyncWindow) ProtoMessage()    {}
 func (*ApplicationSyncWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{24}
+	return fileDescriptor_df6e82b174b5eaec, []int{26}
 }
 func (m *ApplicationSyncWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1756,7 +2017,7 @@ func (m *OperationTerminateResponse) Reset()         { *m = OperationTerminateRe
 func (m *OperationTerminateResponse) String() string { return proto.CompactTextString(m) }
 func (*OperationTerminateResponse) ProtoMessage()    {}
 func (*OperationTerminateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{25}
+	return fileDescriptor_df6e82b174b5eaec, []int{27}
 }
 func (m *OperationTerminateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1801,7 +2062,7 @@ func (m *ResourcesQuery) Reset()         { *m = ResourcesQuery{} }
 func (m *ResourcesQuery) String() string { return proto.CompactTextString(m) }
 func (*ResourcesQuery) ProtoMessage()    {}
 func (*ResourcesQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{26}
+	return fileDescriptor_df6e82b174b5eaec, []int{28}
 }
 func (m *ResourcesQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1883,7 +2144,7 @@ func (m *ManagedResourcesResponse) Reset()         { *m = ManagedResourcesRespon
 func (m *ManagedResourcesResponse) String() string { return proto.CompactTextString(m) }
 func (*ManagedResourcesResponse) ProtoMessage()    {}
 func (*ManagedResourcesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{27}
+	return fileDescriptor_df6e82b174b5eaec, []int{29}
 }
 func (m *ManagedResourcesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1930,6 +2191,8 @@ func init() {
 	proto.RegisterType((*ApplicationUpdateRequest)(nil), "application.ApplicationUpdateRequest")
 	proto.RegisterType((*ApplicationDeleteRequest)(nil), "application.ApplicationDeleteRequest")
 	proto.RegisterType((*ApplicationSyncRequest)(nil), "application.ApplicationSyncRequest")
+	proto.RegisterType((*ApplicationSyncPlanResponse)(nil), "application.ApplicationSyncPlanResponse")
+	proto.RegisterType((*ApplicationSyncTask)(nil), "application.ApplicationSyncTask")
 	proto.RegisterType((*ApplicationUpdateSpecRequest)(nil), "application.ApplicationUpdateSpecRequest")
 	proto.RegisterType((*ApplicationPatchRequest)(nil), "application.ApplicationPatchRequest")
 	proto.RegisterType((*ApplicationRollbackRequest)(nil), "application.ApplicationRollbackRequest")
@@ -1955,143 +2218,159 @@ func init() {
 }
 
 var fileDescriptor_df6e82b174b5eaec = []byte{
-	// 2163 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcd, 0x6f, 0x1c, 0x49,
-	0x15, 0xa7, 0xc6, 0x63, 0xcf, 0xcc, 0x73, 0xb2, 0x49, 0x6a, 0x37, 0xa1, 0xb7, 0xe3, 0x38, 0xa3,
-	0x4a, 0xe2, 0x38, 0x4e, 0xdc, 0x13, 0x9b, 0x00, 0x8b, 0x17, 0x14, 0xe2, 0x24, 0x38, 0x06, 0xc7,
-	0x98, 0x76, 0x42, 0x24, 0x24, 0x84, 0x7a, 0xbb, 0xcb, 0xe3, 0xc6, 0x33, 0xdd, 0x4d, 0x77, 0xcf,
-	0x44, 0xa3, 0x28, 0x07, 0x16, 0x09, 0x71, 0x40, 0x20, 0xc4, 0x1e, 0x58, 0xc4, 0xc7, 0x0a, 0xae,
-	0xdc, 0x80, 0x0b, 0x87, 0xbd, 0x20, 0x50, 0x8e, 0x08, 0xf6, 0x1c, 0x21, 0x8b, 0x3f, 0x80, 0x13,
-	0x67, 0x54, 0xd5, 0x55, 0xdd, 0xd5, 0x93, 0x99, 0x9e, 0xc9, 0x7a, 0x10, 0xca, 0x6d, 0xea, 0x55,
-	0xf5, 0x7b, 0xbf, 0xf7, 0x5d, 0xf5, 0x34, 0x70, 0x31, 0xa2, 0x61, 0x97, 0x86, 0x0d, 0x2b, 0x08,
-	0x5a, 0xae, 0x6d, 0xc5, 0xae, 0xef, 0xa9, 0xbf, 0x8d, 0x20, 0xf4, 0x63, 0x1f, 0xcf, 0x2a, 0x24,
-	0xfd, 0x8d, 0xa6, 0xdf, 0xf4, 0x39, 0xbd, 0xc1, 0x7e, 0x25, 0x47, 0xf4, 0xb9, 0xa6, 0xef, 0x37,
-	0x5b, 0xb4, 0x61, 0x05, 0x6e, 0xc3, 0xf2, 0x3c, 0x3f, 0xe6, 0x87, 0x23, 0xb1, 0x4b, 0x0e, 0xde,
-	0x8a, 0x0c, 0xd7, 0xe7, 0xbb, 0xb6, 0x1f, 0xd2, 0x46, 0x77, 0xa5, 0xd1, 0xa4, 0x1e, 0x0d, 0xad,
-	0x98, 0x3a, 0xe2, 0xcc, 0x8d, 0xec, 0x4c, 0xdb, 0xb2, 0xf7, 0x5d, 0x8f, 0x86, 0xbd, 0x46, 0x70,
-	0xd0, 0x64, 0x84, 0xa8, 0xd1, 0xa6, 0xb1, 0x35, 0xe8, 0xab, 0xcd, 0xa6, 0x1b, 0xef, 0x77, 0xde,
-	0x31, 0x6c, 0xbf, 0xdd, 0xb0, 0x42, 0x0e, 0xec, 0xdb, 0xfc, 0xc7, 0xb2, 0xed, 0x64, 0x5f, 0xab,
-	0xea, 0x75, 0x57, 0xac, 0x56, 0xb0, 0x6f, 0xbd, 0xc8, 0x6a, 0xbd, 0x88, 0x55, 0x48, 0x03, 0x5f,
-	0xd8, 0x8a, 0xff, 0x74, 0x63, 0x3f, 0xec, 0x29, 0x3f, 0x13, 0x1e, 0xe4, 0x4f, 0x08, 0x4e, 0xde,
-	0xca, 0x84, 0x7d, 0xad, 0x43, 0xc3, 0x1e, 0xc6, 0x50, 0xf6, 0xac, 0x36, 0xd5, 0x50, 0x1d, 0x2d,
-	0xd6, 0x4c, 0xfe, 0x1b, 0x6b, 0x50, 0x09, 0xe9, 0x5e, 0x48, 0xa3, 0x7d, 0xad, 0xc4, 0xc9, 0x72,
-	0x89, 0x17, 0xa0, 0xc2, 0x24, 0x53, 0x3b, 0xd6, 0xa6, 0xea, 0x53, 0x8b, 0xb5, 0xf5, 0x63, 0x87,
-	0xcf, 0xcf, 0x57, 0x77, 0x12, 0x52, 0x64, 0xca, 0x4d, 0x6c, 0xc0, 0x89, 0x90, 0x46, 0x7e, 0x27,
-	0xb4, 0xe9, 0xd7, 0x69, 0x18, 0xb9, 0xbe, 0xa7, 0x95, 0x19, 0xa7, 0xf5, 0xf2, 0xb3, 0xe7, 0xe7,
-	0x3f, 0x61, 0xf6, 0x6f, 0xe2, 0x3a, 0x54, 0x23, 0xda, 0xa2, 0x76, 0xec, 0x87, 0xda, 0xb4, 0x72,
-	0x30, 0xa5, 0x92, 0xf3, 0x50, 0xdb, 0xf6, 0x1d, 0x3a, 0x14, 0x34, 0xd9, 0x80, 0xd3, 0x26, 0xed,
-	0xba, 0x8c, 0xdd, 0x7d, 0x1a, 0x5b, 0x8e, 0x15, 0x5b, 0xfd, 0x87, 0x4b, 0xa9, 0x86, 0x3a, 0x54,
-	0x43, 0x71, 0x58, 0x2b, 0x71, 0x7a, 0xba, 0x66, 0x66, 0x9a, 0x57, 0xcc, 0x64, 0x0a, 0xa8, 0x77,
-	0xbb, 0xd4, 0x8b, 0xa3, 0xe1, 0x2c, 0x57, 0xe1, 0x94, 0xd4, 0x6a, 0xdb, 0x6a, 0xd3, 0x28, 0xb0,
-	0x6c, 0x9a, 0xf0, 0x16, 0xba, 0xbc, 0xb8, 0x8d, 0x17, 0xe1, 0x98, 0x4a, 0xd4, 0xa6, 0x94, 0xe3,
-	0xb9, 0x1d, 0xbc, 0x00, 0xb3, 0x72, 0xfd, 0x70, 0xf3, 0x8e, 0x56, 0x56, 0x0e, 0xaa, 0x1b, 0x64,
-	0x07, 0x34, 0x05, 0xfb, 0x7d, 0xcb, 0x73, 0xf7, 0x68, 0x14, 0x0f, 0x47, 0x5d, 0xcf, 0x19, 0x42,
-	0x31, 0x7c, 0x6a, 0x8e, 0xd3, 0xf0, 0x7a, 0xde, 0x1a, 0x81, 0xef, 0x45, 0x94, 0x7c, 0x88, 0x72,
-	0x92, 0x6e, 0x87, 0xd4, 0x8a, 0xa9, 0x49, 0xbf, 0xd3, 0xa1, 0x51, 0x8c, 0x3d, 0x50, 0xb3, 0x92,
-	0x0b, 0x9c, 0x5d, 0xfd, 0x92, 0x91, 0xc5, 0xb0, 0x21, 0x63, 0x98, 0xff, 0xf8, 0x96, 0xed, 0x18,
-	0xc1, 0x41, 0xd3, 0x60, 0xe9, 0x60, 0xa8, 0x19, 0x2e, 0xd3, 0xc1, 0x50, 0x24, 0x49, 0xad, 0x95,
-	0x73, 0xf8, 0x0c, 0xcc, 0x74, 0x82, 0x88, 0x86, 0x31, 0xd7, 0xa1, 0x6a, 0x8a, 0x15, 0x73, 0x73,
-	0xd7, 0x6a, 0xb9, 0x8e, 0x15, 0x33, 0xdb, 0xb2, 0x9d, 0x74, 0x4d, 0x3e, 0xc8, 0x2b, 0xf0, 0x30,
-	0x70, 0x14, 0x05, 0xf6, 0xff, 0x87, 0x0a, 0xe4, 0xa1, 0xab, 0x10, 0x4b, 0x7d, 0x10, 0xef, 0xe5,
-	0x10, 0xde, 0xa1, 0x2d, 0x9a, 0x21, 0x1c, 0xe4, 0x4c, 0x0d, 0x2a, 0xb6, 0x15, 0xd9, 0x96, 0x23,
-	0x59, 0xc9, 0x25, 0x79, 0x56, 0x86, 0x33, 0x0a, 0xab, 0xdd, 0x9e, 0x67, 0x17, 0x31, 0x1a, 0x19,
-	0x15, 0x78, 0x0e, 0x66, 0x9c, 0xb0, 0x67, 0x76, 0xbc, 0xc4, 0xae, 0x62, 0x5f, 0xd0, 0xb0, 0x0e,
-	0xd3, 0x41, 0xd8, 0xf1, 0x28, 0x4f, 0x7a, 0xb9, 0x99, 0x90, 0xb0, 0x0d, 0xd5, 0x28, 0x66, 0xa5,
-	0xad, 0xd9, 0xe3, 0xa9, 0x3e, 0xbb, 0xba, 0x71, 0x04, 0xbb, 0x32, 0x4d, 0x76, 0x05, 0x3b, 0x33,
-	0x65, 0x8c, 0x63, 0xa8, 0xc9, 0xac, 0x88, 0xb4, 0x4a, 0x7d, 0x6a, 0x71, 0x76, 0x75, 0xe7, 0x88,
-	0x52, 0xbe, 0x1a, 0xb0, 0x82, 0xac, 0x14, 0x04, 0xa1, 0x56, 0x26, 0x08, 0xcf, 0x41, 0xad, 0x2d,
-	0x32, 0x2e, 0xd2, 0xaa, 0xac, 0x3e, 0x9a, 0x19, 0x01, 0x3f, 0x84, 0x69, 0xd7, 0xdb, 0xf3, 0x23,
-	0xad, 0xc6, 0xf1, 0xdc, 0x3c, 0x02, 0x9e, 0x4d, 0x6f, 0xcf, 0x37, 0x13, 0x6e, 0xd8, 0x83, 0xe3,
-	0x21, 0x8d, 0xc3, 0x9e, 0xb4, 0x82, 0x06, 0xdc, 0xa8, 0xf7, 0x8e, 0xc0, 0xde, 0x54, 0xf9, 0x99,
-	0x79, 0xf6, 0xe4, 0x0f, 0x08, 0xe6, 0x5e, 0xc8, 0x9b, 0xdd, 0x80, 0x16, 0x06, 0x94, 0x03, 0xe5,
-	0x28, 0xa0, 0x36, 0xaf, 0x87, 0xb3, 0xab, 0x5f, 0x9e, 0x4c, 0x22, 0x31, 0xa1, 0xc2, 0x09, 0x9c,
-	0x7b, 0x61, 0xba, 0xb7, 0xe1, 0x93, 0xca, 0xa7, 0x3b, 0x56, 0x6c, 0xef, 0x17, 0x01, 0x66, 0x11,
-	0xcc, 0xce, 0xe4, 0x2a, 0x78, 0x42, 0xc2, 0x04, 0x6a, 0xfc, 0xc7, 0x83, 0x5e, 0x90, 0x2f, 0xd9,
-	0x19, 0x99, 0x7c, 0x1f, 0x81, 0xae, 0xe6, 0xbc, 0xdf, 0x6a, 0xbd, 0x63, 0xd9, 0x07, 0xc5, 0x22,
-	0x4b, 0xae, 0xc3, 0xe5, 0x4d, 0xad, 0x03, 0xe3, 0x77, 0xf8, 0xfc, 0x7c, 0x69, 0xf3, 0x8e, 0x59,
-	0x72, 0x9d, 0x8f, 0x9f, 0x6e, 0xe4, 0xa3, 0x3e, 0x20, 0x22, 0x58, 0x8b, 0x80, 0x10, 0xa8, 0x79,
-	0x03, 0x3b, 0x58, 0x46, 0x7e, 0x89, 0xce, 0x35, 0x0f, 0x95, 0x6e, 0x7a, 0x05, 0xc8, 0x0e, 0x49,
-	0x22, 0x03, 0xdf, 0x0c, 0xfd, 0x4e, 0xa0, 0x4d, 0xab, 0x96, 0xe6, 0x24, 0xac, 0x41, 0xf9, 0xc0,
-	0xf5, 0x1c, 0x6d, 0x46, 0xd9, 0xe2, 0x14, 0xf2, 0x7e, 0x09, 0xce, 0x0f, 0x50, 0x6b, 0xa4, 0x5f,
-	0x5f, 0x01, 0xdd, 0xb2, 0xd8, 0xab, 0x8c, 0x88, 0xbd, 0xea, 0xe0, 0xd8, 0xfb, 0x0f, 0x82, 0xfa,
-	0x00, 0xdb, 0x8c, 0xee, 0x1f, 0xaf, 0x88, 0x71, 0xf6, 0xfc, 0xd0, 0xa6, 0x5a, 0x25, 0x8d, 0x75,
-	0x64, 0x26, 0x24, 0xf2, 0x6f, 0x04, 0x9a, 0xd4, 0xf6, 0x96, 0xcd, 0x75, 0xef, 0x78, 0xaf, 0xba,
-	0xc2, 0x73, 0x30, 0x63, 0x71, 0x5d, 0x72, 0xe1, 0x20, 0x68, 0xe4, 0x07, 0x08, 0xce, 0xe6, 0x55,
-	0x8e, 0xb6, 0xdc, 0x28, 0x96, 0xd7, 0x34, 0xec, 0x42, 0x25, 0x39, 0x19, 0x69, 0x88, 0xb7, 0x9d,
-	0xcd, 0x23, 0xf5, 0x05, 0x55, 0x90, 0x54, 0x4f, 0xf0, 0x27, 0x37, 0xe1, 0xec, 0xc0, 0x42, 0x23,
-	0x90, 0xd4, 0xa1, 0x2a, 0x7b, 0x61, 0xe2, 0x03, 0x79, 0xa7, 0x90, 0x54, 0xf2, 0x97, 0x52, 0xbe,
-	0x46, 0xfb, 0xce, 0x96, 0xdf, 0x2c, 0xb8, 0x71, 0x8f, 0xe3, 0x3d, 0x0d, 0x2a, 0x81, 0xef, 0x64,
-	0x8e, 0x33, 0xe5, 0x92, 0x7d, 0x6d, 0xfb, 0x5e, 0x6c, 0xb1, 0xb7, 0x5c, 0xce, 0x5f, 0x19, 0x99,
-	0xf9, 0x3e, 0x72, 0x3d, 0x9b, 0xee, 0x52, 0xdb, 0xf7, 0x9c, 0x88, 0x3b, 0x6e, 0x4a, 0xfa, 0x5e,
-	0xdd, 0xc1, 0xf7, 0xa0, 0xc6, 0xd7, 0x0f, 0xdc, 0x36, 0xd5, 0x66, 0x78, 0x07, 0x5e, 0x32, 0x92,
-	0x47, 0xa3, 0xa1, 0x3e, 0x1a, 0x33, 0x0b, 0xb3, 0x47, 0xa3, 0xd1, 0x5d, 0x31, 0xd8, 0x17, 0x66,
-	0xf6, 0x31, 0xc3, 0x15, 0x5b, 0x6e, 0x6b, 0xcb, 0xf5, 0xf8, 0xd5, 0x25, 0x13, 0x98, 0x91, 0x59,
-	0x4c, 0xec, 0xf9, 0xad, 0x96, 0xff, 0x98, 0x97, 0x80, 0xb4, 0x1d, 0x24, 0x34, 0xf2, 0x1e, 0x82,
-	0xea, 0x96, 0xdf, 0xbc, 0xeb, 0xc5, 0x61, 0x8f, 0x05, 0x25, 0xd3, 0x87, 0x7a, 0x79, 0xab, 0x4b,
-	0x22, 0xde, 0x86, 0x5a, 0xec, 0xb6, 0xe9, 0x6e, 0x6c, 0xb5, 0x03, 0xd1, 0x9e, 0x5f, 0x02, 0x78,
-	0x0a, 0x4d, 0xb2, 0x60, 0x81, 0xdc, 0xb2, 0xa2, 0x98, 0x5b, 0x5b, 0x02, 0xe3, 0x14, 0xd2, 0x80,
-	0x37, 0xd3, 0x3b, 0xd4, 0x03, 0x1a, 0xb6, 0x5d, 0xcf, 0x2a, 0x2c, 0x47, 0x64, 0x25, 0x17, 0x50,
-	0xec, 0x0e, 0xf6, 0xc8, 0xf5, 0x1c, 0xff, 0xf1, 0xf0, 0x90, 0x20, 0x7f, 0xcf, 0xbf, 0xdd, 0x94,
-	0x6f, 0xd2, 0x38, 0xbc, 0x07, 0xc7, 0x59, 0xc4, 0x76, 0xa9, 0xd8, 0x10, 0x79, 0x41, 0x72, 0x21,
-	0x3f, 0x90, 0x87, 0x99, 0xff, 0x10, 0x6f, 0xc1, 0x09, 0x2b, 0x8a, 0xdc, 0xa6, 0x47, 0x1d, 0xc9,
-	0xab, 0x34, 0x36, 0xaf, 0xfe, 0x4f, 0x93, 0xcb, 0x3b, 0x3f, 0x91, 0xd8, 0xce, 0x94, 0x4b, 0xf2,
-	0x3d, 0x04, 0xa7, 0x07, 0x32, 0x61, 0x26, 0xe0, 0x55, 0x43, 0x98, 0x40, 0x14, 0xc8, 0x6a, 0x64,
-	0xef, 0x53, 0xa7, 0xd3, 0xa2, 0xf2, 0x69, 0x2b, 0xd7, 0x6c, 0xcf, 0xe9, 0x24, 0x1e, 0x10, 0xe9,
-	0x90, 0xae, 0xf1, 0x3c, 0x40, 0xdb, 0xf2, 0x3a, 0x56, 0x8b, 0x43, 0x28, 0x73, 0x08, 0x0a, 0x85,
-	0xcc, 0x81, 0x3e, 0xc8, 0x7d, 0xe2, 0x39, 0xf8, 0x11, 0x82, 0xd7, 0x64, 0xca, 0x0b, 0xff, 0x18,
-	0x70, 0x42, 0x31, 0xc3, 0x76, 0xea, 0x2a, 0x51, 0xb3, 0xfb, 0x37, 0xfb, 0xd3, 0x19, 0x0d, 0x4e,
-	0xe7, 0xc4, 0xe7, 0x53, 0xca, 0x76, 0x52, 0x0c, 0x72, 0xc5, 0x17, 0x15, 0x16, 0x5f, 0x34, 0xbc,
-	0xf8, 0xa2, 0xbe, 0x6b, 0x46, 0x0f, 0xb4, 0xfb, 0x96, 0x67, 0x35, 0xa9, 0x93, 0x2a, 0x97, 0x06,
-	0xd2, 0x37, 0x61, 0xda, 0x8d, 0x69, 0x5b, 0x06, 0xd0, 0xc6, 0x04, 0x0a, 0xeb, 0x1d, 0x77, 0x6f,
-	0xcf, 0x4c, 0xb8, 0xae, 0xbe, 0x3f, 0x0f, 0x58, 0xf5, 0x3a, 0x0d, 0xbb, 0xae, 0x4d, 0xf1, 0x8f,
-	0x11, 0x94, 0x59, 0x85, 0xc7, 0xe7, 0x86, 0x05, 0x19, 0xb7, 0xbe, 0x3e, 0xa1, 0x3b, 0x36, 0x13,
-	0x45, 0xe6, 0xde, 0xfd, 0xc7, 0xbf, 0x7e, 0x5a, 0x3a, 0x83, 0xdf, 0xe0, 0x33, 0xb2, 0xee, 0x8a,
-	0x3a, 0xb2, 0x8a, 0xf0, 0x0f, 0x11, 0x60, 0xd1, 0x73, 0x94, 0x41, 0x09, 0xbe, 0x3a, 0x0c, 0xdf,
-	0x80, 0x81, 0x8a, 0x7e, 0x4e, 0x29, 0x39, 0x86, 0xed, 0x87, 0x94, 0x15, 0x18, 0x7e, 0x80, 0x03,
-	0x58, 0xe2, 0x00, 0x2e, 0x62, 0x32, 0x08, 0x40, 0xe3, 0x09, 0x0b, 0x80, 0xa7, 0x0d, 0x9a, 0xc8,
-	0xfd, 0x35, 0x82, 0xe9, 0x47, 0xfc, 0xae, 0x34, 0xc2, 0x42, 0x3b, 0x93, 0xb1, 0x10, 0x97, 0xc5,
-	0xa1, 0x92, 0x0b, 0x1c, 0xe6, 0x39, 0x7c, 0x56, 0xc2, 0x8c, 0xe2, 0x90, 0x5a, 0xed, 0x1c, 0xda,
-	0xeb, 0x08, 0xff, 0x06, 0xc1, 0x4c, 0x32, 0x2f, 0xc1, 0x97, 0x86, 0x41, 0xcc, 0xcd, 0x53, 0xf4,
-	0x09, 0x4d, 0x1e, 0xc8, 0x15, 0x0e, 0xf0, 0x02, 0x19, 0xe8, 0xc8, 0xb5, 0xdc, 0x5c, 0xe2, 0x27,
-	0x08, 0xa6, 0x36, 0xe8, 0xc8, 0x30, 0x9b, 0x14, 0xb2, 0x17, 0x4c, 0x37, 0xc0, 0xc3, 0xf8, 0xb7,
-	0x08, 0xde, 0xdc, 0xa0, 0xf1, 0xe0, 0x02, 0x8f, 0x17, 0x47, 0x57, 0x5d, 0x11, 0x6d, 0x57, 0xc7,
-	0x38, 0x99, 0x56, 0xb6, 0x06, 0x47, 0x76, 0x05, 0x5f, 0x2e, 0x8a, 0xbd, 0xa8, 0xe7, 0xd9, 0x8f,
-	0x05, 0x8e, 0xbf, 0x22, 0x38, 0xd9, 0x3f, 0x89, 0xc4, 0xf9, 0x96, 0x30, 0x70, 0x50, 0xa9, 0x7f,
-	0xe5, 0x48, 0x15, 0x24, 0xcf, 0x91, 0xdc, 0xe2, 0xb0, 0xdf, 0xc6, 0x9f, 0x2b, 0x82, 0x2d, 0xc7,
-	0x39, 0x51, 0xe3, 0x89, 0xfc, 0xf9, 0x94, 0x4f, 0xb3, 0x39, 0xe6, 0x77, 0x11, 0x1c, 0xdb, 0xa0,
-	0xf1, 0xfd, 0x74, 0x82, 0x31, 0x34, 0x5a, 0x73, 0x73, 0x46, 0x7d, 0xce, 0x50, 0x46, 0xcf, 0x72,
-	0x2b, 0xb5, 0xe7, 0x32, 0x07, 0x76, 0x19, 0x5f, 0x2a, 0x02, 0x96, 0x4d, 0x4d, 0x3e, 0x44, 0x30,
-	0x93, 0xcc, 0x18, 0x86, 0x8b, 0xcf, 0xcd, 0xee, 0x26, 0x16, 0x92, 0x77, 0x39, 0xd0, 0x9b, 0xfa,
-	0xf5, 0xc1, 0x40, 0xd5, 0xef, 0xa5, 0xc9, 0x0c, 0x8e, 0x3e, 0x9f, 0x48, 0xbf, 0x47, 0x00, 0xd9,
-	0x90, 0x04, 0x5f, 0x29, 0x56, 0x42, 0x19, 0xa4, 0xe8, 0x13, 0x1c, 0x93, 0x10, 0x83, 0x2b, 0xb3,
-	0xa8, 0xd7, 0x0b, 0xa3, 0x38, 0xa0, 0xf6, 0x5a, 0x32, 0x4a, 0xf9, 0x25, 0x82, 0x69, 0xfe, 0x98,
-	0xc6, 0x17, 0x87, 0x01, 0x56, 0xdf, 0xda, 0x13, 0x33, 0xfa, 0x02, 0xc7, 0x59, 0x5f, 0x2d, 0xaa,
-	0x03, 0x6b, 0x68, 0x09, 0x77, 0x61, 0x26, 0x79, 0xcf, 0x0e, 0x8f, 0x8a, 0xdc, 0x7b, 0x57, 0xaf,
-	0x17, 0xb4, 0xa3, 0x24, 0x30, 0x45, 0x09, 0x5a, 0x2a, 0x2c, 0x41, 0x1f, 0x20, 0x28, 0xb3, 0x2a,
-	0x81, 0x2f, 0x14, 0xd5, 0x90, 0x49, 0x5b, 0xe5, 0x2a, 0x87, 0x76, 0x89, 0xd4, 0x47, 0xd5, 0x20,
-	0x66, 0x9a, 0x9f, 0x21, 0x38, 0xd9, 0x7f, 0x69, 0xc1, 0x67, 0xfb, 0xea, 0x8f, 0x7a, 0x53, 0xd3,
-	0xf3, 0x26, 0x1c, 0x76, 0xe1, 0x21, 0x5f, 0xe4, 0x28, 0xd6, 0xf0, 0x5b, 0x23, 0x13, 0x62, 0x5b,
-	0x26, 0x31, 0x63, 0xb4, 0x9c, 0x0d, 0x48, 0xff, 0x88, 0xe0, 0x98, 0xe4, 0xfb, 0x20, 0xa4, 0xb4,
-	0x18, 0xd6, 0x84, 0xe2, 0x9f, 0x09, 0x22, 0x9f, 0xe7, 0xd8, 0x3f, 0x83, 0x6f, 0x8c, 0x89, 0x5d,
-	0x62, 0x5e, 0x8e, 0x19, 0xcc, 0x3f, 0x23, 0x38, 0xf5, 0x28, 0x09, 0xf7, 0xff, 0x07, 0xf8, 0xdb,
-	0x1c, 0xfc, 0x17, 0xf0, 0xdb, 0x05, 0xf7, 0x8a, 0x51, 0x3a, 0x5c, 0x47, 0xf8, 0x77, 0x08, 0xaa,
-	0x72, 0x10, 0x89, 0x2f, 0x0f, 0xcd, 0x87, 0xfc, 0xa8, 0x72, 0x62, 0x31, 0x2c, 0xfa, 0x28, 0xb9,
-	0x58, 0xd8, 0x90, 0x84, 0x70, 0x16, 0xc7, 0xef, 0x21, 0xc0, 0xe9, 0x43, 0x23, 0x7d, 0x7a, 0xe0,
-	0x85, 0x9c, 0xa8, 0xa1, 0x2f, 0x4a, 0xfd, 0xf2, 0xc8, 0x73, 0xf9, 0x86, 0xb4, 0x54, 0xd8, 0x90,
-	0xfc, 0x54, 0xfe, 0x8f, 0x10, 0xcc, 0x6e, 0xd0, 0xf4, 0xb6, 0x5b, 0x60, 0xc8, 0xfc, 0xa8, 0x55,
-	0x5f, 0x1c, 0x7d, 0x50, 0x20, 0xba, 0xc6, 0x11, 0x2d, 0xe0, 0x62, 0x53, 0x49, 0x00, 0xbf, 0x40,
-	0x70, 0x7c, 0x47, 0x0d, 0x4e, 0x7c, 0x6d, 0x94, 0xa4, 0x5c, 0xe9, 0x1e, 0x1f, 0xd7, 0xa7, 0x38,
-	0xae, 0x65, 0x32, 0x16, 0xae, 0x35, 0x31, 0xb1, 0xfc, 0x15, 0x82, 0xd7, 0xd5, 0xe7, 0x81, 0x98,
-	0x52, 0x7d, 0x5c, 0xbb, 0x15, 0x0c, 0xbb, 0xc8, 0x0d, 0x8e, 0xcf, 0xc0, 0xd7, 0xc6, 0xc1, 0xd7,
-	0x10, 0x73, 0x2b, 0xfc, 0x73, 0x04, 0xa7, 0xf8, 0x9c, 0x50, 0x65, 0xdc, 0xd7, 0x56, 0x86, 0x4d,
-	0x15, 0xc7, 0x68, 0x2b, 0xa2, 0xf2, 0x90, 0x97, 0x02, 0xb5, 0x26, 0xe6, 0x7b, 0xec, 0xb9, 0xf7,
-	0x9a, 0x6c, 0x64, 0xc2, 0xbb, 0xcb, 0xa3, 0x0c, 0xf7, 0xb2, 0x8d, 0x4f, 0x84, 0xdb, 0xd2, 0x78,
-	0xe1, 0xf6, 0x5d, 0x04, 0x15, 0x31, 0x9a, 0x2b, 0xb8, 0x1b, 0x28, 0xb3, 0x3b, 0xfd, 0x74, 0xee,
-	0x94, 0x9c, 0x4c, 0x91, 0xcf, 0x72, 0xb1, 0x2b, 0xb8, 0x51, 0x24, 0x36, 0xf0, 0x9d, 0xa8, 0xf1,
-	0x44, 0xcc, 0xec, 0x9e, 0x36, 0x5a, 0x7e, 0x33, 0xba, 0x8e, 0xd6, 0x6f, 0x3f, 0x3b, 0x9c, 0x47,
-	0x7f, 0x3b, 0x9c, 0x47, 0xff, 0x3c, 0x9c, 0x47, 0xdf, 0xf8, 0xf4, 0x18, 0x7f, 0xb3, 0xb0, 0x5b,
-	0x2e, 0xf5, 0x62, 0x55, 0xc4, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x3d, 0xfc, 0xaf, 0xbf, 0x5f,
-	0x22, 0x00, 0x00,
+	// 2422 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcd, 0x8f, 0x1b, 0x49,
+	0x15, 0xa7, 0x3c, 0x9e, 0xb1, 0xfd, 0x26, 0xd9, 0xdd, 0x54, 0x36, 0xa1, 0xd7, 0x99, 0xcc, 0x58,
+	0x9d, 0xaf, 0xc9, 0x24, 0x63, 0x27, 0x43, 0x58, 0x2d, 0xb3, 0xa0, 0x90, 0x2f, 0x26, 0x81, 0x24,
+	0x0c, 0x3d, 0x09, 0x91, 0x16, 0x21, 0x54, 0xe9, 0xae, 0xf1, 0x34, 0xd3, 0xee, 0x6e, 0xba, 0xda,
+	0x8e, 0xac, 0x28, 0x97, 0xe5, 0x82, 0x10, 0x62, 0xb5, 0x82, 0x03, 0x20, 0x3e, 0x56, 0x20, 0x24,
+	0x0e, 0xdc, 0x80, 0x0b, 0x87, 0xe5, 0x80, 0x84, 0x56, 0x9c, 0x10, 0xec, 0x81, 0x53, 0x84, 0x22,
+	0xfe, 0x80, 0x3d, 0x71, 0x46, 0x55, 0x5d, 0xd5, 0x5d, 0xed, 0xb1, 0xdb, 0xce, 0x8e, 0x11, 0xca,
+	0xad, 0xeb, 0x55, 0xd5, 0x7b, 0xbf, 0xf7, 0xea, 0x7d, 0x54, 0x3d, 0x1b, 0x4e, 0x32, 0x1a, 0xf5,
+	0x68, 0xd4, 0x22, 0x61, 0xe8, 0xb9, 0x36, 0x89, 0xdd, 0xc0, 0xd7, 0xbf, 0x9b, 0x61, 0x14, 0xc4,
+	0x01, 0x9e, 0xd7, 0x48, 0xf5, 0x57, 0xdb, 0x41, 0x3b, 0x10, 0xf4, 0x16, 0xff, 0x4a, 0x96, 0xd4,
+	0x17, 0xda, 0x41, 0xd0, 0xf6, 0x68, 0x8b, 0x84, 0x6e, 0x8b, 0xf8, 0x7e, 0x10, 0x8b, 0xc5, 0x4c,
+	0xce, 0x9a, 0xbb, 0x6f, 0xb0, 0xa6, 0x1b, 0x88, 0x59, 0x3b, 0x88, 0x68, 0xab, 0x77, 0xb1, 0xd5,
+	0xa6, 0x3e, 0x8d, 0x48, 0x4c, 0x1d, 0xb9, 0xe6, 0x52, 0xb6, 0xa6, 0x43, 0xec, 0x1d, 0xd7, 0xa7,
+	0x51, 0xbf, 0x15, 0xee, 0xb6, 0x39, 0x81, 0xb5, 0x3a, 0x34, 0x26, 0xc3, 0x76, 0xdd, 0x6a, 0xbb,
+	0xf1, 0x4e, 0xf7, 0x61, 0xd3, 0x0e, 0x3a, 0x2d, 0x12, 0x09, 0x60, 0xdf, 0x14, 0x1f, 0xab, 0xb6,
+	0x93, 0xed, 0xd6, 0xd5, 0xeb, 0x5d, 0x24, 0x5e, 0xb8, 0x43, 0xf6, 0xb2, 0xba, 0x5a, 0xc4, 0x2a,
+	0xa2, 0x61, 0x20, 0x6d, 0x25, 0x3e, 0xdd, 0x38, 0x88, 0xfa, 0xda, 0x67, 0xc2, 0xc3, 0xfc, 0x23,
+	0x82, 0x57, 0xae, 0x64, 0xc2, 0xbe, 0xd2, 0xa5, 0x51, 0x1f, 0x63, 0x28, 0xfb, 0xa4, 0x43, 0x0d,
+	0xd4, 0x40, 0xcb, 0x35, 0x4b, 0x7c, 0x63, 0x03, 0x2a, 0x11, 0xdd, 0x8e, 0x28, 0xdb, 0x31, 0x4a,
+	0x82, 0xac, 0x86, 0xf8, 0x34, 0x54, 0xb8, 0x64, 0x6a, 0xc7, 0xc6, 0x4c, 0x63, 0x66, 0xb9, 0x76,
+	0xf5, 0xc0, 0xb3, 0xa7, 0x4b, 0xd5, 0xcd, 0x84, 0xc4, 0x2c, 0x35, 0x89, 0x9b, 0xf0, 0x72, 0x44,
+	0x59, 0xd0, 0x8d, 0x6c, 0xfa, 0x55, 0x1a, 0x31, 0x37, 0xf0, 0x8d, 0x32, 0xe7, 0x74, 0xb5, 0xfc,
+	0xc1, 0xd3, 0xa5, 0x4f, 0x58, 0x83, 0x93, 0xb8, 0x01, 0x55, 0x46, 0x3d, 0x6a, 0xc7, 0x41, 0x64,
+	0xcc, 0x6a, 0x0b, 0x53, 0xaa, 0xb9, 0x04, 0xb5, 0xbb, 0x81, 0x43, 0x47, 0x82, 0x36, 0x37, 0xe0,
+	0x88, 0x45, 0x7b, 0x2e, 0x67, 0x77, 0x87, 0xc6, 0xc4, 0x21, 0x31, 0x19, 0x5c, 0x5c, 0x4a, 0x35,
+	0xac, 0x43, 0x35, 0x92, 0x8b, 0x8d, 0x92, 0xa0, 0xa7, 0x63, 0x6e, 0xa6, 0x45, 0xcd, 0x4c, 0x96,
+	0x84, 0x7a, 0xa3, 0x47, 0xfd, 0x98, 0x8d, 0x66, 0xb9, 0x06, 0x87, 0x94, 0x56, 0x77, 0x49, 0x87,
+	0xb2, 0x90, 0xd8, 0x34, 0xe1, 0x2d, 0x75, 0xd9, 0x3b, 0x8d, 0x97, 0xe1, 0x80, 0x4e, 0x34, 0x66,
+	0xb4, 0xe5, 0xb9, 0x19, 0x7c, 0x1a, 0xe6, 0xd5, 0xf8, 0xfe, 0xad, 0xeb, 0x46, 0x59, 0x5b, 0xa8,
+	0x4f, 0x98, 0x9b, 0x60, 0x68, 0xd8, 0xef, 0x10, 0xdf, 0xdd, 0xa6, 0x2c, 0x1e, 0x8d, 0xba, 0x91,
+	0x33, 0x84, 0x66, 0xf8, 0xd4, 0x1c, 0x47, 0xe0, 0x70, 0xde, 0x1a, 0x61, 0xe0, 0x33, 0x6a, 0xbe,
+	0x8f, 0x72, 0x92, 0xae, 0x45, 0x94, 0xc4, 0xd4, 0xa2, 0xdf, 0xea, 0x52, 0x16, 0x63, 0x1f, 0xf4,
+	0xa8, 0x14, 0x02, 0xe7, 0xd7, 0xbe, 0xd0, 0xcc, 0x7c, 0xb8, 0xa9, 0x7c, 0x58, 0x7c, 0x7c, 0xc3,
+	0x76, 0x9a, 0xe1, 0x6e, 0xbb, 0xc9, 0xc3, 0xa1, 0xa9, 0x47, 0xb8, 0x0a, 0x87, 0xa6, 0x26, 0x49,
+	0x69, 0xad, 0xad, 0xc3, 0x47, 0x61, 0xae, 0x1b, 0x32, 0x1a, 0xc5, 0x42, 0x87, 0xaa, 0x25, 0x47,
+	0xfc, 0x98, 0x7b, 0xc4, 0x73, 0x1d, 0x12, 0x73, 0xdb, 0xf2, 0x99, 0x74, 0x6c, 0xbe, 0x97, 0x57,
+	0xe0, 0x7e, 0xe8, 0x68, 0x0a, 0xec, 0xfc, 0x0f, 0x15, 0xc8, 0x43, 0xd7, 0x21, 0x96, 0x06, 0x20,
+	0xde, 0xcc, 0x21, 0xbc, 0x4e, 0x3d, 0x9a, 0x21, 0x1c, 0x76, 0x98, 0x06, 0x54, 0x6c, 0xc2, 0x6c,
+	0xe2, 0x28, 0x56, 0x6a, 0x68, 0x7e, 0x54, 0x86, 0xa3, 0x1a, 0xab, 0xad, 0xbe, 0x6f, 0x17, 0x31,
+	0x1a, 0xeb, 0x15, 0x78, 0x01, 0xe6, 0x9c, 0xa8, 0x6f, 0x75, 0xfd, 0xc4, 0xae, 0x72, 0x5e, 0xd2,
+	0x70, 0x1d, 0x66, 0xc3, 0xa8, 0xeb, 0x53, 0xa3, 0xac, 0x4d, 0x26, 0x24, 0x6c, 0x43, 0x95, 0xc5,
+	0x3c, 0xb5, 0xb5, 0xfb, 0x22, 0xd4, 0xe7, 0xd7, 0x36, 0xf6, 0x61, 0x57, 0xae, 0xc9, 0x96, 0x64,
+	0x67, 0xa5, 0x8c, 0x71, 0x0c, 0x35, 0x15, 0x15, 0xcc, 0xa8, 0x34, 0x66, 0x96, 0xe7, 0xd7, 0x36,
+	0xf7, 0x29, 0xe5, 0xcb, 0x21, 0x8d, 0x92, 0xf3, 0x93, 0x8c, 0xa5, 0x5a, 0x99, 0x20, 0xbc, 0x00,
+	0xb5, 0x8e, 0x8c, 0x38, 0x66, 0x54, 0x79, 0x7e, 0xb4, 0x32, 0x02, 0xbe, 0x0f, 0xb3, 0xae, 0xbf,
+	0x1d, 0x30, 0xa3, 0x26, 0xf0, 0x5c, 0xde, 0x07, 0x9e, 0x5b, 0xfe, 0x76, 0x60, 0x25, 0xdc, 0xb0,
+	0x0f, 0x07, 0x23, 0x1a, 0x47, 0x7d, 0x65, 0x05, 0x03, 0x84, 0x51, 0x6f, 0xee, 0x83, 0xbd, 0xa5,
+	0xf3, 0xb3, 0xf2, 0xec, 0x79, 0x26, 0x62, 0x7d, 0xdf, 0xbe, 0xe7, 0x76, 0x68, 0xd0, 0x8d, 0x8d,
+	0x79, 0xcd, 0x3d, 0xf4, 0x09, 0xf3, 0x11, 0x1c, 0x1b, 0xf0, 0xb8, 0x4d, 0x8f, 0xa4, 0xf9, 0x23,
+	0xe7, 0x62, 0x48, 0xcb, 0x66, 0x99, 0x8b, 0xbd, 0x0e, 0xb3, 0x31, 0x61, 0xbb, 0xcc, 0x28, 0x09,
+	0x7b, 0x35, 0x72, 0x58, 0x07, 0x58, 0xdf, 0x23, 0x6c, 0xd7, 0x4a, 0x96, 0x9b, 0x7f, 0x2a, 0xc1,
+	0xe1, 0x21, 0xd3, 0xc2, 0x29, 0x77, 0x08, 0xa3, 0x39, 0x71, 0x09, 0x09, 0x1b, 0x50, 0x7e, 0x44,
+	0x7a, 0x49, 0xbe, 0x9e, 0x91, 0x53, 0x82, 0xc2, 0x71, 0xee, 0x04, 0xc1, 0xee, 0xbd, 0x7e, 0x98,
+	0xa4, 0x90, 0x14, 0xa7, 0xa2, 0xf2, 0x50, 0x20, 0x76, 0x9c, 0x94, 0xb8, 0x8c, 0xb1, 0xa4, 0x71,
+	0xa9, 0xed, 0x28, 0xe8, 0x86, 0xb9, 0xb2, 0x96, 0x90, 0xb8, 0xd4, 0x5d, 0xd7, 0x77, 0x8c, 0x39,
+	0x6d, 0x9f, 0xa0, 0x60, 0x13, 0x6a, 0x7e, 0x5a, 0x44, 0x2a, 0xda, 0xce, 0x8c, 0xcc, 0x77, 0xf3,
+	0x81, 0x51, 0xd5, 0x77, 0x8b, 0xf0, 0x5d, 0x84, 0x0a, 0xdb, 0x75, 0xc3, 0x90, 0x3a, 0x46, 0xad,
+	0x51, 0x4a, 0x03, 0x50, 0x11, 0xf9, 0x7c, 0x87, 0x32, 0x46, 0xda, 0x54, 0x38, 0x8b, 0xda, 0xac,
+	0x88, 0xe6, 0xef, 0x11, 0x2c, 0xec, 0x49, 0x8d, 0x5b, 0x21, 0x2d, 0xcc, 0x19, 0x0e, 0x94, 0x59,
+	0x48, 0x6d, 0x61, 0xc2, 0xf9, 0xb5, 0x2f, 0x4e, 0x27, 0x57, 0x72, 0xa1, 0x4a, 0x35, 0xce, 0xbd,
+	0x30, 0xa3, 0x77, 0xe0, 0x93, 0xda, 0xd6, 0x4d, 0x12, 0xdb, 0x3b, 0x45, 0x80, 0xb9, 0x3f, 0xf0,
+	0x35, 0xb9, 0x22, 0x9d, 0x90, 0xb8, 0xfd, 0xc5, 0x87, 0x3c, 0xf6, 0x6c, 0x3e, 0x23, 0x9b, 0xbf,
+	0x41, 0x50, 0xd7, 0xd3, 0x7a, 0xe0, 0x79, 0x0f, 0x89, 0xbd, 0x5b, 0x2c, 0xb2, 0xe4, 0x3a, 0x22,
+	0xa3, 0xce, 0x5c, 0x05, 0xce, 0xef, 0xd9, 0xd3, 0xa5, 0xd2, 0xad, 0xeb, 0x56, 0xc9, 0x75, 0xf6,
+	0x91, 0x51, 0xf5, 0x50, 0x9a, 0x1d, 0x5a, 0xc3, 0x3f, 0x1c, 0x80, 0x2a, 0x33, 0x56, 0x11, 0xd4,
+	0x9c, 0x07, 0xea, 0x16, 0xaa, 0xf9, 0x1f, 0xe3, 0xfa, 0xb2, 0x08, 0x95, 0x5e, 0x7a, 0x0f, 0xcc,
+	0x16, 0x29, 0xa2, 0x1e, 0x25, 0xa5, 0x89, 0xa3, 0xc4, 0xfc, 0x71, 0x09, 0x96, 0x86, 0xa8, 0x35,
+	0xf6, 0xe4, 0x5f, 0x00, 0xdd, 0x32, 0xef, 0xac, 0x8c, 0xf1, 0xce, 0xea, 0x70, 0xef, 0xfc, 0x0f,
+	0x82, 0xc6, 0x10, 0xdb, 0x8c, 0xbf, 0x44, 0xbc, 0x20, 0xc6, 0xd9, 0x0e, 0x22, 0x99, 0x1a, 0x93,
+	0x68, 0x40, 0x56, 0x42, 0x32, 0x3f, 0x42, 0x60, 0x28, 0x6d, 0xaf, 0x88, 0x1c, 0x6c, 0x75, 0xfd,
+	0x17, 0x5d, 0xe1, 0xac, 0xc6, 0x54, 0xf6, 0xd6, 0x18, 0xf3, 0x3b, 0x08, 0x8e, 0xe5, 0x55, 0x66,
+	0xb7, 0x5d, 0x16, 0xa7, 0xb5, 0xd6, 0x85, 0x4a, 0xb2, 0x92, 0x19, 0x48, 0xd4, 0xd2, 0x5b, 0xfb,
+	0xba, 0x1c, 0xe8, 0x82, 0x94, 0x7a, 0x92, 0xbf, 0x79, 0x39, 0x57, 0xf5, 0xb3, 0x44, 0x93, 0x55,
+	0x7d, 0x75, 0x21, 0xca, 0x57, 0x7d, 0x45, 0x35, 0xdf, 0x2d, 0xe7, 0xb3, 0x78, 0xe0, 0xdc, 0x0e,
+	0xda, 0x05, 0xcf, 0xae, 0x49, 0x4e, 0xcf, 0x80, 0x4a, 0x18, 0x38, 0xf2, 0xe0, 0xc4, 0x7b, 0x56,
+	0x0e, 0xf9, 0x6e, 0x3b, 0xf0, 0x63, 0xe2, 0xfa, 0x34, 0xca, 0x9d, 0x57, 0x46, 0xe6, 0x67, 0xcf,
+	0x5c, 0xdf, 0xa6, 0x5b, 0xd4, 0x0e, 0x7c, 0x87, 0x19, 0xb3, 0xda, 0x1d, 0x21, 0x37, 0x83, 0x6f,
+	0x42, 0x4d, 0x8c, 0xf9, 0x15, 0xc8, 0x98, 0x13, 0xd7, 0xb0, 0x95, 0x66, 0xd2, 0x39, 0x68, 0xea,
+	0x9d, 0x83, 0xcc, 0xc2, 0xbc, 0x73, 0xd0, 0xec, 0x5d, 0x6c, 0xf2, 0x1d, 0x56, 0xb6, 0x99, 0xe3,
+	0x8a, 0x89, 0xeb, 0xdd, 0x76, 0x7d, 0x71, 0x7f, 0xcd, 0x04, 0x66, 0x64, 0xee, 0x13, 0xdb, 0x81,
+	0xe7, 0x05, 0x8f, 0x44, 0x0a, 0x48, 0x0b, 0x46, 0x42, 0xcb, 0xfc, 0xac, 0x36, 0xfa, 0xde, 0xa1,
+	0x17, 0xff, 0xc4, 0xcf, 0x06, 0xfd, 0x5c, 0xbf, 0xdd, 0xe5, 0xfd, 0x7c, 0x05, 0x0e, 0x7a, 0xe4,
+	0x21, 0xf5, 0xb6, 0xd4, 0xb3, 0xfd, 0x80, 0xb6, 0x34, 0x3f, 0xc5, 0x4f, 0x3d, 0xe4, 0xb5, 0x28,
+	0xe8, 0x32, 0xe3, 0xa0, 0x56, 0xbf, 0x52, 0xaa, 0xd0, 0xc5, 0xf5, 0x62, 0x1a, 0x19, 0x2f, 0x69,
+	0x6c, 0x24, 0xcd, 0xfc, 0x27, 0x82, 0xea, 0xed, 0xa0, 0x7d, 0xc3, 0x8f, 0xa3, 0x3e, 0x0f, 0x30,
+	0x7e, 0x36, 0xd4, 0xcf, 0x7b, 0x90, 0x22, 0xe2, 0xbb, 0x50, 0x8b, 0xdd, 0x0e, 0xdd, 0x8a, 0x49,
+	0x27, 0x94, 0x97, 0x91, 0xe7, 0x38, 0x84, 0xd4, 0xcc, 0x8a, 0x05, 0x37, 0x96, 0x47, 0x58, 0x2c,
+	0x42, 0x5e, 0x01, 0x17, 0x14, 0x8e, 0x44, 0xb9, 0x95, 0xde, 0xdc, 0x18, 0xee, 0x5c, 0x7a, 0x61,
+	0xce, 0xc8, 0x66, 0x0b, 0x5e, 0x4b, 0x1f, 0x16, 0xf7, 0x68, 0xd4, 0x71, 0x7d, 0x52, 0x98, 0x9e,
+	0xcd, 0x8b, 0x7b, 0xae, 0xd5, 0x0f, 0x5c, 0xdf, 0x09, 0x1e, 0x8d, 0x0e, 0x11, 0xf3, 0xef, 0xf9,
+	0x86, 0x86, 0xb6, 0x27, 0x8d, 0xcb, 0x9b, 0x70, 0x90, 0x47, 0x70, 0x8f, 0xca, 0x09, 0x99, 0x27,
+	0xcc, 0xa2, 0x3b, 0x77, 0xb2, 0xd4, 0xca, 0x6f, 0xc4, 0xb7, 0xe1, 0x65, 0xc2, 0x98, 0xdb, 0xf6,
+	0xa9, 0xa3, 0x78, 0x95, 0x26, 0xe6, 0x35, 0xb8, 0x35, 0x79, 0xd1, 0x8a, 0x15, 0x89, 0xfd, 0x2d,
+	0x35, 0x34, 0xbf, 0x8d, 0xe0, 0xc8, 0x50, 0x26, 0xdc, 0x04, 0xc2, 0xbb, 0xa5, 0x09, 0x64, 0xc1,
+	0xa8, 0x32, 0x7b, 0x87, 0x3a, 0x5d, 0x8f, 0xaa, 0x7e, 0x8f, 0x1a, 0xf3, 0x39, 0xa7, 0x9b, 0x9c,
+	0x40, 0x92, 0xd7, 0xad, 0x74, 0x8c, 0x17, 0x01, 0x3a, 0xc4, 0xef, 0x12, 0x4f, 0x40, 0x28, 0x0b,
+	0x08, 0x1a, 0xc5, 0x5c, 0x80, 0xfa, 0xb0, 0xe3, 0x93, 0x3d, 0x92, 0x0f, 0x11, 0xbc, 0xa4, 0x52,
+	0xa0, 0x3c, 0x9f, 0x26, 0xbc, 0xac, 0x99, 0xe1, 0x6e, 0x7a, 0x54, 0xb2, 0x86, 0x0d, 0x4e, 0x0e,
+	0xa6, 0xb7, 0xc2, 0x87, 0x80, 0xfe, 0x3c, 0x49, 0x1f, 0x02, 0xbd, 0x21, 0xed, 0xb7, 0x61, 0xc5,
+	0xa8, 0xe0, 0x71, 0x32, 0x90, 0x24, 0xcc, 0x3e, 0x18, 0x77, 0x88, 0x4f, 0xda, 0xd4, 0x49, 0x95,
+	0x4b, 0x1d, 0xe9, 0xeb, 0x30, 0xeb, 0xc6, 0xb4, 0xa3, 0x1c, 0x68, 0x63, 0x0a, 0x85, 0xe6, 0xba,
+	0xbb, 0xbd, 0x6d, 0x25, 0x5c, 0xd7, 0xfe, 0xba, 0x04, 0x58, 0x3f, 0x75, 0x1a, 0xf5, 0x5c, 0x9b,
+	0xe2, 0x77, 0x10, 0x94, 0x79, 0xc5, 0xc3, 0xc7, 0x47, 0x39, 0x99, 0xb0, 0x7e, 0x7d, 0x4a, 0xaf,
+	0x12, 0x2e, 0xca, 0x5c, 0x78, 0xfb, 0x1f, 0xff, 0xfe, 0x41, 0xe9, 0x28, 0x7e, 0x55, 0x34, 0x8e,
+	0x7b, 0x17, 0xf5, 0x3e, 0x2e, 0xc3, 0xdf, 0x43, 0x80, 0x65, 0x0d, 0xd6, 0xba, 0x87, 0xf8, 0xdc,
+	0x28, 0x7c, 0x43, 0xba, 0x8c, 0xf5, 0xe3, 0x5a, 0xda, 0x6a, 0xda, 0x41, 0x44, 0x79, 0x92, 0x12,
+	0x0b, 0x04, 0x80, 0x15, 0x01, 0xe0, 0x24, 0x36, 0x87, 0x01, 0x68, 0x3d, 0xe6, 0x0e, 0xf0, 0xa4,
+	0x45, 0x13, 0xb9, 0xbf, 0x40, 0x30, 0xfb, 0x40, 0xdc, 0x1d, 0xc7, 0x58, 0x68, 0x73, 0x3a, 0x16,
+	0x12, 0xb2, 0x04, 0x54, 0xf3, 0x84, 0x80, 0x79, 0x1c, 0x1f, 0x53, 0x30, 0x59, 0x1c, 0x51, 0xd2,
+	0xc9, 0xa1, 0xbd, 0x80, 0xf0, 0x2f, 0x11, 0xcc, 0x25, 0x4d, 0x44, 0x7c, 0x6a, 0x14, 0xc4, 0x5c,
+	0x93, 0xb1, 0x3e, 0xa5, 0x76, 0x9c, 0x79, 0x56, 0x00, 0x3c, 0x61, 0x0e, 0x3d, 0xc8, 0xf5, 0x5c,
+	0xb3, 0xee, 0x5d, 0x04, 0x33, 0x1b, 0x74, 0xac, 0x9b, 0x4d, 0x0b, 0xd9, 0x1e, 0xd3, 0x0d, 0x39,
+	0x61, 0xfc, 0x2b, 0x04, 0xaf, 0x6d, 0xd0, 0x78, 0x78, 0x82, 0xc7, 0xcb, 0xe3, 0xb3, 0xae, 0xf4,
+	0xb6, 0x73, 0x13, 0xac, 0x4c, 0x33, 0x5b, 0x4b, 0x20, 0x3b, 0x8b, 0xcf, 0x14, 0xf9, 0x1e, 0xef,
+	0x06, 0x3d, 0x92, 0x38, 0xfe, 0x82, 0xe0, 0x95, 0xc1, 0xf6, 0x3c, 0xce, 0x97, 0x84, 0xa1, 0xdd,
+	0xfb, 0xfa, 0x97, 0xf6, 0x95, 0x41, 0xf2, 0x1c, 0xcd, 0x2b, 0x02, 0xf6, 0x9b, 0xf8, 0x33, 0x45,
+	0xb0, 0xd5, 0xab, 0x99, 0xb5, 0x1e, 0xab, 0xcf, 0x27, 0xad, 0x8e, 0x64, 0x81, 0xdf, 0x46, 0x70,
+	0x60, 0x83, 0xc6, 0x77, 0xd2, 0xb6, 0xde, 0x48, 0x6f, 0xcd, 0x35, 0xdf, 0xeb, 0x0b, 0x4d, 0xed,
+	0xf7, 0x18, 0x35, 0x95, 0xda, 0x73, 0x55, 0x00, 0x3b, 0x83, 0x4f, 0x15, 0x01, 0xcb, 0x5a, 0x89,
+	0xef, 0x23, 0x98, 0x4b, 0xba, 0x32, 0xa3, 0xc5, 0xe7, 0x1a, 0xda, 0x53, 0x73, 0xc9, 0x1b, 0x02,
+	0xe8, 0xe5, 0xfa, 0x85, 0xe1, 0x40, 0xf5, 0xfd, 0xca, 0x64, 0x4d, 0x81, 0x3e, 0x1f, 0x48, 0xbf,
+	0x43, 0x00, 0x59, 0x5b, 0x09, 0x9f, 0x2d, 0x56, 0x42, 0x6b, 0x3d, 0xd5, 0xa7, 0xd8, 0x58, 0x32,
+	0x9b, 0x42, 0x99, 0xe5, 0x7a, 0xa3, 0xd0, 0x8b, 0x43, 0x6a, 0xaf, 0x27, 0xcd, 0xa7, 0x9f, 0x21,
+	0x98, 0x15, 0xcd, 0x05, 0x7c, 0x72, 0x14, 0x60, 0xbd, 0xf7, 0x30, 0x35, 0xa3, 0x9f, 0x16, 0x38,
+	0x1b, 0x6b, 0x45, 0x79, 0x60, 0x1d, 0xad, 0xe0, 0x1e, 0xcc, 0x25, 0xef, 0xfb, 0xd1, 0x5e, 0x91,
+	0x7b, 0xff, 0xd7, 0x1b, 0x05, 0xe5, 0x28, 0x71, 0x4c, 0x99, 0x82, 0x56, 0x0a, 0x53, 0xd0, 0x7b,
+	0x08, 0xca, 0x3c, 0x4b, 0xe0, 0x13, 0x45, 0x39, 0x64, 0xda, 0x56, 0x39, 0x27, 0xa0, 0x9d, 0x32,
+	0x1b, 0xe3, 0x72, 0x10, 0x37, 0xcd, 0x77, 0x11, 0x54, 0x55, 0x0f, 0x7a, 0x32, 0x98, 0x85, 0x99,
+	0x53, 0x6f, 0x65, 0xab, 0x64, 0x68, 0x9e, 0x1c, 0x07, 0x24, 0xf4, 0x88, 0xcf, 0xc1, 0xfc, 0x08,
+	0xc1, 0x2b, 0x83, 0x37, 0x28, 0x7c, 0x6c, 0x20, 0x19, 0xea, 0xd7, 0xc6, 0x7a, 0xfe, 0x3c, 0x47,
+	0xdd, 0xbe, 0xcc, 0xcf, 0x0b, 0x24, 0xeb, 0xf8, 0x8d, 0xb1, 0xd1, 0x79, 0x57, 0x65, 0x14, 0xce,
+	0x68, 0x35, 0xfb, 0x09, 0xe3, 0x0f, 0x08, 0x0e, 0x28, 0xbe, 0xf7, 0x22, 0x4a, 0x8b, 0x61, 0x4d,
+	0x29, 0x18, 0xb9, 0x20, 0xf3, 0xb3, 0x02, 0xfb, 0xeb, 0xf8, 0xd2, 0x84, 0xd8, 0x15, 0xe6, 0xd5,
+	0x98, 0xc3, 0xfc, 0x33, 0x82, 0x43, 0x0f, 0x92, 0xd8, 0xfb, 0x7f, 0x80, 0xbf, 0x26, 0xc0, 0x7f,
+	0x0e, 0xbf, 0x59, 0x70, 0xc9, 0x19, 0xa7, 0xc3, 0x05, 0x84, 0x7f, 0x8b, 0xa0, 0xaa, 0xfa, 0xc8,
+	0xf8, 0xcc, 0xc8, 0xe0, 0xcc, 0x77, 0x9a, 0xa7, 0x16, 0x50, 0x13, 0xf9, 0x71, 0x24, 0x85, 0x73,
+	0x3f, 0xfe, 0x21, 0x02, 0x9c, 0xbe, 0x7a, 0xd2, 0x77, 0x10, 0x3e, 0x9d, 0x13, 0x35, 0xf2, 0x79,
+	0x5b, 0x3f, 0x33, 0x76, 0x5d, 0xbe, 0x3a, 0xae, 0x14, 0x56, 0xc7, 0x20, 0x95, 0xff, 0x7d, 0x04,
+	0xf3, 0x1b, 0x34, 0xbd, 0x7a, 0x17, 0x18, 0x32, 0xdf, 0x07, 0xaf, 0x2f, 0x8f, 0x5f, 0x28, 0x11,
+	0x9d, 0x17, 0x88, 0x4e, 0xe3, 0x62, 0x53, 0x29, 0x00, 0x3f, 0x45, 0x70, 0x70, 0x53, 0x77, 0x4e,
+	0x7c, 0x7e, 0x9c, 0xa4, 0x5c, 0x1d, 0x99, 0x1c, 0xd7, 0xa7, 0x04, 0xae, 0x55, 0x73, 0x22, 0x5c,
+	0xeb, 0xb2, 0x9d, 0xfc, 0x73, 0x04, 0x87, 0xf5, 0xb7, 0x8a, 0x6c, 0x21, 0x7e, 0x5c, 0xbb, 0x15,
+	0x74, 0x22, 0xcd, 0x4b, 0x02, 0x5f, 0x13, 0x9f, 0x9f, 0x04, 0x5f, 0x4b, 0x36, 0x15, 0xf1, 0x4f,
+	0x10, 0x1c, 0x12, 0x4d, 0x5c, 0x9d, 0xf1, 0x40, 0x8d, 0x1b, 0xd5, 0xf2, 0x9d, 0xa0, 0xc6, 0xc9,
+	0xcc, 0x63, 0x3e, 0x17, 0xa8, 0x75, 0xf5, 0x03, 0xdf, 0x3b, 0x08, 0x5e, 0x52, 0x55, 0x55, 0x9e,
+	0xee, 0xea, 0x38, 0xc3, 0x3d, 0x6f, 0x15, 0x96, 0xee, 0xb6, 0x32, 0x99, 0xbb, 0xfd, 0x1a, 0x41,
+	0x45, 0xf6, 0x4d, 0x0b, 0x2e, 0x2a, 0x5a, 0x63, 0xb5, 0x7e, 0x24, 0xb7, 0x4a, 0xb5, 0xda, 0xcc,
+	0xaf, 0x09, 0xb1, 0xf7, 0x71, 0xab, 0x48, 0x6c, 0x18, 0x38, 0xac, 0xf5, 0x58, 0xf6, 0xbc, 0x9e,
+	0xb4, 0xbc, 0xa0, 0xcd, 0xde, 0x32, 0x71, 0x61, 0x51, 0xe6, 0x6b, 0x2e, 0xa0, 0xab, 0xd7, 0x3e,
+	0x78, 0xb6, 0x88, 0xfe, 0xf6, 0x6c, 0x11, 0xfd, 0xeb, 0xd9, 0x22, 0x7a, 0xeb, 0xd3, 0x13, 0xfc,
+	0x59, 0xca, 0xf6, 0x5c, 0xea, 0xc7, 0x3a, 0xcf, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xd4, 0xfe,
+	0x00, 0xcc, 0x25, 0x26, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2132,6 +2411,8 @@ type ApplicationServiceClient interface {
 	Delete(ctx context.Context, in *ApplicationDeleteRequest, opts ...grpc.CallOption) (*ApplicationResponse, error)
 	// Sync syncs an application to its target state
 	Sync(ctx context.Context, in *ApplicationSyncRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
+	// SyncPlan returns the ordered list of tasks a sync would execute without mutating the application
+	SyncPlan(ctx context.Context, in *ApplicationSyncRequest, opts ...grpc.CallOption) (*ApplicationSyncPlanResponse, error)
 	// ManagedResources returns list of managed resources
 	ManagedResources(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*ManagedResourcesResponse, error)
 	// ResourceTree returns resource tree
@@ -2152,7 +2433,7 @@ type ApplicationServiceClient interface {
 	RunResourceAction(ctx context.Context, in *ResourceActionRunRequest, opts ...grpc.CallOption) (*ApplicationResponse, error)
 	// DeleteResource deletes a single application resource
 	DeleteResource(ctx context.Context, in *ApplicationResourceDeleteRequest, opts ...grpc.CallOption) (*ApplicationResponse, error)
-	// PodLogs returns stream of log entries for the specified pod. Pod
+	// PodLogs returns stream of log entries for the specified pod, or of all matching pods of the application
 	PodLogs(ctx context.Context, in *ApplicationPodLogsQuery, opts ...grpc.CallOption) (ApplicationService_PodLogsClient, error)
 }
 
@@ -2304,6 +2585,15 @@ func (c *applicationServiceClient) Sync(ctx context.Context, in *ApplicationSync
 	return out, nil
 }
 
+func (c *applicationServiceClient) SyncPlan(ctx context.Context, in *ApplicationSyncRequest, opts ...grpc.CallOption) (*ApplicationSyncPlanResponse, error) {
+	out := new(ApplicationSyncPlanResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/SyncPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationServiceClient) ManagedResources(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*ManagedResourcesResponse, error) {
 	out := new(ManagedResourcesResponse)
 	err := c.cc.Invoke(ctx, "/application.ApplicationService/ManagedResources", in, out, opts...)
@@ -2477,6 +2767,8 @@ type ApplicationServiceServer interface {
 	Delete(context.Context, *ApplicationDeleteRequest) (*ApplicationResponse, error)
 	// Sync syncs an application to its target state
 	Sync(context.Context, *ApplicationSyncRequest) (*v1alpha1.Application, error)
+	// SyncPlan returns the ordered list of tasks a sync would execute without mutating the application
+	SyncPlan(context.Context, *ApplicationSyncRequest) (*ApplicationSyncPlanResponse, error)
 	// ManagedResources returns list of managed resources
 	ManagedResources(context.Context, *ResourcesQuery) (*ManagedResourcesResponse, error)
 	// ResourceTree returns resource tree
@@ -2497,7 +2789,7 @@ type ApplicationServiceServer interface {
 	RunResourceAction(context.Context, *ResourceActionRunRequest) (*ApplicationResponse, error)
 	// DeleteResource deletes a single application resource
 	DeleteResource(context.Context, *ApplicationResourceDeleteRequest) (*ApplicationResponse, error)
-	// PodLogs returns stream of log entries for the specified pod. Pod
+	// PodLogs returns stream of log entries for the specified pod, or of all matching pods of the application
 	PodLogs(*ApplicationPodLogsQuery, ApplicationService_PodLogsServer) error
 }
 
@@ -2544,6 +2836,9 @@ func (*UnimplementedApplicationServiceServer) Delete(ctx context.Context, req *A
 func (*UnimplementedApplicationServiceServer) Sync(ctx context.Context, req *ApplicationSyncRequest) (*v1alpha1.Application, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Sync not implemented")
 }
+func (*UnimplementedApplicationServiceServer) SyncPlan(ctx context.Context, req *ApplicationSyncRequest) (*ApplicationSyncPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncPlan not implemented")
+}
 func (*UnimplementedApplicationServiceServer) ManagedResources(ctx context.Context, req *ResourcesQuery) (*ManagedResourcesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ManagedResources not implemented")
 }
@@ -2819,6 +3114,24 @@ func _ApplicationService_Sync_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationService_SyncPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).SyncPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/SyncPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).SyncPlan(ctx, req.(*ApplicationSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApplicationService_ManagedResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResourcesQuery)
 	if err := dec(in); err != nil {
@@ -3075,6 +3388,10 @@ var _ApplicationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Sync",
 			Handler:    _ApplicationService_Sync_Handler,
 		},
+		{
+			MethodName: "SyncPlan",
+			Handler:    _ApplicationService_SyncPlan_Handler,
+		},
 		{
 			MethodName: "ManagedResources",
 			Handler:    _ApplicationService_ManagedResources_Handler,
@@ -3568,6 +3885,11 @@ func (m *ApplicationSyncRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	i -= len(m.SyncTimeout)
+	copy(dAtA[i:], m.SyncTimeout)
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.SyncTimeout)))
+	i--
+	dAtA[i] = 0x5a
 	if m.RetryStrategy != nil {
 		{
 			size, err := m.RetryStrategy.MarshalToSizedBuffer(dAtA[:i])
@@ -3662,7 +3984,7 @@ func (m *ApplicationSyncRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationUpdateSpecRequest) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationSyncPlanResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3672,12 +3994,12 @@ func (m *ApplicationUpdateSpecRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplicationUpdateSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationSyncPlanResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationUpdateSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationSyncPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3686,21 +4008,145 @@ func (m *ApplicationUpdateSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Validate != nil {
-		i--
-		if *m.Validate {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.Tasks) > 0 {
+		for iNdEx := len(m.Tasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x18
 	}
-	{
-		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
+	i -= len(m.Revision)
+	copy(dAtA[i:], m.Revision)
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Revision)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationSyncTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSyncTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSyncTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x52
+	i--
+	if m.Skipped {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x48
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x42
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.Group)
+	copy(dAtA[i:], m.Group)
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Group)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Action)
+	copy(dAtA[i:], m.Action)
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Action)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.HookType)
+	copy(dAtA[i:], m.HookType)
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.HookType)))
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintApplication(dAtA, i, uint64(m.Wave))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationUpdateSpecRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationUpdateSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationUpdateSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Validate != nil {
+		i--
+		if *m.Validate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
 		i -= size
 		i = encodeVarintApplication(dAtA, i, uint64(size))
 	}
@@ -3788,6 +4234,11 @@ func (m *ApplicationRollbackRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	i -= len(m.Revision)
+	copy(dAtA[i:], m.Revision)
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Revision)))
+	i--
+	dAtA[i] = 0x2a
 	i--
 	if m.Prune {
 		dAtA[i] = 1
@@ -4185,6 +4636,39 @@ func (m *ApplicationPodLogsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	i -= len(m.Filter)
+	copy(dAtA[i:], m.Filter)
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Filter)))
+	i--
+	dAtA[i] = 0x72
+	i--
+	if m.Previous {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x68
+	i -= len(m.LabelSelector)
+	copy(dAtA[i:], m.LabelSelector)
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.LabelSelector)))
+	i--
+	dAtA[i] = 0x62
+	i -= len(m.ResourceName)
+	copy(dAtA[i:], m.ResourceName)
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.ResourceName)))
+	i--
+	dAtA[i] = 0x5a
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0x52
+	i -= len(m.Group)
+	copy(dAtA[i:], m.Group)
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Group)))
+	i--
+	dAtA[i] = 0x4a
 	i--
 	if m.Follow {
 		dAtA[i] = 1
@@ -4216,9 +4700,7 @@ func (m *ApplicationPodLogsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	i = encodeVarintApplication(dAtA, i, uint64(len(m.Container)))
 	i--
 	dAtA[i] = 0x22
-	if m.PodName == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("podName")
-	} else {
+	if m.PodName != nil {
 		i -= len(*m.PodName)
 		copy(dAtA[i:], *m.PodName)
 		i = encodeVarintApplication(dAtA, i, uint64(len(*m.PodName)))
@@ -4266,6 +4748,16 @@ func (m *LogEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	i -= len(m.Container)
+	copy(dAtA[i:], m.Container)
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.Container)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.PodName)
+	copy(dAtA[i:], m.PodName)
+	i = encodeVarintApplication(dAtA, i, uint64(len(m.PodName)))
+	i--
+	dAtA[i] = 0x22
 	i--
 	if m.Last {
 		dAtA[i] = 1
@@ -4853,6 +5345,58 @@ func (m *ApplicationSyncRequest) Size() (n int) {
 		l = m.RetryStrategy.Size()
 		n += 1 + l + sovApplication(uint64(l))
 	}
+	l = len(m.SyncTimeout)
+	n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSyncPlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Revision)
+	n += 1 + l + sovApplication(uint64(l))
+	if len(m.Tasks) > 0 {
+		for _, e := range m.Tasks {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSyncTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovApplication(uint64(l))
+	n += 1 + sovApplication(uint64(m.Wave))
+	l = len(m.HookType)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Action)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Group)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovApplication(uint64(l))
+	n += 2
+	l = len(m.Message)
+	n += 1 + l + sovApplication(uint64(l))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4913,6 +5457,8 @@ func (m *ApplicationRollbackRequest) Size() (n int) {
 	n += 1 + sovApplication(uint64(m.ID))
 	n += 2
 	n += 2
+	l = len(m.Revision)
+	n += 1 + l + sovApplication(uint64(l))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5089,6 +5635,17 @@ func (m *ApplicationPodLogsQuery) Size() (n int) {
 	}
 	n += 1 + sovApplication(uint64(m.TailLines))
 	n += 2
+	l = len(m.Group)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.ResourceName)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.LabelSelector)
+	n += 1 + l + sovApplication(uint64(l))
+	n += 2
+	l = len(m.Filter)
+	n += 1 + l + sovApplication(uint64(l))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5106,6 +5663,10 @@ func (m *LogEntry) Size() (n int) {
 	l = m.TimeStamp.Size()
 	n += 1 + l + sovApplication(uint64(l))
 	n += 2
+	l = len(m.PodName)
+	n += 1 + l + sovApplication(uint64(l))
+	l = len(m.Container)
+	n += 1 + l + sovApplication(uint64(l))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6741,6 +7302,38 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncTimeout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncTimeout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -6769,7 +7362,7 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationUpdateSpecRequest) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSyncPlanResponse) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -6793,15 +7386,15 @@ func (m *ApplicationUpdateSpecRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationUpdateSpecRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSyncPlanResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationUpdateSpecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSyncPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6829,13 +7422,12 @@ func (m *ApplicationUpdateSpecRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Name = &s
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6862,23 +7454,523 @@ func (m *ApplicationUpdateSpecRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tasks = append(m.Tasks, &ApplicationSyncTask{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000002)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validate", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("revision")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSyncTask) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSyncTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSyncTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wave", wireType)
+			}
+			m.Wave = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Wave |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000004)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000008)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000010)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skipped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Skipped = bool(v != 0)
+			hasFields[0] |= uint64(0x00000020)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("phase")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("wave")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("action")
+	}
+	if hasFields[0]&uint64(0x00000008) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
+	}
+	if hasFields[0]&uint64(0x00000010) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+	if hasFields[0]&uint64(0x00000020) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("skipped")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationUpdateSpecRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationUpdateSpecRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationUpdateSpecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
 				b := dAtA[iNdEx]
 				iNdEx++
 				v |= int(b&0x7F) << shift
@@ -7166,7 +8258,6 @@ func (m *ApplicationRollbackRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			hasFields[0] |= uint64(0x00000002)
 		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
@@ -7206,7 +8297,39 @@ func (m *ApplicationRollbackRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Prune = bool(v != 0)
+			m.Prune = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -7229,9 +8352,6 @@ func (m *ApplicationRollbackRequest) Unmarshal(dAtA []byte) error {
 	if hasFields[0]&uint64(0x00000001) == 0 {
 		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
 	}
-	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("id")
-	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
@@ -8764,7 +9884,6 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.PodName = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000004)
 		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
@@ -8797,7 +9916,7 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 			}
 			m.Container = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000008)
+			hasFields[0] |= uint64(0x00000004)
 		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SinceSeconds", wireType)
@@ -8817,7 +9936,7 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			hasFields[0] |= uint64(0x00000010)
+			hasFields[0] |= uint64(0x00000008)
 		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SinceTime", wireType)
@@ -8873,7 +9992,7 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			hasFields[0] |= uint64(0x00000020)
+			hasFields[0] |= uint64(0x00000010)
 		case 8:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Follow", wireType)
@@ -8894,7 +10013,187 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Follow = bool(v != 0)
-			hasFields[0] |= uint64(0x00000040)
+			hasFields[0] |= uint64(0x00000020)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LabelSelector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Previous", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Previous = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -8921,18 +10220,15 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("namespace")
 	}
 	if hasFields[0]&uint64(0x00000004) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("podName")
-	}
-	if hasFields[0]&uint64(0x00000008) == 0 {
 		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("container")
 	}
-	if hasFields[0]&uint64(0x00000010) == 0 {
+	if hasFields[0]&uint64(0x00000008) == 0 {
 		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("sinceSeconds")
 	}
-	if hasFields[0]&uint64(0x00000020) == 0 {
+	if hasFields[0]&uint64(0x00000010) == 0 {
 		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("tailLines")
 	}
-	if hasFields[0]&uint64(0x00000040) == 0 {
+	if hasFields[0]&uint64(0x00000020) == 0 {
 		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("follow")
 	}
 
@@ -9059,6 +10355,70 @@ func (m *LogEntry) Unmarshal(dAtA []byte) error {
 			}
 			m.Last = bool(v != 0)
 			hasFields[0] |= uint64(0x00000004)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Container = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
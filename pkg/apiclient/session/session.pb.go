@@ -357,7 +357,7 @@ type SessionServiceClient interface {
 	GetUserInfo(ctx context.Context, in *GetUserInfoRequest, opts ...grpc.CallOption) (*GetUserInfoResponse, error)
 	// Create a new JWT for authentication and set a cookie if using HTTP
 	Create(ctx context.Context, in *SessionCreateRequest, opts ...grpc.CallOption) (*SessionResponse, error)
-	// Delete an existing JWT cookie if using HTTP
+	// Delete revokes the session of the current token and deletes an existing JWT cookie if using HTTP
 	Delete(ctx context.Context, in *SessionDeleteRequest, opts ...grpc.CallOption) (*SessionResponse, error)
 }
 
@@ -402,7 +402,7 @@ type SessionServiceServer interface {
 	GetUserInfo(context.Context, *GetUserInfoRequest) (*GetUserInfoResponse, error)
 	// Create a new JWT for authentication and set a cookie if using HTTP
 	Create(context.Context, *SessionCreateRequest) (*SessionResponse, error)
-	// Delete an existing JWT cookie if using HTTP
+	// Delete revokes the session of the current token and deletes an existing JWT cookie if using HTTP
 	Delete(context.Context, *SessionDeleteRequest) (*SessionResponse, error)
 }
 
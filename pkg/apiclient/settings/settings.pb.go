@@ -90,9 +90,11 @@ type Settings struct {
 	ConfigManagementPlugins []*v1alpha1.ConfigManagementPlugin `protobuf:"bytes,12,rep,name=configManagementPlugins,proto3" json:"configManagementPlugins,omitempty"`
 	KustomizeVersions       []string                           `protobuf:"bytes,13,rep,name=kustomizeVersions,proto3" json:"kustomizeVersions,omitempty"`
 	UiCssURL                string                             `protobuf:"bytes,14,opt,name=uiCssURL,proto3" json:"uiCssURL,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{}                           `json:"-"`
-	XXX_unrecognized        []byte                             `json:"-"`
-	XXX_sizecache           int32                              `json:"-"`
+	// The method used to track the resources of applications: label, annotation or annotation+label
+	TrackingMethod       string   `protobuf:"bytes,15,opt,name=trackingMethod,proto3" json:"trackingMethod,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Settings) Reset()         { *m = Settings{} }
@@ -226,6 +228,13 @@ func (m *Settings) GetUiCssURL() string {
 	return ""
 }
 
+func (m *Settings) GetTrackingMethod() string {
+	if m != nil {
+		return m.TrackingMethod
+	}
+	return ""
+}
+
 type GoogleAnalyticsConfig struct {
 	TrackingID           string   `protobuf:"bytes,1,opt,name=trackingID,proto3" json:"trackingID,omitempty"`
 	AnonymizeUsers       bool     `protobuf:"varint,2,opt,name=anonymizeUsers,proto3" json:"anonymizeUsers,omitempty"`
@@ -593,66 +602,67 @@ func init() {
 func init() { proto.RegisterFile("server/settings/settings.proto", fileDescriptor_a480d494da040caa) }
 
 var fileDescriptor_a480d494da040caa = []byte{
-	// 942 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xd7, 0xc6, 0x69, 0x6c, 0x3f, 0xd7, 0x71, 0x32, 0xdf, 0x2f, 0x61, 0xb1, 0x2a, 0xdb, 0xf8,
-	0x50, 0x19, 0x09, 0x76, 0x89, 0x7b, 0x00, 0x21, 0x10, 0x60, 0xbb, 0x6a, 0x4d, 0x5c, 0xa5, 0x9d,
-	0x36, 0x3d, 0x20, 0xa1, 0x68, 0xb2, 0x1e, 0x36, 0x83, 0xd7, 0x33, 0xab, 0x99, 0x59, 0x53, 0x73,
-	0xe4, 0x86, 0x38, 0x21, 0xfe, 0x29, 0x8e, 0x48, 0xdc, 0x2d, 0x64, 0xf1, 0x1f, 0xf0, 0x0f, 0xa0,
-	0x9d, 0xfd, 0x91, 0x8d, 0x6d, 0x2a, 0xa4, 0xde, 0xde, 0xbc, 0xcf, 0xfb, 0x35, 0x6f, 0x3e, 0xf3,
-	0x1e, 0xb4, 0x14, 0x95, 0x0b, 0x2a, 0x5d, 0x45, 0xb5, 0x66, 0xdc, 0x57, 0xb9, 0xe0, 0x84, 0x52,
-	0x68, 0x81, 0xca, 0x5e, 0x10, 0x29, 0x4d, 0x65, 0xf3, 0xff, 0xbe, 0xf0, 0x85, 0xd1, 0xb9, 0xb1,
-	0x94, 0xc0, 0xcd, 0x7b, 0xbe, 0x10, 0x7e, 0x40, 0x5d, 0x12, 0x32, 0x97, 0x70, 0x2e, 0x34, 0xd1,
-	0x4c, 0xf0, 0xd4, 0xb9, 0x39, 0xf6, 0x99, 0xbe, 0x8e, 0xae, 0x1c, 0x4f, 0xcc, 0x5d, 0x22, 0x8d,
-	0xfb, 0x77, 0x46, 0xf8, 0xc0, 0x9b, 0xba, 0xe1, 0xcc, 0x8f, 0xdd, 0x94, 0x4b, 0xc2, 0x30, 0x60,
-	0x9e, 0x71, 0x74, 0x17, 0xa7, 0x24, 0x08, 0xaf, 0xc9, 0xa9, 0xeb, 0x53, 0x4e, 0x25, 0xd1, 0x74,
-	0x9a, 0x86, 0xfa, 0xec, 0x75, 0xa1, 0x36, 0xef, 0x20, 0xd8, 0xd4, 0x73, 0xbd, 0x80, 0xb0, 0x79,
-	0x5a, 0x49, 0xb7, 0x01, 0xf5, 0xe7, 0x29, 0xfa, 0x2c, 0xa2, 0x72, 0xd9, 0xfd, 0xbb, 0x0c, 0x95,
-	0x4c, 0x83, 0xde, 0x81, 0x52, 0x24, 0x03, 0xdb, 0xea, 0x58, 0xbd, 0xea, 0xa0, 0xbc, 0x5e, 0xb5,
-	0x4b, 0x17, 0x78, 0x82, 0x63, 0x1d, 0xfa, 0x10, 0xaa, 0x53, 0xfa, 0x6a, 0x28, 0xf8, 0xb7, 0xcc,
-	0xb7, 0xf7, 0x3a, 0x56, 0xaf, 0xd6, 0x47, 0x4e, 0xda, 0x13, 0x67, 0x94, 0x21, 0xf8, 0xc6, 0x08,
-	0x0d, 0x01, 0xe2, 0xfc, 0xa9, 0x4b, 0xc9, 0xb8, 0xfc, 0x2f, 0x77, 0x39, 0x1f, 0x8f, 0x86, 0x09,
-	0x34, 0x38, 0x5c, 0xaf, 0xda, 0x70, 0x73, 0xc6, 0x05, 0x37, 0xd4, 0x81, 0x1a, 0x09, 0xc3, 0x09,
-	0xb9, 0xa2, 0xc1, 0x19, 0x5d, 0xda, 0xfb, 0x71, 0x65, 0xb8, 0xa8, 0x42, 0x2f, 0xe1, 0x58, 0x52,
-	0x25, 0x22, 0xe9, 0xd1, 0xf3, 0x05, 0x95, 0x92, 0x4d, 0xa9, 0xb2, 0xef, 0x74, 0x4a, 0xbd, 0x5a,
-	0xbf, 0x97, 0x67, 0xcb, 0x6e, 0xe8, 0xe0, 0x4d, 0xd3, 0x87, 0x5c, 0xcb, 0x25, 0xde, 0x0e, 0x81,
-	0x1c, 0x40, 0x4a, 0x13, 0x1d, 0xa9, 0x01, 0x99, 0xfa, 0xf4, 0x21, 0x27, 0x57, 0x01, 0x9d, 0xda,
-	0x07, 0x1d, 0xab, 0x57, 0xc1, 0x3b, 0x10, 0xf4, 0x18, 0x1a, 0x09, 0x07, 0xbe, 0xe4, 0x24, 0x58,
-	0x6a, 0xe6, 0x29, 0xbb, 0x6c, 0xee, 0xdc, 0xca, 0xab, 0x78, 0x74, 0x1b, 0x4f, 0xaf, 0xbb, 0xe9,
-	0x86, 0xbe, 0x87, 0xa3, 0x59, 0xa4, 0xb4, 0x98, 0xb3, 0x1f, 0xe8, 0x79, 0x68, 0x78, 0x64, 0x57,
-	0x4c, 0xa8, 0x33, 0xe7, 0xe6, 0xf5, 0x9d, 0xec, 0xf5, 0x8d, 0x70, 0xe9, 0x4d, 0x9d, 0x70, 0xe6,
-	0x3b, 0x31, 0x91, 0x9c, 0x02, 0x91, 0x9c, 0x8c, 0x48, 0xce, 0xd9, 0x46, 0x48, 0xbc, 0x95, 0x04,
-	0xbd, 0x0b, 0xfb, 0xd7, 0x34, 0x08, 0xed, 0xaa, 0x49, 0x56, 0xcf, 0xeb, 0x7e, 0x4c, 0x83, 0x10,
-	0x1b, 0x08, 0xbd, 0x07, 0xe5, 0x30, 0x88, 0x7c, 0xc6, 0x95, 0x0d, 0xa6, 0xc7, 0x8d, 0xdc, 0xea,
-	0xa9, 0xd1, 0xe3, 0x0c, 0x8f, 0x1b, 0x18, 0x29, 0x2a, 0x27, 0x22, 0x3e, 0x8d, 0x98, 0x4a, 0x1a,
-	0x58, 0x4b, 0x1a, 0xb8, 0x8d, 0xa0, 0x9f, 0x2d, 0x78, 0xdb, 0x33, 0x2d, 0x79, 0x42, 0x38, 0xf1,
-	0xe9, 0x9c, 0x72, 0xfd, 0x34, 0xcd, 0x75, 0xd7, 0xe4, 0x7a, 0xf6, 0x06, 0xd7, 0x1f, 0xee, 0x8c,
-	0x8c, 0xff, 0x2d, 0x23, 0x7a, 0x1f, 0x8e, 0xf3, 0xfe, 0xbc, 0xa4, 0x52, 0x99, 0x57, 0xa8, 0x77,
-	0x4a, 0xbd, 0x2a, 0xde, 0x06, 0x50, 0x13, 0x2a, 0x11, 0x1b, 0x2a, 0x75, 0x81, 0x27, 0xf6, 0xa1,
-	0xe1, 0x68, 0x7e, 0x6e, 0xfe, 0x62, 0xc1, 0xc9, 0x6e, 0xda, 0xa1, 0x23, 0x28, 0xcd, 0xe8, 0x32,
-	0xf9, 0x6f, 0x38, 0x16, 0x11, 0x81, 0x3b, 0x0b, 0x12, 0x44, 0x34, 0xfd, 0x62, 0x6f, 0xf2, 0xe0,
-	0x9b, 0x39, 0x71, 0x12, 0xf9, 0x93, 0xbd, 0x8f, 0xad, 0xee, 0x25, 0xbc, 0xb5, 0x93, 0x8c, 0xa8,
-	0x05, 0xa0, 0x25, 0xf1, 0x66, 0x8c, 0xfb, 0xe3, 0x51, 0x5a, 0x58, 0x41, 0x83, 0xee, 0xc3, 0x21,
-	0xe1, 0x82, 0x2f, 0xe3, 0xdb, 0x5f, 0x28, 0x2a, 0x95, 0x29, 0xb4, 0x82, 0x37, 0xb4, 0xdd, 0x4f,
-	0x61, 0x3f, 0x66, 0x0d, 0xb2, 0xa1, 0xec, 0x5d, 0x13, 0x7d, 0x91, 0x4d, 0x15, 0x9c, 0x1d, 0xe3,
-	0x96, 0xc5, 0xe2, 0x0b, 0xfa, 0x4a, 0x9b, 0x18, 0x55, 0x9c, 0x9f, 0xbb, 0xf7, 0xe0, 0x20, 0x79,
-	0x07, 0x84, 0x60, 0x9f, 0x93, 0x39, 0x4d, 0x9d, 0x8d, 0xdc, 0xfd, 0x1c, 0xaa, 0xf9, 0xc0, 0x41,
-	0x7d, 0x00, 0x4f, 0x70, 0x4e, 0x3d, 0x2d, 0xa4, 0xb2, 0x2d, 0xc3, 0x93, 0x9b, 0xc1, 0x34, 0xcc,
-	0x20, 0x5c, 0xb0, 0xea, 0x3e, 0x80, 0x6a, 0x0e, 0xec, 0xca, 0x10, 0xeb, 0xf4, 0x32, 0xa4, 0x69,
-	0x5d, 0x46, 0xee, 0xfe, 0x54, 0x82, 0xc2, 0x90, 0xda, 0xe9, 0x76, 0x02, 0x07, 0x4c, 0xa9, 0x88,
-	0xca, 0xd4, 0x31, 0x3d, 0xa1, 0x1e, 0x54, 0xbc, 0x80, 0x51, 0xae, 0xc7, 0x23, 0x33, 0x07, 0xab,
-	0x83, 0xbb, 0xeb, 0x55, 0xbb, 0x32, 0x4c, 0x75, 0x38, 0x47, 0xd1, 0x29, 0xd4, 0xbc, 0x80, 0x65,
-	0x40, 0x32, 0xee, 0x06, 0x8d, 0xf5, 0xaa, 0x5d, 0x1b, 0x4e, 0xc6, 0xb9, 0x7d, 0xd1, 0x26, 0x4e,
-	0xaa, 0x3c, 0x11, 0xa6, 0x43, 0xaf, 0x8a, 0xd3, 0x13, 0xba, 0x84, 0x3a, 0x9b, 0xbe, 0x10, 0x33,
-	0xca, 0x87, 0x66, 0x01, 0xd8, 0x07, 0xa6, 0x37, 0xf7, 0x77, 0x4c, 0x60, 0x67, 0x5c, 0x34, 0x34,
-	0xd4, 0x1c, 0x1c, 0xaf, 0x57, 0xed, 0xfa, 0x78, 0x54, 0xd0, 0xe3, 0xdb, 0xf1, 0x9a, 0x4b, 0x40,
-	0xdb, 0x7e, 0x3b, 0x28, 0xfd, 0xe4, 0x36, 0xa5, 0x3f, 0x7a, 0x2d, 0xa5, 0x93, 0x0d, 0xe6, 0xe4,
-	0xcb, 0x37, 0x5e, 0x05, 0x8e, 0x89, 0x5f, 0xa0, 0x6f, 0xff, 0x1b, 0x68, 0x64, 0x13, 0xfd, 0x39,
-	0x95, 0x0b, 0xe6, 0x51, 0xf4, 0x15, 0x94, 0x1e, 0x51, 0x8d, 0x4e, 0xb6, 0x46, 0xbe, 0x59, 0x73,
-	0xcd, 0xe3, 0x2d, 0x7d, 0xd7, 0xfe, 0xf1, 0x8f, 0xbf, 0x7e, 0xdd, 0x43, 0xe8, 0xc8, 0x2c, 0xed,
-	0xc5, 0x69, 0xbe, 0x36, 0x07, 0x5f, 0xfc, 0xb6, 0x6e, 0x59, 0xbf, 0xaf, 0x5b, 0xd6, 0x9f, 0xeb,
-	0x96, 0xf5, 0x75, 0xff, 0x3f, 0x2c, 0xef, 0xe4, 0x01, 0xf3, 0x08, 0x57, 0x07, 0x66, 0xdb, 0x3e,
-	0xf8, 0x27, 0x00, 0x00, 0xff, 0xff, 0xde, 0x07, 0xc4, 0xab, 0x56, 0x08, 0x00, 0x00,
+	// 953 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xd7, 0xc6, 0x69, 0x62, 0x3f, 0x37, 0x71, 0x32, 0x40, 0x58, 0xac, 0xca, 0x31, 0x3e, 0x54,
+	0x46, 0x82, 0x5d, 0x92, 0x1e, 0x40, 0x08, 0x04, 0xd8, 0xae, 0x5a, 0x13, 0x47, 0x69, 0xa7, 0x4d,
+	0x0f, 0x48, 0x28, 0x9a, 0xec, 0x0e, 0xeb, 0xc1, 0xeb, 0x99, 0xd5, 0xcc, 0xac, 0xa9, 0x39, 0x72,
+	0x43, 0x9c, 0x10, 0x47, 0xbe, 0x10, 0x47, 0x24, 0xee, 0x16, 0xb2, 0xf8, 0x20, 0x68, 0x67, 0xff,
+	0x64, 0x63, 0x9b, 0xaa, 0x52, 0x6f, 0xef, 0xbd, 0xdf, 0xfb, 0x37, 0x6f, 0x7f, 0xfb, 0x1e, 0xb4,
+	0x14, 0x95, 0x33, 0x2a, 0x5d, 0x45, 0xb5, 0x66, 0x3c, 0x50, 0x85, 0xe0, 0x44, 0x52, 0x68, 0x81,
+	0x76, 0xbd, 0x30, 0x56, 0x9a, 0xca, 0xe6, 0xdb, 0x81, 0x08, 0x84, 0xb1, 0xb9, 0x89, 0x94, 0xc2,
+	0xcd, 0x7b, 0x81, 0x10, 0x41, 0x48, 0x5d, 0x12, 0x31, 0x97, 0x70, 0x2e, 0x34, 0xd1, 0x4c, 0xf0,
+	0x2c, 0xb8, 0x39, 0x0c, 0x98, 0x1e, 0xc7, 0xd7, 0x8e, 0x27, 0xa6, 0x2e, 0x91, 0x26, 0xfc, 0x07,
+	0x23, 0x7c, 0xe4, 0xf9, 0x6e, 0x34, 0x09, 0x92, 0x30, 0xe5, 0x92, 0x28, 0x0a, 0x99, 0x67, 0x02,
+	0xdd, 0xd9, 0x09, 0x09, 0xa3, 0x31, 0x39, 0x71, 0x03, 0xca, 0xa9, 0x24, 0x9a, 0xfa, 0x59, 0xaa,
+	0x2f, 0x5e, 0x95, 0x6a, 0xf5, 0x0d, 0x82, 0xf9, 0x9e, 0xeb, 0x85, 0x84, 0x4d, 0xb3, 0x4e, 0x3a,
+	0x0d, 0xd8, 0x7b, 0x96, 0xa1, 0x4f, 0x63, 0x2a, 0xe7, 0x9d, 0x3f, 0xaa, 0x50, 0xcd, 0x2d, 0xe8,
+	0x3d, 0xa8, 0xc4, 0x32, 0xb4, 0xad, 0xb6, 0xd5, 0xad, 0xf5, 0x76, 0x97, 0x8b, 0xe3, 0xca, 0x25,
+	0x1e, 0xe1, 0xc4, 0x86, 0x3e, 0x86, 0x9a, 0x4f, 0x5f, 0xf6, 0x05, 0xff, 0x9e, 0x05, 0xf6, 0x56,
+	0xdb, 0xea, 0xd6, 0x4f, 0x91, 0x93, 0xcd, 0xc4, 0x19, 0xe4, 0x08, 0xbe, 0x71, 0x42, 0x7d, 0x80,
+	0xa4, 0x7e, 0x16, 0x52, 0x31, 0x21, 0x6f, 0x15, 0x21, 0x17, 0xc3, 0x41, 0x3f, 0x85, 0x7a, 0xfb,
+	0xcb, 0xc5, 0x31, 0xdc, 0xe8, 0xb8, 0x14, 0x86, 0xda, 0x50, 0x27, 0x51, 0x34, 0x22, 0xd7, 0x34,
+	0x3c, 0xa3, 0x73, 0x7b, 0x3b, 0xe9, 0x0c, 0x97, 0x4d, 0xe8, 0x05, 0x1c, 0x4a, 0xaa, 0x44, 0x2c,
+	0x3d, 0x7a, 0x31, 0xa3, 0x52, 0x32, 0x9f, 0x2a, 0xfb, 0x4e, 0xbb, 0xd2, 0xad, 0x9f, 0x76, 0x8b,
+	0x6a, 0xf9, 0x0b, 0x1d, 0xbc, 0xea, 0xfa, 0x90, 0x6b, 0x39, 0xc7, 0xeb, 0x29, 0x90, 0x03, 0x48,
+	0x69, 0xa2, 0x63, 0xd5, 0x23, 0x7e, 0x40, 0x1f, 0x72, 0x72, 0x1d, 0x52, 0xdf, 0xde, 0x69, 0x5b,
+	0xdd, 0x2a, 0xde, 0x80, 0xa0, 0xc7, 0xd0, 0x48, 0x39, 0xf0, 0x35, 0x27, 0xe1, 0x5c, 0x33, 0x4f,
+	0xd9, 0xbb, 0xe6, 0xcd, 0xad, 0xa2, 0x8b, 0x47, 0xb7, 0xf1, 0xec, 0xb9, 0xab, 0x61, 0xe8, 0x47,
+	0x38, 0x98, 0xc4, 0x4a, 0x8b, 0x29, 0xfb, 0x89, 0x5e, 0x44, 0x86, 0x47, 0x76, 0xd5, 0xa4, 0x3a,
+	0x73, 0x6e, 0xbe, 0xbe, 0x93, 0x7f, 0x7d, 0x23, 0x5c, 0x79, 0xbe, 0x13, 0x4d, 0x02, 0x27, 0x21,
+	0x92, 0x53, 0x22, 0x92, 0x93, 0x13, 0xc9, 0x39, 0x5b, 0x49, 0x89, 0xd7, 0x8a, 0xa0, 0xf7, 0x61,
+	0x7b, 0x4c, 0xc3, 0xc8, 0xae, 0x99, 0x62, 0x7b, 0x45, 0xdf, 0x8f, 0x69, 0x18, 0x61, 0x03, 0xa1,
+	0x0f, 0x60, 0x37, 0x0a, 0xe3, 0x80, 0x71, 0x65, 0x83, 0x99, 0x71, 0xa3, 0xf0, 0x7a, 0x62, 0xec,
+	0x38, 0xc7, 0x93, 0x01, 0xc6, 0x8a, 0xca, 0x91, 0x48, 0xb4, 0x01, 0x53, 0xe9, 0x00, 0xeb, 0xe9,
+	0x00, 0xd7, 0x11, 0xf4, 0xab, 0x05, 0xef, 0x7a, 0x66, 0x24, 0xe7, 0x84, 0x93, 0x80, 0x4e, 0x29,
+	0xd7, 0x4f, 0xb2, 0x5a, 0x77, 0x4d, 0xad, 0xa7, 0x6f, 0xf0, 0xfc, 0xfe, 0xc6, 0xcc, 0xf8, 0xff,
+	0x2a, 0xa2, 0x0f, 0xe1, 0xb0, 0x98, 0xcf, 0x0b, 0x2a, 0x95, 0xf9, 0x0a, 0x7b, 0xed, 0x4a, 0xb7,
+	0x86, 0xd7, 0x01, 0xd4, 0x84, 0x6a, 0xcc, 0xfa, 0x4a, 0x5d, 0xe2, 0x91, 0xbd, 0x6f, 0x38, 0x5a,
+	0xe8, 0xe8, 0x3e, 0xec, 0x6b, 0x49, 0xbc, 0x09, 0xe3, 0xc1, 0x39, 0xd5, 0x63, 0xe1, 0xdb, 0x0d,
+	0xe3, 0xb1, 0x62, 0x6d, 0xfe, 0x66, 0xc1, 0xd1, 0x66, 0x7a, 0xa2, 0x03, 0xa8, 0x4c, 0xe8, 0x3c,
+	0xfd, 0x2f, 0x71, 0x22, 0x22, 0x02, 0x77, 0x66, 0x24, 0x8c, 0xa9, 0xbd, 0xf5, 0xc6, 0xc4, 0x58,
+	0xad, 0x89, 0xd3, 0xcc, 0x9f, 0x6d, 0x7d, 0x6a, 0x75, 0xae, 0xe0, 0x9d, 0x8d, 0xa4, 0x45, 0x2d,
+	0x80, 0xbc, 0xfd, 0xe1, 0x20, 0x6b, 0xac, 0x64, 0x49, 0x1e, 0x4d, 0xb8, 0xe0, 0xf3, 0x64, 0x4a,
+	0x97, 0x8a, 0x4a, 0x65, 0x1a, 0xad, 0xe2, 0x15, 0x6b, 0xe7, 0x73, 0xd8, 0x4e, 0xd8, 0x85, 0x6c,
+	0xd8, 0xf5, 0xc6, 0x44, 0x5f, 0xe6, 0xdb, 0x07, 0xe7, 0x6a, 0x32, 0xda, 0x44, 0x7c, 0x4e, 0x5f,
+	0x6a, 0x93, 0xa3, 0x86, 0x0b, 0xbd, 0x73, 0x0f, 0x76, 0xd2, 0xef, 0x85, 0x10, 0x6c, 0x73, 0x32,
+	0xa5, 0x59, 0xb0, 0x91, 0x3b, 0x5f, 0x42, 0xad, 0x58, 0x4c, 0xe8, 0x14, 0xc0, 0x13, 0x9c, 0x53,
+	0x4f, 0x0b, 0xa9, 0x6c, 0xab, 0x5d, 0xb9, 0xb5, 0xc0, 0xfa, 0x39, 0x84, 0x4b, 0x5e, 0x9d, 0x07,
+	0x50, 0x2b, 0x80, 0x4d, 0x15, 0x12, 0x9b, 0x9e, 0x47, 0x34, 0xeb, 0xcb, 0xc8, 0x9d, 0x5f, 0x2a,
+	0x50, 0x5a, 0x66, 0x1b, 0xc3, 0x8e, 0x60, 0x87, 0x29, 0x15, 0x53, 0x99, 0x05, 0x66, 0x1a, 0xea,
+	0x42, 0xd5, 0x0b, 0x19, 0xe5, 0x7a, 0x38, 0x30, 0xfb, 0xb2, 0xd6, 0xbb, 0xbb, 0x5c, 0x1c, 0x57,
+	0xfb, 0x99, 0x0d, 0x17, 0x28, 0x3a, 0x81, 0xba, 0x17, 0xb2, 0x1c, 0x48, 0xd7, 0x62, 0xaf, 0xb1,
+	0x5c, 0x1c, 0xd7, 0xfb, 0xa3, 0x61, 0xe1, 0x5f, 0xf6, 0x49, 0x8a, 0x2a, 0x4f, 0x44, 0xd9, 0x72,
+	0xac, 0xe1, 0x4c, 0x43, 0x57, 0xb0, 0xc7, 0xfc, 0xe7, 0x62, 0x42, 0x79, 0xdf, 0x1c, 0x0a, 0x7b,
+	0xc7, 0xcc, 0xe6, 0xfe, 0x86, 0x4d, 0xed, 0x0c, 0xcb, 0x8e, 0x86, 0x9a, 0xbd, 0xc3, 0xe5, 0xe2,
+	0x78, 0x6f, 0x38, 0x28, 0xd9, 0xf1, 0xed, 0x7c, 0xcd, 0x39, 0xa0, 0xf5, 0xb8, 0x0d, 0x94, 0x3e,
+	0xbf, 0x4d, 0xe9, 0x4f, 0x5e, 0x49, 0xe9, 0xf4, 0xd2, 0x39, 0xc5, 0x91, 0x4e, 0x4e, 0x86, 0x63,
+	0xf2, 0x97, 0xe8, 0x7b, 0xfa, 0x1d, 0x34, 0xf2, 0xcd, 0xff, 0x8c, 0xca, 0x19, 0xf3, 0x28, 0xfa,
+	0x06, 0x2a, 0x8f, 0xa8, 0x46, 0x47, 0x6b, 0xa7, 0xc1, 0x9c, 0xc3, 0xe6, 0xe1, 0x9a, 0xbd, 0x63,
+	0xff, 0xfc, 0xf7, 0xbf, 0xbf, 0x6f, 0x21, 0x74, 0x60, 0x8e, 0xfb, 0xec, 0xa4, 0x38, 0xaf, 0xbd,
+	0xaf, 0xfe, 0x5c, 0xb6, 0xac, 0xbf, 0x96, 0x2d, 0xeb, 0x9f, 0x65, 0xcb, 0xfa, 0xf6, 0xf4, 0x35,
+	0x8e, 0x7c, 0xfa, 0x01, 0x8b, 0x0c, 0xd7, 0x3b, 0xe6, 0x2a, 0x3f, 0xf8, 0x2f, 0x00, 0x00, 0xff,
+	0xff, 0x36, 0x12, 0x04, 0x1c, 0x7e, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -788,6 +798,13 @@ func (m *Settings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.TrackingMethod) > 0 {
+		i -= len(m.TrackingMethod)
+		copy(dAtA[i:], m.TrackingMethod)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.TrackingMethod)))
+		i--
+		dAtA[i] = 0x7a
+	}
 	if len(m.UiCssURL) > 0 {
 		i -= len(m.UiCssURL)
 		copy(dAtA[i:], m.UiCssURL)
@@ -1344,6 +1361,10 @@ func (m *Settings) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSettings(uint64(l))
 	}
+	l = len(m.TrackingMethod)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2124,6 +2145,38 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 			}
 			m.UiCssURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackingMethod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrackingMethod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSettings(dAtA[iNdEx:])
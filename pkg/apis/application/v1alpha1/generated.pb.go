@@ -792,10 +792,38 @@ func (m *ClusterList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClusterList proto.InternalMessageInfo
 
+func (m *ClusterNamespaceDiscovery) Reset()      { *m = ClusterNamespaceDiscovery{} }
+func (*ClusterNamespaceDiscovery) ProtoMessage() {}
+func (*ClusterNamespaceDiscovery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7dc23c2911a1a00, []int{27}
+}
+func (m *ClusterNamespaceDiscovery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterNamespaceDiscovery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ClusterNamespaceDiscovery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterNamespaceDiscovery.Merge(m, src)
+}
+func (m *ClusterNamespaceDiscovery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterNamespaceDiscovery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterNamespaceDiscovery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterNamespaceDiscovery proto.InternalMessageInfo
+
 func (m *Command) Reset()      { *m = Command{} }
 func (*Command) ProtoMessage() {}
 func (*Command) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{27}
+	return fileDescriptor_e7dc23c2911a1a00, []int{28}
 }
 func (m *Command) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -823,7 +851,7 @@ var xxx_messageInfo_Command proto.InternalMessageInfo
 func (m *ComparedTo) Reset()      { *m = ComparedTo{} }
 func (*ComparedTo) ProtoMessage() {}
 func (*ComparedTo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{28}
+	return fileDescriptor_e7dc23c2911a1a00, []int{29}
 }
 func (m *ComparedTo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -851,7 +879,7 @@ var xxx_messageInfo_ComparedTo proto.InternalMessageInfo
 func (m *ComponentParameter) Reset()      { *m = ComponentParameter{} }
 func (*ComponentParameter) ProtoMessage() {}
 func (*ComponentParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{29}
+	return fileDescriptor_e7dc23c2911a1a00, []int{30}
 }
 func (m *ComponentParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -879,7 +907,7 @@ var xxx_messageInfo_ComponentParameter proto.InternalMessageInfo
 func (m *ConfigManagementPlugin) Reset()      { *m = ConfigManagementPlugin{} }
 func (*ConfigManagementPlugin) ProtoMessage() {}
 func (*ConfigManagementPlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{30}
+	return fileDescriptor_e7dc23c2911a1a00, []int{31}
 }
 func (m *ConfigManagementPlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -907,7 +935,7 @@ var xxx_messageInfo_ConfigManagementPlugin proto.InternalMessageInfo
 func (m *ConnectionState) Reset()      { *m = ConnectionState{} }
 func (*ConnectionState) ProtoMessage() {}
 func (*ConnectionState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{31}
+	return fileDescriptor_e7dc23c2911a1a00, []int{32}
 }
 func (m *ConnectionState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -935,7 +963,7 @@ var xxx_messageInfo_ConnectionState proto.InternalMessageInfo
 func (m *EnvEntry) Reset()      { *m = EnvEntry{} }
 func (*EnvEntry) ProtoMessage() {}
 func (*EnvEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{32}
+	return fileDescriptor_e7dc23c2911a1a00, []int{33}
 }
 func (m *EnvEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -963,7 +991,7 @@ var xxx_messageInfo_EnvEntry proto.InternalMessageInfo
 func (m *ExecProviderConfig) Reset()      { *m = ExecProviderConfig{} }
 func (*ExecProviderConfig) ProtoMessage() {}
 func (*ExecProviderConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{33}
+	return fileDescriptor_e7dc23c2911a1a00, []int{34}
 }
 func (m *ExecProviderConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -991,7 +1019,7 @@ var xxx_messageInfo_ExecProviderConfig proto.InternalMessageInfo
 func (m *GnuPGPublicKey) Reset()      { *m = GnuPGPublicKey{} }
 func (*GnuPGPublicKey) ProtoMessage() {}
 func (*GnuPGPublicKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{34}
+	return fileDescriptor_e7dc23c2911a1a00, []int{35}
 }
 func (m *GnuPGPublicKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1019,7 +1047,7 @@ var xxx_messageInfo_GnuPGPublicKey proto.InternalMessageInfo
 func (m *GnuPGPublicKeyList) Reset()      { *m = GnuPGPublicKeyList{} }
 func (*GnuPGPublicKeyList) ProtoMessage() {}
 func (*GnuPGPublicKeyList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{35}
+	return fileDescriptor_e7dc23c2911a1a00, []int{36}
 }
 func (m *GnuPGPublicKeyList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1047,7 +1075,7 @@ var xxx_messageInfo_GnuPGPublicKeyList proto.InternalMessageInfo
 func (m *HealthStatus) Reset()      { *m = HealthStatus{} }
 func (*HealthStatus) ProtoMessage() {}
 func (*HealthStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{36}
+	return fileDescriptor_e7dc23c2911a1a00, []int{37}
 }
 func (m *HealthStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1075,7 +1103,7 @@ var xxx_messageInfo_HealthStatus proto.InternalMessageInfo
 func (m *HelmFileParameter) Reset()      { *m = HelmFileParameter{} }
 func (*HelmFileParameter) ProtoMessage() {}
 func (*HelmFileParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{37}
+	return fileDescriptor_e7dc23c2911a1a00, []int{38}
 }
 func (m *HelmFileParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1103,7 +1131,7 @@ var xxx_messageInfo_HelmFileParameter proto.InternalMessageInfo
 func (m *HelmParameter) Reset()      { *m = HelmParameter{} }
 func (*HelmParameter) ProtoMessage() {}
 func (*HelmParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{38}
+	return fileDescriptor_e7dc23c2911a1a00, []int{39}
 }
 func (m *HelmParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1128,10 +1156,66 @@ func (m *HelmParameter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HelmParameter proto.InternalMessageInfo
 
+func (m *HelmPostRenderer) Reset()      { *m = HelmPostRenderer{} }
+func (*HelmPostRenderer) ProtoMessage() {}
+func (*HelmPostRenderer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7dc23c2911a1a00, []int{40}
+}
+func (m *HelmPostRenderer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HelmPostRenderer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HelmPostRenderer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HelmPostRenderer.Merge(m, src)
+}
+func (m *HelmPostRenderer) XXX_Size() int {
+	return m.Size()
+}
+func (m *HelmPostRenderer) XXX_DiscardUnknown() {
+	xxx_messageInfo_HelmPostRenderer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HelmPostRenderer proto.InternalMessageInfo
+
+func (m *HelmValuesRepo) Reset()      { *m = HelmValuesRepo{} }
+func (*HelmValuesRepo) ProtoMessage() {}
+func (*HelmValuesRepo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7dc23c2911a1a00, []int{41}
+}
+func (m *HelmValuesRepo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HelmValuesRepo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HelmValuesRepo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HelmValuesRepo.Merge(m, src)
+}
+func (m *HelmValuesRepo) XXX_Size() int {
+	return m.Size()
+}
+func (m *HelmValuesRepo) XXX_DiscardUnknown() {
+	xxx_messageInfo_HelmValuesRepo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HelmValuesRepo proto.InternalMessageInfo
+
 func (m *HostInfo) Reset()      { *m = HostInfo{} }
 func (*HostInfo) ProtoMessage() {}
 func (*HostInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{39}
+	return fileDescriptor_e7dc23c2911a1a00, []int{42}
 }
 func (m *HostInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1159,7 +1243,7 @@ var xxx_messageInfo_HostInfo proto.InternalMessageInfo
 func (m *HostResourceInfo) Reset()      { *m = HostResourceInfo{} }
 func (*HostResourceInfo) ProtoMessage() {}
 func (*HostResourceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{40}
+	return fileDescriptor_e7dc23c2911a1a00, []int{43}
 }
 func (m *HostResourceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1184,10 +1268,66 @@ func (m *HostResourceInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HostResourceInfo proto.InternalMessageInfo
 
+func (m *HydrateTo) Reset()      { *m = HydrateTo{} }
+func (*HydrateTo) ProtoMessage() {}
+func (*HydrateTo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7dc23c2911a1a00, []int{44}
+}
+func (m *HydrateTo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HydrateTo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HydrateTo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HydrateTo.Merge(m, src)
+}
+func (m *HydrateTo) XXX_Size() int {
+	return m.Size()
+}
+func (m *HydrateTo) XXX_DiscardUnknown() {
+	xxx_messageInfo_HydrateTo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HydrateTo proto.InternalMessageInfo
+
+func (m *HydratorStatus) Reset()      { *m = HydratorStatus{} }
+func (*HydratorStatus) ProtoMessage() {}
+func (*HydratorStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7dc23c2911a1a00, []int{45}
+}
+func (m *HydratorStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HydratorStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HydratorStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HydratorStatus.Merge(m, src)
+}
+func (m *HydratorStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *HydratorStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_HydratorStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HydratorStatus proto.InternalMessageInfo
+
 func (m *Info) Reset()      { *m = Info{} }
 func (*Info) ProtoMessage() {}
 func (*Info) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{41}
+	return fileDescriptor_e7dc23c2911a1a00, []int{46}
 }
 func (m *Info) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1215,7 +1355,7 @@ var xxx_messageInfo_Info proto.InternalMessageInfo
 func (m *InfoItem) Reset()      { *m = InfoItem{} }
 func (*InfoItem) ProtoMessage() {}
 func (*InfoItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{42}
+	return fileDescriptor_e7dc23c2911a1a00, []int{47}
 }
 func (m *InfoItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1243,7 +1383,7 @@ var xxx_messageInfo_InfoItem proto.InternalMessageInfo
 func (m *JWTToken) Reset()      { *m = JWTToken{} }
 func (*JWTToken) ProtoMessage() {}
 func (*JWTToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{43}
+	return fileDescriptor_e7dc23c2911a1a00, []int{48}
 }
 func (m *JWTToken) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1271,7 +1411,7 @@ var xxx_messageInfo_JWTToken proto.InternalMessageInfo
 func (m *JWTTokens) Reset()      { *m = JWTTokens{} }
 func (*JWTTokens) ProtoMessage() {}
 func (*JWTTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{44}
+	return fileDescriptor_e7dc23c2911a1a00, []int{49}
 }
 func (m *JWTTokens) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1299,7 +1439,7 @@ var xxx_messageInfo_JWTTokens proto.InternalMessageInfo
 func (m *JsonnetVar) Reset()      { *m = JsonnetVar{} }
 func (*JsonnetVar) ProtoMessage() {}
 func (*JsonnetVar) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{45}
+	return fileDescriptor_e7dc23c2911a1a00, []int{50}
 }
 func (m *JsonnetVar) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1327,7 +1467,7 @@ var xxx_messageInfo_JsonnetVar proto.InternalMessageInfo
 func (m *KnownTypeField) Reset()      { *m = KnownTypeField{} }
 func (*KnownTypeField) ProtoMessage() {}
 func (*KnownTypeField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{46}
+	return fileDescriptor_e7dc23c2911a1a00, []int{51}
 }
 func (m *KnownTypeField) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1355,7 +1495,7 @@ var xxx_messageInfo_KnownTypeField proto.InternalMessageInfo
 func (m *KsonnetParameter) Reset()      { *m = KsonnetParameter{} }
 func (*KsonnetParameter) ProtoMessage() {}
 func (*KsonnetParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{47}
+	return fileDescriptor_e7dc23c2911a1a00, []int{52}
 }
 func (m *KsonnetParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1383,7 +1523,7 @@ var xxx_messageInfo_KsonnetParameter proto.InternalMessageInfo
 func (m *KustomizeOptions) Reset()      { *m = KustomizeOptions{} }
 func (*KustomizeOptions) ProtoMessage() {}
 func (*KustomizeOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{48}
+	return fileDescriptor_e7dc23c2911a1a00, []int{53}
 }
 func (m *KustomizeOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1411,7 +1551,7 @@ var xxx_messageInfo_KustomizeOptions proto.InternalMessageInfo
 func (m *Operation) Reset()      { *m = Operation{} }
 func (*Operation) ProtoMessage() {}
 func (*Operation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{49}
+	return fileDescriptor_e7dc23c2911a1a00, []int{54}
 }
 func (m *Operation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1439,7 +1579,7 @@ var xxx_messageInfo_Operation proto.InternalMessageInfo
 func (m *OperationInitiator) Reset()      { *m = OperationInitiator{} }
 func (*OperationInitiator) ProtoMessage() {}
 func (*OperationInitiator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{50}
+	return fileDescriptor_e7dc23c2911a1a00, []int{55}
 }
 func (m *OperationInitiator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1467,7 +1607,7 @@ var xxx_messageInfo_OperationInitiator proto.InternalMessageInfo
 func (m *OperationState) Reset()      { *m = OperationState{} }
 func (*OperationState) ProtoMessage() {}
 func (*OperationState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{51}
+	return fileDescriptor_e7dc23c2911a1a00, []int{56}
 }
 func (m *OperationState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1495,7 +1635,7 @@ var xxx_messageInfo_OperationState proto.InternalMessageInfo
 func (m *OrphanedResourceKey) Reset()      { *m = OrphanedResourceKey{} }
 func (*OrphanedResourceKey) ProtoMessage() {}
 func (*OrphanedResourceKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{52}
+	return fileDescriptor_e7dc23c2911a1a00, []int{57}
 }
 func (m *OrphanedResourceKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1523,7 +1663,7 @@ var xxx_messageInfo_OrphanedResourceKey proto.InternalMessageInfo
 func (m *OrphanedResourcesMonitorSettings) Reset()      { *m = OrphanedResourcesMonitorSettings{} }
 func (*OrphanedResourcesMonitorSettings) ProtoMessage() {}
 func (*OrphanedResourcesMonitorSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{53}
+	return fileDescriptor_e7dc23c2911a1a00, []int{58}
 }
 func (m *OrphanedResourcesMonitorSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1551,7 +1691,7 @@ var xxx_messageInfo_OrphanedResourcesMonitorSettings proto.InternalMessageInfo
 func (m *OverrideIgnoreDiff) Reset()      { *m = OverrideIgnoreDiff{} }
 func (*OverrideIgnoreDiff) ProtoMessage() {}
 func (*OverrideIgnoreDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{54}
+	return fileDescriptor_e7dc23c2911a1a00, []int{59}
 }
 func (m *OverrideIgnoreDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1579,7 +1719,7 @@ var xxx_messageInfo_OverrideIgnoreDiff proto.InternalMessageInfo
 func (m *ProjectRole) Reset()      { *m = ProjectRole{} }
 func (*ProjectRole) ProtoMessage() {}
 func (*ProjectRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{55}
+	return fileDescriptor_e7dc23c2911a1a00, []int{60}
 }
 func (m *ProjectRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1607,7 +1747,7 @@ var xxx_messageInfo_ProjectRole proto.InternalMessageInfo
 func (m *RepoCreds) Reset()      { *m = RepoCreds{} }
 func (*RepoCreds) ProtoMessage() {}
 func (*RepoCreds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{56}
+	return fileDescriptor_e7dc23c2911a1a00, []int{61}
 }
 func (m *RepoCreds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1635,7 +1775,7 @@ var xxx_messageInfo_RepoCreds proto.InternalMessageInfo
 func (m *RepoCredsList) Reset()      { *m = RepoCredsList{} }
 func (*RepoCredsList) ProtoMessage() {}
 func (*RepoCredsList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{57}
+	return fileDescriptor_e7dc23c2911a1a00, []int{62}
 }
 func (m *RepoCredsList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1663,7 +1803,7 @@ var xxx_messageInfo_RepoCredsList proto.InternalMessageInfo
 func (m *Repository) Reset()      { *m = Repository{} }
 func (*Repository) ProtoMessage() {}
 func (*Repository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{58}
+	return fileDescriptor_e7dc23c2911a1a00, []int{63}
 }
 func (m *Repository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1691,7 +1831,7 @@ var xxx_messageInfo_Repository proto.InternalMessageInfo
 func (m *RepositoryCertificate) Reset()      { *m = RepositoryCertificate{} }
 func (*RepositoryCertificate) ProtoMessage() {}
 func (*RepositoryCertificate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{59}
+	return fileDescriptor_e7dc23c2911a1a00, []int{64}
 }
 func (m *RepositoryCertificate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1719,7 +1859,7 @@ var xxx_messageInfo_RepositoryCertificate proto.InternalMessageInfo
 func (m *RepositoryCertificateList) Reset()      { *m = RepositoryCertificateList{} }
 func (*RepositoryCertificateList) ProtoMessage() {}
 func (*RepositoryCertificateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{60}
+	return fileDescriptor_e7dc23c2911a1a00, []int{65}
 }
 func (m *RepositoryCertificateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1747,7 +1887,7 @@ var xxx_messageInfo_RepositoryCertificateList proto.InternalMessageInfo
 func (m *RepositoryList) Reset()      { *m = RepositoryList{} }
 func (*RepositoryList) ProtoMessage() {}
 func (*RepositoryList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{61}
+	return fileDescriptor_e7dc23c2911a1a00, []int{66}
 }
 func (m *RepositoryList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1775,7 +1915,7 @@ var xxx_messageInfo_RepositoryList proto.InternalMessageInfo
 func (m *ResourceAction) Reset()      { *m = ResourceAction{} }
 func (*ResourceAction) ProtoMessage() {}
 func (*ResourceAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{62}
+	return fileDescriptor_e7dc23c2911a1a00, []int{67}
 }
 func (m *ResourceAction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1803,7 +1943,7 @@ var xxx_messageInfo_ResourceAction proto.InternalMessageInfo
 func (m *ResourceActionDefinition) Reset()      { *m = ResourceActionDefinition{} }
 func (*ResourceActionDefinition) ProtoMessage() {}
 func (*ResourceActionDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{63}
+	return fileDescriptor_e7dc23c2911a1a00, []int{68}
 }
 func (m *ResourceActionDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1831,7 +1971,7 @@ var xxx_messageInfo_ResourceActionDefinition proto.InternalMessageInfo
 func (m *ResourceActionParam) Reset()      { *m = ResourceActionParam{} }
 func (*ResourceActionParam) ProtoMessage() {}
 func (*ResourceActionParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{64}
+	return fileDescriptor_e7dc23c2911a1a00, []int{69}
 }
 func (m *ResourceActionParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1859,7 +1999,7 @@ var xxx_messageInfo_ResourceActionParam proto.InternalMessageInfo
 func (m *ResourceActions) Reset()      { *m = ResourceActions{} }
 func (*ResourceActions) ProtoMessage() {}
 func (*ResourceActions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{65}
+	return fileDescriptor_e7dc23c2911a1a00, []int{70}
 }
 func (m *ResourceActions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1887,7 +2027,7 @@ var xxx_messageInfo_ResourceActions proto.InternalMessageInfo
 func (m *ResourceDiff) Reset()      { *m = ResourceDiff{} }
 func (*ResourceDiff) ProtoMessage() {}
 func (*ResourceDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{66}
+	return fileDescriptor_e7dc23c2911a1a00, []int{71}
 }
 func (m *ResourceDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1915,7 +2055,7 @@ var xxx_messageInfo_ResourceDiff proto.InternalMessageInfo
 func (m *ResourceIgnoreDifferences) Reset()      { *m = ResourceIgnoreDifferences{} }
 func (*ResourceIgnoreDifferences) ProtoMessage() {}
 func (*ResourceIgnoreDifferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{67}
+	return fileDescriptor_e7dc23c2911a1a00, []int{72}
 }
 func (m *ResourceIgnoreDifferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1943,7 +2083,7 @@ var xxx_messageInfo_ResourceIgnoreDifferences proto.InternalMessageInfo
 func (m *ResourceNetworkingInfo) Reset()      { *m = ResourceNetworkingInfo{} }
 func (*ResourceNetworkingInfo) ProtoMessage() {}
 func (*ResourceNetworkingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{68}
+	return fileDescriptor_e7dc23c2911a1a00, []int{73}
 }
 func (m *ResourceNetworkingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1971,7 +2111,7 @@ var xxx_messageInfo_ResourceNetworkingInfo proto.InternalMessageInfo
 func (m *ResourceNode) Reset()      { *m = ResourceNode{} }
 func (*ResourceNode) ProtoMessage() {}
 func (*ResourceNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{69}
+	return fileDescriptor_e7dc23c2911a1a00, []int{74}
 }
 func (m *ResourceNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1999,7 +2139,7 @@ var xxx_messageInfo_ResourceNode proto.InternalMessageInfo
 func (m *ResourceOverride) Reset()      { *m = ResourceOverride{} }
 func (*ResourceOverride) ProtoMessage() {}
 func (*ResourceOverride) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{70}
+	return fileDescriptor_e7dc23c2911a1a00, []int{75}
 }
 func (m *ResourceOverride) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2027,7 +2167,7 @@ var xxx_messageInfo_ResourceOverride proto.InternalMessageInfo
 func (m *ResourceRef) Reset()      { *m = ResourceRef{} }
 func (*ResourceRef) ProtoMessage() {}
 func (*ResourceRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{71}
+	return fileDescriptor_e7dc23c2911a1a00, []int{76}
 }
 func (m *ResourceRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2055,7 +2195,7 @@ var xxx_messageInfo_ResourceRef proto.InternalMessageInfo
 func (m *ResourceResult) Reset()      { *m = ResourceResult{} }
 func (*ResourceResult) ProtoMessage() {}
 func (*ResourceResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{72}
+	return fileDescriptor_e7dc23c2911a1a00, []int{77}
 }
 func (m *ResourceResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2083,7 +2223,7 @@ var xxx_messageInfo_ResourceResult proto.InternalMessageInfo
 func (m *ResourceStatus) Reset()      { *m = ResourceStatus{} }
 func (*ResourceStatus) ProtoMessage() {}
 func (*ResourceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{73}
+	return fileDescriptor_e7dc23c2911a1a00, []int{78}
 }
 func (m *ResourceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2111,7 +2251,7 @@ var xxx_messageInfo_ResourceStatus proto.InternalMessageInfo
 func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
 func (*RetryStrategy) ProtoMessage() {}
 func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{74}
+	return fileDescriptor_e7dc23c2911a1a00, []int{79}
 }
 func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2139,7 +2279,7 @@ var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 func (m *RevisionHistory) Reset()      { *m = RevisionHistory{} }
 func (*RevisionHistory) ProtoMessage() {}
 func (*RevisionHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{75}
+	return fileDescriptor_e7dc23c2911a1a00, []int{80}
 }
 func (m *RevisionHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2167,7 +2307,7 @@ var xxx_messageInfo_RevisionHistory proto.InternalMessageInfo
 func (m *RevisionMetadata) Reset()      { *m = RevisionMetadata{} }
 func (*RevisionMetadata) ProtoMessage() {}
 func (*RevisionMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{76}
+	return fileDescriptor_e7dc23c2911a1a00, []int{81}
 }
 func (m *RevisionMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2195,7 +2335,7 @@ var xxx_messageInfo_RevisionMetadata proto.InternalMessageInfo
 func (m *SignatureKey) Reset()      { *m = SignatureKey{} }
 func (*SignatureKey) ProtoMessage() {}
 func (*SignatureKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{77}
+	return fileDescriptor_e7dc23c2911a1a00, []int{82}
 }
 func (m *SignatureKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2223,7 +2363,7 @@ var xxx_messageInfo_SignatureKey proto.InternalMessageInfo
 func (m *SyncOperation) Reset()      { *m = SyncOperation{} }
 func (*SyncOperation) ProtoMessage() {}
 func (*SyncOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{78}
+	return fileDescriptor_e7dc23c2911a1a00, []int{83}
 }
 func (m *SyncOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2251,7 +2391,7 @@ var xxx_messageInfo_SyncOperation proto.InternalMessageInfo
 func (m *SyncOperationResource) Reset()      { *m = SyncOperationResource{} }
 func (*SyncOperationResource) ProtoMessage() {}
 func (*SyncOperationResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{79}
+	return fileDescriptor_e7dc23c2911a1a00, []int{84}
 }
 func (m *SyncOperationResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2279,7 +2419,7 @@ var xxx_messageInfo_SyncOperationResource proto.InternalMessageInfo
 func (m *SyncOperationResult) Reset()      { *m = SyncOperationResult{} }
 func (*SyncOperationResult) ProtoMessage() {}
 func (*SyncOperationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{80}
+	return fileDescriptor_e7dc23c2911a1a00, []int{85}
 }
 func (m *SyncOperationResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2307,7 +2447,7 @@ var xxx_messageInfo_SyncOperationResult proto.InternalMessageInfo
 func (m *SyncPolicy) Reset()      { *m = SyncPolicy{} }
 func (*SyncPolicy) ProtoMessage() {}
 func (*SyncPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{81}
+	return fileDescriptor_e7dc23c2911a1a00, []int{86}
 }
 func (m *SyncPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2335,7 +2475,7 @@ var xxx_messageInfo_SyncPolicy proto.InternalMessageInfo
 func (m *SyncPolicyAutomated) Reset()      { *m = SyncPolicyAutomated{} }
 func (*SyncPolicyAutomated) ProtoMessage() {}
 func (*SyncPolicyAutomated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{82}
+	return fileDescriptor_e7dc23c2911a1a00, []int{87}
 }
 func (m *SyncPolicyAutomated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2360,10 +2500,38 @@ func (m *SyncPolicyAutomated) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SyncPolicyAutomated proto.InternalMessageInfo
 
+func (m *SyncSchedule) Reset()      { *m = SyncSchedule{} }
+func (*SyncSchedule) ProtoMessage() {}
+func (*SyncSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7dc23c2911a1a00, []int{88}
+}
+func (m *SyncSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncSchedule.Merge(m, src)
+}
+func (m *SyncSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncSchedule proto.InternalMessageInfo
+
 func (m *SyncStatus) Reset()      { *m = SyncStatus{} }
 func (*SyncStatus) ProtoMessage() {}
 func (*SyncStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{83}
+	return fileDescriptor_e7dc23c2911a1a00, []int{89}
 }
 func (m *SyncStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2391,7 +2559,7 @@ var xxx_messageInfo_SyncStatus proto.InternalMessageInfo
 func (m *SyncStrategy) Reset()      { *m = SyncStrategy{} }
 func (*SyncStrategy) ProtoMessage() {}
 func (*SyncStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{84}
+	return fileDescriptor_e7dc23c2911a1a00, []int{90}
 }
 func (m *SyncStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2419,7 +2587,7 @@ var xxx_messageInfo_SyncStrategy proto.InternalMessageInfo
 func (m *SyncStrategyApply) Reset()      { *m = SyncStrategyApply{} }
 func (*SyncStrategyApply) ProtoMessage() {}
 func (*SyncStrategyApply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{85}
+	return fileDescriptor_e7dc23c2911a1a00, []int{91}
 }
 func (m *SyncStrategyApply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2447,7 +2615,7 @@ var xxx_messageInfo_SyncStrategyApply proto.InternalMessageInfo
 func (m *SyncStrategyHook) Reset()      { *m = SyncStrategyHook{} }
 func (*SyncStrategyHook) ProtoMessage() {}
 func (*SyncStrategyHook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{86}
+	return fileDescriptor_e7dc23c2911a1a00, []int{92}
 }
 func (m *SyncStrategyHook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2475,7 +2643,7 @@ var xxx_messageInfo_SyncStrategyHook proto.InternalMessageInfo
 func (m *SyncWindow) Reset()      { *m = SyncWindow{} }
 func (*SyncWindow) ProtoMessage() {}
 func (*SyncWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{87}
+	return fileDescriptor_e7dc23c2911a1a00, []int{93}
 }
 func (m *SyncWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2503,7 +2671,7 @@ var xxx_messageInfo_SyncWindow proto.InternalMessageInfo
 func (m *TLSClientConfig) Reset()      { *m = TLSClientConfig{} }
 func (*TLSClientConfig) ProtoMessage() {}
 func (*TLSClientConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7dc23c2911a1a00, []int{88}
+	return fileDescriptor_e7dc23c2911a1a00, []int{94}
 }
 func (m *TLSClientConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2559,6 +2727,7 @@ func init() {
 	proto.RegisterType((*ClusterConfig)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ClusterConfig")
 	proto.RegisterType((*ClusterInfo)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ClusterInfo")
 	proto.RegisterType((*ClusterList)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ClusterList")
+	proto.RegisterType((*ClusterNamespaceDiscovery)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ClusterNamespaceDiscovery")
 	proto.RegisterType((*Command)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.Command")
 	proto.RegisterType((*ComparedTo)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ComparedTo")
 	proto.RegisterType((*ComponentParameter)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ComponentParameter")
@@ -2572,8 +2741,12 @@ func init() {
 	proto.RegisterType((*HealthStatus)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.HealthStatus")
 	proto.RegisterType((*HelmFileParameter)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.HelmFileParameter")
 	proto.RegisterType((*HelmParameter)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.HelmParameter")
+	proto.RegisterType((*HelmPostRenderer)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.HelmPostRenderer")
+	proto.RegisterType((*HelmValuesRepo)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.HelmValuesRepo")
 	proto.RegisterType((*HostInfo)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.HostInfo")
 	proto.RegisterType((*HostResourceInfo)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.HostResourceInfo")
+	proto.RegisterType((*HydrateTo)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.HydrateTo")
+	proto.RegisterType((*HydratorStatus)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.HydratorStatus")
 	proto.RegisterType((*Info)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.Info")
 	proto.RegisterType((*InfoItem)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.InfoItem")
 	proto.RegisterType((*JWTToken)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.JWTToken")
@@ -2618,6 +2791,7 @@ func init() {
 	proto.RegisterType((*SyncOperationResult)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncOperationResult")
 	proto.RegisterType((*SyncPolicy)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncPolicy")
 	proto.RegisterType((*SyncPolicyAutomated)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncPolicyAutomated")
+	proto.RegisterType((*SyncSchedule)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncSchedule")
 	proto.RegisterType((*SyncStatus)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncStatus")
 	proto.RegisterType((*SyncStrategy)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncStrategy")
 	proto.RegisterType((*SyncStrategyApply)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncStrategyApply")
@@ -2631,402 +2805,445 @@ func init() {
 }
 
 var fileDescriptor_e7dc23c2911a1a00 = []byte{
-	// 6317 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3d, 0x6d, 0x6c, 0x24, 0xd9,
-	0x51, 0xd7, 0x33, 0xfe, 0x18, 0x97, 0x3f, 0x76, 0xfd, 0x76, 0xf7, 0xe2, 0x2c, 0x97, 0xf5, 0xaa,
-	0x4f, 0x49, 0x0e, 0x92, 0xd8, 0xdc, 0x71, 0x84, 0x4b, 0x0e, 0x12, 0x3c, 0xf6, 0x7e, 0x78, 0xd7,
-	0xeb, 0xf5, 0x95, 0x7d, 0xbb, 0xd2, 0xe5, 0x83, 0x6b, 0xf7, 0xbc, 0x99, 0xe9, 0xf5, 0x4c, 0xf7,
-	0x5c, 0x77, 0x8f, 0x77, 0xe7, 0x48, 0x42, 0x02, 0x89, 0x74, 0x0a, 0x77, 0x01, 0x11, 0x25, 0x7f,
-	0x48, 0x24, 0x02, 0x3f, 0x10, 0x91, 0x10, 0x8a, 0x10, 0x12, 0xfc, 0x24, 0x48, 0xe8, 0x7e, 0x85,
-	0x28, 0x42, 0x70, 0x42, 0xc8, 0x70, 0x1b, 0x21, 0x21, 0xf8, 0x91, 0x20, 0x90, 0x90, 0xf6, 0x17,
-	0x7a, 0xdf, 0xaf, 0x7b, 0x66, 0xd6, 0xf6, 0x4e, 0xef, 0xe6, 0x14, 0xfe, 0x79, 0xaa, 0xaa, 0xab,
-	0xea, 0x7d, 0x54, 0xbd, 0x7a, 0xf5, 0xea, 0x3d, 0xc3, 0x7a, 0x23, 0x48, 0x9b, 0xdd, 0xdd, 0x25,
-	0x3f, 0x6a, 0x2f, 0x7b, 0x71, 0x23, 0xea, 0xc4, 0xd1, 0x2d, 0xfe, 0xc7, 0x87, 0xfc, 0xda, 0x72,
-	0x67, 0xaf, 0xb1, 0xec, 0x75, 0x82, 0x64, 0xd9, 0xeb, 0x74, 0x5a, 0x81, 0xef, 0xa5, 0x41, 0x14,
-	0x2e, 0xef, 0x3f, 0xed, 0xb5, 0x3a, 0x4d, 0xef, 0xe9, 0xe5, 0x06, 0x0d, 0x69, 0xec, 0xa5, 0xb4,
-	0xb6, 0xd4, 0x89, 0xa3, 0x34, 0x22, 0x1f, 0x31, 0xac, 0x96, 0x14, 0x2b, 0xfe, 0xc7, 0xaf, 0xf9,
-	0xb5, 0xa5, 0xce, 0x5e, 0x63, 0x89, 0xb1, 0x5a, 0xb2, 0x58, 0x2d, 0x29, 0x56, 0x67, 0x3f, 0x64,
-	0x69, 0xd1, 0x88, 0x1a, 0xd1, 0x32, 0xe7, 0xb8, 0xdb, 0xad, 0xf3, 0x5f, 0xfc, 0x07, 0xff, 0x4b,
-	0x48, 0x3a, 0xeb, 0xee, 0x3d, 0x97, 0x2c, 0x05, 0x11, 0xd3, 0x6d, 0xd9, 0x8f, 0x62, 0xba, 0xbc,
-	0xdf, 0xa7, 0xcd, 0xd9, 0x67, 0x0d, 0x4d, 0xdb, 0xf3, 0x9b, 0x41, 0x48, 0xe3, 0x9e, 0x69, 0x50,
-	0x9b, 0xa6, 0xde, 0xa0, 0xaf, 0x96, 0x87, 0x7d, 0x15, 0x77, 0xc3, 0x34, 0x68, 0xd3, 0xbe, 0x0f,
-	0x3e, 0x7c, 0xd8, 0x07, 0x89, 0xdf, 0xa4, 0x6d, 0x2f, 0xff, 0x9d, 0xfb, 0x0a, 0xcc, 0xae, 0xdc,
-	0xdc, 0x5e, 0xe9, 0xa6, 0xcd, 0xd5, 0x28, 0xac, 0x07, 0x0d, 0xf2, 0x8b, 0x30, 0xed, 0xb7, 0xba,
-	0x49, 0x4a, 0xe3, 0x4d, 0xaf, 0x4d, 0x17, 0x9c, 0xf3, 0xce, 0x53, 0x53, 0xd5, 0x53, 0x6f, 0x1e,
-	0x2c, 0x3e, 0x76, 0xf7, 0x60, 0x71, 0x7a, 0xd5, 0xa0, 0xd0, 0xa6, 0x23, 0x3f, 0x0b, 0x93, 0x71,
-	0xd4, 0xa2, 0x2b, 0xb8, 0xb9, 0x50, 0xe2, 0x9f, 0x9c, 0x90, 0x9f, 0x4c, 0xa2, 0x00, 0xa3, 0xc2,
-	0xbb, 0xdf, 0x2f, 0x01, 0xac, 0x74, 0x3a, 0x5b, 0x71, 0x74, 0x8b, 0xfa, 0x29, 0x79, 0x19, 0x2a,
-	0xac, 0x17, 0x6a, 0x5e, 0xea, 0x71, 0x69, 0xd3, 0xcf, 0xfc, 0xfc, 0x92, 0x68, 0xcc, 0x92, 0xdd,
-	0x18, 0x33, 0x72, 0x8c, 0x7a, 0x69, 0xff, 0xe9, 0xa5, 0xeb, 0xbb, 0xec, 0xfb, 0x6b, 0x34, 0xf5,
-	0xaa, 0x44, 0x0a, 0x03, 0x03, 0x43, 0xcd, 0x95, 0xec, 0xc1, 0x58, 0xd2, 0xa1, 0x3e, 0x57, 0x6c,
-	0xfa, 0x99, 0xf5, 0xa5, 0x07, 0x9e, 0x1f, 0x4b, 0x46, 0xed, 0xed, 0x0e, 0xf5, 0xab, 0x33, 0x52,
-	0xec, 0x18, 0xfb, 0x85, 0x5c, 0x08, 0x49, 0x60, 0x22, 0x49, 0xbd, 0xb4, 0x9b, 0x2c, 0x94, 0xb9,
-	0xb8, 0xab, 0xc5, 0x88, 0xe3, 0x2c, 0xab, 0x73, 0x52, 0xe0, 0x84, 0xf8, 0x8d, 0x52, 0x94, 0xfb,
-	0x4f, 0x0e, 0xcc, 0x19, 0xe2, 0x8d, 0x20, 0x49, 0xc9, 0x27, 0xfb, 0xba, 0x75, 0xe9, 0x68, 0xdd,
-	0xca, 0xbe, 0xe6, 0x9d, 0x7a, 0x52, 0x0a, 0xab, 0x28, 0x88, 0xd5, 0xa5, 0xb7, 0x60, 0x3c, 0x48,
-	0x69, 0x3b, 0x59, 0x28, 0x9d, 0x2f, 0x3f, 0x35, 0xfd, 0xcc, 0x85, 0x42, 0x1a, 0x59, 0x9d, 0x95,
-	0x12, 0xc7, 0xd7, 0x19, 0x6f, 0x14, 0x22, 0xdc, 0x7f, 0x03, 0xbb, 0x71, 0xac, 0xab, 0xc9, 0xd3,
-	0x30, 0x9d, 0x44, 0xdd, 0xd8, 0xa7, 0x48, 0x3b, 0x51, 0xb2, 0xe0, 0x9c, 0x2f, 0xb3, 0x19, 0xc7,
-	0x26, 0xe8, 0xb6, 0x01, 0xa3, 0x4d, 0x43, 0x7e, 0xdb, 0x81, 0x99, 0x1a, 0x4d, 0xd2, 0x20, 0xe4,
-	0xf2, 0x95, 0xe6, 0x2f, 0x8c, 0xa6, 0xb9, 0x02, 0xae, 0x19, 0xce, 0xd5, 0xd3, 0xb2, 0x15, 0x33,
-	0x16, 0x30, 0xc1, 0x8c, 0x70, 0x66, 0x65, 0x35, 0x9a, 0xf8, 0x71, 0xd0, 0x61, 0xbf, 0xf9, 0x54,
-	0xb1, 0xac, 0x6c, 0xcd, 0xa0, 0xd0, 0xa6, 0x23, 0x7b, 0x30, 0xce, 0xac, 0x28, 0x59, 0x18, 0xe3,
-	0xca, 0x5f, 0x1c, 0x41, 0x79, 0xd9, 0x9d, 0xcc, 0x3a, 0x4d, 0xbf, 0xb3, 0x5f, 0x09, 0x0a, 0x19,
-	0xe4, 0x0d, 0x07, 0x16, 0xa4, 0x89, 0x23, 0x15, 0x5d, 0x79, 0xb3, 0x19, 0xa4, 0xb4, 0x15, 0x24,
-	0xe9, 0xc2, 0x38, 0x57, 0x60, 0xf9, 0x68, 0x53, 0xea, 0x52, 0x1c, 0x75, 0x3b, 0x57, 0x83, 0xb0,
-	0x56, 0x3d, 0x2f, 0x25, 0x2d, 0xac, 0x0e, 0x61, 0x8c, 0x43, 0x45, 0x92, 0xaf, 0x3a, 0x70, 0x36,
-	0xf4, 0xda, 0x34, 0xe9, 0x78, 0x6c, 0x50, 0x05, 0xba, 0xda, 0xf2, 0xfc, 0x3d, 0xae, 0xd1, 0xc4,
-	0x83, 0x69, 0xe4, 0x4a, 0x8d, 0xce, 0x6e, 0x0e, 0x65, 0x8d, 0xf7, 0x11, 0x4b, 0xfe, 0xc0, 0x81,
-	0xf9, 0x28, 0xee, 0x34, 0xbd, 0x90, 0xd6, 0x14, 0x36, 0x59, 0x98, 0xe4, 0x16, 0xf7, 0x89, 0x11,
-	0xc6, 0xe7, 0x7a, 0x9e, 0xe7, 0xb5, 0x28, 0x0c, 0xd2, 0x28, 0xde, 0xa6, 0x69, 0x1a, 0x84, 0x8d,
-	0xa4, 0x7a, 0xe6, 0xee, 0xc1, 0xe2, 0x7c, 0x1f, 0x15, 0xf6, 0x2b, 0x43, 0xee, 0xc0, 0x74, 0xd2,
-	0x0b, 0xfd, 0x9b, 0x41, 0x58, 0x8b, 0x6e, 0x27, 0x0b, 0x95, 0x91, 0x4d, 0x76, 0x5b, 0x73, 0x93,
-	0x46, 0x67, 0xb8, 0xa3, 0x2d, 0x6a, 0xf0, 0x90, 0x99, 0x49, 0x34, 0x55, 0xf4, 0x90, 0x99, 0x69,
-	0x74, 0x1f, 0xb1, 0xe4, 0x8b, 0x0e, 0xcc, 0x26, 0x41, 0x23, 0xf4, 0xd2, 0x6e, 0x4c, 0xaf, 0xd2,
-	0x5e, 0xb2, 0x00, 0x5c, 0x91, 0x4b, 0xa3, 0x74, 0x89, 0xc5, 0xaf, 0x7a, 0x46, 0x2a, 0x38, 0x6b,
-	0x43, 0x13, 0xcc, 0x0a, 0x1d, 0x64, 0x5f, 0x66, 0x36, 0x4f, 0x17, 0x6b, 0x5f, 0x66, 0x2e, 0x0f,
-	0x15, 0xe9, 0xfe, 0x4d, 0x09, 0x4e, 0xe6, 0x57, 0x1c, 0xf2, 0x47, 0x0e, 0x9c, 0xb8, 0x75, 0x3b,
-	0xdd, 0x89, 0xf6, 0x68, 0x98, 0x54, 0x7b, 0xcc, 0x41, 0x70, 0x77, 0x3b, 0xfd, 0xcc, 0xcb, 0x05,
-	0x2e, 0x6c, 0x4b, 0x57, 0xb2, 0x22, 0x2e, 0x84, 0x69, 0xdc, 0xab, 0xbe, 0x4b, 0x36, 0xe6, 0xc4,
-	0x95, 0x9b, 0x3b, 0x36, 0x16, 0xf3, 0x1a, 0x9d, 0x7d, 0xcd, 0x81, 0xd3, 0x83, 0x58, 0x90, 0x93,
-	0x50, 0xde, 0xa3, 0x3d, 0x11, 0xc5, 0x20, 0xfb, 0x93, 0xbc, 0x04, 0xe3, 0xfb, 0x5e, 0xab, 0x4b,
-	0x65, 0x34, 0xb0, 0x36, 0x42, 0x2b, 0xb4, 0x5a, 0x28, 0x58, 0x7e, 0xb4, 0xf4, 0x9c, 0xe3, 0xfe,
-	0x6d, 0x19, 0xa6, 0xad, 0x85, 0xe1, 0x11, 0x84, 0x37, 0xad, 0x4c, 0x78, 0x73, 0xa5, 0x98, 0x05,
-	0x6d, 0x68, 0x7c, 0x93, 0xe6, 0xe2, 0x9b, 0x8d, 0x82, 0xe4, 0xdd, 0x37, 0xc0, 0x21, 0xaf, 0xc0,
-	0x54, 0xd4, 0x61, 0x81, 0x2b, 0x5b, 0x2d, 0xc7, 0x46, 0x1e, 0xb9, 0xeb, 0x8a, 0x57, 0x75, 0xf6,
-	0xee, 0xc1, 0xe2, 0x94, 0xfe, 0x89, 0x46, 0x8a, 0xfb, 0x8f, 0x0e, 0x9c, 0xb6, 0x14, 0x5c, 0x8d,
-	0xc2, 0x5a, 0xc0, 0x47, 0xf4, 0x3c, 0x8c, 0xa5, 0xbd, 0x8e, 0x0a, 0x8d, 0x75, 0x1f, 0xed, 0xf4,
-	0x3a, 0x14, 0x39, 0x86, 0x05, 0xc3, 0x6d, 0x9a, 0x24, 0x5e, 0x83, 0xe6, 0x83, 0xe1, 0x6b, 0x02,
-	0x8c, 0x0a, 0x4f, 0x62, 0x20, 0x2d, 0x2f, 0x49, 0x77, 0x62, 0x2f, 0x4c, 0x38, 0xfb, 0x9d, 0xa0,
-	0x4d, 0x65, 0xd7, 0xfe, 0xdc, 0xd1, 0x26, 0x0a, 0xfb, 0xa2, 0xfa, 0xf8, 0xdd, 0x83, 0x45, 0xb2,
-	0xd1, 0xc7, 0x09, 0x07, 0x70, 0x77, 0xbf, 0xea, 0xc0, 0xe3, 0x83, 0x63, 0x17, 0xf2, 0x3e, 0x98,
-	0x48, 0x68, 0xbc, 0x4f, 0x63, 0xd9, 0x3a, 0x33, 0x1e, 0x1c, 0x8a, 0x12, 0x4b, 0x96, 0x61, 0x4a,
-	0x3b, 0x58, 0xd9, 0xc6, 0x79, 0x49, 0x3a, 0x65, 0xbc, 0xb2, 0xa1, 0x61, 0x9d, 0xc6, 0x7e, 0xc8,
-	0x48, 0x47, 0x77, 0x1a, 0xdf, 0x48, 0x70, 0x8c, 0xfb, 0xcf, 0x0e, 0x9c, 0xb0, 0xb4, 0x7a, 0x04,
-	0x41, 0xec, 0x5e, 0x36, 0x88, 0xbd, 0x58, 0xcc, 0x4c, 0x1e, 0x12, 0xc5, 0xfe, 0xf9, 0x04, 0xcc,
-	0xdb, 0xf3, 0x9d, 0x3b, 0x5f, 0xbe, 0x6d, 0xa2, 0x9d, 0xe8, 0x45, 0xdc, 0x90, 0x1d, 0x6e, 0xb6,
-	0x4d, 0x02, 0x8c, 0x0a, 0xcf, 0x7a, 0xb0, 0xe3, 0xa5, 0x4d, 0xd9, 0xdb, 0xba, 0x07, 0xb7, 0xbc,
-	0xb4, 0x89, 0x1c, 0x43, 0x3e, 0x06, 0x73, 0xa9, 0x17, 0x37, 0x68, 0x8a, 0x74, 0x3f, 0x48, 0x94,
-	0xa5, 0x4c, 0x55, 0x1f, 0x97, 0xb4, 0x73, 0x3b, 0x19, 0x2c, 0xe6, 0xa8, 0x49, 0x08, 0x63, 0x4d,
-	0xda, 0x6a, 0xcb, 0xe0, 0x65, 0xab, 0x20, 0xc3, 0xe6, 0x0d, 0xbd, 0x4c, 0x5b, 0xed, 0x6a, 0x85,
-	0xe9, 0xcb, 0xfe, 0x42, 0x2e, 0x87, 0xfc, 0xa6, 0x03, 0x53, 0x7b, 0xdd, 0x24, 0x8d, 0xda, 0xc1,
-	0xab, 0x74, 0xa1, 0xc2, 0xa5, 0xbe, 0x58, 0xa4, 0xd4, 0xab, 0x8a, 0xb9, 0x30, 0x73, 0xfd, 0x13,
-	0x8d, 0x58, 0xf2, 0x2a, 0x4c, 0xee, 0x25, 0x51, 0x18, 0x52, 0x16, 0x8e, 0x30, 0x0d, 0xb6, 0x0b,
-	0xd5, 0x40, 0xb0, 0xae, 0x4e, 0xb3, 0x21, 0x95, 0x3f, 0x50, 0x09, 0xe4, 0x1d, 0x50, 0x0b, 0x62,
-	0xea, 0xa7, 0x51, 0xdc, 0x5b, 0x80, 0xe2, 0x3b, 0x60, 0x4d, 0x31, 0x17, 0x1d, 0xa0, 0x7f, 0xa2,
-	0x11, 0x4b, 0xf6, 0x61, 0xa2, 0xd3, 0xea, 0x36, 0x82, 0x70, 0x61, 0x9a, 0x2b, 0x80, 0x45, 0x2a,
-	0xb0, 0xc5, 0x39, 0x57, 0x81, 0xb9, 0x10, 0xf1, 0x37, 0x4a, 0x69, 0xe4, 0x49, 0x18, 0xf7, 0x9b,
-	0x5e, 0x9c, 0x2e, 0xcc, 0xf0, 0x49, 0xaa, 0xad, 0x66, 0x95, 0x01, 0x51, 0xe0, 0xdc, 0x6f, 0x96,
-	0xe0, 0xec, 0xf0, 0x56, 0x09, 0xf3, 0xf1, 0xbb, 0x71, 0x22, 0xbc, 0x71, 0xc5, 0x36, 0x1f, 0x0e,
-	0x46, 0x85, 0x27, 0x9f, 0x83, 0xc9, 0x5b, 0x72, 0x9c, 0x4b, 0xc5, 0x8f, 0xf3, 0x15, 0x39, 0xce,
-	0x5a, 0xfe, 0x15, 0x35, 0xd6, 0x52, 0x28, 0x53, 0x95, 0xde, 0xf1, 0x5b, 0xdd, 0x9a, 0xf2, 0x81,
-	0x9a, 0xf4, 0x82, 0x00, 0xa3, 0xc2, 0x33, 0xd2, 0x20, 0x14, 0xa4, 0x63, 0x59, 0xd2, 0xf5, 0x50,
-	0x92, 0x4a, 0xbc, 0x7b, 0x50, 0x86, 0x33, 0x03, 0x8d, 0x8d, 0x2c, 0x01, 0xf0, 0xa0, 0xe4, 0x62,
-	0xc0, 0xf6, 0x8b, 0x62, 0x87, 0x3c, 0xc7, 0x62, 0x88, 0x1b, 0x1a, 0x8a, 0x16, 0x05, 0xf9, 0x0c,
-	0x40, 0xc7, 0x8b, 0xbd, 0x36, 0x4d, 0x69, 0xac, 0x3c, 0xe2, 0xe5, 0x11, 0xba, 0x88, 0x29, 0xb1,
-	0xa5, 0x18, 0x9a, 0x08, 0x46, 0x83, 0x12, 0xb4, 0xe4, 0xb1, 0xfd, 0x70, 0x4c, 0x5b, 0xd4, 0x4b,
-	0xe8, 0xa6, 0x59, 0x25, 0xf4, 0x7e, 0x18, 0x0d, 0x0a, 0x6d, 0x3a, 0xb6, 0x5c, 0xf1, 0x26, 0x24,
-	0xb2, 0xa3, 0xf4, 0x72, 0xc5, 0x1b, 0x99, 0xa0, 0xc4, 0x92, 0xd7, 0x1d, 0x98, 0xab, 0x07, 0x2d,
-	0x6a, 0xa4, 0xcb, 0x0d, 0xec, 0xc6, 0x88, 0x2d, 0xbc, 0x68, 0x33, 0x35, 0x8e, 0x36, 0x03, 0x4e,
-	0x30, 0x27, 0x9b, 0x0d, 0xf0, 0x3e, 0x8d, 0xb9, 0x87, 0x9e, 0xc8, 0x0e, 0xf0, 0x0d, 0x01, 0x46,
-	0x85, 0x77, 0xbf, 0x5a, 0x82, 0x85, 0x61, 0xb3, 0x8d, 0x74, 0xd8, 0x9c, 0x4a, 0x6f, 0x78, 0x71,
-	0x22, 0x63, 0xf2, 0x51, 0x36, 0x75, 0x92, 0xe9, 0x0d, 0x2f, 0xb6, 0xa7, 0x26, 0xe7, 0x8e, 0x4a,
-	0x0c, 0x69, 0xc0, 0x58, 0xda, 0xf2, 0x8a, 0x48, 0xfb, 0x58, 0xe2, 0x4c, 0x08, 0xb5, 0xb1, 0x92,
-	0x20, 0x17, 0x40, 0x9e, 0x80, 0xb1, 0x56, 0xb0, 0xcb, 0x82, 0x4c, 0x36, 0x71, 0xf9, 0xca, 0xb1,
-	0x11, 0xec, 0x26, 0xc8, 0xa1, 0xee, 0x0f, 0x9c, 0x01, 0xbd, 0x22, 0xdd, 0x2b, 0x9b, 0x4b, 0x34,
-	0xdc, 0x0f, 0xe2, 0x28, 0x6c, 0xd3, 0x30, 0xcd, 0x67, 0x30, 0x2f, 0x18, 0x14, 0xda, 0x74, 0xe4,
-	0x37, 0x06, 0x18, 0xc0, 0x28, 0xc9, 0x3b, 0xa9, 0xce, 0x91, 0x6d, 0xc0, 0x7d, 0x73, 0x7c, 0x80,
-	0xaf, 0xd3, 0x6b, 0x16, 0x79, 0x06, 0x80, 0xc5, 0x49, 0x5b, 0x31, 0xad, 0x07, 0x77, 0x64, 0xab,
-	0x34, 0xcb, 0x4d, 0x8d, 0x41, 0x8b, 0x4a, 0x7d, 0xb3, 0xdd, 0xad, 0xb3, 0x6f, 0x4a, 0xfd, 0xdf,
-	0x08, 0x0c, 0x5a, 0x54, 0xe4, 0x59, 0x98, 0x08, 0xda, 0x5e, 0x83, 0xaa, 0xbe, 0x7f, 0x82, 0xd9,
-	0xd3, 0x3a, 0x87, 0xdc, 0x3b, 0x58, 0x9c, 0xd3, 0x0a, 0x71, 0x10, 0x4a, 0x5a, 0xf2, 0x2d, 0x07,
-	0x66, 0xfc, 0xa8, 0xdd, 0x8e, 0xc2, 0x0d, 0x6f, 0x97, 0xb6, 0x54, 0x86, 0xaa, 0xf1, 0x50, 0x96,
-	0xf3, 0xa5, 0x55, 0x4b, 0x92, 0xd8, 0x2b, 0xea, 0xa4, 0x9b, 0x8d, 0xc2, 0x8c, 0x4a, 0xb6, 0xd9,
-	0x8d, 0xdf, 0xdf, 0xec, 0xc8, 0x5f, 0x38, 0x30, 0x2f, 0xbe, 0x5d, 0x09, 0xc3, 0x28, 0x95, 0x29,
-	0x43, 0x91, 0x62, 0x6a, 0x3d, 0xcc, 0x36, 0x59, 0xe2, 0x44, 0xc3, 0xde, 0x2d, 0x75, 0x9c, 0xef,
-	0xc3, 0x63, 0xbf, 0x86, 0x67, 0x3f, 0x0e, 0xf3, 0x7d, 0x7d, 0x33, 0x60, 0x13, 0x7c, 0xda, 0xde,
-	0x04, 0x4f, 0x59, 0xdb, 0xd7, 0xb3, 0x6b, 0xf0, 0xf8, 0x60, 0x45, 0x8e, 0xc3, 0xc5, 0xfd, 0x7d,
-	0x07, 0xde, 0x35, 0x24, 0x16, 0xd0, 0x3b, 0x01, 0x67, 0xd8, 0x4e, 0x80, 0x7c, 0x1a, 0xca, 0x34,
-	0xdc, 0x97, 0x26, 0xb8, 0x3a, 0x42, 0x6f, 0x5f, 0x08, 0xf7, 0x45, 0x27, 0x4e, 0xde, 0x3d, 0x58,
-	0x2c, 0x5f, 0x08, 0xf7, 0x91, 0x31, 0x76, 0xbf, 0x34, 0x91, 0xd9, 0x69, 0x6c, 0xab, 0x6d, 0x2d,
-	0xd7, 0x52, 0xee, 0x33, 0x36, 0x8a, 0x1c, 0x64, 0x6b, 0x1b, 0x25, 0x32, 0xd2, 0x52, 0x16, 0x79,
-	0xcd, 0xe1, 0x79, 0x60, 0xb5, 0xfd, 0x92, 0x91, 0xc9, 0x43, 0xc8, 0x49, 0xdb, 0xa9, 0x65, 0x05,
-	0x44, 0x5b, 0x34, 0x33, 0x8e, 0x8e, 0x48, 0xc9, 0xe4, 0xe3, 0x13, 0x95, 0x29, 0x56, 0x78, 0xd2,
-	0x05, 0x48, 0x7a, 0xa1, 0xbf, 0x15, 0xb5, 0x02, 0xbf, 0x27, 0x77, 0xe3, 0xa3, 0xa6, 0x13, 0x05,
-	0x33, 0x11, 0xa1, 0x98, 0xdf, 0x68, 0x09, 0x22, 0xdf, 0x74, 0x60, 0x3e, 0x68, 0x84, 0x51, 0x4c,
-	0xd7, 0x82, 0x7a, 0x9d, 0xc6, 0x34, 0xf4, 0xa9, 0x5a, 0xc7, 0x77, 0x46, 0x10, 0xaf, 0x32, 0x61,
-	0xeb, 0x79, 0xde, 0xc6, 0xf6, 0xfa, 0x50, 0xd8, 0xaf, 0x09, 0xf1, 0x60, 0x2c, 0x08, 0xeb, 0x91,
-	0xf4, 0x12, 0x1f, 0x1f, 0x41, 0xa3, 0xf5, 0xb0, 0x1e, 0x19, 0xcb, 0x60, 0xbf, 0x90, 0xb3, 0x26,
-	0x1b, 0x70, 0x3a, 0x96, 0xbb, 0xb5, 0xcb, 0x41, 0xc2, 0x42, 0xe0, 0x8d, 0xa0, 0x1d, 0xa4, 0x7c,
-	0xc7, 0x56, 0xae, 0x2e, 0xdc, 0x3d, 0x58, 0x3c, 0x8d, 0x03, 0xf0, 0x38, 0xf0, 0x2b, 0xf7, 0xbf,
-	0x2b, 0xd9, 0x2d, 0xa9, 0x48, 0xb5, 0xbc, 0x0a, 0x53, 0xb1, 0xce, 0x63, 0x8b, 0xb0, 0x62, 0xbd,
-	0x80, 0xde, 0x95, 0x09, 0x1e, 0x9d, 0x25, 0x30, 0x19, 0x6b, 0x23, 0x8e, 0x85, 0x17, 0x6c, 0xc0,
-	0xa5, 0x1d, 0x8c, 0x3a, 0xa7, 0xa4, 0x48, 0x93, 0xc5, 0xea, 0x85, 0x3e, 0x72, 0x01, 0x24, 0x82,
-	0x89, 0x26, 0xf5, 0x5a, 0x69, 0x53, 0xa6, 0x5a, 0x2e, 0x8d, 0x14, 0x07, 0x32, 0x46, 0xf9, 0x04,
-	0x96, 0x80, 0xa2, 0x14, 0x43, 0xba, 0x30, 0xd9, 0x14, 0x7d, 0x2f, 0x57, 0xc6, 0x2b, 0x23, 0xf5,
-	0x69, 0x66, 0x34, 0x8d, 0xa9, 0x4a, 0x00, 0x2a, 0x59, 0xe4, 0xb7, 0x1c, 0x00, 0x5f, 0x65, 0xae,
-	0x94, 0xb1, 0x5c, 0x2f, 0xc6, 0xbf, 0xe8, 0x8c, 0x98, 0x09, 0x29, 0x34, 0x28, 0x41, 0x4b, 0x2c,
-	0x79, 0x19, 0x66, 0x62, 0xea, 0x47, 0xa1, 0x1f, 0xb4, 0x68, 0x6d, 0x25, 0xe5, 0x41, 0xef, 0xf1,
-	0xd2, 0x5b, 0x27, 0xd9, 0xd2, 0x8e, 0x16, 0x0f, 0xcc, 0x70, 0x24, 0x5f, 0x72, 0x60, 0x4e, 0xa7,
-	0xee, 0xd8, 0x50, 0x50, 0x99, 0xc5, 0x58, 0x2f, 0x22, 0x4b, 0xc8, 0x19, 0x56, 0x09, 0x8b, 0xec,
-	0xb3, 0x30, 0xcc, 0x09, 0x25, 0x2f, 0x01, 0x44, 0xbb, 0x3c, 0x47, 0xc6, 0xda, 0x59, 0x39, 0x76,
-	0x3b, 0xe7, 0x44, 0x96, 0x57, 0x71, 0x40, 0x8b, 0x1b, 0xb9, 0x0a, 0x20, 0xec, 0x64, 0xa7, 0xd7,
-	0xa1, 0x3c, 0x59, 0x31, 0x55, 0xfd, 0x80, 0xea, 0xf9, 0x6d, 0x8d, 0xb9, 0x77, 0xb0, 0xd8, 0xbf,
-	0x25, 0xe4, 0xc9, 0x49, 0xeb, 0x73, 0x72, 0x07, 0x26, 0x93, 0x6e, 0xbb, 0xed, 0xe9, 0xbc, 0xc3,
-	0xb5, 0x82, 0x16, 0x3c, 0xc1, 0xd4, 0x4c, 0x49, 0x09, 0x40, 0x25, 0xce, 0x0d, 0x81, 0xf4, 0xd3,
-	0x93, 0x67, 0x61, 0x86, 0xde, 0x49, 0x69, 0x1c, 0x7a, 0xad, 0x17, 0x71, 0x43, 0x6d, 0x58, 0xf9,
-	0xb0, 0x5f, 0xb0, 0xe0, 0x98, 0xa1, 0x22, 0xae, 0x8e, 0x55, 0x4b, 0x9c, 0x1e, 0x4c, 0xac, 0xaa,
-	0x22, 0x53, 0xf7, 0xc7, 0xa5, 0xcc, 0x6a, 0xbf, 0x13, 0x53, 0x4a, 0x5a, 0x30, 0x1e, 0x46, 0x35,
-	0xed, 0xdf, 0x2e, 0x15, 0xe0, 0xdf, 0x36, 0xa3, 0x9a, 0x75, 0x90, 0xca, 0x7e, 0x25, 0x28, 0x84,
-	0xf0, 0xf3, 0x26, 0x75, 0x2a, 0xc7, 0x11, 0x32, 0xb4, 0x29, 0x4c, 0xac, 0x3e, 0x6f, 0xba, 0x6e,
-	0x4b, 0xc1, 0xac, 0x50, 0xd2, 0x84, 0xf1, 0x66, 0x94, 0xa4, 0x22, 0xae, 0x1f, 0x2d, 0xb0, 0xba,
-	0x1c, 0x25, 0x29, 0x5f, 0xa4, 0x74, 0x83, 0x19, 0x24, 0x41, 0x21, 0xc0, 0xfd, 0xa1, 0x93, 0xc9,
-	0x4a, 0xdc, 0xf4, 0x52, 0xbf, 0x79, 0x61, 0x9f, 0x6d, 0xb2, 0xae, 0x66, 0x72, 0xe7, 0xbf, 0x64,
-	0xe7, 0xce, 0xef, 0x1d, 0x2c, 0xbe, 0x7f, 0x58, 0x11, 0xcb, 0x6d, 0xc6, 0x61, 0x89, 0xb3, 0xb0,
-	0xd2, 0xec, 0x9f, 0x85, 0x69, 0x4b, 0x3b, 0xb9, 0x68, 0x14, 0x95, 0xc5, 0xd5, 0x11, 0x93, 0x05,
-	0x44, 0x5b, 0x9e, 0xfb, 0x7b, 0x0e, 0x4c, 0x56, 0x3d, 0x7f, 0x2f, 0xaa, 0xd7, 0xc9, 0x07, 0xa1,
-	0x52, 0xeb, 0xca, 0xe3, 0x09, 0xd1, 0x36, 0x9d, 0x78, 0x5e, 0x93, 0x70, 0xd4, 0x14, 0x6c, 0xda,
-	0xd6, 0x3d, 0x3f, 0x8d, 0x62, 0xae, 0x73, 0x59, 0x4c, 0xdb, 0x8b, 0x1c, 0x82, 0x12, 0xc3, 0x76,
-	0xb1, 0x6d, 0xef, 0x8e, 0xfa, 0x38, 0x9f, 0x11, 0xb9, 0x66, 0x50, 0x68, 0xd3, 0xb9, 0x7f, 0x3d,
-	0x0e, 0x93, 0xf2, 0xec, 0xef, 0xc8, 0xc9, 0x7c, 0x15, 0x91, 0x97, 0x86, 0x46, 0xe4, 0x1d, 0x98,
-	0xf0, 0x79, 0x79, 0x90, 0x5c, 0x2e, 0x47, 0x49, 0x0c, 0x49, 0xed, 0x44, 0xb9, 0x91, 0xd1, 0x49,
-	0xfc, 0x46, 0x29, 0x87, 0xbc, 0xe1, 0xc0, 0x09, 0x9f, 0x6d, 0xa0, 0x7d, 0xe3, 0xd1, 0xc7, 0x46,
-	0x3e, 0xe0, 0x5a, 0xcd, 0x72, 0x34, 0x27, 0x8c, 0x39, 0x04, 0xe6, 0x65, 0x93, 0xe7, 0x61, 0x56,
-	0xf4, 0xd6, 0x8d, 0xcc, 0x0e, 0xd2, 0x9c, 0xf4, 0xda, 0x48, 0xcc, 0xd2, 0x92, 0x25, 0xb1, 0x0d,
-	0xe7, 0x27, 0x21, 0x62, 0x17, 0x29, 0x73, 0x71, 0xfa, 0xa8, 0x24, 0x41, 0x8b, 0x82, 0xc4, 0x40,
-	0x62, 0x5a, 0x8f, 0x69, 0xd2, 0x44, 0xfa, 0x4a, 0x97, 0x26, 0x29, 0x5f, 0x4d, 0x26, 0x1f, 0xec,
-	0x50, 0x08, 0xfb, 0x38, 0xe1, 0x00, 0xee, 0xa4, 0x29, 0xa3, 0xd7, 0xca, 0xc8, 0x56, 0x24, 0x07,
-	0x78, 0x68, 0x10, 0xbb, 0x08, 0xe3, 0x49, 0xd3, 0x8b, 0x6b, 0x7c, 0x09, 0x2b, 0x57, 0xa7, 0x98,
-	0xfb, 0xd8, 0x66, 0x00, 0x14, 0x70, 0xf7, 0x7f, 0x1c, 0x38, 0xa9, 0x66, 0x89, 0xe7, 0x37, 0x29,
-	0xfb, 0x96, 0x7c, 0x0c, 0xe6, 0x74, 0x9c, 0xb8, 0x1a, 0x75, 0x65, 0x62, 0xa7, 0x6c, 0x72, 0x6e,
-	0x98, 0xc1, 0x62, 0x8e, 0x9a, 0x2c, 0xc3, 0x14, 0x53, 0x59, 0x7c, 0x2a, 0xcc, 0x4e, 0xc7, 0xa2,
-	0x2b, 0x5b, 0xeb, 0xf2, 0x2b, 0x43, 0x43, 0x22, 0x98, 0x6f, 0x79, 0x49, 0xca, 0x35, 0x60, 0x91,
-	0xe3, 0x03, 0x1e, 0xcc, 0xf1, 0x32, 0x8d, 0x8d, 0x3c, 0x23, 0xec, 0xe7, 0xed, 0x7e, 0x6f, 0x0c,
-	0x66, 0x33, 0xc6, 0xc1, 0xbc, 0x4a, 0x37, 0x61, 0xab, 0x9d, 0xde, 0x2e, 0x6b, 0xaf, 0xf2, 0xa2,
-	0x84, 0xa3, 0xa6, 0x60, 0xd4, 0x1d, 0x2f, 0x49, 0x6e, 0x47, 0x71, 0x4d, 0x9a, 0xb2, 0xa6, 0xde,
-	0x92, 0x70, 0xd4, 0x14, 0xcc, 0xbf, 0xec, 0x52, 0x2f, 0xa6, 0x31, 0x3f, 0xc2, 0xce, 0xfb, 0x97,
-	0xaa, 0x41, 0xa1, 0x4d, 0xc7, 0xed, 0x32, 0x6d, 0x25, 0xab, 0xad, 0x80, 0x86, 0xa9, 0x50, 0xb3,
-	0x00, 0xbb, 0xdc, 0xd9, 0xd8, 0xb6, 0x39, 0x1a, 0xbb, 0xcc, 0x21, 0x30, 0x2f, 0x9b, 0x7c, 0xc1,
-	0x81, 0x59, 0xef, 0x76, 0x62, 0x0a, 0x18, 0xb9, 0x61, 0x8e, 0xe6, 0xa1, 0x32, 0x05, 0x91, 0xd5,
-	0x79, 0x66, 0xde, 0x19, 0x10, 0x66, 0x25, 0x92, 0xaf, 0x39, 0x40, 0xe8, 0x1d, 0xea, 0x6f, 0xc5,
-	0xd1, 0x7e, 0x50, 0x53, 0xa3, 0x27, 0xa3, 0xdc, 0x51, 0xe2, 0xaa, 0x0b, 0x7d, 0x4c, 0x85, 0x49,
-	0xf7, 0xc3, 0x71, 0x80, 0x02, 0xee, 0xb7, 0xca, 0x30, 0x6d, 0x19, 0xe3, 0x40, 0x9f, 0xea, 0xbc,
-	0x93, 0x7c, 0x6a, 0xe9, 0x18, 0x3e, 0xf5, 0x33, 0x30, 0xe5, 0x2b, 0xe7, 0x50, 0x40, 0xa9, 0x65,
-	0xde, 0xdf, 0x18, 0xe7, 0xa0, 0x41, 0x68, 0x04, 0x92, 0x4b, 0x30, 0x6f, 0xb1, 0x91, 0x5e, 0x65,
-	0x8c, 0x7b, 0x15, 0x9d, 0x34, 0x58, 0xc9, 0x13, 0x60, 0xff, 0x37, 0xee, 0xdf, 0x3b, 0x7a, 0x8c,
-	0x1e, 0xc1, 0x89, 0x77, 0x23, 0x7b, 0xe2, 0x5d, 0x1d, 0xbd, 0xc3, 0x86, 0x9c, 0x76, 0x6f, 0xc2,
-	0xe4, 0x6a, 0xd4, 0x6e, 0x7b, 0x61, 0x8d, 0xbc, 0x17, 0x26, 0x7d, 0xf1, 0xa7, 0x0c, 0xea, 0xf9,
-	0x59, 0xa8, 0xc4, 0xa2, 0xc2, 0x91, 0x27, 0x60, 0xcc, 0x8b, 0x1b, 0x2a, 0x90, 0xe7, 0x09, 0xff,
-	0x95, 0xb8, 0x91, 0x20, 0x87, 0xba, 0x6f, 0x94, 0x00, 0x56, 0xa3, 0x76, 0xc7, 0x8b, 0x69, 0x6d,
-	0x27, 0xfa, 0x7f, 0x9f, 0xad, 0x73, 0x5f, 0x77, 0x80, 0xb0, 0xfe, 0x88, 0x42, 0x1a, 0x9a, 0x23,
-	0x06, 0xb6, 0xc8, 0xf9, 0x0a, 0x2a, 0x57, 0x0c, 0x33, 0x8f, 0x15, 0x02, 0x0d, 0xcd, 0x11, 0x42,
-	0xbf, 0x27, 0x55, 0x92, 0xb7, 0x9c, 0x3d, 0xa6, 0xe5, 0x27, 0x6c, 0x32, 0xe7, 0xeb, 0x7e, 0xa5,
-	0x04, 0x8f, 0x0b, 0xa7, 0x73, 0xcd, 0x0b, 0xbd, 0x06, 0x6d, 0x33, 0xad, 0x8e, 0x9a, 0xee, 0x7d,
-	0x99, 0x45, 0x1e, 0x81, 0x3a, 0x96, 0x1d, 0x69, 0x4e, 0x8a, 0xb9, 0x24, 0x66, 0xcf, 0x7a, 0x18,
-	0xa4, 0xc8, 0x39, 0x93, 0x0e, 0x54, 0x54, 0xdd, 0xbb, 0x74, 0x15, 0x45, 0x48, 0xd1, 0x86, 0x76,
-	0x49, 0xf2, 0x46, 0x2d, 0xc5, 0xfd, 0xae, 0x03, 0x79, 0xff, 0xc7, 0xc3, 0x71, 0x51, 0x39, 0x95,
-	0x0f, 0xc7, 0xb3, 0xb5, 0x4e, 0xc7, 0xa8, 0x1e, 0xfa, 0x24, 0x4c, 0x7b, 0x69, 0x4a, 0xdb, 0x1d,
-	0x11, 0x21, 0x96, 0x1f, 0x2c, 0xdf, 0x70, 0x2d, 0xaa, 0x05, 0xf5, 0x80, 0x47, 0x86, 0x36, 0x3b,
-	0xf7, 0x05, 0xa8, 0xa8, 0x0c, 0xfa, 0x11, 0x86, 0xf1, 0xc9, 0xcc, 0x69, 0xc0, 0x90, 0x89, 0xf2,
-	0xbf, 0x25, 0x18, 0xb0, 0x7a, 0xb1, 0x26, 0x1b, 0x1f, 0x91, 0x69, 0xf2, 0xf1, 0xfc, 0x04, 0xe9,
-	0x8a, 0xa3, 0x03, 0xb1, 0xc3, 0xbd, 0x51, 0xe8, 0xd2, 0x6b, 0x4e, 0x13, 0xa6, 0xa5, 0x72, 0xfa,
-	0x44, 0x81, 0x3c, 0x03, 0xe0, 0x75, 0x02, 0xb5, 0x8c, 0x8d, 0x65, 0xcf, 0xd9, 0x56, 0xb6, 0xd6,
-	0xd5, 0x1a, 0x66, 0x51, 0xb1, 0x00, 0x2c, 0x08, 0x93, 0xd4, 0x6b, 0xb5, 0x2e, 0x07, 0x61, 0x2a,
-	0xf7, 0x13, 0xda, 0xf2, 0xd7, 0x0d, 0x0a, 0x6d, 0xba, 0xb3, 0x1f, 0xb6, 0x06, 0xe5, 0x38, 0x47,
-	0x32, 0xaf, 0x97, 0x60, 0xee, 0x52, 0xd8, 0xdd, 0xba, 0xb4, 0xd5, 0xdd, 0x6d, 0x05, 0xfe, 0x55,
-	0xda, 0x63, 0x23, 0xb6, 0x47, 0x7b, 0xeb, 0x6b, 0xb2, 0xcf, 0xf5, 0x88, 0x5d, 0x65, 0x40, 0x14,
-	0x38, 0xa6, 0x66, 0x3d, 0x08, 0x1b, 0x34, 0xee, 0xc4, 0x81, 0x8c, 0x9c, 0x2d, 0x35, 0x2f, 0x1a,
-	0x14, 0xda, 0x74, 0x8c, 0x77, 0x74, 0x3b, 0xa4, 0x71, 0xde, 0x6d, 0x5c, 0x67, 0x40, 0x14, 0x38,
-	0x46, 0x94, 0xc6, 0xdd, 0x24, 0x95, 0x3d, 0xa6, 0x89, 0x76, 0x18, 0x10, 0x05, 0x8e, 0xcd, 0x8d,
-	0xa4, 0xbb, 0xcb, 0x73, 0x5e, 0xb9, 0x53, 0xbb, 0x6d, 0x01, 0x46, 0x85, 0x67, 0xa4, 0x7b, 0xb4,
-	0xb7, 0xc6, 0xd6, 0xce, 0xdc, 0xb9, 0xfa, 0x55, 0x01, 0x46, 0x85, 0x77, 0xef, 0x3a, 0x40, 0xb2,
-	0xdd, 0xf1, 0x08, 0x96, 0xdf, 0x30, 0xbb, 0xfc, 0x8e, 0x92, 0x9b, 0xcc, 0xea, 0x3e, 0x64, 0x15,
-	0xfe, 0x43, 0x07, 0x66, 0xec, 0xec, 0x34, 0x69, 0xe4, 0x5c, 0xd0, 0xf5, 0xac, 0x0b, 0xba, 0x77,
-	0xb0, 0xf8, 0x2b, 0x83, 0xee, 0x61, 0x35, 0x82, 0x34, 0xea, 0x24, 0x1f, 0xa2, 0x61, 0x23, 0x08,
-	0x29, 0x4f, 0xc8, 0x88, 0xac, 0x76, 0x26, 0xf5, 0xbd, 0x1a, 0xd5, 0xe8, 0x03, 0xf8, 0x30, 0xf7,
-	0x26, 0xcc, 0xf7, 0x55, 0x52, 0x1c, 0xc1, 0xdd, 0x1c, 0x5a, 0x0e, 0xe7, 0xbe, 0xe1, 0xc0, 0x6c,
-	0xa6, 0x0a, 0xa5, 0x20, 0x27, 0xc6, 0x4d, 0x22, 0xe2, 0x47, 0x1a, 0x71, 0x10, 0x8a, 0x94, 0x48,
-	0xc5, 0x32, 0x09, 0x83, 0x42, 0x9b, 0xce, 0xfd, 0x9d, 0x12, 0x54, 0x54, 0xe2, 0xec, 0x08, 0xaa,
-	0xbc, 0xe6, 0xc0, 0xac, 0xde, 0xc3, 0xf2, 0x28, 0x77, 0xf4, 0x9a, 0x04, 0x26, 0x5e, 0x1f, 0x77,
-	0xb1, 0x28, 0x57, 0xc7, 0xda, 0x68, 0x4b, 0xc2, 0xac, 0x60, 0x72, 0x03, 0x20, 0xe9, 0x25, 0x29,
-	0x6d, 0x5b, 0xc1, 0xb6, 0x6b, 0xd9, 0xc5, 0x92, 0x1f, 0xc5, 0x94, 0x59, 0xc1, 0x66, 0x54, 0xa3,
-	0xdb, 0x9a, 0xd2, 0xb8, 0x40, 0x03, 0x43, 0x8b, 0x93, 0xfb, 0x67, 0x25, 0x38, 0x99, 0x57, 0x89,
-	0x7c, 0x02, 0x66, 0x94, 0x74, 0xeb, 0x06, 0x9a, 0x4a, 0x15, 0xce, 0xa0, 0x85, 0xbb, 0x77, 0xb0,
-	0xb8, 0xd8, 0x7f, 0x05, 0x6f, 0xc9, 0x26, 0xc1, 0x0c, 0x33, 0x91, 0x45, 0x90, 0x49, 0x8f, 0x6a,
-	0x6f, 0xa5, 0xd3, 0x91, 0xa9, 0x00, 0x2b, 0x8b, 0x60, 0x63, 0x31, 0x47, 0x4d, 0xb6, 0xe0, 0xb4,
-	0x05, 0xd9, 0xa4, 0x41, 0xa3, 0xb9, 0x1b, 0xc5, 0xa2, 0x16, 0xba, 0x5c, 0x7d, 0x42, 0x72, 0x39,
-	0x8d, 0x03, 0x68, 0x70, 0xe0, 0x97, 0x6c, 0xd7, 0xee, 0x7b, 0x1d, 0xcf, 0x0f, 0xd2, 0x9e, 0xdc,
-	0x40, 0x68, 0x0f, 0xb2, 0x2a, 0xe1, 0xa8, 0x29, 0xdc, 0x6b, 0x30, 0x76, 0xc4, 0xe9, 0x73, 0xa4,
-	0xe5, 0xf8, 0x05, 0xa8, 0x30, 0x76, 0xcc, 0x69, 0x14, 0xc5, 0x32, 0x82, 0x8a, 0xaa, 0x8b, 0x27,
-	0x2e, 0x94, 0x03, 0x4f, 0x25, 0x6a, 0x74, 0xb3, 0xd6, 0x93, 0xa4, 0xcb, 0x83, 0x0d, 0x86, 0x24,
-	0x4f, 0x42, 0x99, 0xde, 0xe9, 0xe4, 0x33, 0x32, 0x17, 0xee, 0x74, 0x82, 0x98, 0x26, 0x8c, 0x88,
-	0xde, 0xe9, 0x90, 0xb3, 0x50, 0x0a, 0x6a, 0x72, 0x29, 0x01, 0x49, 0x53, 0x5a, 0x5f, 0xc3, 0x52,
-	0x50, 0x73, 0xbb, 0x30, 0xa5, 0x0b, 0xf1, 0x49, 0x53, 0x79, 0x58, 0x67, 0xe4, 0x1c, 0xb7, 0x62,
-	0x3a, 0xc4, 0xb7, 0x76, 0x01, 0x4c, 0x09, 0x53, 0x51, 0x9e, 0xe5, 0x3c, 0x8c, 0xf9, 0x91, 0xac,
-	0x10, 0xac, 0x18, 0x36, 0xdc, 0xb5, 0x72, 0x8c, 0x7b, 0x13, 0xe6, 0xae, 0x86, 0xd1, 0xed, 0x90,
-	0xad, 0x77, 0x17, 0x03, 0xda, 0xaa, 0x31, 0xc6, 0x75, 0xf6, 0x47, 0x7e, 0x15, 0xe7, 0x58, 0x14,
-	0x38, 0x5d, 0xb3, 0x5e, 0x1a, 0x56, 0xb3, 0xee, 0x7e, 0xd9, 0x81, 0x93, 0xf9, 0x92, 0xa5, 0x9f,
-	0xd8, 0x7e, 0xe2, 0xf3, 0x4c, 0x19, 0x55, 0x19, 0x73, 0xbd, 0x23, 0x4e, 0x11, 0x9f, 0x83, 0x99,
-	0xdd, 0x6e, 0xd0, 0xaa, 0xc9, 0xdf, 0x52, 0x1f, 0x5d, 0xf8, 0x53, 0xb5, 0x70, 0x98, 0xa1, 0x64,
-	0xe1, 0xd9, 0x6e, 0x10, 0x7a, 0x71, 0x6f, 0xcb, 0xac, 0x18, 0xda, 0x37, 0x55, 0x35, 0x06, 0x2d,
-	0x2a, 0xf7, 0xcd, 0x32, 0x98, 0x7b, 0x01, 0xa4, 0x2e, 0x0f, 0xa6, 0x9d, 0x91, 0x93, 0x4b, 0xdb,
-	0xbd, 0xd0, 0x37, 0xd7, 0x0f, 0x2a, 0xb9, 0x73, 0xe9, 0x2f, 0x3a, 0x2c, 0x2a, 0x0c, 0xd2, 0xc0,
-	0xe3, 0x6e, 0x42, 0xee, 0x89, 0xae, 0x15, 0x71, 0x88, 0xb9, 0x2e, 0xd8, 0x46, 0xb1, 0x1d, 0x64,
-	0x6a, 0x49, 0x68, 0x8b, 0x25, 0x9f, 0x92, 0xc9, 0xe0, 0x72, 0x31, 0xa5, 0x0c, 0x95, 0x5c, 0x06,
-	0xb8, 0x0d, 0xe3, 0x31, 0x4d, 0x63, 0x55, 0x3b, 0x72, 0x79, 0xa4, 0x73, 0xb0, 0x34, 0xee, 0x6d,
-	0xa7, 0x6c, 0xd3, 0xd5, 0xb0, 0xc2, 0x20, 0x0e, 0x46, 0x21, 0xc5, 0x4d, 0x80, 0xf4, 0xf7, 0xc2,
-	0x31, 0x93, 0xab, 0xcb, 0x30, 0xe5, 0x75, 0xd3, 0xa8, 0xcd, 0x3a, 0x88, 0x8f, 0x4a, 0xc5, 0x4a,
-	0x1f, 0x2b, 0x04, 0x1a, 0x1a, 0xf7, 0xb5, 0x71, 0xc8, 0x1d, 0x16, 0x93, 0xae, 0x7d, 0x89, 0xc5,
-	0x29, 0xf0, 0x12, 0x8b, 0xd6, 0x64, 0xd0, 0x45, 0x16, 0xd2, 0x80, 0xf1, 0x4e, 0xd3, 0x4b, 0x94,
-	0x51, 0xbe, 0xa0, 0xfa, 0x68, 0x8b, 0x01, 0xef, 0x1d, 0x2c, 0xfe, 0xea, 0xd1, 0x42, 0x3e, 0x36,
-	0x3f, 0x97, 0x45, 0xc5, 0x99, 0x11, 0xcd, 0x79, 0xa0, 0xe0, 0x6f, 0x07, 0x7d, 0xe5, 0x43, 0x36,
-	0xae, 0x9f, 0x13, 0x25, 0x44, 0x48, 0x93, 0x6e, 0x2b, 0x95, 0xd3, 0x60, 0xb3, 0x28, 0xab, 0x12,
-	0x5c, 0x4d, 0x2d, 0x91, 0xf8, 0x8d, 0x96, 0x44, 0xf2, 0x09, 0x98, 0x4a, 0x52, 0x2f, 0x4e, 0x1f,
-	0xb0, 0x1c, 0x41, 0x77, 0xf8, 0xb6, 0x62, 0x82, 0x86, 0x1f, 0x79, 0x09, 0xa0, 0x1e, 0x84, 0x41,
-	0xd2, 0x7c, 0xc0, 0x63, 0x1b, 0xae, 0xf8, 0x45, 0xcd, 0x01, 0x2d, 0x6e, 0xcc, 0x95, 0xf1, 0x49,
-	0x2d, 0x32, 0x8e, 0x15, 0xbe, 0x6a, 0x6a, 0x57, 0x86, 0x1a, 0x83, 0x16, 0x95, 0xfb, 0x39, 0x38,
-	0x95, 0xbf, 0x27, 0x2a, 0xb7, 0x7f, 0x8d, 0x38, 0xea, 0x76, 0xf2, 0x0b, 0x07, 0xbf, 0x4d, 0x88,
-	0x02, 0xc7, 0x1c, 0xfa, 0x5e, 0x10, 0xd6, 0xf2, 0x0e, 0xfd, 0x6a, 0x10, 0xd6, 0x90, 0x63, 0x8e,
-	0x70, 0xb3, 0xe7, 0x2f, 0x1d, 0x38, 0x7f, 0xd8, 0x75, 0x56, 0xb6, 0xaf, 0xbf, 0xed, 0xc5, 0xa1,
-	0xac, 0xe3, 0xe7, 0x1e, 0xe3, 0xa6, 0x17, 0x87, 0xc8, 0xa1, 0x64, 0x1f, 0x26, 0x44, 0xc1, 0x95,
-	0x0c, 0x82, 0x37, 0x0b, 0xbc, 0x59, 0xcb, 0xf6, 0x4f, 0x3a, 0x17, 0x23, 0x2a, 0xbd, 0x50, 0x4a,
-	0x73, 0xaf, 0x00, 0xb9, 0xbe, 0x4f, 0xe3, 0x38, 0xa8, 0x59, 0xe5, 0x61, 0xe4, 0x59, 0x98, 0xb9,
-	0xb5, 0x7d, 0x7d, 0x73, 0x2b, 0x0a, 0x42, 0x5e, 0x2c, 0x6c, 0x15, 0x2b, 0x5c, 0xb1, 0xe0, 0x98,
-	0xa1, 0x72, 0xbf, 0x5d, 0x82, 0x69, 0xeb, 0xd6, 0xf5, 0x11, 0x62, 0x86, 0xdc, 0x2d, 0xf1, 0xd2,
-	0x11, 0x6f, 0x89, 0x3f, 0x05, 0x95, 0x4e, 0xd4, 0x0a, 0xfc, 0x40, 0xd7, 0xf0, 0xce, 0xf0, 0x43,
-	0x20, 0x09, 0x43, 0x8d, 0x25, 0x29, 0x4c, 0xe9, 0xab, 0x94, 0xb2, 0x2e, 0xa9, 0x90, 0x90, 0x49,
-	0xdb, 0x87, 0xb9, 0x22, 0x69, 0x04, 0x11, 0x17, 0x26, 0xf8, 0xe4, 0x12, 0xf5, 0x48, 0xb2, 0x6a,
-	0x83, 0xcf, 0xba, 0x04, 0x25, 0xc6, 0xfd, 0x41, 0x09, 0xa6, 0x90, 0x76, 0xa2, 0xd5, 0x98, 0xd6,
-	0x12, 0xf2, 0x1e, 0x28, 0x77, 0xe3, 0x96, 0xec, 0x29, 0x9d, 0x7e, 0x79, 0x11, 0x37, 0x90, 0xc1,
-	0x33, 0xae, 0xbc, 0x74, 0xac, 0x73, 0xb2, 0xf2, 0xa1, 0xe7, 0x64, 0xcf, 0xc3, 0x6c, 0x92, 0x34,
-	0xb7, 0xe2, 0x60, 0xdf, 0x4b, 0xd9, 0x54, 0x91, 0xb9, 0x0a, 0x73, 0x48, 0xb1, 0x7d, 0xd9, 0x20,
-	0x31, 0x4b, 0x4b, 0x2e, 0xc1, 0xbc, 0x39, 0xb0, 0xa2, 0x71, 0xca, 0x53, 0x13, 0x22, 0x8b, 0xa1,
-	0x8f, 0x09, 0xcc, 0x11, 0x97, 0x24, 0xc0, 0xfe, 0x6f, 0xc8, 0x1a, 0x9c, 0xcc, 0x00, 0x99, 0x22,
-	0x22, 0xc5, 0xb1, 0x20, 0xf9, 0x9c, 0xcc, 0xf0, 0x61, 0xba, 0xf4, 0x7d, 0xe1, 0xbe, 0xe5, 0xc0,
-	0xac, 0xee, 0xd4, 0x47, 0x90, 0xef, 0x08, 0xb2, 0xf9, 0x8e, 0xb5, 0x91, 0xd6, 0x79, 0xa9, 0xf6,
-	0x90, 0x70, 0xfc, 0xef, 0x26, 0x00, 0xf8, 0x43, 0x0f, 0x01, 0xaf, 0x7b, 0x3b, 0x0f, 0x63, 0x31,
-	0xed, 0x44, 0x79, 0xdb, 0x62, 0x14, 0xc8, 0x31, 0xef, 0xdc, 0x39, 0x33, 0xe8, 0x94, 0x6e, 0xfc,
-	0x27, 0x78, 0x4a, 0xb7, 0x0d, 0x67, 0x82, 0x30, 0xa1, 0x7e, 0x37, 0x96, 0x2e, 0x90, 0xed, 0xd8,
-	0xd5, 0xfc, 0xab, 0x54, 0xdf, 0x23, 0x19, 0x9d, 0x59, 0x1f, 0x44, 0x84, 0x83, 0xbf, 0x65, 0xfd,
-	0xa9, 0x10, 0x7c, 0x81, 0xac, 0x58, 0xdb, 0x43, 0x09, 0x47, 0x4d, 0xc1, 0x82, 0x2f, 0x1a, 0x7a,
-	0xbb, 0x2d, 0xba, 0x51, 0x4f, 0xf8, 0x9a, 0x67, 0x05, 0x5f, 0x17, 0x04, 0xe2, 0xe2, 0x36, 0x1a,
-	0x9a, 0xc1, 0x76, 0x37, 0x55, 0x90, 0xdd, 0xc1, 0x71, 0xed, 0x4e, 0xef, 0xbe, 0xa6, 0x87, 0xde,
-	0x18, 0x56, 0x6b, 0xc1, 0xcc, 0xd0, 0xb5, 0xe0, 0x63, 0x30, 0x17, 0x84, 0x4d, 0x1a, 0x07, 0x29,
-	0xad, 0x71, 0x43, 0x58, 0x98, 0xe5, 0x1d, 0xa1, 0x33, 0x17, 0xeb, 0x19, 0x2c, 0xe6, 0xa8, 0x4d,
-	0x1f, 0x5e, 0x5f, 0x5d, 0x5f, 0x98, 0x1b, 0xd4, 0x87, 0xd7, 0x57, 0xd7, 0xd1, 0xd0, 0xb8, 0xaf,
-	0x95, 0xe0, 0x8c, 0xb1, 0x28, 0xd6, 0x94, 0xa0, 0xce, 0xa6, 0x15, 0xbf, 0x89, 0x22, 0xce, 0x62,
-	0xad, 0xfc, 0x8c, 0x49, 0xf5, 0x68, 0x0c, 0x5a, 0x54, 0x3c, 0xcd, 0x41, 0x63, 0x5e, 0xbd, 0x95,
-	0x37, 0xb7, 0x55, 0x09, 0x47, 0x4d, 0xc1, 0x1f, 0x21, 0xa2, 0x71, 0x2a, 0x13, 0xbc, 0xf9, 0xe2,
-	0x84, 0x55, 0x83, 0x42, 0x9b, 0x8e, 0x2d, 0x7c, 0xbe, 0x1a, 0x6d, 0x66, 0x72, 0x33, 0x62, 0xe1,
-	0xd3, 0x03, 0xac, 0xb1, 0x4a, 0x1d, 0x9e, 0xcf, 0x1a, 0xef, 0x57, 0x87, 0xef, 0x55, 0x34, 0x85,
-	0xfb, 0x63, 0x07, 0xde, 0x3d, 0xb0, 0x2b, 0x1e, 0x81, 0x0f, 0xed, 0x66, 0x7d, 0xe8, 0xd6, 0x88,
-	0x3e, 0xb4, 0xaf, 0x09, 0x43, 0xfc, 0xe9, 0x3f, 0x38, 0x30, 0x67, 0xe8, 0x1f, 0x41, 0x3b, 0xeb,
-	0xc5, 0xbd, 0x28, 0x64, 0xf4, 0x16, 0xc5, 0x45, 0x99, 0x86, 0xbd, 0xc5, 0x1b, 0x26, 0x22, 0xbf,
-	0x15, 0x5f, 0x5d, 0xe8, 0x3f, 0x24, 0x10, 0xdb, 0x87, 0x09, 0x7e, 0x51, 0x2b, 0x29, 0x20, 0xfc,
-	0xcc, 0x0a, 0xe7, 0xb9, 0x16, 0x13, 0x7e, 0xf2, 0x9f, 0x09, 0x4a, 0x69, 0xbc, 0xac, 0x30, 0x48,
-	0x98, 0x45, 0xd6, 0x64, 0x4e, 0xc8, 0x94, 0x15, 0x4a, 0x38, 0x6a, 0x0a, 0xb7, 0x0d, 0x0b, 0x59,
-	0xe6, 0x6b, 0xb4, 0xce, 0x77, 0xf5, 0x47, 0x6a, 0x23, 0xdb, 0xe1, 0xf2, 0xaf, 0x36, 0xba, 0x5e,
-	0xfe, 0x4a, 0xff, 0x8a, 0x42, 0xa0, 0xa1, 0x71, 0xff, 0xc4, 0x81, 0x53, 0x03, 0x1a, 0x53, 0x60,
-	0x2e, 0x2c, 0x35, 0xc6, 0x3f, 0xe4, 0x99, 0x85, 0x1a, 0xad, 0x7b, 0x6a, 0x07, 0x69, 0xed, 0x37,
-	0xd7, 0x04, 0x18, 0x15, 0xde, 0xfd, 0x0f, 0x07, 0x4e, 0x64, 0x75, 0x4d, 0xc8, 0x15, 0x20, 0xa2,
-	0x31, 0x6b, 0x41, 0xe2, 0x47, 0xfb, 0x34, 0xee, 0xb1, 0x96, 0x0b, 0xad, 0xcf, 0x4a, 0x4e, 0x64,
-	0xa5, 0x8f, 0x02, 0x07, 0x7c, 0x45, 0xbe, 0xcc, 0x4b, 0x03, 0x54, 0x6f, 0xab, 0x69, 0xb2, 0x5d,
-	0xd8, 0x34, 0x31, 0x23, 0x69, 0xc7, 0xff, 0x5a, 0x1e, 0xda, 0xc2, 0xdd, 0x1f, 0x95, 0x41, 0xa7,
-	0xc9, 0xf9, 0x7e, 0xa5, 0xa0, 0x9d, 0x5e, 0xe6, 0xd1, 0x87, 0xf2, 0x31, 0x1e, 0x7d, 0x18, 0xbb,
-	0xdf, 0x0e, 0x47, 0x3c, 0x42, 0x60, 0xe2, 0x1c, 0xcb, 0xd1, 0xef, 0x18, 0x14, 0xda, 0x74, 0x4c,
-	0x93, 0x56, 0xb0, 0x4f, 0xc5, 0x47, 0x13, 0x59, 0x4d, 0x36, 0x14, 0x02, 0x0d, 0x0d, 0xd3, 0xa4,
-	0x16, 0xd4, 0xeb, 0x3c, 0xd6, 0xb0, 0x34, 0x61, 0xbd, 0x83, 0x1c, 0xc3, 0x28, 0x9a, 0x51, 0xb4,
-	0x27, 0xc3, 0x0b, 0x4d, 0x71, 0x39, 0x8a, 0xf6, 0x90, 0x63, 0xc8, 0x35, 0x38, 0x15, 0x46, 0x71,
-	0xdb, 0x6b, 0x05, 0xaf, 0xd2, 0x9a, 0x96, 0x22, 0xc3, 0x8a, 0x9f, 0x91, 0x1f, 0x9c, 0xda, 0xec,
-	0x27, 0xc1, 0x41, 0xdf, 0xb1, 0xe9, 0xd7, 0x89, 0x69, 0x2d, 0xf0, 0x53, 0x9b, 0x1b, 0x64, 0xa7,
-	0xdf, 0x56, 0x1f, 0x05, 0x0e, 0xf8, 0xca, 0xfd, 0x4f, 0xbe, 0x40, 0x0d, 0xb9, 0xc6, 0xf4, 0xc8,
-	0x36, 0xfa, 0xd9, 0x09, 0x32, 0x76, 0x84, 0x09, 0xc2, 0x36, 0xd2, 0x49, 0x14, 0xea, 0x8d, 0xf4,
-	0xf8, 0xd0, 0x8d, 0xb4, 0x45, 0xe5, 0x7e, 0x77, 0x1c, 0x1e, 0xd7, 0x67, 0x3c, 0x34, 0xbd, 0x1d,
-	0xc5, 0x7b, 0x41, 0xd8, 0xe0, 0xe7, 0x22, 0xdf, 0x74, 0x60, 0x46, 0x4c, 0x14, 0x79, 0x0d, 0x55,
-	0x9c, 0x03, 0xf8, 0x45, 0x54, 0xda, 0x67, 0x24, 0x2d, 0xed, 0x58, 0x52, 0x72, 0x57, 0x50, 0x6d,
-	0x14, 0x66, 0xd4, 0x21, 0xaf, 0x02, 0xa8, 0x47, 0x37, 0xea, 0x45, 0xbc, 0x3b, 0xa2, 0x94, 0x43,
-	0x5a, 0x37, 0x21, 0xd8, 0x8e, 0x96, 0x80, 0x96, 0x34, 0xf2, 0x25, 0x07, 0x26, 0x5a, 0xa2, 0x57,
-	0x44, 0x5e, 0xf7, 0x53, 0xc5, 0xf7, 0x8a, 0xdd, 0x1f, 0x7a, 0x51, 0x93, 0x3d, 0x21, 0x85, 0x13,
-	0x84, 0xc9, 0x20, 0x6c, 0xc4, 0x34, 0x51, 0x29, 0x87, 0xf7, 0x0f, 0x3a, 0x4a, 0xdc, 0x88, 0xbc,
-	0x5a, 0xd5, 0x6b, 0x79, 0xa1, 0x4f, 0xe3, 0x75, 0x41, 0x6e, 0xbf, 0x83, 0xc0, 0x01, 0xa8, 0x18,
-	0xf5, 0x5d, 0x1f, 0x19, 0x3f, 0xca, 0xf5, 0x91, 0xb3, 0x1f, 0x87, 0xf9, 0xbe, 0x61, 0x3c, 0xd6,
-	0x6d, 0xd9, 0x8f, 0xc0, 0xf4, 0x03, 0x7e, 0xea, 0xfe, 0xe9, 0x84, 0x71, 0xd2, 0x9b, 0x51, 0x8d,
-	0xdf, 0x66, 0x88, 0xcd, 0x68, 0xca, 0x08, 0xab, 0xa8, 0xb9, 0x61, 0x3d, 0xa5, 0xa0, 0x81, 0x68,
-	0xcb, 0x63, 0x33, 0xb3, 0xe3, 0xc5, 0x34, 0x7c, 0xa8, 0x33, 0x73, 0x4b, 0x4b, 0x40, 0x4b, 0x1a,
-	0xa1, 0x99, 0xe3, 0x86, 0xd5, 0x11, 0x8f, 0x1b, 0x58, 0xb8, 0x37, 0xb0, 0xf0, 0xfc, 0x0d, 0x07,
-	0xe6, 0xc2, 0xcc, 0x7c, 0x95, 0x99, 0xe7, 0x17, 0x0a, 0x37, 0x04, 0x71, 0x59, 0x2c, 0x0b, 0xc3,
-	0x9c, 0x70, 0xb2, 0x02, 0x27, 0xd4, 0x08, 0x64, 0x6f, 0x15, 0xe8, 0xcd, 0x39, 0x66, 0xd1, 0x98,
-	0xa7, 0xb7, 0x2e, 0x40, 0x4d, 0x0c, 0xbb, 0x00, 0x45, 0xf6, 0xf4, 0x5d, 0xc7, 0xc9, 0x62, 0xef,
-	0x3a, 0xc2, 0x80, 0x7b, 0x8e, 0x37, 0x61, 0xca, 0x8f, 0xa9, 0x97, 0x3e, 0xe0, 0xfd, 0x37, 0xfe,
-	0x4c, 0xcd, 0xaa, 0x62, 0x80, 0x86, 0x97, 0xfb, 0xf5, 0x32, 0x9c, 0x54, 0xdd, 0xa1, 0x52, 0xb2,
-	0x6c, 0xc1, 0x11, 0x72, 0x4d, 0xe4, 0xa6, 0x17, 0x9c, 0xcb, 0x0a, 0x81, 0x86, 0x86, 0x85, 0x8c,
-	0x22, 0x7a, 0x4b, 0xf2, 0x47, 0x14, 0x32, 0x2a, 0x44, 0x85, 0x27, 0x5f, 0x1f, 0x78, 0xdd, 0xb8,
-	0x80, 0x03, 0xb9, 0xbe, 0x7c, 0xf2, 0x31, 0xef, 0x19, 0xbf, 0xee, 0xc0, 0x89, 0xbd, 0xcc, 0x19,
-	0xb0, 0x72, 0xa4, 0xa3, 0x14, 0x14, 0x65, 0x4f, 0x95, 0xcd, 0x14, 0xcc, 0xc2, 0x13, 0xcc, 0x8b,
-	0x76, 0xff, 0xcb, 0x01, 0xdb, 0xab, 0x1c, 0x2d, 0xda, 0xb0, 0x9e, 0x62, 0x28, 0x1d, 0xf2, 0x14,
-	0x83, 0x0a, 0x4c, 0xca, 0x47, 0x8b, 0x4b, 0xc7, 0x8e, 0x11, 0x97, 0x8e, 0x0f, 0x8d, 0x64, 0xde,
-	0x03, 0xe5, 0x6e, 0x50, 0x93, 0xa1, 0xa5, 0x49, 0x38, 0xaf, 0xaf, 0x21, 0x83, 0xbb, 0x7f, 0x35,
-	0x6e, 0x36, 0x91, 0xf2, 0x44, 0xe9, 0xa7, 0xa2, 0xd9, 0x75, 0x5d, 0x1f, 0x26, 0x5a, 0xbe, 0xd9,
-	0x57, 0x1f, 0xf6, 0xcb, 0xc7, 0x3f, 0x2c, 0x14, 0x1d, 0x34, 0xac, 0x3c, 0x6c, 0xf2, 0x90, 0x93,
-	0xc2, 0x5b, 0x50, 0x61, 0xd1, 0x37, 0xcf, 0x03, 0x55, 0x32, 0x4a, 0x55, 0x2e, 0x4b, 0xf8, 0xbd,
-	0x83, 0xc5, 0x8f, 0x1e, 0x5f, 0x2d, 0xf5, 0x35, 0x6a, 0xfe, 0x24, 0x81, 0x29, 0xf6, 0x37, 0x3f,
-	0xd4, 0x94, 0x71, 0xfd, 0x8b, 0xda, 0x9d, 0x28, 0x44, 0x21, 0x27, 0xa6, 0x46, 0x0e, 0x09, 0x61,
-	0x8a, 0x3f, 0x72, 0xc0, 0x85, 0x8a, 0xf0, 0x7f, 0x4b, 0x1f, 0x2f, 0x2a, 0xc4, 0xbd, 0x83, 0xc5,
-	0xe7, 0x8f, 0x2f, 0x54, 0x7f, 0x8e, 0x46, 0x84, 0xfb, 0x76, 0xd9, 0xcc, 0x5d, 0x59, 0x16, 0xf8,
-	0x53, 0x31, 0x77, 0x9f, 0xcb, 0xcd, 0xdd, 0xf3, 0x7d, 0x73, 0x77, 0xce, 0x3c, 0x0a, 0x90, 0x99,
-	0x8d, 0x8f, 0x74, 0x81, 0x3c, 0x7c, 0x9f, 0xc9, 0xc3, 0x82, 0x57, 0xba, 0x41, 0x4c, 0x93, 0xad,
-	0xb8, 0x1b, 0x06, 0x61, 0x83, 0xcf, 0xc5, 0x8a, 0x1d, 0x16, 0x64, 0xd0, 0x98, 0xa7, 0x77, 0xbf,
-	0xcd, 0x0f, 0x7a, 0xac, 0xca, 0x08, 0x36, 0xc4, 0x2d, 0xfe, 0x54, 0x84, 0x28, 0xc6, 0xd2, 0x43,
-	0x2c, 0xde, 0x87, 0x10, 0x38, 0x92, 0xc2, 0xe4, 0xae, 0xb8, 0xd0, 0x5a, 0x40, 0x31, 0xbe, 0xbc,
-	0x1a, 0xcb, 0x6f, 0x2c, 0xa9, 0x7b, 0xb2, 0xf7, 0xcc, 0x9f, 0xa8, 0x44, 0xb9, 0xdf, 0x28, 0xc3,
-	0x89, 0xdc, 0x8b, 0x06, 0xe4, 0x83, 0x50, 0x51, 0x4f, 0x56, 0xe4, 0xd3, 0xc5, 0xfa, 0xf9, 0x42,
-	0x4d, 0x41, 0x3e, 0x0d, 0x50, 0xa3, 0x9d, 0x56, 0xd4, 0xe3, 0x51, 0xc7, 0xd8, 0xb1, 0xa3, 0x0e,
-	0x1d, 0x9f, 0xae, 0x69, 0x2e, 0x68, 0x71, 0x94, 0xe5, 0x67, 0xe3, 0xe2, 0xae, 0x6e, 0xb6, 0xfc,
-	0xcc, 0xba, 0x8a, 0x32, 0xf1, 0x08, 0xaf, 0xa2, 0x04, 0x70, 0x42, 0xe8, 0xa7, 0x0b, 0x10, 0x1e,
-	0xa0, 0xce, 0xe0, 0x14, 0x9b, 0x4b, 0x6b, 0x59, 0x36, 0x98, 0xe7, 0xeb, 0x7e, 0xa5, 0xc4, 0x02,
-	0x2f, 0xd1, 0xd3, 0xd7, 0x54, 0xb6, 0xf6, 0x7d, 0x30, 0xe1, 0x75, 0xd3, 0x66, 0xd4, 0x77, 0xb5,
-	0x78, 0x85, 0x43, 0x51, 0x62, 0xc9, 0x06, 0x8c, 0xd5, 0xbc, 0x54, 0x3d, 0xb6, 0x7b, 0x1c, 0xe5,
-	0x4c, 0x8e, 0xc6, 0x4b, 0x29, 0x72, 0x2e, 0xe4, 0x09, 0x18, 0x4b, 0xbd, 0x46, 0xe6, 0x51, 0xb0,
-	0x1d, 0xaf, 0x91, 0x20, 0x87, 0xda, 0x8b, 0xca, 0xd8, 0x21, 0x8b, 0xca, 0xf3, 0xd6, 0x03, 0xd0,
-	0xd6, 0x19, 0x40, 0xff, 0xbb, 0xcd, 0xa2, 0x1a, 0x36, 0x43, 0xeb, 0xfe, 0x02, 0xcc, 0xd8, 0xef,
-	0x3a, 0x1f, 0xa9, 0x8c, 0xde, 0xfd, 0x97, 0x31, 0x98, 0xcd, 0x14, 0xa9, 0x64, 0xa6, 0xb8, 0x73,
-	0xe8, 0x14, 0x7f, 0x12, 0xc6, 0x3b, 0x71, 0x37, 0xa4, 0xb2, 0xf6, 0x48, 0x0b, 0x61, 0x16, 0x4f,
-	0x51, 0xe0, 0xd8, 0xa8, 0xd4, 0xe2, 0x1e, 0x76, 0x43, 0x99, 0x2c, 0xd6, 0xa3, 0xb2, 0xc6, 0xa1,
-	0x28, 0xb1, 0xe4, 0xb3, 0x30, 0x93, 0x70, 0x57, 0x28, 0x9c, 0x83, 0xb4, 0x98, 0x4b, 0x23, 0x3f,
-	0xb7, 0x22, 0xab, 0xb0, 0xf8, 0xb6, 0xdb, 0x86, 0x60, 0x46, 0x1c, 0xf9, 0x82, 0x63, 0x3f, 0x31,
-	0x33, 0x31, 0xf2, 0xb9, 0x46, 0xbe, 0xf8, 0x47, 0x98, 0xce, 0xfd, 0x5f, 0x9a, 0xe9, 0x68, 0xb3,
-	0x9d, 0x7c, 0x08, 0x66, 0x0b, 0x03, 0x4c, 0xf6, 0x03, 0x30, 0xd5, 0xf6, 0xc2, 0xa0, 0x4e, 0x93,
-	0x54, 0xbc, 0xc1, 0x3e, 0x25, 0x76, 0x3b, 0xd7, 0x14, 0x10, 0x0d, 0x9e, 0xff, 0x83, 0x03, 0xde,
-	0x2a, 0xb1, 0x57, 0x99, 0xb2, 0xfe, 0xc1, 0x81, 0x01, 0xa3, 0x4d, 0xe3, 0x7e, 0xc7, 0x81, 0x33,
-	0x03, 0x7b, 0xe2, 0x9d, 0x9b, 0xff, 0x73, 0xbf, 0x53, 0x82, 0x53, 0x03, 0x4a, 0xb7, 0xc8, 0xfe,
-	0xc3, 0x79, 0x83, 0x48, 0x16, 0x86, 0xcd, 0x0e, 0x9d, 0x15, 0xc7, 0x5b, 0x76, 0x8c, 0xeb, 0x2f,
-	0x3f, 0x3a, 0xd7, 0xef, 0xfe, 0x71, 0x09, 0xac, 0x17, 0xb2, 0xc8, 0xaf, 0xdb, 0x85, 0x89, 0x4e,
-	0x21, 0x85, 0x74, 0x82, 0xb3, 0xae, 0x6a, 0x14, 0xfd, 0x35, 0xa8, 0xc8, 0x31, 0x3f, 0x4d, 0x4b,
-	0x87, 0x4f, 0x53, 0x12, 0xa8, 0xda, 0xcf, 0x72, 0xc1, 0xb5, 0x9f, 0x53, 0x7d, 0x75, 0x9f, 0xdf,
-	0x70, 0xc4, 0xec, 0xca, 0xb5, 0xc7, 0xf8, 0x52, 0xe7, 0x3e, 0xbe, 0xf4, 0x83, 0x50, 0x49, 0x68,
-	0xab, 0xce, 0xa2, 0x37, 0xe9, 0x73, 0xf5, 0x54, 0xd8, 0x96, 0x70, 0xd4, 0x14, 0xfc, 0x02, 0x58,
-	0xab, 0x15, 0xdd, 0xbe, 0xd0, 0xee, 0xa4, 0x3d, 0xe9, 0x7d, 0xcd, 0x05, 0x30, 0x8d, 0x41, 0x8b,
-	0xca, 0xfd, 0x91, 0x23, 0x06, 0x52, 0x06, 0xe1, 0xcf, 0xe5, 0xee, 0xe6, 0x1c, 0x3d, 0x7e, 0xed,
-	0x01, 0xf8, 0xfa, 0x6e, 0x6c, 0x01, 0x6f, 0x67, 0x99, 0x8b, 0xb6, 0xf6, 0xcb, 0x4e, 0x0a, 0x86,
-	0x96, 0xb0, 0x8c, 0xc1, 0x94, 0x0f, 0x33, 0x18, 0xf7, 0xdf, 0x1d, 0xc8, 0xac, 0x0b, 0xa4, 0x0d,
-	0xe3, 0x4c, 0x83, 0x5e, 0x01, 0xd7, 0x78, 0x6d, 0xbe, 0xcc, 0x98, 0xe4, 0x84, 0xe0, 0x7f, 0xa2,
-	0x90, 0x42, 0x02, 0x19, 0x7b, 0x97, 0x46, 0xbe, 0x2e, 0x6e, 0x4b, 0x63, 0xa1, 0xbb, 0x7c, 0xdb,
-	0x5a, 0x07, 0xf1, 0xee, 0x73, 0x30, 0xdf, 0xa7, 0x11, 0x2f, 0xd5, 0x8f, 0xd4, 0xad, 0x65, 0x6b,
-	0xe2, 0xf1, 0x2b, 0x43, 0x28, 0x70, 0x2c, 0x76, 0x3f, 0x99, 0x67, 0x4f, 0xbe, 0xe6, 0xc0, 0x7c,
-	0x92, 0xe7, 0xf7, 0x50, 0x7a, 0x4d, 0xe7, 0xa2, 0xfa, 0x50, 0xd8, 0xaf, 0x81, 0xfb, 0x3d, 0xe9,
-	0x8c, 0xc4, 0x3f, 0xfc, 0xd0, 0x8b, 0x88, 0x33, 0x74, 0x11, 0x61, 0x66, 0xe5, 0x37, 0x69, 0xad,
-	0xdb, 0xea, 0xab, 0x03, 0xd9, 0x96, 0x70, 0xd4, 0x14, 0x99, 0x67, 0x75, 0xca, 0x87, 0x3e, 0xab,
-	0xf3, 0x2c, 0xcc, 0xd8, 0x17, 0xec, 0x79, 0x52, 0x4c, 0x1e, 0x02, 0xd8, 0x77, 0xf1, 0x31, 0x43,
-	0x95, 0x7b, 0x9c, 0x65, 0xfc, 0xd0, 0xc7, 0x59, 0x9e, 0x82, 0x8a, 0xfc, 0x17, 0x1a, 0x2a, 0xe9,
-	0x2a, 0x8a, 0x4c, 0x24, 0x0c, 0x35, 0x96, 0x39, 0x85, 0xb6, 0x17, 0x76, 0xbd, 0x16, 0xeb, 0x21,
-	0x59, 0xe6, 0xa4, 0x0d, 0xea, 0x9a, 0xc6, 0xa0, 0x45, 0xc5, 0x4c, 0x24, 0xff, 0xe8, 0x45, 0xa6,
-	0x58, 0xca, 0x39, 0xb4, 0x58, 0x2a, 0x5b, 0x9d, 0x53, 0x3a, 0x52, 0x75, 0x8e, 0x5d, 0x38, 0x53,
-	0xbe, 0x6f, 0xe1, 0xcc, 0x7b, 0xcd, 0x15, 0x4b, 0x51, 0x61, 0x33, 0x3d, 0xe8, 0x7a, 0x25, 0x71,
-	0x61, 0xc2, 0xf7, 0x74, 0xb5, 0xe3, 0x8c, 0x08, 0x88, 0x56, 0x57, 0x38, 0x91, 0xc4, 0x54, 0x97,
-	0xde, 0x7c, 0xfb, 0xdc, 0x63, 0xdf, 0x7f, 0xfb, 0xdc, 0x63, 0x6f, 0xbd, 0x7d, 0xee, 0xb1, 0xcf,
-	0xdf, 0x3d, 0xe7, 0xbc, 0x79, 0xf7, 0x9c, 0xf3, 0xfd, 0xbb, 0xe7, 0x9c, 0xb7, 0xee, 0x9e, 0x73,
-	0xfe, 0xf5, 0xee, 0x39, 0xe7, 0x77, 0x7f, 0x78, 0xee, 0xb1, 0x97, 0x2a, 0x6a, 0xae, 0xfe, 0x5f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xb2, 0x6e, 0xfc, 0x5c, 0x23, 0x6e, 0x00, 0x00,
+	// 7006 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x5d, 0x6c, 0x1c, 0xd7,
+	0x75, 0xb0, 0x67, 0x77, 0x49, 0xee, 0x5e, 0xfe, 0x48, 0xbc, 0x92, 0xed, 0x8d, 0xbe, 0x44, 0x14,
+	0xc6, 0x5f, 0x1c, 0x7f, 0x5f, 0xbe, 0x50, 0x9f, 0x5d, 0x37, 0x71, 0x92, 0x36, 0x29, 0x97, 0x94,
+	0x44, 0x4a, 0x14, 0x49, 0x1f, 0xd2, 0x12, 0xea, 0xfc, 0xd4, 0xc3, 0xdd, 0xbb, 0xbb, 0x23, 0xee,
+	0xce, 0xac, 0x67, 0x66, 0x29, 0xd1, 0x4d, 0xd2, 0xb4, 0x4d, 0x01, 0x23, 0xb5, 0xdb, 0xa2, 0x41,
+	0xf3, 0x92, 0x04, 0x4d, 0x5a, 0xe4, 0xa1, 0x01, 0x8a, 0xa2, 0x28, 0x0a, 0xf4, 0x39, 0x05, 0x0a,
+	0x3f, 0xb5, 0x41, 0x50, 0xb4, 0x46, 0x51, 0xb0, 0xb1, 0xf2, 0x12, 0xb4, 0x0f, 0x49, 0x81, 0x02,
+	0x05, 0xf4, 0xd2, 0xe2, 0xdc, 0xff, 0x99, 0xdd, 0x15, 0x49, 0xed, 0x48, 0x31, 0xd2, 0x27, 0xee,
+	0x9c, 0x73, 0xee, 0x39, 0xf7, 0xf7, 0xdc, 0x73, 0xcf, 0x39, 0xf7, 0x92, 0xac, 0xb5, 0xfc, 0xa4,
+	0xdd, 0xdf, 0x5d, 0xac, 0x87, 0xdd, 0x8b, 0x5e, 0xd4, 0x0a, 0x7b, 0x51, 0x78, 0x8b, 0xff, 0xf8,
+	0x50, 0xbd, 0x71, 0xb1, 0xb7, 0xd7, 0xba, 0xe8, 0xf5, 0xfc, 0xf8, 0xa2, 0xd7, 0xeb, 0x75, 0xfc,
+	0xba, 0x97, 0xf8, 0x61, 0x70, 0x71, 0xff, 0x59, 0xaf, 0xd3, 0x6b, 0x7b, 0xcf, 0x5e, 0x6c, 0xb1,
+	0x80, 0x45, 0x5e, 0xc2, 0x1a, 0x8b, 0xbd, 0x28, 0x4c, 0x42, 0xfa, 0x51, 0xc3, 0x6a, 0x51, 0xb1,
+	0xe2, 0x3f, 0x7e, 0xa5, 0xde, 0x58, 0xec, 0xed, 0xb5, 0x16, 0x91, 0xd5, 0xa2, 0xc5, 0x6a, 0x51,
+	0xb1, 0x3a, 0xf7, 0x21, 0xab, 0x16, 0xad, 0xb0, 0x15, 0x5e, 0xe4, 0x1c, 0x77, 0xfb, 0x4d, 0xfe,
+	0xc5, 0x3f, 0xf8, 0x2f, 0x21, 0xe9, 0x9c, 0xbb, 0xf7, 0x42, 0xbc, 0xe8, 0x87, 0x58, 0xb7, 0x8b,
+	0xf5, 0x30, 0x62, 0x17, 0xf7, 0x07, 0x6a, 0x73, 0xee, 0x79, 0x43, 0xd3, 0xf5, 0xea, 0x6d, 0x3f,
+	0x60, 0xd1, 0x81, 0x69, 0x50, 0x97, 0x25, 0xde, 0xb0, 0x52, 0x17, 0x47, 0x95, 0x8a, 0xfa, 0x41,
+	0xe2, 0x77, 0xd9, 0x40, 0x81, 0x0f, 0x1f, 0x55, 0x20, 0xae, 0xb7, 0x59, 0xd7, 0xcb, 0x96, 0x73,
+	0x5f, 0x25, 0xb3, 0x4b, 0x37, 0xb7, 0x97, 0xfa, 0x49, 0x7b, 0x39, 0x0c, 0x9a, 0x7e, 0x8b, 0xfe,
+	0x3c, 0x99, 0xae, 0x77, 0xfa, 0x71, 0xc2, 0xa2, 0x0d, 0xaf, 0xcb, 0xaa, 0xce, 0x05, 0xe7, 0x99,
+	0x4a, 0xed, 0xcc, 0x5b, 0x87, 0x0b, 0x8f, 0xdd, 0x3d, 0x5c, 0x98, 0x5e, 0x36, 0x28, 0xb0, 0xe9,
+	0xe8, 0xff, 0x21, 0x53, 0x51, 0xd8, 0x61, 0x4b, 0xb0, 0x51, 0x2d, 0xf0, 0x22, 0xa7, 0x64, 0x91,
+	0x29, 0x10, 0x60, 0x50, 0x78, 0xf7, 0x7b, 0x05, 0x42, 0x96, 0x7a, 0xbd, 0xad, 0x28, 0xbc, 0xc5,
+	0xea, 0x09, 0x7d, 0x85, 0x94, 0xb1, 0x17, 0x1a, 0x5e, 0xe2, 0x71, 0x69, 0xd3, 0xcf, 0xfd, 0xff,
+	0x45, 0xd1, 0x98, 0x45, 0xbb, 0x31, 0x66, 0xe4, 0x90, 0x7a, 0x71, 0xff, 0xd9, 0xc5, 0xcd, 0x5d,
+	0x2c, 0x7f, 0x9d, 0x25, 0x5e, 0x8d, 0x4a, 0x61, 0xc4, 0xc0, 0x40, 0x73, 0xa5, 0x7b, 0xa4, 0x14,
+	0xf7, 0x58, 0x9d, 0x57, 0x6c, 0xfa, 0xb9, 0xb5, 0xc5, 0x07, 0x9e, 0x1f, 0x8b, 0xa6, 0xda, 0xdb,
+	0x3d, 0x56, 0xaf, 0xcd, 0x48, 0xb1, 0x25, 0xfc, 0x02, 0x2e, 0x84, 0xc6, 0x64, 0x32, 0x4e, 0xbc,
+	0xa4, 0x1f, 0x57, 0x8b, 0x5c, 0xdc, 0xb5, 0x7c, 0xc4, 0x71, 0x96, 0xb5, 0x39, 0x29, 0x70, 0x52,
+	0x7c, 0x83, 0x14, 0xe5, 0xfe, 0x93, 0x43, 0xe6, 0x0c, 0xf1, 0xba, 0x1f, 0x27, 0xf4, 0xd3, 0x03,
+	0xdd, 0xba, 0x78, 0xbc, 0x6e, 0xc5, 0xd2, 0xbc, 0x53, 0x4f, 0x4b, 0x61, 0x65, 0x05, 0xb1, 0xba,
+	0xf4, 0x16, 0x99, 0xf0, 0x13, 0xd6, 0x8d, 0xab, 0x85, 0x0b, 0xc5, 0x67, 0xa6, 0x9f, 0xbb, 0x94,
+	0x4b, 0x23, 0x6b, 0xb3, 0x52, 0xe2, 0xc4, 0x1a, 0xf2, 0x06, 0x21, 0xc2, 0xfd, 0xf6, 0x8c, 0xdd,
+	0x38, 0xec, 0x6a, 0xfa, 0x2c, 0x99, 0x8e, 0xc3, 0x7e, 0x54, 0x67, 0xc0, 0x7a, 0x61, 0x5c, 0x75,
+	0x2e, 0x14, 0x71, 0xc6, 0xe1, 0x04, 0xdd, 0x36, 0x60, 0xb0, 0x69, 0xe8, 0x6f, 0x3b, 0x64, 0xa6,
+	0xc1, 0xe2, 0xc4, 0x0f, 0xb8, 0x7c, 0x55, 0xf3, 0x17, 0xc7, 0xab, 0xb9, 0x02, 0xae, 0x18, 0xce,
+	0xb5, 0xb3, 0xb2, 0x15, 0x33, 0x16, 0x30, 0x86, 0x94, 0x70, 0x5c, 0x65, 0x0d, 0x16, 0xd7, 0x23,
+	0xbf, 0x87, 0xdf, 0xd5, 0x62, 0x7a, 0x95, 0xad, 0x18, 0x14, 0xd8, 0x74, 0x74, 0x8f, 0x4c, 0xe0,
+	0x2a, 0x8a, 0xab, 0x25, 0x5e, 0xf9, 0xcb, 0x63, 0x54, 0x5e, 0x76, 0x27, 0xae, 0x4e, 0xd3, 0xef,
+	0xf8, 0x15, 0x83, 0x90, 0x41, 0xdf, 0x74, 0x48, 0x55, 0x2e, 0x71, 0x60, 0xa2, 0x2b, 0x6f, 0xb6,
+	0xfd, 0x84, 0x75, 0xfc, 0x38, 0xa9, 0x4e, 0xf0, 0x0a, 0x5c, 0x3c, 0xde, 0x94, 0xba, 0x12, 0x85,
+	0xfd, 0xde, 0x35, 0x3f, 0x68, 0xd4, 0x2e, 0x48, 0x49, 0xd5, 0xe5, 0x11, 0x8c, 0x61, 0xa4, 0x48,
+	0xfa, 0x15, 0x87, 0x9c, 0x0b, 0xbc, 0x2e, 0x8b, 0x7b, 0x5e, 0x9d, 0x29, 0x74, 0xad, 0xe3, 0xd5,
+	0xf7, 0x78, 0x8d, 0x26, 0x1f, 0xac, 0x46, 0xae, 0xac, 0xd1, 0xb9, 0x8d, 0x91, 0xac, 0xe1, 0x3e,
+	0x62, 0xe9, 0x37, 0x1d, 0x32, 0x1f, 0x46, 0xbd, 0xb6, 0x17, 0xb0, 0x86, 0xc2, 0xc6, 0xd5, 0x29,
+	0xbe, 0xe2, 0x3e, 0x35, 0xc6, 0xf8, 0x6c, 0x66, 0x79, 0x5e, 0x0f, 0x03, 0x3f, 0x09, 0xa3, 0x6d,
+	0x96, 0x24, 0x7e, 0xd0, 0x8a, 0x6b, 0x8f, 0xdf, 0x3d, 0x5c, 0x98, 0x1f, 0xa0, 0x82, 0xc1, 0xca,
+	0xd0, 0x3b, 0x64, 0x3a, 0x3e, 0x08, 0xea, 0x37, 0xfd, 0xa0, 0x11, 0xde, 0x8e, 0xab, 0xe5, 0xb1,
+	0x97, 0xec, 0xb6, 0xe6, 0x26, 0x17, 0x9d, 0xe1, 0x0e, 0xb6, 0xa8, 0xe1, 0x43, 0x66, 0x26, 0x51,
+	0x25, 0xef, 0x21, 0x33, 0xd3, 0xe8, 0x3e, 0x62, 0xe9, 0x97, 0x1c, 0x32, 0x1b, 0xfb, 0xad, 0xc0,
+	0x4b, 0xfa, 0x11, 0xbb, 0xc6, 0x0e, 0xe2, 0x2a, 0xe1, 0x15, 0xb9, 0x32, 0x4e, 0x97, 0x58, 0xfc,
+	0x6a, 0x8f, 0xcb, 0x0a, 0xce, 0xda, 0xd0, 0x18, 0xd2, 0x42, 0x87, 0xad, 0x2f, 0x33, 0x9b, 0xa7,
+	0xf3, 0x5d, 0x5f, 0x66, 0x2e, 0x8f, 0x14, 0x29, 0xba, 0xe5, 0x20, 0xa8, 0x6f, 0xd7, 0xdb, 0xac,
+	0xd1, 0x47, 0x2d, 0x33, 0x33, 0x7e, 0xb7, 0x58, 0xfc, 0xac, 0x6e, 0xb1, 0xa5, 0x40, 0x5a, 0x28,
+	0xfd, 0x08, 0x99, 0x6d, 0xb3, 0x4e, 0xf7, 0x86, 0xd7, 0xe9, 0xb3, 0x97, 0x60, 0x3d, 0xae, 0xce,
+	0x72, 0xed, 0x3e, 0x8f, 0x05, 0x57, 0x6d, 0x04, 0xa4, 0xe9, 0xdc, 0xbf, 0x2e, 0x90, 0xd3, 0xd9,
+	0x1d, 0x93, 0xfe, 0xb1, 0x43, 0x4e, 0xdd, 0xba, 0x9d, 0xec, 0x84, 0x7b, 0x2c, 0x88, 0x6b, 0x07,
+	0xa8, 0xe0, 0xf8, 0x76, 0x31, 0xfd, 0xdc, 0x2b, 0x39, 0x6e, 0xcc, 0x8b, 0x57, 0xd3, 0x22, 0x2e,
+	0x05, 0x49, 0x74, 0x50, 0x7b, 0x52, 0xb6, 0xf7, 0xd4, 0xd5, 0x9b, 0x3b, 0x36, 0x16, 0xb2, 0x35,
+	0x3a, 0xf7, 0xba, 0x43, 0xce, 0x0e, 0x63, 0x41, 0x4f, 0x93, 0xe2, 0x1e, 0x3b, 0x10, 0x56, 0x18,
+	0xe0, 0x4f, 0xfa, 0x32, 0x99, 0xd8, 0xc7, 0x26, 0x4b, 0x6b, 0x66, 0x65, 0x8c, 0x56, 0xe8, 0x6a,
+	0x81, 0x60, 0xf9, 0xb1, 0xc2, 0x0b, 0x8e, 0xfb, 0x37, 0x45, 0x32, 0x6d, 0x6d, 0x6c, 0x8f, 0xc0,
+	0x3c, 0xeb, 0xa4, 0xcc, 0xb3, 0xab, 0xf9, 0x6c, 0xc8, 0x23, 0xed, 0xb3, 0x24, 0x63, 0x9f, 0xad,
+	0xe7, 0x24, 0xef, 0xbe, 0x06, 0x1a, 0x7d, 0x95, 0x54, 0xc2, 0x1e, 0x1a, 0xde, 0xb8, 0xdb, 0x97,
+	0xc6, 0x1e, 0xb9, 0x4d, 0xc5, 0xab, 0x36, 0x7b, 0xf7, 0x70, 0xa1, 0xa2, 0x3f, 0xc1, 0x48, 0x71,
+	0xff, 0xd1, 0x21, 0x67, 0xad, 0x0a, 0x2e, 0x87, 0x41, 0xc3, 0xe7, 0x23, 0x7a, 0x81, 0x94, 0x92,
+	0x83, 0x9e, 0x32, 0xed, 0x75, 0x1f, 0xed, 0x1c, 0xf4, 0x18, 0x70, 0x0c, 0x1a, 0xf3, 0x5d, 0x16,
+	0xc7, 0x5e, 0x8b, 0x65, 0x8d, 0xf9, 0xeb, 0x02, 0x0c, 0x0a, 0x4f, 0x23, 0x42, 0x3b, 0x5e, 0x9c,
+	0xec, 0x44, 0x5e, 0x10, 0x73, 0xf6, 0x3b, 0x7e, 0x97, 0xc9, 0xae, 0xfd, 0xbf, 0xc7, 0x9b, 0x28,
+	0x58, 0xa2, 0xf6, 0xc4, 0xdd, 0xc3, 0x05, 0xba, 0x3e, 0xc0, 0x09, 0x86, 0x70, 0x77, 0xbf, 0xe2,
+	0x90, 0x27, 0x86, 0xdb, 0x5e, 0xf4, 0x69, 0x32, 0x19, 0xb3, 0x68, 0x9f, 0x45, 0xb2, 0x75, 0x66,
+	0x3c, 0x38, 0x14, 0x24, 0x96, 0x5e, 0x24, 0x15, 0xbd, 0x41, 0xc8, 0x36, 0xce, 0x4b, 0xd2, 0x8a,
+	0xd9, 0x55, 0x0c, 0x0d, 0x76, 0x5a, 0xe0, 0xc9, 0x96, 0x59, 0x9d, 0x86, 0xb4, 0xc0, 0x31, 0xee,
+	0x3f, 0x3b, 0xe4, 0x94, 0x55, 0xab, 0x47, 0x60, 0x84, 0xef, 0xa5, 0x8d, 0xf0, 0xcb, 0xf9, 0xcc,
+	0xe4, 0x11, 0x56, 0xf8, 0x5f, 0x4c, 0x92, 0x79, 0x7b, 0xbe, 0xf3, 0xcd, 0x83, 0x1f, 0xfb, 0x58,
+	0x2f, 0x7c, 0x09, 0xd6, 0xab, 0x4e, 0x7a, 0xa6, 0x80, 0x00, 0x83, 0xc2, 0x63, 0x0f, 0xf6, 0xbc,
+	0xa4, 0x5d, 0x2d, 0xa4, 0x7b, 0x70, 0xcb, 0x4b, 0xda, 0xc0, 0x31, 0xf4, 0x13, 0x64, 0x2e, 0xf1,
+	0xa2, 0x16, 0x4b, 0x80, 0xed, 0xfb, 0xb1, 0x5a, 0x29, 0x95, 0xda, 0x13, 0x92, 0x76, 0x6e, 0x27,
+	0x85, 0x85, 0x0c, 0x35, 0x0d, 0x48, 0x09, 0x77, 0x04, 0x69, 0x7c, 0x6d, 0xe5, 0xb4, 0xb0, 0x79,
+	0x43, 0x71, 0xe3, 0xa9, 0x95, 0xb1, 0xbe, 0xf8, 0x0b, 0xb8, 0x1c, 0xfa, 0x1b, 0x0e, 0xa9, 0xec,
+	0xf5, 0xe3, 0x24, 0xec, 0xfa, 0xaf, 0xb1, 0x6a, 0x99, 0x4b, 0x7d, 0x29, 0x4f, 0xa9, 0xd7, 0x14,
+	0x73, 0xb1, 0xcc, 0xf5, 0x27, 0x18, 0xb1, 0xf4, 0x35, 0x32, 0xb5, 0x17, 0x87, 0x41, 0xc0, 0xd0,
+	0x9c, 0xc2, 0x1a, 0x6c, 0xe7, 0x5a, 0x03, 0xc1, 0xba, 0x36, 0x8d, 0x43, 0x2a, 0x3f, 0x40, 0x09,
+	0xe4, 0x1d, 0xd0, 0xf0, 0x23, 0x56, 0x4f, 0xc2, 0xe8, 0xa0, 0x4a, 0xf2, 0xef, 0x80, 0x15, 0xc5,
+	0x5c, 0x74, 0x80, 0xfe, 0x04, 0x23, 0x96, 0xee, 0x93, 0xc9, 0x5e, 0xa7, 0xdf, 0xf2, 0x83, 0xea,
+	0x34, 0xaf, 0x00, 0xe4, 0x59, 0x81, 0x2d, 0xce, 0xb9, 0x46, 0x50, 0x85, 0x88, 0xdf, 0x20, 0xa5,
+	0xd1, 0xa7, 0xc8, 0x44, 0xbd, 0xed, 0x45, 0x49, 0x75, 0x86, 0x4f, 0x52, 0xbd, 0x6a, 0x96, 0x11,
+	0x08, 0x02, 0xe7, 0x7e, 0xa3, 0x40, 0xce, 0x8d, 0x6e, 0x95, 0x58, 0x3e, 0xf5, 0x7e, 0x14, 0x0b,
+	0x6d, 0x5c, 0xb6, 0x97, 0x0f, 0x07, 0x83, 0xc2, 0xd3, 0x2f, 0x90, 0xa9, 0x5b, 0x72, 0x9c, 0x0b,
+	0xf9, 0x8f, 0xf3, 0x55, 0x39, 0xce, 0x5a, 0xfe, 0x55, 0x35, 0xd6, 0x52, 0x28, 0x56, 0x95, 0xdd,
+	0xa9, 0x77, 0xfa, 0x0d, 0xa5, 0x03, 0x35, 0xe9, 0x25, 0x01, 0x06, 0x85, 0x47, 0x52, 0x3f, 0x10,
+	0xa4, 0xa5, 0x34, 0xe9, 0x5a, 0x20, 0x49, 0x25, 0xde, 0xfd, 0x5a, 0x99, 0x3c, 0x3e, 0x74, 0xb1,
+	0xd1, 0x45, 0x42, 0xb8, 0x51, 0x72, 0xd9, 0x47, 0x4b, 0x54, 0x9c, 0xf0, 0xe7, 0xd0, 0x86, 0xb8,
+	0xa1, 0xa1, 0x60, 0x51, 0xd0, 0xcf, 0x11, 0xd2, 0xf3, 0x22, 0xaf, 0xcb, 0x12, 0x16, 0x29, 0x8d,
+	0xb8, 0x3a, 0x46, 0x17, 0x61, 0x25, 0xb6, 0x14, 0x43, 0x63, 0xc1, 0x68, 0x50, 0x0c, 0x96, 0x3c,
+	0x3c, 0xcf, 0x47, 0xac, 0xc3, 0xbc, 0x98, 0x6d, 0x98, 0x5d, 0x42, 0x9f, 0xe7, 0xc1, 0xa0, 0xc0,
+	0xa6, 0xc3, 0xed, 0x8a, 0x37, 0x21, 0xae, 0x96, 0xd2, 0xdb, 0x15, 0x6f, 0x64, 0x0c, 0x12, 0x4b,
+	0xdf, 0x70, 0xc8, 0x5c, 0xd3, 0xef, 0x30, 0x23, 0x5d, 0x1e, 0xc0, 0xd7, 0xc7, 0x6c, 0xe1, 0x65,
+	0x9b, 0xa9, 0x51, 0xb4, 0x29, 0x70, 0x0c, 0x19, 0xd9, 0x38, 0xc0, 0xfb, 0x2c, 0xe2, 0x1a, 0x7a,
+	0x32, 0x3d, 0xc0, 0x37, 0x04, 0x18, 0x14, 0x9e, 0xfe, 0x3f, 0x52, 0x8e, 0xf7, 0xfc, 0xde, 0x72,
+	0xd4, 0x10, 0x87, 0xe2, 0xb2, 0xd9, 0xd1, 0xb6, 0x25, 0x1c, 0x34, 0x05, 0x5d, 0x22, 0xa7, 0x7a,
+	0x5e, 0x1c, 0x2f, 0x47, 0xac, 0xc1, 0x82, 0xc4, 0xf7, 0x3a, 0x31, 0x57, 0xab, 0x65, 0x63, 0x4a,
+	0x6f, 0xa5, 0xd1, 0x90, 0xa5, 0xa7, 0xbf, 0x4c, 0x9e, 0xf4, 0x5b, 0x41, 0x18, 0xb1, 0xeb, 0x7e,
+	0x1c, 0xfb, 0x41, 0xcb, 0x4c, 0x17, 0xae, 0x1f, 0xcb, 0xb5, 0x05, 0xc9, 0xea, 0xc9, 0xb5, 0xe1,
+	0x64, 0x30, 0xaa, 0x3c, 0x0e, 0xf2, 0x5e, 0x7f, 0x97, 0xc9, 0x36, 0x56, 0x49, 0x7a, 0x90, 0xaf,
+	0x19, 0x14, 0xd8, 0x74, 0xe8, 0xac, 0xf2, 0x7a, 0xbe, 0xfc, 0x8a, 0xab, 0xd3, 0xc6, 0x59, 0xb5,
+	0xb4, 0xb5, 0xa6, 0xc0, 0x60, 0xd3, 0xd0, 0x5f, 0x77, 0xc8, 0x4c, 0x2f, 0x8c, 0x13, 0x60, 0x41,
+	0x83, 0x45, 0x2c, 0xaa, 0xce, 0x8c, 0xed, 0x4b, 0xe4, 0xf3, 0xd9, 0x62, 0x59, 0x3b, 0x8d, 0x2e,
+	0x2a, 0x1b, 0x02, 0x29, 0x91, 0xf4, 0x8b, 0x0e, 0x99, 0x16, 0xd3, 0x4f, 0x38, 0xd9, 0x66, 0x2f,
+	0x14, 0xc7, 0xf4, 0x9e, 0xea, 0xd3, 0x1b, 0xe7, 0x68, 0x7a, 0xce, 0xc0, 0x62, 0xb0, 0x45, 0xba,
+	0x5f, 0x29, 0x90, 0xea, 0x28, 0x55, 0x45, 0x7b, 0xa8, 0x90, 0x92, 0x1b, 0x5e, 0x14, 0x57, 0x9d,
+	0xb1, 0x3d, 0x1a, 0x92, 0xe9, 0x0d, 0x2f, 0xb2, 0xf5, 0x1a, 0xe7, 0x0e, 0x4a, 0x0c, 0x6d, 0x91,
+	0x52, 0xd2, 0xf1, 0xf2, 0xf0, 0x79, 0x5a, 0xe2, 0x8c, 0xfd, 0xbd, 0xbe, 0x14, 0x03, 0x17, 0x40,
+	0xdf, 0x4b, 0x4a, 0x1d, 0x7f, 0x17, 0x4f, 0x28, 0x38, 0x55, 0xb8, 0xd9, 0xb1, 0xee, 0xef, 0xc6,
+	0xc0, 0xa1, 0xee, 0xf7, 0x9d, 0x21, 0xbd, 0x22, 0xf7, 0x66, 0x9c, 0xa3, 0x2c, 0xd8, 0xf7, 0xa3,
+	0x30, 0xe8, 0xb2, 0x20, 0xc9, 0xba, 0xef, 0x2f, 0x19, 0x14, 0xd8, 0x74, 0xf4, 0xd7, 0x86, 0x68,
+	0xcf, 0x71, 0x66, 0x9b, 0xac, 0xce, 0xb1, 0x15, 0xa8, 0xfb, 0xd6, 0xc4, 0x90, 0x8d, 0x52, 0x1b,
+	0x3c, 0xf4, 0x39, 0x42, 0xd0, 0xc8, 0xde, 0x8a, 0x58, 0xd3, 0xbf, 0x23, 0x5b, 0xa5, 0x59, 0x6e,
+	0x68, 0x0c, 0x58, 0x54, 0xaa, 0xcc, 0x76, 0xbf, 0x89, 0x65, 0x0a, 0x83, 0x65, 0x04, 0x06, 0x2c,
+	0x2a, 0xfa, 0x3c, 0x99, 0xf4, 0xbb, 0x5e, 0x8b, 0xa9, 0xbe, 0x7f, 0x2f, 0x2a, 0xe3, 0x35, 0x0e,
+	0xb9, 0x77, 0xb8, 0x30, 0xa7, 0x2b, 0xc4, 0x41, 0x20, 0x69, 0xe9, 0xb7, 0x1c, 0x32, 0x53, 0x0f,
+	0xbb, 0xdd, 0x30, 0x58, 0xf7, 0x76, 0x59, 0x47, 0xb9, 0x67, 0x5b, 0x0f, 0xc5, 0x16, 0x5c, 0x5c,
+	0xb6, 0x24, 0x09, 0x47, 0x83, 0xf6, 0x38, 0xdb, 0x28, 0x48, 0x55, 0xc9, 0xd6, 0xd9, 0x13, 0x47,
+	0xe8, 0xec, 0xbf, 0x74, 0xc8, 0xbc, 0x28, 0xbb, 0x14, 0x04, 0x61, 0x22, 0xfd, 0xe5, 0xc2, 0xbf,
+	0xda, 0x79, 0x98, 0x6d, 0xb2, 0xc4, 0x89, 0x86, 0xbd, 0x47, 0xd6, 0x71, 0x7e, 0x00, 0x0f, 0x83,
+	0x35, 0x3c, 0xf7, 0x49, 0x32, 0x3f, 0xd0, 0x37, 0x43, 0x3c, 0x28, 0x67, 0x6d, 0x0f, 0x4a, 0xc5,
+	0xf2, 0x7d, 0x9c, 0x5b, 0x21, 0x4f, 0x0c, 0xaf, 0xc8, 0x49, 0xb8, 0xb8, 0x5f, 0x73, 0xc8, 0x93,
+	0x23, 0x0c, 0x49, 0x7d, 0x8c, 0x74, 0x46, 0x1d, 0x23, 0xe9, 0x67, 0x49, 0x91, 0x05, 0xfb, 0x72,
+	0x09, 0x2e, 0x8f, 0xd1, 0xdb, 0x97, 0x82, 0x7d, 0xd1, 0x89, 0x53, 0x77, 0x0f, 0x17, 0x8a, 0x97,
+	0x82, 0x7d, 0x40, 0xc6, 0xee, 0xb7, 0xa7, 0x52, 0xc7, 0xd4, 0x6d, 0xe5, 0x13, 0xe1, 0xb5, 0x94,
+	0x87, 0xd4, 0xf5, 0x3c, 0x07, 0xd9, 0x3a, 0x83, 0xf3, 0x6f, 0x90, 0xb2, 0xe8, 0xeb, 0x0e, 0x0f,
+	0x82, 0xa8, 0xb3, 0xbb, 0x34, 0x6b, 0x1f, 0x42, 0x40, 0xc6, 0x8e, 0xab, 0x28, 0x20, 0xd8, 0xa2,
+	0x71, 0x71, 0xf4, 0x84, 0x3f, 0x2f, 0x6b, 0xdc, 0xaa, 0x30, 0x89, 0xc2, 0xd3, 0x3e, 0x21, 0xe8,
+	0xaf, 0xdc, 0x0a, 0x3b, 0x7e, 0xfd, 0x40, 0xba, 0x72, 0xc6, 0xf5, 0xa5, 0x0b, 0x66, 0xc2, 0xbc,
+	0x35, 0xdf, 0x60, 0x09, 0xa2, 0xdf, 0x70, 0xc8, 0xbc, 0xb0, 0x4b, 0x56, 0xfc, 0x66, 0x93, 0x45,
+	0x2c, 0xa8, 0x33, 0x65, 0x04, 0xee, 0x8c, 0x21, 0x5e, 0xb9, 0x81, 0xd7, 0xb2, 0xbc, 0xcd, 0xda,
+	0x1b, 0x40, 0xc1, 0x60, 0x4d, 0xa8, 0x47, 0x4a, 0x7e, 0xd0, 0x0c, 0xa5, 0x96, 0xf8, 0xe4, 0x18,
+	0x35, 0x5a, 0x0b, 0x9a, 0xa1, 0x59, 0x19, 0xf8, 0x05, 0x9c, 0x35, 0x5d, 0x27, 0x67, 0x23, 0x79,
+	0xd4, 0x5f, 0xf5, 0x63, 0x3c, 0x3f, 0xad, 0xfb, 0x5d, 0x3f, 0xe1, 0x66, 0x65, 0xb1, 0x56, 0xbd,
+	0x7b, 0xb8, 0x70, 0x16, 0x86, 0xe0, 0x61, 0x68, 0x29, 0xfa, 0x41, 0x52, 0x69, 0xb0, 0x1e, 0x0b,
+	0x1a, 0xf1, 0x66, 0xc0, 0x43, 0x22, 0x15, 0x79, 0xc6, 0x54, 0x40, 0x30, 0x78, 0x74, 0xdf, 0xb5,
+	0x0f, 0x1a, 0x91, 0x97, 0xb0, 0x9d, 0xb0, 0x5a, 0x19, 0xdb, 0x7d, 0xb7, 0xaa, 0x78, 0x09, 0x91,
+	0xfa, 0x13, 0x8c, 0x14, 0xf7, 0x47, 0x95, 0xb4, 0xbf, 0x45, 0xf8, 0x11, 0x5f, 0x23, 0x95, 0x48,
+	0x07, 0x99, 0x9c, 0xb1, 0x2d, 0x32, 0x35, 0xfa, 0x82, 0xbb, 0x71, 0x81, 0x99, 0x70, 0x92, 0x11,
+	0x87, 0xe6, 0x0f, 0x4e, 0x48, 0xb9, 0x4e, 0xc7, 0x9d, 0xf3, 0x52, 0xa4, 0x71, 0xd1, 0x1e, 0x04,
+	0xe8, 0xa2, 0x3d, 0x08, 0xea, 0x34, 0x24, 0x93, 0x6d, 0xe6, 0x75, 0x92, 0xb6, 0xf4, 0x23, 0x5e,
+	0x19, 0xcb, 0xe6, 0x44, 0x46, 0x59, 0xef, 0xac, 0x80, 0x82, 0x14, 0x43, 0xfb, 0x64, 0xaa, 0x2d,
+	0xe6, 0x86, 0xdc, 0xb9, 0xaf, 0x8e, 0xd5, 0xa7, 0xa9, 0xd9, 0x66, 0x54, 0x89, 0x04, 0x80, 0x92,
+	0x45, 0x7f, 0xd3, 0x21, 0xa4, 0xae, 0xdc, 0xb2, 0x6a, 0x31, 0x6f, 0xe6, 0xa3, 0xff, 0xb4, 0xbb,
+	0xd7, 0x98, 0x3c, 0x1a, 0x14, 0x83, 0x25, 0x96, 0xbe, 0x42, 0x66, 0x22, 0x56, 0x0f, 0x83, 0xba,
+	0xdf, 0x61, 0x8d, 0xa5, 0xa4, 0x3a, 0x79, 0x62, 0xdf, 0x2d, 0x3f, 0x49, 0x80, 0xc5, 0x03, 0x52,
+	0x1c, 0xe9, 0x6f, 0x39, 0x64, 0x4e, 0xfb, 0xa5, 0x71, 0x28, 0x98, 0x74, 0xd1, 0xad, 0xe5, 0xe1,
+	0x02, 0xe7, 0x0c, 0x6b, 0x14, 0x8f, 0xad, 0x69, 0x18, 0x64, 0x84, 0xd2, 0x97, 0x09, 0x09, 0x77,
+	0xb9, 0x03, 0x18, 0xdb, 0x59, 0x3e, 0x71, 0x3b, 0xe7, 0x44, 0x08, 0x43, 0x71, 0x00, 0x8b, 0x1b,
+	0xbd, 0x46, 0x88, 0x58, 0x27, 0xe8, 0x46, 0xe7, 0x2a, 0xa2, 0x52, 0xfb, 0xa0, 0xea, 0xf9, 0x6d,
+	0x8d, 0xb9, 0x77, 0xb8, 0x30, 0xe8, 0xef, 0x40, 0x04, 0x58, 0xc5, 0xe9, 0x1d, 0x32, 0x15, 0xf7,
+	0xbb, 0x5d, 0x4f, 0x3b, 0xd5, 0xae, 0xe7, 0xb4, 0x21, 0x0b, 0xa6, 0x66, 0x4a, 0x4a, 0x00, 0x28,
+	0x71, 0x34, 0x26, 0x65, 0xa1, 0x82, 0xc2, 0xa8, 0x3a, 0x3d, 0xf6, 0x18, 0xad, 0x4a, 0x56, 0x6a,
+	0xad, 0xe3, 0xa9, 0x5f, 0xc1, 0x40, 0x0b, 0x72, 0x03, 0x42, 0x07, 0x2b, 0x49, 0x9f, 0x27, 0x33,
+	0xec, 0x4e, 0xc2, 0xa2, 0xc0, 0xeb, 0xf0, 0x30, 0xa0, 0x70, 0x01, 0xf1, 0xb9, 0x76, 0xc9, 0x82,
+	0x43, 0x8a, 0x8a, 0xba, 0xda, 0x80, 0x2f, 0x70, 0x7a, 0x62, 0x0c, 0x78, 0x65, 0xae, 0xbb, 0x3f,
+	0x29, 0xa4, 0x4c, 0xa0, 0x9d, 0x88, 0x31, 0xda, 0x21, 0x13, 0x41, 0xd8, 0xd0, 0x4a, 0xf5, 0x4a,
+	0x0e, 0x4a, 0x75, 0x23, 0x6c, 0x58, 0xa9, 0x15, 0xf8, 0x15, 0x83, 0x10, 0xc2, 0x43, 0xad, 0x2a,
+	0x4e, 0xcf, 0x11, 0xd5, 0x42, 0xbe, 0x62, 0x75, 0xa8, 0x75, 0xd3, 0x96, 0x02, 0x69, 0xa1, 0xb4,
+	0x4d, 0x26, 0xda, 0x61, 0x9c, 0x88, 0xc3, 0xce, 0x78, 0xd6, 0xe6, 0x6a, 0x18, 0x27, 0x7c, 0xe7,
+	0xd6, 0x0d, 0x46, 0x48, 0x0c, 0x42, 0x80, 0xfb, 0x43, 0x27, 0xe5, 0xe7, 0xbb, 0xe9, 0x25, 0xf5,
+	0xf6, 0xa5, 0x7d, 0x3c, 0x79, 0x5e, 0x4b, 0x45, 0xa3, 0x3e, 0x62, 0x47, 0xa3, 0xee, 0x1d, 0x2e,
+	0x7c, 0x60, 0x54, 0x5a, 0xdb, 0x6d, 0xe4, 0xb0, 0xc8, 0x59, 0x58, 0x81, 0xab, 0xcf, 0xa3, 0xab,
+	0x45, 0x4b, 0x91, 0x3b, 0x55, 0x5e, 0x71, 0x11, 0x6d, 0x46, 0x5a, 0x40, 0xb0, 0xe5, 0xb9, 0xbf,
+	0xef, 0x90, 0xa9, 0x9a, 0x57, 0xdf, 0x0b, 0x9b, 0x4d, 0x74, 0x7c, 0x35, 0xfa, 0x32, 0xe0, 0x27,
+	0xda, 0xa6, 0x1d, 0x5f, 0x2b, 0x12, 0x0e, 0x9a, 0x02, 0xa7, 0x6d, 0xd3, 0x43, 0x9f, 0x30, 0xaf,
+	0x73, 0x51, 0x4c, 0xdb, 0xcb, 0x1c, 0x02, 0x12, 0x83, 0x47, 0xfb, 0xae, 0x77, 0x47, 0x15, 0xce,
+	0xfa, 0x18, 0xaf, 0x1b, 0x14, 0xd8, 0x74, 0xee, 0x0f, 0x26, 0xc9, 0x94, 0xcc, 0x06, 0x38, 0x76,
+	0x78, 0x4c, 0x1d, 0x53, 0x0a, 0x23, 0x8f, 0x29, 0x3d, 0x32, 0x59, 0xe7, 0x09, 0x83, 0x72, 0x8f,
+	0x1e, 0xc7, 0xd5, 0x2a, 0x6b, 0x27, 0x12, 0x10, 0x4d, 0x9d, 0xc4, 0x37, 0x48, 0x39, 0x98, 0x2e,
+	0x71, 0xaa, 0x1e, 0x06, 0x01, 0xab, 0x9b, 0x6d, 0xa4, 0x34, 0x76, 0xc8, 0x78, 0x39, 0xcd, 0xd1,
+	0x38, 0x1a, 0x33, 0x08, 0xc8, 0xca, 0xa6, 0x1f, 0x27, 0xb3, 0xa2, 0xb7, 0x6e, 0xa4, 0x8e, 0xd5,
+	0x26, 0xc9, 0xc1, 0x46, 0x42, 0x9a, 0x16, 0xbd, 0xdb, 0x3a, 0xb6, 0x28, 0x8e, 0xd6, 0xd2, 0xbb,
+	0xad, 0x83, 0x8f, 0x31, 0x58, 0x14, 0x18, 0x66, 0x8d, 0x58, 0x33, 0x62, 0x71, 0x1b, 0xd8, 0xab,
+	0x7d, 0x16, 0x27, 0x7c, 0x0b, 0x9b, 0x7a, 0xb0, 0x30, 0x2b, 0x0c, 0x70, 0x82, 0x21, 0xdc, 0x69,
+	0x5b, 0x9a, 0xf4, 0xe5, 0xb1, 0x57, 0x91, 0x1c, 0xe0, 0x91, 0x96, 0xfd, 0x02, 0x99, 0x88, 0xdb,
+	0x5e, 0xd4, 0xe0, 0xfb, 0x66, 0xb1, 0x56, 0x41, 0xf5, 0xb1, 0x8d, 0x00, 0x10, 0x70, 0xfa, 0x75,
+	0x87, 0x50, 0xdd, 0x1b, 0x2b, 0x7e, 0x5c, 0x0f, 0xf7, 0x99, 0xde, 0x1c, 0x77, 0xc6, 0xaf, 0xd9,
+	0xc6, 0x00, 0x6f, 0xd1, 0x53, 0x83, 0x70, 0x18, 0x52, 0x0f, 0xf7, 0x3f, 0x1c, 0x72, 0x5a, 0x4d,
+	0x62, 0xaf, 0xde, 0x66, 0xd8, 0x34, 0x8c, 0x66, 0x6a, 0xdb, 0x79, 0x39, 0xec, 0x4b, 0x67, 0x5c,
+	0xd1, 0x38, 0xd9, 0x21, 0x85, 0x85, 0x0c, 0x35, 0x86, 0xa8, 0xb1, 0xde, 0xa2, 0xa8, 0xd0, 0x0a,
+	0xda, 0x3e, 0x5f, 0xda, 0x5a, 0x93, 0xa5, 0x0c, 0x0d, 0x0d, 0xc9, 0x3c, 0x06, 0xcb, 0x79, 0x0d,
+	0xd0, 0x9a, 0x7e, 0xc0, 0x48, 0x3c, 0xcf, 0x2b, 0x5b, 0xcf, 0x32, 0x82, 0x41, 0xde, 0xee, 0xdf,
+	0x96, 0xc8, 0x6c, 0x6a, 0xed, 0xa2, 0xd2, 0xeb, 0xc7, 0x2c, 0xb2, 0x5c, 0x1c, 0x5a, 0xe9, 0xbd,
+	0x24, 0xe1, 0xa0, 0x29, 0x90, 0x1a, 0xbd, 0xf7, 0xb7, 0xc3, 0xa8, 0x51, 0x2d, 0xa4, 0xa9, 0xb7,
+	0x24, 0x1c, 0x34, 0x05, 0xaa, 0xbf, 0x5d, 0xe6, 0x45, 0x2c, 0xe2, 0x39, 0x2b, 0x59, 0xf5, 0x57,
+	0x33, 0x28, 0xb0, 0xe9, 0xb8, 0xda, 0x48, 0x3a, 0xf1, 0x72, 0xc7, 0x67, 0x41, 0x22, 0xaa, 0x99,
+	0x83, 0xda, 0xd8, 0x59, 0xdf, 0xb6, 0x39, 0x1a, 0xb5, 0x91, 0x41, 0x40, 0x56, 0x36, 0xba, 0xf6,
+	0x67, 0xbd, 0xdb, 0xb1, 0xc9, 0xb8, 0xae, 0x4e, 0x8c, 0xad, 0x40, 0x53, 0x19, 0xdc, 0x22, 0x53,
+	0x2a, 0x05, 0x82, 0xb4, 0x44, 0xfa, 0x07, 0x0e, 0xa1, 0xec, 0x0e, 0xab, 0x6f, 0x45, 0xe1, 0xbe,
+	0xdf, 0x50, 0xa3, 0x57, 0x9d, 0x1c, 0xdb, 0xd6, 0xbc, 0x34, 0xc0, 0x54, 0xac, 0xa3, 0x41, 0x38,
+	0x0c, 0xa9, 0x80, 0xfb, 0xad, 0x22, 0x99, 0xb6, 0x74, 0xc5, 0x50, 0x95, 0xef, 0xbc, 0x9b, 0x54,
+	0x7e, 0xe1, 0x04, 0x2a, 0xff, 0x73, 0xa4, 0x52, 0x57, 0xca, 0x21, 0x87, 0xdc, 0xf0, 0xac, 0xbe,
+	0x31, 0xca, 0x41, 0x83, 0xc0, 0x08, 0xa4, 0x57, 0xc8, 0xbc, 0xc5, 0x46, 0x6a, 0x95, 0x12, 0xd7,
+	0x2a, 0xda, 0xd1, 0xb3, 0x94, 0x25, 0x80, 0xc1, 0x32, 0xee, 0xdf, 0x3b, 0x7a, 0x8c, 0x1e, 0x41,
+	0x8a, 0x4b, 0x2b, 0x9d, 0xe2, 0x52, 0x1b, 0xbf, 0xc3, 0x46, 0xa4, 0xb7, 0xbc, 0x46, 0xde, 0x33,
+	0x72, 0x2f, 0x40, 0x73, 0x28, 0xda, 0xf5, 0xea, 0x32, 0x46, 0xaf, 0x77, 0x30, 0xa8, 0x2d, 0x2d,
+	0x03, 0xc7, 0xe0, 0xcc, 0xe8, 0xa0, 0xd3, 0x79, 0x9b, 0x75, 0x98, 0x36, 0xe3, 0xac, 0x99, 0xb1,
+	0x6e, 0x23, 0x21, 0x4d, 0xeb, 0x6e, 0x90, 0x29, 0x74, 0x3b, 0x7b, 0x41, 0x83, 0xbe, 0x9f, 0x4c,
+	0xd5, 0xc5, 0x4f, 0x79, 0xde, 0xe1, 0x89, 0x17, 0x12, 0x0b, 0x0a, 0x87, 0x01, 0x22, 0x2f, 0x6a,
+	0xa9, 0x33, 0x0e, 0x0f, 0x10, 0x2d, 0x45, 0xad, 0x18, 0x38, 0xd4, 0x7d, 0xb3, 0x40, 0xc8, 0x72,
+	0xd8, 0xed, 0x79, 0x11, 0x6b, 0xec, 0x84, 0xff, 0xe3, 0xbd, 0xbb, 0xee, 0x1b, 0x0e, 0xa1, 0xd8,
+	0x1f, 0x61, 0xc0, 0x02, 0x13, 0x92, 0xc2, 0x0d, 0xb6, 0xae, 0xa0, 0x72, 0xb7, 0x32, 0x6b, 0x48,
+	0x21, 0xc0, 0xd0, 0x1c, 0xc3, 0x2a, 0x7e, 0x4a, 0x05, 0x05, 0x8a, 0xe9, 0x9c, 0x10, 0x1e, 0xe1,
+	0x94, 0x31, 0x02, 0xf7, 0x77, 0x0a, 0xe4, 0x09, 0xa1, 0xf0, 0xae, 0x7b, 0x81, 0xd7, 0x62, 0x18,
+	0x80, 0x3b, 0x76, 0x78, 0xe0, 0x15, 0x34, 0xca, 0x7c, 0x95, 0x03, 0x32, 0xd6, 0x7a, 0x10, 0x73,
+	0x49, 0xcc, 0x9e, 0xb5, 0xc0, 0x4f, 0x80, 0x73, 0xa6, 0x3d, 0x52, 0x56, 0x97, 0x84, 0xaa, 0xc5,
+	0xdc, 0xa4, 0xe8, 0x45, 0x7e, 0x45, 0xf2, 0x06, 0x2d, 0xc5, 0xfd, 0xae, 0x43, 0xb2, 0xba, 0x97,
+	0x9f, 0x54, 0x44, 0x9a, 0x66, 0xf6, 0xa4, 0x92, 0x4e, 0xac, 0x3c, 0x41, 0xaa, 0xe2, 0xa7, 0xc9,
+	0xb4, 0x97, 0x24, 0xac, 0xdb, 0x13, 0xc6, 0x73, 0xf1, 0xc1, 0xfc, 0x3f, 0xd7, 0xc3, 0x86, 0xdf,
+	0xf4, 0xb9, 0xd1, 0x6c, 0xb3, 0x73, 0x5f, 0x24, 0x65, 0x15, 0x71, 0x39, 0xc6, 0x30, 0x3e, 0x95,
+	0x8a, 0x1e, 0x8d, 0x98, 0x28, 0xff, 0x59, 0x20, 0x43, 0x76, 0x4e, 0x6c, 0xb2, 0xd1, 0x11, 0xa9,
+	0x26, 0x9f, 0x4c, 0x4f, 0xd0, 0xbe, 0x08, 0x35, 0x89, 0xc3, 0xff, 0x8d, 0x5c, 0xb7, 0x7d, 0x13,
+	0x7d, 0x9a, 0x96, 0x95, 0xd3, 0x11, 0x28, 0x8c, 0xcb, 0x9a, 0x5c, 0x07, 0x99, 0xf8, 0xa2, 0x9d,
+	0x94, 0x26, 0x25, 0x02, 0x2c, 0x2a, 0x34, 0xfe, 0xfc, 0x20, 0x4e, 0xbc, 0x4e, 0x67, 0xd5, 0x0f,
+	0x12, 0x79, 0xd4, 0xd2, 0x2b, 0x7f, 0xcd, 0xa0, 0xc0, 0xa6, 0x3b, 0xf7, 0x61, 0x6b, 0x50, 0x4e,
+	0x12, 0xc2, 0x7b, 0xa3, 0x40, 0xe6, 0xae, 0x04, 0xfd, 0xad, 0x2b, 0x5b, 0xfd, 0xdd, 0x8e, 0x5f,
+	0xbf, 0xc6, 0x0e, 0x70, 0xc4, 0xf6, 0xd8, 0xc1, 0xda, 0x4a, 0xd5, 0x49, 0x8f, 0xd8, 0x35, 0x04,
+	0x82, 0xc0, 0x61, 0x35, 0x9b, 0x7e, 0xd0, 0x62, 0x51, 0x2f, 0xf2, 0xa5, 0xd5, 0x6e, 0x55, 0xf3,
+	0xb2, 0x41, 0x81, 0x4d, 0x87, 0xbc, 0xc3, 0xdb, 0x01, 0x8b, 0xb2, 0x6a, 0x63, 0x13, 0x81, 0x20,
+	0x70, 0x48, 0x94, 0x44, 0xfd, 0x38, 0xa9, 0x96, 0xd2, 0x44, 0x3b, 0x08, 0x04, 0x81, 0xc3, 0xb9,
+	0x11, 0xf7, 0x77, 0xb9, 0x0f, 0x32, 0x13, 0xe5, 0xdd, 0x16, 0x60, 0x50, 0x78, 0x24, 0xdd, 0x63,
+	0x07, 0x2b, 0xb8, 0x6f, 0x67, 0x92, 0x78, 0xae, 0x09, 0x30, 0x28, 0xbc, 0x7b, 0xd7, 0x21, 0x34,
+	0xdd, 0x1d, 0x8f, 0x60, 0xeb, 0x0f, 0xd2, 0x5b, 0xff, 0x38, 0x7e, 0xc8, 0x74, 0xdd, 0x47, 0x58,
+	0x00, 0x7f, 0xe4, 0x90, 0x19, 0x3b, 0x5a, 0x40, 0x5b, 0x19, 0x15, 0xb4, 0x99, 0x56, 0x41, 0xf7,
+	0x0e, 0x17, 0x7e, 0x71, 0xd8, 0xa5, 0xd5, 0x96, 0x9f, 0x84, 0xbd, 0xf8, 0x43, 0x2c, 0x68, 0xf9,
+	0x01, 0xe3, 0xbe, 0x2a, 0x11, 0x65, 0x48, 0x85, 0x22, 0x96, 0xc3, 0x06, 0x7b, 0x00, 0x1d, 0xe6,
+	0xde, 0x24, 0xf3, 0x03, 0x69, 0x5b, 0xc7, 0x50, 0x37, 0x47, 0xe6, 0xde, 0xba, 0x6f, 0x3a, 0x64,
+	0x36, 0x95, 0xf2, 0x96, 0x93, 0x12, 0xe3, 0x4b, 0x22, 0xe4, 0x21, 0xa6, 0xc8, 0x0f, 0x84, 0xb7,
+	0xa8, 0x6c, 0x2d, 0x09, 0x83, 0x02, 0x9b, 0xce, 0xdd, 0x23, 0xa7, 0xb3, 0x19, 0x4b, 0xb8, 0x61,
+	0x9b, 0x74, 0xdb, 0xcc, 0x86, 0x3d, 0x34, 0x37, 0xf6, 0x69, 0x9d, 0x1a, 0x5a, 0x48, 0x6f, 0x22,
+	0xe9, 0x54, 0x4e, 0xf7, 0x9b, 0x0e, 0x99, 0x4b, 0x27, 0x27, 0x61, 0xdb, 0xbc, 0x8e, 0xef, 0xc5,
+	0xd9, 0xe5, 0xbe, 0x84, 0x40, 0x10, 0x38, 0x3b, 0xfb, 0xb9, 0x70, 0x44, 0xf6, 0xf3, 0x60, 0x6e,
+	0x73, 0xf1, 0x24, 0xb9, 0xcd, 0xee, 0xef, 0x16, 0x48, 0x59, 0xf9, 0x58, 0x8f, 0x31, 0x34, 0xaf,
+	0x3b, 0x64, 0x56, 0xfb, 0x13, 0xb0, 0x4c, 0x0e, 0x39, 0x3d, 0xab, 0x7c, 0x2c, 0x64, 0xb8, 0x18,
+	0x4f, 0x1c, 0xda, 0xba, 0x05, 0x5b, 0x12, 0xa4, 0x05, 0xd3, 0x1b, 0x18, 0x30, 0x8f, 0x13, 0xd6,
+	0xb5, 0x0e, 0x3e, 0xae, 0xa5, 0x27, 0x16, 0xeb, 0x61, 0xc4, 0x50, 0x2b, 0xa0, 0x4f, 0x7a, 0x5b,
+	0x53, 0x9a, 0x2d, 0xc1, 0xc0, 0xc0, 0xe2, 0xe4, 0xfe, 0x59, 0x81, 0x9c, 0xce, 0x56, 0x89, 0x7e,
+	0x0a, 0x83, 0x59, 0xe2, 0xdb, 0xba, 0xbe, 0xac, 0xbc, 0xca, 0x33, 0x60, 0xe1, 0xee, 0x1d, 0x2e,
+	0x2c, 0x0c, 0xde, 0xdf, 0x5e, 0xb4, 0x49, 0x20, 0xc5, 0x4c, 0x78, 0x74, 0xa4, 0x7f, 0xac, 0x76,
+	0xb0, 0xd4, 0xeb, 0x49, 0xb7, 0x8c, 0xe5, 0xd1, 0xb1, 0xb1, 0x90, 0xa1, 0xa6, 0x5b, 0xe4, 0xac,
+	0x05, 0xd9, 0x60, 0x7e, 0xab, 0xbd, 0x1b, 0x46, 0xe2, 0x22, 0x4a, 0xb1, 0xf6, 0x5e, 0xc9, 0xe5,
+	0x2c, 0x0c, 0xa1, 0x81, 0xa1, 0x25, 0xd1, 0x83, 0x52, 0xf7, 0x7a, 0x5e, 0xdd, 0x4f, 0x0e, 0xe4,
+	0x61, 0x4e, 0x6b, 0xd4, 0x65, 0x09, 0x07, 0x4d, 0xe1, 0xbe, 0xed, 0x10, 0x13, 0x6b, 0x3e, 0x49,
+	0xea, 0xfe, 0x0b, 0x64, 0x46, 0x4c, 0xc7, 0x5a, 0xe4, 0x05, 0x75, 0xa5, 0x46, 0x74, 0xd6, 0xd1,
+	0x8e, 0x85, 0x83, 0x14, 0xa5, 0x56, 0x3c, 0xc5, 0x91, 0x49, 0xff, 0x2b, 0xe4, 0x34, 0x06, 0x7d,
+	0x2f, 0x47, 0x61, 0x57, 0xd6, 0xad, 0xc1, 0x9b, 0x52, 0xae, 0x55, 0x25, 0xf5, 0xe9, 0xed, 0x0c,
+	0x1e, 0x06, 0x4a, 0xb8, 0xdf, 0x2f, 0x90, 0xb9, 0x74, 0xb4, 0x09, 0x17, 0x7f, 0x23, 0x3a, 0xd8,
+	0x5e, 0x5d, 0xca, 0x5a, 0x90, 0x2b, 0x1c, 0x0a, 0x12, 0x8b, 0x0a, 0x4a, 0x46, 0xdd, 0x1b, 0x48,
+	0x9c, 0xd9, 0xb3, 0x57, 0x0d, 0x0a, 0x6c, 0x3a, 0x0c, 0x26, 0xaa, 0xcf, 0x07, 0x37, 0x26, 0x57,
+	0x35, 0x07, 0xb0, 0xb8, 0xd9, 0x43, 0x53, 0x3a, 0xe1, 0xd0, 0x4c, 0x9c, 0x78, 0x68, 0x26, 0x47,
+	0xee, 0x09, 0xd7, 0x49, 0xe9, 0x98, 0xea, 0xe6, 0x58, 0xe6, 0xec, 0x8b, 0xa4, 0x8c, 0xec, 0x70,
+	0xd3, 0xcd, 0x8b, 0x65, 0x48, 0xca, 0xea, 0x12, 0x1b, 0x75, 0x49, 0xd1, 0xf7, 0x94, 0x93, 0x55,
+	0x2f, 0x83, 0xb5, 0x38, 0xee, 0xf3, 0xfe, 0x45, 0x24, 0x7d, 0x8a, 0x14, 0xd9, 0x9d, 0x5e, 0xd6,
+	0x9b, 0x7a, 0xe9, 0x4e, 0xcf, 0x8f, 0x58, 0x8c, 0x44, 0xec, 0x4e, 0x8f, 0x9e, 0x23, 0x05, 0xbf,
+	0x21, 0x67, 0x2c, 0x91, 0x34, 0x85, 0xb5, 0x15, 0x28, 0xf8, 0x0d, 0xb7, 0x4f, 0x2a, 0x4a, 0x20,
+	0x0f, 0x9f, 0x09, 0x0b, 0xc5, 0x19, 0x3b, 0x7c, 0xa6, 0x98, 0x8e, 0xb0, 0x4d, 0xfa, 0x84, 0x98,
+	0x94, 0xd1, 0xbc, 0x76, 0xe6, 0x0b, 0xa4, 0x54, 0x0f, 0x65, 0x3a, 0xbf, 0xe5, 0xd5, 0xe0, 0xa6,
+	0x09, 0xc7, 0xb8, 0x37, 0xc9, 0xdc, 0xb5, 0x20, 0xbc, 0x1d, 0xa0, 0xbd, 0x78, 0xd9, 0x67, 0x9d,
+	0x06, 0x32, 0x6e, 0xe2, 0x8f, 0xec, 0xb6, 0xc8, 0xb1, 0x20, 0x70, 0xfa, 0x82, 0x59, 0x61, 0xd4,
+	0x05, 0x33, 0xf7, 0xcb, 0x0e, 0x39, 0x9d, 0x4d, 0x11, 0xfd, 0xa9, 0x9d, 0xc7, 0xbf, 0x88, 0x95,
+	0x51, 0x36, 0xc3, 0x66, 0x4f, 0x64, 0x45, 0xbc, 0x40, 0x66, 0x76, 0xfb, 0x7e, 0xa7, 0x21, 0xbf,
+	0xab, 0x4e, 0x7a, 0x5d, 0xd5, 0x2c, 0x1c, 0xa4, 0x28, 0xf1, 0x78, 0xb3, 0xeb, 0x07, 0x5e, 0x74,
+	0xb0, 0x65, 0x2c, 0x2e, 0xbd, 0x97, 0xd5, 0x34, 0x06, 0x2c, 0x2a, 0xf7, 0xbf, 0x8a, 0xc4, 0x5c,
+	0xe2, 0xa3, 0x4d, 0x99, 0x68, 0xe3, 0x8c, 0xed, 0x18, 0x46, 0xcd, 0xa9, 0xf9, 0x8a, 0xf3, 0x9f,
+	0x95, 0x67, 0xf3, 0x25, 0x07, 0x4f, 0x55, 0x7e, 0xe2, 0x7b, 0x7c, 0x5b, 0xa9, 0x16, 0xc6, 0xf6,
+	0xff, 0x6a, 0x59, 0x6b, 0x82, 0x6d, 0x18, 0xd9, 0x87, 0x34, 0x2d, 0x09, 0x6c, 0xb1, 0xf4, 0x33,
+	0x32, 0xce, 0x54, 0xcc, 0x27, 0x75, 0xac, 0x9c, 0x09, 0x2e, 0x75, 0xc9, 0x44, 0xc4, 0x92, 0x48,
+	0xe5, 0xea, 0xad, 0x8e, 0x15, 0x62, 0x4f, 0xa2, 0x83, 0xed, 0x04, 0xd5, 0x74, 0xcb, 0x3a, 0x46,
+	0x70, 0x30, 0x08, 0x29, 0xb8, 0x9d, 0xc4, 0x32, 0x40, 0x12, 0xf6, 0x07, 0x4e, 0xaa, 0xdb, 0x06,
+	0x05, 0x36, 0x9d, 0x1b, 0x13, 0x3a, 0xd8, 0x79, 0x27, 0x8c, 0xa7, 0x60, 0xc4, 0xa8, 0x9f, 0x84,
+	0x5d, 0xbe, 0x87, 0x16, 0xf8, 0xaa, 0x36, 0x11, 0x23, 0x85, 0x00, 0x43, 0xe3, 0xbe, 0x3d, 0x41,
+	0x32, 0x39, 0x33, 0xb4, 0x6f, 0x5f, 0x54, 0x75, 0x72, 0xbc, 0xa8, 0xaa, 0x6b, 0x32, 0xec, 0xb2,
+	0x2a, 0xfa, 0x79, 0x7b, 0x6d, 0x2f, 0x56, 0x6b, 0xf9, 0x45, 0xd5, 0xb5, 0x5b, 0x08, 0xbc, 0x77,
+	0xb8, 0xf0, 0x4b, 0xc7, 0x3b, 0x69, 0x61, 0x8f, 0x5e, 0x14, 0x89, 0xc1, 0x46, 0x34, 0xe7, 0x01,
+	0x82, 0xbf, 0x7d, 0xd6, 0x2a, 0x1e, 0xe1, 0x2f, 0xfa, 0x82, 0xc8, 0xf4, 0x04, 0x16, 0xf7, 0x3b,
+	0x89, 0x9c, 0x3d, 0x1b, 0x79, 0x2d, 0x46, 0xc1, 0xd5, 0xa4, 0x7c, 0x8a, 0x6f, 0xb0, 0x24, 0xd2,
+	0x4f, 0x91, 0x4a, 0x9c, 0x78, 0x51, 0xf2, 0x80, 0x59, 0x59, 0xba, 0xc3, 0xb7, 0x15, 0x13, 0x30,
+	0xfc, 0xd0, 0x7c, 0x69, 0xfa, 0x81, 0x1f, 0xb7, 0x1f, 0x30, 0x90, 0xcc, 0x2b, 0x7e, 0x59, 0x73,
+	0x00, 0x8b, 0x1b, 0x6a, 0x40, 0xbe, 0x16, 0x44, 0x90, 0xa1, 0xcc, 0x37, 0x5b, 0xad, 0x01, 0x41,
+	0x63, 0xc0, 0xa2, 0xa2, 0x1b, 0x64, 0xae, 0xe9, 0xf9, 0x9d, 0x7e, 0xc4, 0x96, 0x3b, 0x5e, 0x1c,
+	0xf3, 0xdb, 0x3a, 0xe8, 0xb3, 0x7a, 0x9a, 0x5f, 0x47, 0x4a, 0x61, 0xee, 0x29, 0x93, 0xd0, 0x82,
+	0x42, 0xa6, 0xb4, 0xfb, 0x05, 0x72, 0x26, 0xfb, 0x36, 0x86, 0xf4, 0xe2, 0xb4, 0xa2, 0xb0, 0xdf,
+	0xcb, 0xee, 0x5f, 0xfc, 0x05, 0x05, 0x10, 0x38, 0xdc, 0x57, 0xf6, 0xfc, 0xa0, 0x91, 0xdd, 0x57,
+	0xf0, 0x81, 0x05, 0xe0, 0x98, 0x63, 0xdc, 0x06, 0xfe, 0x2b, 0x87, 0x5c, 0x38, 0xea, 0x09, 0x0f,
+	0x74, 0xcf, 0xdd, 0xf6, 0xa2, 0x40, 0xc6, 0x15, 0xb8, 0xe2, 0xba, 0xe9, 0x45, 0x01, 0x70, 0x28,
+	0x5e, 0x6c, 0x14, 0x79, 0xb6, 0xf2, 0xec, 0xb6, 0x91, 0xe3, 0x6b, 0x22, 0xe8, 0x06, 0xd1, 0x06,
+	0xb1, 0x48, 0xf0, 0x05, 0x29, 0xcd, 0xbd, 0x4a, 0xe8, 0xe6, 0x3e, 0x8b, 0x22, 0xbf, 0x61, 0x65,
+	0x05, 0x63, 0x3a, 0xd6, 0xad, 0xed, 0xcd, 0x8d, 0xad, 0xd0, 0x0f, 0xf8, 0x1d, 0x11, 0x2b, 0x1d,
+	0xeb, 0xaa, 0x05, 0x87, 0x14, 0x95, 0xfb, 0x9d, 0x02, 0x99, 0xb6, 0x5e, 0x9a, 0x39, 0x86, 0xe9,
+	0x92, 0x79, 0x19, 0xa7, 0x70, 0xcc, 0x97, 0x71, 0x9e, 0x21, 0xe5, 0x5e, 0xd8, 0xf1, 0xeb, 0xbe,
+	0xbe, 0xba, 0xc1, 0xb3, 0xcd, 0xb6, 0x24, 0x0c, 0x34, 0x96, 0x26, 0xa4, 0xa2, 0x9f, 0x5f, 0xa8,
+	0x96, 0xf2, 0xb3, 0xdc, 0xf4, 0x7a, 0x33, 0xcf, 0x2a, 0x18, 0x41, 0x98, 0xe0, 0xc3, 0x27, 0x97,
+	0x48, 0xf3, 0x94, 0x79, 0x69, 0x7c, 0xd6, 0xc5, 0x20, 0x31, 0x78, 0x88, 0xa9, 0xa0, 0x81, 0x8f,
+	0xd7, 0xd9, 0x62, 0xfa, 0x3e, 0x52, 0xec, 0x47, 0x1d, 0xd9, 0x53, 0xda, 0x8b, 0x8a, 0xc6, 0x3f,
+	0xc2, 0x53, 0x5b, 0x43, 0xe1, 0x44, 0xa1, 0xf6, 0xe2, 0x91, 0xa1, 0x76, 0x8c, 0x73, 0xc6, 0xed,
+	0xad, 0xc8, 0xdf, 0xf7, 0x12, 0x9c, 0x2a, 0xf2, 0x14, 0x62, 0xe2, 0x9c, 0xdb, 0xab, 0x06, 0x09,
+	0x69, 0x5a, 0x8c, 0x34, 0x9a, 0x98, 0x37, 0x8b, 0x12, 0xee, 0x61, 0x14, 0xdb, 0xa0, 0x8e, 0x34,
+	0x9a, 0x28, 0xb9, 0x24, 0x80, 0xc1, 0x32, 0x78, 0x32, 0x4c, 0x01, 0xb1, 0x22, 0xe2, 0xb0, 0xa2,
+	0x4f, 0x86, 0x29, 0x3e, 0x58, 0x97, 0x81, 0x12, 0x78, 0xe8, 0x9d, 0xd5, 0x9d, 0xfa, 0x08, 0xdc,
+	0x96, 0x7e, 0xda, 0x6d, 0xb9, 0x32, 0x96, 0xb9, 0x21, 0xab, 0x3d, 0xe2, 0x54, 0xf0, 0x77, 0x93,
+	0x84, 0x20, 0x4d, 0xec, 0x27, 0xa1, 0x8c, 0x52, 0xb2, 0x5e, 0x98, 0x5d, 0x5b, 0x48, 0x01, 0x1c,
+	0xf3, 0xee, 0x9d, 0x33, 0xc3, 0x02, 0xfd, 0x13, 0x3f, 0xc5, 0x40, 0xff, 0x36, 0x79, 0xdc, 0x0f,
+	0x62, 0xbc, 0x79, 0x2d, 0x55, 0x20, 0x3a, 0x9a, 0xd4, 0xfc, 0x2b, 0xd7, 0xde, 0x27, 0x19, 0x3d,
+	0xbe, 0x36, 0x8c, 0x08, 0x86, 0x97, 0xc5, 0xfe, 0x54, 0x88, 0xec, 0x55, 0x58, 0xc5, 0x07, 0x34,
+	0x05, 0x1a, 0x73, 0x2c, 0xf0, 0x76, 0x3b, 0x6c, 0xbd, 0xa9, 0x2e, 0xc1, 0x9a, 0x03, 0xab, 0x40,
+	0x5c, 0xde, 0x06, 0x43, 0x33, 0x7c, 0xdd, 0x55, 0x72, 0x5a, 0x77, 0xe4, 0xa4, 0xeb, 0x4e, 0x1f,
+	0x02, 0xa7, 0x47, 0xbe, 0x32, 0xa2, 0xf6, 0x82, 0x99, 0x91, 0x7b, 0xc1, 0x27, 0xc8, 0x9c, 0x1f,
+	0xb4, 0x59, 0xe4, 0x27, 0xac, 0xc1, 0x17, 0x42, 0x75, 0x96, 0x77, 0x84, 0x76, 0xb8, 0xad, 0xa5,
+	0xb0, 0x90, 0xa1, 0x36, 0x7d, 0xb8, 0xb9, 0xbc, 0x56, 0x9d, 0x1b, 0xd6, 0x87, 0x9b, 0xcb, 0x6b,
+	0x60, 0x68, 0xdc, 0xd7, 0x0b, 0xe4, 0x71, 0xb3, 0xa2, 0xb0, 0x29, 0x7e, 0x13, 0xa7, 0x15, 0xbf,
+	0x80, 0x28, 0xd2, 0x39, 0x2c, 0xb7, 0xa2, 0xf1, 0x50, 0x6a, 0x0c, 0x58, 0x54, 0xdc, 0x3b, 0xc7,
+	0x22, 0x9e, 0x9f, 0x9a, 0x5d, 0x6e, 0xcb, 0x12, 0x0e, 0x9a, 0x82, 0x3f, 0xbc, 0xc8, 0xa2, 0x44,
+	0xc6, 0x69, 0xb2, 0xf9, 0x4d, 0xcb, 0x06, 0x05, 0x36, 0x1d, 0x6e, 0x7c, 0x75, 0x35, 0xda, 0xb8,
+	0xe4, 0x66, 0xc4, 0xc6, 0xa7, 0x07, 0x58, 0x63, 0x55, 0x75, 0xb8, 0x1b, 0x76, 0x62, 0xb0, 0x3a,
+	0x08, 0x07, 0x4d, 0xe1, 0xfe, 0xc4, 0x21, 0xef, 0x19, 0xda, 0x15, 0x8f, 0x40, 0x87, 0xf6, 0xd3,
+	0x3a, 0x74, 0x6b, 0x4c, 0x1d, 0x3a, 0xd0, 0x84, 0x11, 0xfa, 0xf4, 0x1f, 0x1c, 0x32, 0x67, 0xe8,
+	0x1f, 0x41, 0x3b, 0x9b, 0xf9, 0xbd, 0xa2, 0x68, 0xea, 0x5d, 0xab, 0x0c, 0x34, 0xec, 0x6d, 0xde,
+	0x30, 0x61, 0xf9, 0x2d, 0xd5, 0xd5, 0x23, 0x40, 0x47, 0x18, 0x62, 0xf8, 0xae, 0x06, 0xfa, 0x66,
+	0xe2, 0x1c, 0xcc, 0xcf, 0xb4, 0x70, 0xee, 0xf2, 0xb1, 0x82, 0x31, 0x5c, 0x0a, 0x48, 0x69, 0x3c,
+	0x71, 0xda, 0x8f, 0x71, 0x45, 0x36, 0xa4, 0x6b, 0xca, 0x24, 0x4e, 0x4b, 0x38, 0x68, 0x0a, 0xb7,
+	0x4b, 0xaa, 0x69, 0xe6, 0x2b, 0xac, 0xc9, 0x9d, 0x0b, 0xc7, 0x6a, 0x23, 0x9e, 0x98, 0x79, 0xa9,
+	0xf5, 0xbe, 0x97, 0x7d, 0x06, 0x68, 0x49, 0x21, 0xc0, 0xd0, 0xb8, 0x7f, 0xe2, 0x90, 0x33, 0x43,
+	0x1a, 0x93, 0xa3, 0x4b, 0x2e, 0x31, 0x8b, 0x7f, 0xc4, 0xd3, 0x4c, 0x0d, 0xd6, 0xf4, 0xd4, 0x89,
+	0xd4, 0x3a, 0xbf, 0xae, 0x08, 0x30, 0x28, 0xbc, 0xfb, 0xaf, 0x0e, 0x39, 0x95, 0xae, 0x6b, 0x4c,
+	0xaf, 0x12, 0x2a, 0x1a, 0xa3, 0x93, 0x9b, 0xb0, 0xe5, 0xa2, 0xd6, 0xe7, 0x24, 0x27, 0xba, 0x34,
+	0x40, 0x01, 0x43, 0x4a, 0xd1, 0x2f, 0xf3, 0x0c, 0x1f, 0xd5, 0xdb, 0x6a, 0x9a, 0x6c, 0xe7, 0x36,
+	0x4d, 0xcc, 0x48, 0xda, 0xf6, 0xbf, 0x96, 0x07, 0xb6, 0x70, 0xf7, 0xc7, 0x45, 0xa2, 0xa3, 0x3b,
+	0xfc, 0xbc, 0x92, 0xd3, 0x49, 0x2f, 0xf5, 0x50, 0x54, 0xf1, 0x04, 0x0f, 0x45, 0x95, 0xee, 0x77,
+	0xc2, 0x11, 0x8e, 0x78, 0x63, 0xe7, 0x58, 0x8a, 0x7e, 0xc7, 0xa0, 0xc0, 0xa6, 0xc3, 0x9a, 0x74,
+	0xfc, 0x7d, 0x26, 0x0a, 0x4d, 0xa6, 0x6b, 0xb2, 0xae, 0x10, 0x60, 0x68, 0xb0, 0x26, 0x0d, 0xbf,
+	0xd9, 0xac, 0x4e, 0xa5, 0x6b, 0x82, 0xbd, 0x03, 0x1c, 0x83, 0x14, 0xed, 0x30, 0xdc, 0x93, 0xe6,
+	0x85, 0xa6, 0x58, 0x0d, 0xc3, 0x3d, 0xe0, 0x18, 0x7a, 0x9d, 0x9c, 0x09, 0xc2, 0xa8, 0xeb, 0x75,
+	0xfc, 0xd7, 0x58, 0x43, 0x4b, 0x91, 0x66, 0xc5, 0xff, 0x92, 0x05, 0xce, 0x6c, 0x0c, 0x92, 0xc0,
+	0xb0, 0x72, 0x38, 0xfd, 0x7a, 0x11, 0x6b, 0xf8, 0xf5, 0xc4, 0xe6, 0x46, 0xd2, 0xd3, 0x6f, 0x6b,
+	0x80, 0x02, 0x86, 0x94, 0x72, 0xff, 0x8d, 0x6f, 0x50, 0x23, 0x6e, 0xaf, 0x3e, 0xb2, 0x83, 0x7e,
+	0x7a, 0x82, 0x94, 0x8e, 0x31, 0x41, 0xf0, 0x20, 0x1d, 0x87, 0x81, 0x3e, 0x48, 0x4f, 0x8c, 0x3c,
+	0x48, 0x5b, 0x54, 0xee, 0x77, 0x27, 0xc8, 0x13, 0x3a, 0x34, 0xc9, 0x92, 0xdb, 0x61, 0xb4, 0xe7,
+	0x07, 0x2d, 0x1e, 0x9e, 0xf9, 0x86, 0xa3, 0x62, 0x40, 0xf2, 0xf5, 0x01, 0x11, 0x8e, 0xa8, 0xe7,
+	0x71, 0x97, 0x28, 0x25, 0x69, 0x71, 0xc7, 0x92, 0x92, 0x79, 0x79, 0xc0, 0x46, 0x41, 0xaa, 0x3a,
+	0xf4, 0x35, 0x42, 0x54, 0x30, 0xbb, 0x99, 0xc7, 0x5b, 0x65, 0xaa, 0x72, 0xc0, 0x9a, 0xc6, 0x04,
+	0xdb, 0xd1, 0x12, 0xc0, 0x92, 0x86, 0x57, 0x0f, 0x27, 0x3b, 0xa2, 0x57, 0x84, 0x7b, 0xf9, 0x33,
+	0xf9, 0xf7, 0x8a, 0xdd, 0x1f, 0x7a, 0x53, 0x93, 0x3d, 0x21, 0x85, 0x53, 0xc0, 0x27, 0x91, 0x5a,
+	0x11, 0x8b, 0x95, 0xcb, 0xe1, 0x03, 0xc3, 0x22, 0xe0, 0xeb, 0xa1, 0xd7, 0xa8, 0x79, 0x1d, 0x2f,
+	0xa8, 0x63, 0x02, 0x34, 0x27, 0xb7, 0xdf, 0x4e, 0xe2, 0x00, 0x50, 0x8c, 0x06, 0x2e, 0xc8, 0x4d,
+	0x1c, 0xe7, 0x82, 0x1c, 0x3e, 0x92, 0x30, 0x30, 0x8c, 0x27, 0x7a, 0x24, 0xe1, 0xa3, 0x64, 0xfa,
+	0x01, 0x8b, 0xba, 0x7f, 0x3a, 0x69, 0x94, 0x34, 0x46, 0xfb, 0xf1, 0xbe, 0x56, 0x64, 0x46, 0x53,
+	0x5a, 0x58, 0x79, 0xcd, 0x0d, 0xeb, 0xf9, 0x25, 0x0d, 0x04, 0x5b, 0x1e, 0xce, 0xcc, 0x9e, 0x17,
+	0xb1, 0xe0, 0xa1, 0xce, 0xcc, 0x2d, 0x2d, 0x01, 0x2c, 0x69, 0x94, 0xa5, 0xa2, 0x1e, 0xcb, 0x63,
+	0x46, 0x3d, 0xd0, 0xdc, 0x1b, 0x7a, 0xb5, 0xe6, 0x4d, 0x87, 0xcc, 0x05, 0xa9, 0xf9, 0x5a, 0x2d,
+	0x8d, 0x9d, 0x89, 0x3b, 0x7c, 0x21, 0x88, 0x3b, 0xb8, 0x69, 0x18, 0x64, 0x84, 0xe3, 0x0b, 0x4f,
+	0x6a, 0x04, 0xd2, 0xf7, 0xa6, 0xf4, 0xe1, 0x1c, 0xd2, 0x68, 0xc8, 0xd2, 0x5b, 0x57, 0x3c, 0x27,
+	0x47, 0x5d, 0xf1, 0xa4, 0x7b, 0xfa, 0x0a, 0xf9, 0x54, 0xbe, 0x57, 0xc8, 0xc9, 0x90, 0xeb, 0xe3,
+	0x37, 0x49, 0xa5, 0x1e, 0x31, 0x99, 0x09, 0x70, 0xf2, 0x6b, 0xc5, 0xfc, 0x0d, 0x80, 0x65, 0xc5,
+	0x00, 0x0c, 0x2f, 0xf7, 0xab, 0x45, 0x72, 0x5a, 0x75, 0x87, 0x72, 0xc9, 0xe2, 0x86, 0x23, 0xe4,
+	0x1a, 0xcb, 0x4d, 0x6f, 0x38, 0xab, 0x0a, 0x01, 0x86, 0x06, 0x4d, 0x46, 0x61, 0xbd, 0xc5, 0xd9,
+	0x90, 0x87, 0xb4, 0x0a, 0x41, 0xe1, 0xe9, 0x57, 0x87, 0xbe, 0x32, 0x91, 0x43, 0x5c, 0x70, 0xc0,
+	0x9f, 0x7c, 0xc2, 0xe7, 0x25, 0xde, 0x70, 0xc8, 0xa9, 0xbd, 0x54, 0x28, 0x5a, 0x29, 0xd2, 0x71,
+	0xf2, 0x02, 0xd3, 0xc1, 0x6d, 0x33, 0x05, 0xd3, 0xf0, 0x18, 0xb2, 0xa2, 0xdd, 0x7f, 0x77, 0x88,
+	0xad, 0x55, 0x8e, 0x67, 0x6d, 0x58, 0x2f, 0xf0, 0x14, 0x8e, 0x78, 0x81, 0x47, 0x19, 0x26, 0xc5,
+	0xe3, 0xd9, 0xa5, 0xa5, 0x13, 0xd8, 0xa5, 0x13, 0x23, 0x2d, 0x19, 0x74, 0x38, 0xfb, 0x8d, 0xea,
+	0x64, 0xc6, 0xe1, 0xbc, 0xb6, 0x02, 0x08, 0xc7, 0x7b, 0xa4, 0x73, 0xa6, 0xcd, 0x3c, 0x42, 0xf5,
+	0x33, 0xd1, 0xec, 0xa6, 0x4e, 0xf3, 0x14, 0x2d, 0xdf, 0x18, 0x48, 0xf3, 0xfc, 0x85, 0x93, 0x07,
+	0x1f, 0x45, 0x07, 0x8d, 0xca, 0xf2, 0x9c, 0x3a, 0x22, 0xf2, 0x78, 0x8b, 0x94, 0xd1, 0xfa, 0xe6,
+	0x7e, 0xa0, 0x72, 0xaa, 0x52, 0xe5, 0x55, 0x09, 0xbf, 0x77, 0xb8, 0xf0, 0xb1, 0x93, 0x57, 0x4b,
+	0x95, 0x06, 0xcd, 0x9f, 0xc6, 0xa4, 0x82, 0xbf, 0x79, 0x90, 0x54, 0xda, 0xf5, 0x2f, 0x69, 0x75,
+	0xa2, 0x10, 0xb9, 0x44, 0x60, 0x8d, 0x1c, 0x1a, 0x90, 0x0a, 0x12, 0x0a, 0xa1, 0xc2, 0xfc, 0xdf,
+	0xd2, 0xe1, 0x4a, 0x85, 0xb8, 0x77, 0xb8, 0xf0, 0xf1, 0x93, 0x0b, 0xd5, 0xc5, 0xc1, 0x88, 0x40,
+	0x0d, 0x6d, 0x42, 0xa9, 0xd3, 0x0f, 0xa6, 0xa1, 0x87, 0x86, 0x51, 0xd7, 0xc9, 0x8c, 0x1d, 0x78,
+	0x94, 0xbe, 0xcc, 0x67, 0x94, 0x55, 0x6c, 0x87, 0x28, 0x87, 0x86, 0x2d, 0x53, 0xa5, 0xdd, 0x77,
+	0x8a, 0x66, 0x89, 0xc9, 0x2c, 0xb6, 0x9f, 0x89, 0x25, 0xf6, 0x42, 0x66, 0x89, 0x5d, 0x18, 0x58,
+	0x62, 0x73, 0xe6, 0x49, 0x98, 0xd4, 0xa2, 0x79, 0xa4, 0xfb, 0xf8, 0xd1, 0xc7, 0x61, 0x6e, 0xbd,
+	0xbc, 0xda, 0xf7, 0x23, 0x16, 0x6f, 0x45, 0xfd, 0x00, 0x13, 0x9a, 0x2b, 0xe9, 0xf7, 0x29, 0x21,
+	0x8d, 0x86, 0x2c, 0x3d, 0xa6, 0xad, 0xce, 0xa6, 0xf2, 0x48, 0x70, 0x88, 0x3b, 0xfc, 0x21, 0x23,
+	0x91, 0xba, 0xa6, 0x87, 0x58, 0xbc, 0x5e, 0x24, 0x70, 0x34, 0x21, 0x53, 0xbb, 0xe2, 0x65, 0x81,
+	0x1c, 0xae, 0xfe, 0xc8, 0x37, 0x0a, 0xf8, 0xdd, 0x4c, 0xf5, 0x60, 0xc1, 0x3d, 0xf3, 0x13, 0x94,
+	0x28, 0xfa, 0x51, 0x4c, 0x44, 0x4c, 0xa2, 0x83, 0xcd, 0x40, 0x06, 0x55, 0x17, 0x44, 0x12, 0x22,
+	0x07, 0x0d, 0x9d, 0xd0, 0x8a, 0x9e, 0xae, 0x92, 0x99, 0x46, 0xb8, 0x11, 0x26, 0x92, 0x98, 0xef,
+	0xd6, 0x95, 0xda, 0xff, 0xe6, 0xff, 0x17, 0xc5, 0x82, 0x0f, 0x5f, 0x15, 0x76, 0x49, 0xf7, 0xeb,
+	0x45, 0x72, 0x4a, 0xe5, 0x41, 0xcb, 0x37, 0x74, 0xd0, 0x49, 0xa8, 0x5e, 0x75, 0xca, 0xba, 0xd6,
+	0x15, 0x29, 0x68, 0x0a, 0xfa, 0x59, 0x42, 0x1a, 0xac, 0xd7, 0x09, 0x0f, 0xf8, 0xfa, 0x2f, 0x9d,
+	0x78, 0xfd, 0x6b, 0x5b, 0x7e, 0x45, 0x73, 0x01, 0x8b, 0xa3, 0xcc, 0x18, 0x9c, 0xe0, 0xc3, 0x97,
+	0xc9, 0x18, 0xb4, 0x6e, 0xdf, 0x4d, 0x3e, 0xc2, 0xdb, 0x77, 0x3e, 0x39, 0x25, 0xea, 0xa7, 0xb5,
+	0xd6, 0x03, 0xe4, 0x78, 0x9c, 0xc1, 0x09, 0xbd, 0x92, 0x66, 0x03, 0x59, 0xbe, 0x78, 0x9d, 0xed,
+	0xb4, 0xea, 0xf3, 0xeb, 0xca, 0xb3, 0xfd, 0x34, 0x99, 0xf4, 0xfa, 0x49, 0x3b, 0x1c, 0x78, 0x68,
+	0x62, 0x89, 0x43, 0x41, 0x62, 0xe9, 0x3a, 0x29, 0x35, 0xd0, 0x05, 0x54, 0x38, 0x71, 0xe5, 0x8c,
+	0x3f, 0x0b, 0x1d, 0x44, 0x9c, 0x0b, 0xe6, 0x53, 0x24, 0x5e, 0x2b, 0xf5, 0x6e, 0xe6, 0x8e, 0x87,
+	0xd7, 0x9d, 0x10, 0x6a, 0x6f, 0xc0, 0xa5, 0x23, 0x36, 0xe0, 0x8f, 0x5b, 0xff, 0x20, 0xc4, 0x8a,
+	0x97, 0x0c, 0xfe, 0x5f, 0x0f, 0x91, 0xf0, 0x9e, 0xa2, 0x75, 0x7f, 0x8e, 0xcc, 0xd8, 0xff, 0xf7,
+	0xe3, 0x58, 0x37, 0x87, 0xdc, 0x7f, 0x29, 0x91, 0xd9, 0x54, 0x82, 0x50, 0x6a, 0x8a, 0x3b, 0x47,
+	0x4e, 0xf1, 0xa7, 0xc8, 0x44, 0x2f, 0xea, 0x07, 0x4c, 0xe6, 0x7d, 0x69, 0x21, 0xa8, 0x76, 0x30,
+	0xf9, 0x09, 0xff, 0xc8, 0x94, 0x68, 0xe8, 0x07, 0xd2, 0xb1, 0x6e, 0xa7, 0x44, 0x43, 0x3f, 0x00,
+	0x89, 0xa5, 0x9f, 0x27, 0x33, 0xfc, 0x7f, 0x72, 0x48, 0x0d, 0x55, 0x2d, 0x8d, 0xad, 0x7b, 0xb7,
+	0x2d, 0x76, 0xc2, 0x45, 0x61, 0x43, 0x20, 0x25, 0x0e, 0xaf, 0xc8, 0x5b, 0xaf, 0x9c, 0x4d, 0x8e,
+	0x1d, 0x03, 0xca, 0x26, 0x5e, 0x89, 0xa5, 0x73, 0xff, 0xc7, 0xce, 0x7a, 0x7a, 0xd9, 0x4e, 0x3d,
+	0x84, 0x65, 0x4b, 0x86, 0x2c, 0xd9, 0x0f, 0x92, 0x4a, 0xd7, 0x0b, 0xfc, 0x26, 0xc3, 0x07, 0x79,
+	0xac, 0x07, 0xe9, 0xae, 0x2b, 0x20, 0x18, 0x3c, 0xff, 0x07, 0x58, 0xbc, 0x55, 0xe2, 0x5c, 0x57,
+	0xb1, 0xfe, 0x01, 0x96, 0x01, 0x83, 0x4d, 0xe3, 0xfe, 0xb9, 0x43, 0x1e, 0x1f, 0xda, 0x13, 0xef,
+	0x5e, 0x5f, 0xa9, 0xfb, 0xe5, 0x22, 0x39, 0x33, 0x24, 0x6d, 0x8e, 0xee, 0x3f, 0x9c, 0x67, 0xf0,
+	0x04, 0x77, 0xd1, 0xed, 0x43, 0x67, 0xc5, 0xc9, 0xb6, 0x1d, 0xa3, 0xfa, 0x8b, 0x8f, 0x50, 0xf5,
+	0xa7, 0x6c, 0xdd, 0x52, 0x7e, 0xb6, 0xae, 0xfb, 0x76, 0x91, 0x58, 0xaf, 0x53, 0xd2, 0x5f, 0xb5,
+	0xb3, 0x4d, 0x9d, 0x5c, 0xb2, 0x23, 0x05, 0x67, 0x9d, 0xaa, 0x2a, 0xea, 0x32, 0x2c, 0x73, 0x35,
+	0x3b, 0xff, 0x0b, 0x47, 0xcf, 0x7f, 0x4c, 0xcc, 0x11, 0x79, 0xc0, 0xc5, 0x9c, 0xf3, 0x80, 0x2b,
+	0x03, 0x39, 0xc0, 0x77, 0x48, 0x25, 0xd6, 0xff, 0x44, 0xa9, 0x94, 0xef, 0x3f, 0x51, 0x32, 0x69,
+	0x9d, 0x4a, 0x02, 0x18, 0x61, 0x0f, 0x9a, 0x7d, 0xfc, 0x75, 0x87, 0x9c, 0x19, 0x32, 0x00, 0x66,
+	0x57, 0x71, 0xee, 0xb3, 0xab, 0xe0, 0x13, 0xef, 0xac, 0xd3, 0x44, 0x63, 0x5a, 0xee, 0x3e, 0xe6,
+	0x89, 0x77, 0x09, 0x07, 0x4d, 0xc1, 0x6f, 0xff, 0x76, 0x3a, 0xe1, 0xed, 0x4b, 0xdd, 0x5e, 0x72,
+	0x20, 0xf7, 0x21, 0x73, 0xfb, 0x57, 0x63, 0xc0, 0xa2, 0x72, 0xff, 0xb0, 0x40, 0x66, 0xec, 0x4e,
+	0xe0, 0x22, 0xe5, 0xef, 0xec, 0xde, 0xa8, 0x68, 0xa0, 0x1c, 0x5b, 0xd4, 0x89, 0xdf, 0x65, 0x2f,
+	0x87, 0xc1, 0x40, 0x1e, 0xc6, 0x8e, 0x84, 0x83, 0xa6, 0x30, 0x6d, 0x2e, 0xde, 0xa7, 0xcd, 0xcf,
+	0x93, 0x19, 0x6b, 0xa8, 0x62, 0x69, 0xdd, 0xf2, 0x8d, 0xcd, 0x5a, 0xa9, 0x31, 0xa4, 0xa8, 0x32,
+	0xaf, 0x3e, 0x4d, 0x1c, 0xf9, 0xea, 0x13, 0xe6, 0x76, 0x88, 0x47, 0x29, 0x94, 0xaf, 0x53, 0xe4,
+	0x76, 0x48, 0x18, 0x68, 0xac, 0xfb, 0x63, 0x47, 0xac, 0x4d, 0x79, 0x6a, 0x7c, 0x21, 0x73, 0x75,
+	0xf5, 0xf8, 0x07, 0xae, 0x03, 0x7c, 0x92, 0x52, 0x3d, 0x1d, 0x91, 0xc3, 0x53, 0x9f, 0xe6, 0x1d,
+	0x0a, 0xfb, 0x21, 0x4a, 0x05, 0x03, 0x4b, 0x58, 0x4a, 0xb9, 0x16, 0x8f, 0x52, 0xae, 0xee, 0x8f,
+	0x1c, 0x92, 0xb2, 0x21, 0x30, 0xcf, 0x1f, 0x6b, 0x70, 0x90, 0xc3, 0x2b, 0x17, 0x36, 0x5f, 0x1c,
+	0x4e, 0xb9, 0xc6, 0xf9, 0x4f, 0x10, 0x52, 0xa8, 0x2f, 0x0f, 0x8b, 0x85, 0xb1, 0x5f, 0x72, 0xb1,
+	0xa5, 0xe1, 0x59, 0xb3, 0x56, 0x4e, 0x9f, 0x3a, 0xdd, 0x17, 0xc8, 0xfc, 0x40, 0x8d, 0xf8, 0x4d,
+	0x9c, 0x50, 0x3d, 0xea, 0x61, 0x4d, 0x53, 0x7e, 0xa3, 0x16, 0x04, 0xce, 0xfd, 0x8e, 0x43, 0x4e,
+	0x67, 0xd9, 0xe3, 0xeb, 0x3f, 0xf3, 0x71, 0x96, 0xdf, 0x43, 0xe9, 0x35, 0xed, 0xe3, 0x1d, 0x40,
+	0xc1, 0x60, 0x0d, 0xdc, 0xef, 0xc8, 0xfd, 0x45, 0xfc, 0xf3, 0x40, 0x6d, 0x70, 0x38, 0x23, 0x0d,
+	0x0e, 0x5b, 0x0b, 0x14, 0x8e, 0xa3, 0x05, 0x1a, 0xe9, 0xb7, 0xf3, 0xee, 0xf7, 0x20, 0xdf, 0xbb,
+	0x6c, 0x81, 0xa3, 0xda, 0xec, 0x7a, 0x41, 0xdf, 0xeb, 0x60, 0x0f, 0xc9, 0xf4, 0x41, 0xbd, 0xa0,
+	0xae, 0x6b, 0x0c, 0x58, 0x54, 0x29, 0xbd, 0x57, 0x3e, 0x52, 0xef, 0xf1, 0xbb, 0xa4, 0x1d, 0x16,
+	0x34, 0xbc, 0xa8, 0x5a, 0x49, 0x53, 0x2f, 0x4b, 0x38, 0x68, 0x0a, 0x5c, 0x7e, 0xd9, 0xb7, 0xae,
+	0x52, 0x09, 0x8e, 0xce, 0x91, 0x09, 0x8e, 0xe9, 0x8c, 0xba, 0xc2, 0xb1, 0x32, 0xea, 0xec, 0x64,
+	0xb7, 0xe2, 0x7d, 0x93, 0xdd, 0xde, 0x6f, 0x5e, 0x37, 0x10, 0x59, 0x71, 0xd3, 0xc3, 0x5e, 0x36,
+	0xc0, 0x58, 0x52, 0xdd, 0xd3, 0x19, 0xca, 0x33, 0xc2, 0x30, 0x5f, 0x5e, 0xe2, 0x44, 0x12, 0x53,
+	0x5b, 0x7c, 0xeb, 0x9d, 0xf3, 0x8f, 0x7d, 0xef, 0x9d, 0xf3, 0x8f, 0xbd, 0xfd, 0xce, 0xf9, 0xc7,
+	0xbe, 0x78, 0xf7, 0xbc, 0xf3, 0xd6, 0xdd, 0xf3, 0xce, 0xf7, 0xee, 0x9e, 0x77, 0xde, 0xbe, 0x7b,
+	0xde, 0xf9, 0xc1, 0xdd, 0xf3, 0xce, 0xef, 0xfd, 0xf0, 0xfc, 0x63, 0x2f, 0x97, 0xd5, 0x3a, 0xf8,
+	0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf8, 0xe2, 0x53, 0x6b, 0xcb, 0x7a, 0x00, 0x00,
 }
 
 func (m *AWSAuthConfig) Marshal() (dAtA []byte, err error) {
@@ -3182,6 +3399,29 @@ func (m *AppProjectSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.HelmValueURLs) > 0 {
+		for iNdEx := len(m.HelmValueURLs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.HelmValueURLs[iNdEx])
+			copy(dAtA[i:], m.HelmValueURLs[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.HelmValueURLs[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.SyncSchedules) > 0 {
+		for iNdEx := len(m.SyncSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SyncSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if len(m.ClusterResourceBlacklist) > 0 {
 		for iNdEx := len(m.ClusterResourceBlacklist) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -3744,15 +3984,10 @@ func (m *ApplicationSourceHelm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Version)
-	copy(dAtA[i:], m.Version)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
-	i--
-	dAtA[i] = 0x32
-	if len(m.FileParameters) > 0 {
-		for iNdEx := len(m.FileParameters) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ValuesRepos) > 0 {
+		for iNdEx := len(m.ValuesRepos) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.FileParameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ValuesRepos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3760,23 +3995,92 @@ func (m *ApplicationSourceHelm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x6a
 		}
 	}
-	i -= len(m.Values)
-	copy(dAtA[i:], m.Values)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Values)))
-	i--
-	dAtA[i] = 0x22
-	i -= len(m.ReleaseName)
-	copy(dAtA[i:], m.ReleaseName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ReleaseName)))
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Parameters) > 0 {
-		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+	if m.PostRenderer != nil {
+		{
+			size, err := m.PostRenderer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.APIVersions) > 0 {
+		for iNdEx := len(m.APIVersions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.APIVersions[iNdEx])
+			copy(dAtA[i:], m.APIVersions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.APIVersions[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	i -= len(m.KubeVersion)
+	copy(dAtA[i:], m.KubeVersion)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.KubeVersion)))
+	i--
+	dAtA[i] = 0x52
+	i--
+	if m.IgnoreMissingValueFiles {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x48
+	i--
+	if m.PassCredentials {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x40
+	i--
+	if m.SkipCrds {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x38
+	i -= len(m.Version)
+	copy(dAtA[i:], m.Version)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
+	i--
+	dAtA[i] = 0x32
+	if len(m.FileParameters) > 0 {
+		for iNdEx := len(m.FileParameters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FileParameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	i -= len(m.Values)
+	copy(dAtA[i:], m.Values)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Values)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.ReleaseName)
+	copy(dAtA[i:], m.ReleaseName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ReleaseName)))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4058,6 +4362,27 @@ func (m *ApplicationSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HydrateTo != nil {
+		{
+			size, err := m.HydrateTo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.DependsOn) > 0 {
+		for iNdEx := len(m.DependsOn) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DependsOn[iNdEx])
+			copy(dAtA[i:], m.DependsOn[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.DependsOn[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.RevisionHistoryLimit != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.RevisionHistoryLimit))
 		i--
@@ -4151,6 +4476,18 @@ func (m *ApplicationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Hydrator != nil {
+		{
+			size, err := m.Hydrator.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	{
 		size, err := m.Summary.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -4469,6 +4806,18 @@ func (m *Cluster) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NamespaceDiscovery != nil {
+		{
+			size, err := m.NamespaceDiscovery.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.Shard != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.Shard))
 		i--
@@ -4754,6 +5103,42 @@ func (m *ClusterList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClusterNamespaceDiscovery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterNamespaceDiscovery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterNamespaceDiscovery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.LabelSelector)
+	copy(dAtA[i:], m.LabelSelector)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LabelSelector)))
+	i--
+	dAtA[i] = 0x12
+	i--
+	if m.RBAC {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *Command) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5282,6 +5667,77 @@ func (m *HelmParameter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HelmPostRenderer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HelmPostRenderer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HelmPostRenderer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Plugin)
+	copy(dAtA[i:], m.Plugin)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Plugin)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Kustomize)
+	copy(dAtA[i:], m.Kustomize)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kustomize)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *HelmValuesRepo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HelmValuesRepo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HelmValuesRepo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.TargetRevision)
+	copy(dAtA[i:], m.TargetRevision)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TargetRevision)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.RepoURL)
+	copy(dAtA[i:], m.RepoURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RepoURL)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Alias)
+	copy(dAtA[i:], m.Alias)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Alias)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *HostInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5371,7 +5827,7 @@ func (m *HostResourceInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Info) Marshal() (dAtA []byte, err error) {
+func (m *HydrateTo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5381,30 +5837,43 @@ func (m *Info) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Info) MarshalTo(dAtA []byte) (int, error) {
+func (m *HydrateTo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Info) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HydrateTo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Value)
-	copy(dAtA[i:], m.Value)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i--
+	if m.SyncFromHydrated {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.Path)
+	copy(dAtA[i:], m.Path)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.TargetBranch)
+	copy(dAtA[i:], m.TargetBranch)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TargetBranch)))
 	i--
 	dAtA[i] = 0x12
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i -= len(m.RepoURL)
+	copy(dAtA[i:], m.RepoURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RepoURL)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *InfoItem) Marshal() (dAtA []byte, err error) {
+func (m *HydratorStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5414,30 +5883,57 @@ func (m *InfoItem) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InfoItem) MarshalTo(dAtA []byte) (int, error) {
+func (m *HydratorStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InfoItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HydratorStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Value)
-	copy(dAtA[i:], m.Value)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i -= len(m.Path)
+	copy(dAtA[i:], m.Path)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.TargetBranch)
+	copy(dAtA[i:], m.TargetBranch)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TargetBranch)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.RepoURL)
+	copy(dAtA[i:], m.RepoURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RepoURL)))
+	i--
+	dAtA[i] = 0x22
+	if m.HydratedAt != nil {
+		{
+			size, err := m.HydratedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.HydratedSHA)
+	copy(dAtA[i:], m.HydratedSHA)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HydratedSHA)))
 	i--
 	dAtA[i] = 0x12
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i -= len(m.DrySHA)
+	copy(dAtA[i:], m.DrySHA)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DrySHA)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *JWTToken) Marshal() (dAtA []byte, err error) {
+func (m *Info) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5447,21 +5943,87 @@ func (m *JWTToken) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JWTToken) MarshalTo(dAtA []byte) (int, error) {
+func (m *Info) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JWTToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Info) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.ID)
-	copy(dAtA[i:], m.ID)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ID)))
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *InfoItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InfoItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InfoItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *JWTToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JWTToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JWTToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.ID)
+	copy(dAtA[i:], m.ID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ID)))
+	i--
+	dAtA[i] = 0x1a
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ExpiresAt))
 	i--
 	dAtA[i] = 0x10
@@ -5673,6 +6235,11 @@ func (m *Operation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.SyncTimeout)
+	copy(dAtA[i:], m.SyncTimeout)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SyncTimeout)))
+	i--
+	dAtA[i] = 0x2a
 	{
 		size, err := m.Retry.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -5778,6 +6345,15 @@ func (m *OperationState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FailureClasses) > 0 {
+		for iNdEx := len(m.FailureClasses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FailureClasses[iNdEx])
+			copy(dAtA[i:], m.FailureClasses[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.FailureClasses[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	i = encodeVarintGenerated(dAtA, i, uint64(m.RetryCount))
 	i--
 	dAtA[i] = 0x40
@@ -7022,6 +7598,23 @@ func (m *ResourceResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.FailureClass)
+	copy(dAtA[i:], m.FailureClass)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FailureClass)))
+	i--
+	dAtA[i] = 0x62
+	if m.StartedAt != nil {
+		{
+			size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	i -= len(m.SyncPhase)
 	copy(dAtA[i:], m.SyncPhase)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SyncPhase)))
@@ -7176,6 +7769,24 @@ func (m *RetryStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DoNotRetryOn) > 0 {
+		for iNdEx := len(m.DoNotRetryOn) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DoNotRetryOn[iNdEx])
+			copy(dAtA[i:], m.DoNotRetryOn[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.DoNotRetryOn[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RetryOn) > 0 {
+		for iNdEx := len(m.RetryOn) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RetryOn[iNdEx])
+			copy(dAtA[i:], m.RetryOn[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.RetryOn[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Backoff != nil {
 		{
 			size, err := m.Backoff.MarshalToSizedBuffer(dAtA[:i])
@@ -7505,6 +8116,18 @@ func (m *SyncOperationResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.StartedAt != nil {
+		{
+			size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	{
 		size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -7557,6 +8180,25 @@ func (m *SyncPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.SyncTimeout)
+	copy(dAtA[i:], m.SyncTimeout)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SyncTimeout)))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.Retry != nil {
 		{
 			size, err := m.Retry.MarshalToSizedBuffer(dAtA[:i])
@@ -7640,6 +8282,74 @@ func (m *SyncPolicyAutomated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SyncSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Clusters) > 0 {
+		for iNdEx := len(m.Clusters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Clusters[iNdEx])
+			copy(dAtA[i:], m.Clusters[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Clusters[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Namespaces) > 0 {
+		for iNdEx := len(m.Namespaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Namespaces[iNdEx])
+			copy(dAtA[i:], m.Namespaces[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespaces[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Applications) > 0 {
+		for iNdEx := len(m.Applications) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Applications[iNdEx])
+			copy(dAtA[i:], m.Applications[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Applications[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i--
+	if m.Prune {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.TimeZone)
+	copy(dAtA[i:], m.TimeZone)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TimeZone)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Schedule)
+	copy(dAtA[i:], m.Schedule)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Schedule)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SyncStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7814,6 +8524,16 @@ func (m *SyncWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Calendar)
+	copy(dAtA[i:], m.Calendar)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Calendar)))
+	i--
+	dAtA[i] = 0x4a
+	i -= len(m.TimeZone)
+	copy(dAtA[i:], m.TimeZone)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TimeZone)))
+	i--
+	dAtA[i] = 0x42
 	i--
 	if m.ManualSync {
 		dAtA[i] = 1
@@ -8046,6 +8766,18 @@ func (m *AppProjectSpec) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.SyncSchedules) > 0 {
+		for _, e := range m.SyncSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.HelmValueURLs) > 0 {
+		for _, s := range m.HelmValueURLs {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -8218,6 +8950,27 @@ func (m *ApplicationSourceHelm) Size() (n int) {
 	}
 	l = len(m.Version)
 	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	n += 2
+	n += 2
+	l = len(m.KubeVersion)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.APIVersions) > 0 {
+		for _, s := range m.APIVersions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.PostRenderer != nil {
+		l = m.PostRenderer.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.ValuesRepos) > 0 {
+		for _, e := range m.ValuesRepos {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -8350,6 +9103,16 @@ func (m *ApplicationSpec) Size() (n int) {
 	if m.RevisionHistoryLimit != nil {
 		n += 1 + sovGenerated(uint64(*m.RevisionHistoryLimit))
 	}
+	if len(m.DependsOn) > 0 {
+		for _, s := range m.DependsOn {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.HydrateTo != nil {
+		l = m.HydrateTo.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -8397,6 +9160,10 @@ func (m *ApplicationStatus) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.Summary.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Hydrator != nil {
+		l = m.Hydrator.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -8508,6 +9275,10 @@ func (m *Cluster) Size() (n int) {
 	if m.Shard != nil {
 		n += 1 + sovGenerated(uint64(*m.Shard))
 	}
+	if m.NamespaceDiscovery != nil {
+		l = m.NamespaceDiscovery.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -8584,6 +9355,18 @@ func (m *ClusterList) Size() (n int) {
 	return n
 }
 
+func (m *ClusterNamespaceDiscovery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	l = len(m.LabelSelector)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Command) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8787,6 +9570,34 @@ func (m *HelmParameter) Size() (n int) {
 	return n
 }
 
+func (m *HelmPostRenderer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kustomize)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Plugin)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *HelmValuesRepo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Alias)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.RepoURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TargetRevision)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *HostInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8820,26 +9631,65 @@ func (m *HostResourceInfo) Size() (n int) {
 	return n
 }
 
-func (m *Info) Size() (n int) {
+func (m *HydrateTo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = len(m.RepoURL)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Value)
+	l = len(m.TargetBranch)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Path)
 	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
 	return n
 }
 
-func (m *InfoItem) Size() (n int) {
+func (m *HydratorStatus) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = len(m.DrySHA)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.HydratedSHA)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.HydratedAt != nil {
+		l = m.HydratedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.RepoURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TargetBranch)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *Info) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *InfoItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Value)
 	n += 1 + l + sovGenerated(uint64(l))
@@ -8949,6 +9799,8 @@ func (m *Operation) Size() (n int) {
 	}
 	l = m.Retry.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SyncTimeout)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -8987,6 +9839,12 @@ func (m *OperationState) Size() (n int) {
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	n += 1 + sovGenerated(uint64(m.RetryCount))
+	if len(m.FailureClasses) > 0 {
+		for _, s := range m.FailureClasses {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -9464,6 +10322,12 @@ func (m *ResourceResult) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.SyncPhase)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.StartedAt != nil {
+		l = m.StartedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.FailureClass)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -9505,6 +10369,18 @@ func (m *RetryStrategy) Size() (n int) {
 		l = m.Backoff.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.RetryOn) > 0 {
+		for _, s := range m.RetryOn {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.DoNotRetryOn) > 0 {
+		for _, s := range m.DoNotRetryOn {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -9634,6 +10510,10 @@ func (m *SyncOperationResult) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.Source.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.StartedAt != nil {
+		l = m.StartedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -9657,6 +10537,14 @@ func (m *SyncPolicy) Size() (n int) {
 		l = m.Retry.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.SyncTimeout)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -9672,6 +10560,38 @@ func (m *SyncPolicyAutomated) Size() (n int) {
 	return n
 }
 
+func (m *SyncSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Schedule)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TimeZone)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if len(m.Applications) > 0 {
+		for _, s := range m.Applications {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Namespaces) > 0 {
+		for _, s := range m.Namespaces {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Clusters) > 0 {
+		for _, s := range m.Clusters {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *SyncStatus) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9756,6 +10676,10 @@ func (m *SyncWindow) Size() (n int) {
 		}
 	}
 	n += 2
+	l = len(m.TimeZone)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Calendar)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -9872,6 +10796,11 @@ func (this *AppProjectSpec) String() string {
 		repeatedStringForClusterResourceBlacklist += fmt.Sprintf("%v", f) + ","
 	}
 	repeatedStringForClusterResourceBlacklist += "}"
+	repeatedStringForSyncSchedules := "[]SyncSchedule{"
+	for _, f := range this.SyncSchedules {
+		repeatedStringForSyncSchedules += strings.Replace(strings.Replace(f.String(), "SyncSchedule", "SyncSchedule", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSyncSchedules += "}"
 	s := strings.Join([]string{`&AppProjectSpec{`,
 		`SourceRepos:` + fmt.Sprintf("%v", this.SourceRepos) + `,`,
 		`Destinations:` + repeatedStringForDestinations + `,`,
@@ -9884,6 +10813,8 @@ func (this *AppProjectSpec) String() string {
 		`NamespaceResourceWhitelist:` + repeatedStringForNamespaceResourceWhitelist + `,`,
 		`SignatureKeys:` + repeatedStringForSignatureKeys + `,`,
 		`ClusterResourceBlacklist:` + repeatedStringForClusterResourceBlacklist + `,`,
+		`SyncSchedules:` + repeatedStringForSyncSchedules + `,`,
+		`HelmValueURLs:` + fmt.Sprintf("%v", this.HelmValueURLs) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10006,6 +10937,11 @@ func (this *ApplicationSourceHelm) String() string {
 		repeatedStringForFileParameters += strings.Replace(strings.Replace(f.String(), "HelmFileParameter", "HelmFileParameter", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForFileParameters += "}"
+	repeatedStringForValuesRepos := "[]HelmValuesRepo{"
+	for _, f := range this.ValuesRepos {
+		repeatedStringForValuesRepos += strings.Replace(strings.Replace(f.String(), "HelmValuesRepo", "HelmValuesRepo", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForValuesRepos += "}"
 	s := strings.Join([]string{`&ApplicationSourceHelm{`,
 		`ValueFiles:` + fmt.Sprintf("%v", this.ValueFiles) + `,`,
 		`Parameters:` + repeatedStringForParameters + `,`,
@@ -10013,6 +10949,13 @@ func (this *ApplicationSourceHelm) String() string {
 		`Values:` + fmt.Sprintf("%v", this.Values) + `,`,
 		`FileParameters:` + repeatedStringForFileParameters + `,`,
 		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`SkipCrds:` + fmt.Sprintf("%v", this.SkipCrds) + `,`,
+		`PassCredentials:` + fmt.Sprintf("%v", this.PassCredentials) + `,`,
+		`IgnoreMissingValueFiles:` + fmt.Sprintf("%v", this.IgnoreMissingValueFiles) + `,`,
+		`KubeVersion:` + fmt.Sprintf("%v", this.KubeVersion) + `,`,
+		`APIVersions:` + fmt.Sprintf("%v", this.APIVersions) + `,`,
+		`PostRenderer:` + strings.Replace(this.PostRenderer.String(), "HelmPostRenderer", "HelmPostRenderer", 1) + `,`,
+		`ValuesRepos:` + repeatedStringForValuesRepos + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10128,6 +11071,8 @@ func (this *ApplicationSpec) String() string {
 		`IgnoreDifferences:` + repeatedStringForIgnoreDifferences + `,`,
 		`Info:` + repeatedStringForInfo + `,`,
 		`RevisionHistoryLimit:` + valueToStringGenerated(this.RevisionHistoryLimit) + `,`,
+		`DependsOn:` + fmt.Sprintf("%v", this.DependsOn) + `,`,
+		`HydrateTo:` + strings.Replace(this.HydrateTo.String(), "HydrateTo", "HydrateTo", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10162,6 +11107,7 @@ func (this *ApplicationStatus) String() string {
 		`ObservedAt:` + strings.Replace(fmt.Sprintf("%v", this.ObservedAt), "Time", "v1.Time", 1) + `,`,
 		`SourceType:` + fmt.Sprintf("%v", this.SourceType) + `,`,
 		`Summary:` + strings.Replace(strings.Replace(this.Summary.String(), "ApplicationSummary", "ApplicationSummary", 1), `&`, ``, 1) + `,`,
+		`Hydrator:` + strings.Replace(this.Hydrator.String(), "HydratorStatus", "HydratorStatus", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10241,6 +11187,7 @@ func (this *Cluster) String() string {
 		`RefreshRequestedAt:` + strings.Replace(fmt.Sprintf("%v", this.RefreshRequestedAt), "Time", "v1.Time", 1) + `,`,
 		`Info:` + strings.Replace(strings.Replace(this.Info.String(), "ClusterInfo", "ClusterInfo", 1), `&`, ``, 1) + `,`,
 		`Shard:` + valueToStringGenerated(this.Shard) + `,`,
+		`NamespaceDiscovery:` + strings.Replace(this.NamespaceDiscovery.String(), "ClusterNamespaceDiscovery", "ClusterNamespaceDiscovery", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10301,6 +11248,17 @@ func (this *ClusterList) String() string {
 	}, "")
 	return s
 }
+func (this *ClusterNamespaceDiscovery) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterNamespaceDiscovery{`,
+		`RBAC:` + fmt.Sprintf("%v", this.RBAC) + `,`,
+		`LabelSelector:` + fmt.Sprintf("%v", this.LabelSelector) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Command) String() string {
 	if this == nil {
 		return "nil"
@@ -10459,6 +11417,29 @@ func (this *HelmParameter) String() string {
 	}, "")
 	return s
 }
+func (this *HelmPostRenderer) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HelmPostRenderer{`,
+		`Kustomize:` + fmt.Sprintf("%v", this.Kustomize) + `,`,
+		`Plugin:` + fmt.Sprintf("%v", this.Plugin) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HelmValuesRepo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HelmValuesRepo{`,
+		`Alias:` + fmt.Sprintf("%v", this.Alias) + `,`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`TargetRevision:` + fmt.Sprintf("%v", this.TargetRevision) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *HostInfo) String() string {
 	if this == nil {
 		return "nil"
@@ -10489,6 +11470,34 @@ func (this *HostResourceInfo) String() string {
 	}, "")
 	return s
 }
+func (this *HydrateTo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HydrateTo{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`TargetBranch:` + fmt.Sprintf("%v", this.TargetBranch) + `,`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`SyncFromHydrated:` + fmt.Sprintf("%v", this.SyncFromHydrated) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HydratorStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HydratorStatus{`,
+		`DrySHA:` + fmt.Sprintf("%v", this.DrySHA) + `,`,
+		`HydratedSHA:` + fmt.Sprintf("%v", this.HydratedSHA) + `,`,
+		`HydratedAt:` + strings.Replace(fmt.Sprintf("%v", this.HydratedAt), "Time", "v1.Time", 1) + `,`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`TargetBranch:` + fmt.Sprintf("%v", this.TargetBranch) + `,`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Info) String() string {
 	if this == nil {
 		return "nil"
@@ -10598,6 +11607,7 @@ func (this *Operation) String() string {
 		`InitiatedBy:` + strings.Replace(strings.Replace(this.InitiatedBy.String(), "OperationInitiator", "OperationInitiator", 1), `&`, ``, 1) + `,`,
 		`Info:` + repeatedStringForInfo + `,`,
 		`Retry:` + strings.Replace(strings.Replace(this.Retry.String(), "RetryStrategy", "RetryStrategy", 1), `&`, ``, 1) + `,`,
+		`SyncTimeout:` + fmt.Sprintf("%v", this.SyncTimeout) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10625,6 +11635,7 @@ func (this *OperationState) String() string {
 		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
 		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
 		`RetryCount:` + fmt.Sprintf("%v", this.RetryCount) + `,`,
+		`FailureClasses:` + fmt.Sprintf("%v", this.FailureClasses) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10995,6 +12006,8 @@ func (this *ResourceResult) String() string {
 		`HookType:` + fmt.Sprintf("%v", this.HookType) + `,`,
 		`HookPhase:` + fmt.Sprintf("%v", this.HookPhase) + `,`,
 		`SyncPhase:` + fmt.Sprintf("%v", this.SyncPhase) + `,`,
+		`StartedAt:` + strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1) + `,`,
+		`FailureClass:` + fmt.Sprintf("%v", this.FailureClass) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11024,6 +12037,8 @@ func (this *RetryStrategy) String() string {
 	s := strings.Join([]string{`&RetryStrategy{`,
 		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
 		`Backoff:` + strings.Replace(this.Backoff.String(), "Backoff", "Backoff", 1) + `,`,
+		`RetryOn:` + fmt.Sprintf("%v", this.RetryOn) + `,`,
+		`DoNotRetryOn:` + fmt.Sprintf("%v", this.DoNotRetryOn) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11114,6 +12129,7 @@ func (this *SyncOperationResult) String() string {
 		`Resources:` + repeatedStringForResources + `,`,
 		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
 		`Source:` + strings.Replace(strings.Replace(this.Source.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + `,`,
+		`StartedAt:` + strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11122,10 +12138,17 @@ func (this *SyncPolicy) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForSchedules := "[]SyncSchedule{"
+	for _, f := range this.Schedules {
+		repeatedStringForSchedules += strings.Replace(strings.Replace(f.String(), "SyncSchedule", "SyncSchedule", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSchedules += "}"
 	s := strings.Join([]string{`&SyncPolicy{`,
 		`Automated:` + strings.Replace(this.Automated.String(), "SyncPolicyAutomated", "SyncPolicyAutomated", 1) + `,`,
 		`SyncOptions:` + fmt.Sprintf("%v", this.SyncOptions) + `,`,
 		`Retry:` + strings.Replace(this.Retry.String(), "RetryStrategy", "RetryStrategy", 1) + `,`,
+		`Schedules:` + repeatedStringForSchedules + `,`,
+		`SyncTimeout:` + fmt.Sprintf("%v", this.SyncTimeout) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11142,6 +12165,21 @@ func (this *SyncPolicyAutomated) String() string {
 	}, "")
 	return s
 }
+func (this *SyncSchedule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncSchedule{`,
+		`Schedule:` + fmt.Sprintf("%v", this.Schedule) + `,`,
+		`TimeZone:` + fmt.Sprintf("%v", this.TimeZone) + `,`,
+		`Prune:` + fmt.Sprintf("%v", this.Prune) + `,`,
+		`Applications:` + fmt.Sprintf("%v", this.Applications) + `,`,
+		`Namespaces:` + fmt.Sprintf("%v", this.Namespaces) + `,`,
+		`Clusters:` + fmt.Sprintf("%v", this.Clusters) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SyncStatus) String() string {
 	if this == nil {
 		return "nil"
@@ -11197,6 +12235,8 @@ func (this *SyncWindow) String() string {
 		`Namespaces:` + fmt.Sprintf("%v", this.Namespaces) + `,`,
 		`Clusters:` + fmt.Sprintf("%v", this.Clusters) + `,`,
 		`ManualSync:` + fmt.Sprintf("%v", this.ManualSync) + `,`,
+		`TimeZone:` + fmt.Sprintf("%v", this.TimeZone) + `,`,
+		`Calendar:` + fmt.Sprintf("%v", this.Calendar) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12013,6 +13053,72 @@ func (m *AppProjectSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncSchedules = append(m.SyncSchedules, SyncSchedule{})
+			if err := m.SyncSchedules[len(m.SyncSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HelmValueURLs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HelmValueURLs = append(m.HelmValueURLs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -13585,30 +14691,224 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 			}
 			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipCrds", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			m.SkipCrds = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PassCredentials", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
+			m.PassCredentials = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreMissingValueFiles", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IgnoreMissingValueFiles = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubeVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KubeVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIVersions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APIVersions = append(m.APIVersions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostRenderer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PostRenderer == nil {
+				m.PostRenderer = &HelmPostRenderer{}
+			}
+			if err := m.PostRenderer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValuesRepos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValuesRepos = append(m.ValuesRepos, HelmValuesRepo{})
+			if err := m.ValuesRepos[len(m.ValuesRepos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ApplicationSourceJsonnet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -14686,6 +15986,74 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.RevisionHistoryLimit = &v
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DependsOn = append(m.DependsOn, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HydrateTo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HydrateTo == nil {
+				m.HydrateTo = &HydrateTo{}
+			}
+			if err := m.HydrateTo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -15080,19 +16448,55 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hydrator", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hydrator == nil {
+				m.Hydrator = &HydratorStatus{}
+			}
+			if err := m.Hydrator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
@@ -15943,6 +17347,42 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Shard = &v
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceDiscovery", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NamespaceDiscovery == nil {
+				m.NamespaceDiscovery = &ClusterNamespaceDiscovery{}
+			}
+			if err := m.NamespaceDiscovery.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16638,6 +18078,111 @@ func (m *ClusterList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClusterNamespaceDiscovery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterNamespaceDiscovery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterNamespaceDiscovery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RBAC", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RBAC = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LabelSelector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Command) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -18491,7 +20036,7 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HostInfo) Unmarshal(dAtA []byte) error {
+func (m *HelmPostRenderer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18514,15 +20059,15 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HostInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmPostRenderer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmPostRenderer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kustomize", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18550,47 +20095,13 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Kustomize = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ResourcesInfo = append(m.ResourcesInfo, HostResourceInfo{})
-			if err := m.ResourcesInfo[len(m.ResourcesInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SystemInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18600,24 +20111,23 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SystemInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Plugin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18643,7 +20153,7 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
+func (m *HelmValuesRepo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18666,15 +20176,15 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HostResourceInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmValuesRepo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostResourceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmValuesRepo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18702,13 +20212,13 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceName = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postIndex])
+			m.Alias = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestedByApp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
-			m.RequestedByApp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18718,35 +20228,29 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestedByApp |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestedByNeighbors", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.RequestedByNeighbors = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestedByNeighbors |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Capacity = 0
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevision", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18756,13 +20260,26 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Capacity |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetRevision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
 			if err != nil {
 				return err
@@ -18785,7 +20302,7 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Info) Unmarshal(dAtA []byte) error {
+func (m *HostInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18808,10 +20325,10 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Info: wiretype end group for non-group")
+			return fmt.Errorf("proto: HostInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Info: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HostInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -18848,9 +20365,9 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18860,23 +20377,58 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.ResourcesInfo = append(m.ResourcesInfo, HostResourceInfo{})
+			if err := m.ResourcesInfo[len(m.ResourcesInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SystemInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18902,7 +20454,7 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InfoItem) Unmarshal(dAtA []byte) error {
+func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18925,15 +20477,15 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InfoItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: HostResourceInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InfoItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HostResourceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18961,13 +20513,13 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ResourceName = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedByApp", wireType)
 			}
-			var stringLen uint64
+			m.RequestedByApp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18977,24 +20529,49 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RequestedByApp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedByNeighbors", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			m.RequestedByNeighbors = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestedByNeighbors |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			m.Capacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Capacity |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19019,7 +20596,7 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JWTToken) Unmarshal(dAtA []byte) error {
+func (m *HydrateTo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19042,17 +20619,17 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JWTToken: wiretype end group for non-group")
+			return fmt.Errorf("proto: HydrateTo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JWTToken: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HydrateTo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
-			m.IssuedAt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19062,16 +20639,29 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IssuedAt |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBranch", wireType)
 			}
-			m.ExpiresAt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19081,14 +20671,27 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpiresAt |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetBranch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19116,8 +20719,28 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncFromHydrated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SyncFromHydrated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19142,7 +20765,7 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JWTTokens) Unmarshal(dAtA []byte) error {
+func (m *HydratorStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19165,17 +20788,17 @@ func (m *JWTTokens) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JWTTokens: wiretype end group for non-group")
+			return fmt.Errorf("proto: HydratorStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JWTTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HydratorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DrySHA", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19185,82 +20808,95 @@ func (m *JWTTokens) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, JWTToken{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DrySHA = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HydratedSHA", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.HydratedSHA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HydratedAt", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.HydratedAt == nil {
+				m.HydratedAt = &v1.Time{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JsonnetVar: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JsonnetVar: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.HydratedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19288,11 +20924,11 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBranch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19320,13 +20956,13 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.TargetBranch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19336,12 +20972,24 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Code = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19366,7 +21014,7 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
+func (m *Info) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19389,15 +21037,15 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KnownTypeField: wiretype end group for non-group")
+			return fmt.Errorf("proto: Info: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KnownTypeField: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Info: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19425,11 +21073,11 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Field = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19457,7 +21105,7 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19483,7 +21131,7 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KsonnetParameter) Unmarshal(dAtA []byte) error {
+func (m *InfoItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19506,45 +21154,13 @@ func (m *KsonnetParameter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KsonnetParameter: wiretype end group for non-group")
+			return fmt.Errorf("proto: InfoItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KsonnetParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InfoItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Component", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Component = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
@@ -19576,7 +21192,7 @@ func (m *KsonnetParameter) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
@@ -19632,7 +21248,7 @@ func (m *KsonnetParameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
+func (m *JWTToken) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19655,17 +21271,17 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: JWTToken: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JWTToken: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuildOptions", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
 			}
-			var stringLen uint64
+			m.IssuedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19675,27 +21291,33 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.IssuedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.BuildOptions = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BinaryPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19723,7 +21345,7 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BinaryPath = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19749,7 +21371,7 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Operation) Unmarshal(dAtA []byte) error {
+func (m *JWTTokens) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19772,15 +21394,15 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Operation: wiretype end group for non-group")
+			return fmt.Errorf("proto: JWTTokens: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Operation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JWTTokens: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19807,51 +21429,69 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Sync == nil {
-				m.Sync = &SyncOperation{}
-			}
-			if err := m.Sync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, JWTToken{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedBy", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitiatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JsonnetVar: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JsonnetVar: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19861,31 +21501,29 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Info = append(m.Info, &Info{})
-			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19895,25 +21533,44 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Code = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19938,7 +21595,7 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperationInitiator) Unmarshal(dAtA []byte) error {
+func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19961,15 +21618,15 @@ func (m *OperationInitiator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperationInitiator: wiretype end group for non-group")
+			return fmt.Errorf("proto: KnownTypeField: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperationInitiator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KnownTypeField: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19997,13 +21654,13 @@ func (m *OperationInitiator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			m.Field = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Automated", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20013,12 +21670,24 @@ func (m *OperationInitiator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Automated = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -20043,7 +21712,7 @@ func (m *OperationInitiator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperationState) Unmarshal(dAtA []byte) error {
+func (m *KsonnetParameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20066,17 +21735,17 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperationState: wiretype end group for non-group")
+			return fmt.Errorf("proto: KsonnetParameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperationState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KsonnetParameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Component", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20086,28 +21755,27 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Component = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20135,11 +21803,11 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = github_com_argoproj_gitops_engine_pkg_sync_common.OperationPhase(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20167,49 +21835,66 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncResult", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SyncResult == nil {
-				m.SyncResult = &SyncOperationResult{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.SyncResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KustomizeOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KustomizeOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildOptions", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20219,30 +21904,29 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BuildOptions = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryPath", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20252,55 +21936,32 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinishedAt == nil {
-				m.FinishedAt = &v1.Time{}
-			}
-			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BinaryPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryCount", wireType)
-			}
-			m.RetryCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RetryCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
 			}
 			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenerated
@@ -20317,7 +21978,7 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
+func (m *Operation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20340,17 +22001,17 @@ func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OrphanedResourceKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: Operation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OrphanedResourceKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Operation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20360,29 +22021,33 @@ func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			if m.Sync == nil {
+				m.Sync = &SyncOperation{}
+			}
+			if err := m.Sync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedBy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20392,29 +22057,30 @@ func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			if err := m.InitiatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20424,82 +22090,31 @@ func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			m.Info = append(m.Info, &Info{})
+			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Warn", wireType)
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20509,18 +22124,30 @@ func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Warn = &b
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ignore", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncTimeout", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20530,25 +22157,23 @@ func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ignore = append(m.Ignore, OrphanedResourceKey{})
-			if err := m.Ignore[len(m.Ignore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SyncTimeout = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20574,7 +22199,7 @@ func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OverrideIgnoreDiff) Unmarshal(dAtA []byte) error {
+func (m *OperationInitiator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20597,15 +22222,15 @@ func (m *OverrideIgnoreDiff) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OverrideIgnoreDiff: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperationInitiator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OverrideIgnoreDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperationInitiator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONPointers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20633,8 +22258,28 @@ func (m *OverrideIgnoreDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JSONPointers = append(m.JSONPointers, string(dAtA[iNdEx:postIndex]))
+			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Automated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Automated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -20659,7 +22304,7 @@ func (m *OverrideIgnoreDiff) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectRole) Unmarshal(dAtA []byte) error {
+func (m *OperationState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20682,17 +22327,17 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectRole: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperationState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectRole: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperationState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20702,27 +22347,28 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20750,11 +22396,11 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Phase = github_com_argoproj_gitops_engine_pkg_sync_common.OperationPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20782,11 +22428,11 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Policies = append(m.Policies, string(dAtA[iNdEx:postIndex]))
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JWTTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncResult", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20813,16 +22459,18 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JWTTokens = append(m.JWTTokens, JWTToken{})
-			if err := m.JWTTokens[len(m.JWTTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SyncResult == nil {
+				m.SyncResult = &SyncOperationResult{}
+			}
+			if err := m.SyncResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20832,82 +22480,30 @@ func (m *ProjectRole) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RepoCreds) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RepoCreds: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoCreds: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20917,29 +22513,33 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryCount", wireType)
 			}
-			var stringLen uint64
+			m.RetryCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20949,27 +22549,14 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RetryCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Username = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureClasses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20997,11 +22584,64 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.FailureClasses = append(m.FailureClasses, SyncFailureClass(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrphanedResourceKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrphanedResourceKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21029,11 +22669,11 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SSHPrivateKey = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21061,11 +22701,11 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TLSClientCertData = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21093,7 +22733,7 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TLSClientCertKey = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21119,7 +22759,7 @@ func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepoCredsList) Unmarshal(dAtA []byte) error {
+func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21142,17 +22782,17 @@ func (m *RepoCredsList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepoCredsList: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoCredsList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warn", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21162,28 +22802,16 @@ func (m *RepoCredsList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			b := bool(v != 0)
+			m.Warn = &b
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ignore", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21210,8 +22838,8 @@ func (m *RepoCredsList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, RepoCreds{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Ignore = append(m.Ignore, OrphanedResourceKey{})
+			if err := m.Ignore[len(m.Ignore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -21239,7 +22867,7 @@ func (m *RepoCredsList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Repository) Unmarshal(dAtA []byte) error {
+func (m *OverrideIgnoreDiff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21262,15 +22890,15 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Repository: wiretype end group for non-group")
+			return fmt.Errorf("proto: OverrideIgnoreDiff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Repository: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OverrideIgnoreDiff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONPointers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21298,75 +22926,64 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
+			m.JSONPointers = append(m.JSONPointers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21394,104 +23011,11 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SSHPrivateKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ConnectionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsecureIgnoreHostKey", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.InsecureIgnoreHostKey = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Insecure = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableLFS", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.EnableLFS = bool(v != 0)
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21519,11 +23043,11 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TLSClientCertData = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21551,13 +23075,13 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TLSClientCertKey = string(dAtA[iNdEx:postIndex])
+			m.Policies = append(m.Policies, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 11:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JWTTokens", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21567,27 +23091,29 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.JWTTokens = append(m.JWTTokens, JWTToken{})
+			if err := m.JWTTokens[len(m.JWTTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21615,48 +23141,8 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InheritedCreds", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.InheritedCreds = bool(v != 0)
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableOCI", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.EnableOCI = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -21681,7 +23167,7 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
+func (m *RepoCreds) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21704,15 +23190,15 @@ func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepositoryCertificate: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepoCreds: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepositoryCertificate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepoCreds: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21740,11 +23226,11 @@ func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServerName = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CertType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21772,11 +23258,11 @@ func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CertType = string(dAtA[iNdEx:postIndex])
+			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CertSubType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21804,13 +23290,13 @@ func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CertSubType = string(dAtA[iNdEx:postIndex])
+			m.Password = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CertData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKey", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21820,29 +23306,27 @@ func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CertData = append(m.CertData[:0], dAtA[iNdEx:postIndex]...)
-			if m.CertData == nil {
-				m.CertData = []byte{}
-			}
+			m.SSHPrivateKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CertInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertData", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21870,99 +23354,13 @@ func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CertInfo = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RepositoryCertificateList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RepositoryCertificateList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepositoryCertificateList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TLSClientCertData = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21972,25 +23370,23 @@ func (m *RepositoryCertificateList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, RepositoryCertificate{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TLSClientCertKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22016,7 +23412,7 @@ func (m *RepositoryCertificateList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepositoryList) Unmarshal(dAtA []byte) error {
+func (m *RepoCredsList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22039,10 +23435,10 @@ func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepositoryList: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepoCredsList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepositoryList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepoCredsList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -22107,7 +23503,7 @@ func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &Repository{})
+			m.Items = append(m.Items, RepoCreds{})
 			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -22136,7 +23532,7 @@ func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceAction) Unmarshal(dAtA []byte) error {
+func (m *Repository) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22159,15 +23555,15 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceAction: wiretype end group for non-group")
+			return fmt.Errorf("proto: Repository: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceAction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Repository: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22195,13 +23591,13 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Repo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22211,31 +23607,29 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Params = append(m.Params, ResourceActionParam{})
-			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22245,68 +23639,27 @@ func (m *ResourceAction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Disabled = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceActionDefinition: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceActionDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22334,13 +23687,13 @@ func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.SSHPrivateKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionLua", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22350,82 +23703,50 @@ func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActionLua = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.ConnectionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureIgnoreHostKey", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceActionParam: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceActionParam: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			m.InsecureIgnoreHostKey = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22435,27 +23756,35 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			m.Insecure = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableLFS", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.EnableLFS = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertData", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22483,11 +23812,11 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.TLSClientCertData = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCertKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22515,11 +23844,11 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.TLSClientCertKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22547,64 +23876,11 @@ func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Default = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceActions) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceActions: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceActions: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionDiscoveryLua", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22632,13 +23908,13 @@ func (m *ResourceActions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActionDiscoveryLua = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Definitions", wireType)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InheritedCreds", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22648,26 +23924,32 @@ func (m *ResourceActions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.InheritedCreds = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableOCI", wireType)
 			}
-			m.Definitions = append(m.Definitions, ResourceActionDefinition{})
-			if err := m.Definitions[len(m.Definitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.EnableOCI = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -22692,7 +23974,7 @@ func (m *ResourceActions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
+func (m *RepositoryCertificate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22715,15 +23997,15 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceDiff: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepositoryCertificate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepositoryCertificate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22751,11 +24033,11 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.ServerName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CertType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22783,11 +24065,11 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.CertType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CertSubType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22815,13 +24097,13 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.CertSubType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CertData", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22831,27 +24113,29 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.CertData = append(m.CertData[:0], dAtA[iNdEx:postIndex]...)
+			if m.CertData == nil {
+				m.CertData = []byte{}
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CertInfo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22879,97 +24163,66 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetState = string(dAtA[iNdEx:postIndex])
+			m.CertInfo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiveState", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiveState = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepositoryCertificateList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Diff = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Hook = bool(v != 0)
-		case 9:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepositoryCertificateList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepositoryCertificateList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NormalizedLiveState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22979,29 +24232,30 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NormalizedLiveState = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PredictedLiveState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23011,23 +24265,25 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PredictedLiveState = string(dAtA[iNdEx:postIndex])
+			m.Items = append(m.Items, RepositoryCertificate{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23053,7 +24309,7 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
+func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23076,17 +24332,17 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceIgnoreDifferences: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepositoryList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceIgnoreDifferences: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepositoryList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23096,29 +24352,30 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23128,25 +24385,80 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.Items = append(m.Items, &Repository{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
@@ -23178,11 +24490,11 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23192,29 +24504,31 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Params = append(m.Params, ResourceActionParam{})
+			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONPointers", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23224,13 +24538,1399 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			m.Disabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceActionDefinition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceActionDefinition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceActionDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionLua", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActionLua = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceActionParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceActionParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceActionParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Default = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceActions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceActions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceActions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionDiscoveryLua", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActionDiscoveryLua = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Definitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Definitions = append(m.Definitions, ResourceActionDefinition{})
+			if err := m.Definitions[len(m.Definitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiveState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiveState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Diff = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Hook = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizedLiveState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NormalizedLiveState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredictedLiveState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PredictedLiveState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceIgnoreDifferences: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceIgnoreDifferences: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONPointers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JSONPointers = append(m.JSONPointers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceNetworkingInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceNetworkingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetLabels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TargetLabels == nil {
+				m.TargetLabels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.TargetLabels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRefs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetRefs = append(m.TargetRefs, ResourceRef{})
+			if err := m.TargetRefs[len(m.TargetRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ingress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ingress = append(m.Ingress, v11.LoadBalancerIngress{})
+			if err := m.Ingress[len(m.Ingress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalURLs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			postIndex := iNdEx + intStringLen
@@ -23240,7 +25940,7 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JSONPointers = append(m.JSONPointers, string(dAtA[iNdEx:postIndex]))
+			m.ExternalURLs = append(m.ExternalURLs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23266,7 +25966,7 @@ func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
+func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23289,15 +25989,15 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceNetworkingInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceNetworkingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetLabels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23324,107 +26024,47 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TargetLabels == nil {
-				m.TargetLabels = make(map[string]string)
+			if err := m.ResourceRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentRefs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.TargetLabels[mapkey] = mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentRefs = append(m.ParentRefs, ResourceRef{})
+			if err := m.ParentRefs[len(m.ParentRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetRefs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23451,16 +26091,84 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetRefs = append(m.TargetRefs, ResourceRef{})
-			if err := m.TargetRefs[len(m.TargetRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Info = append(m.Info, InfoItem{})
+			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkingInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NetworkingInfo == nil {
+				m.NetworkingInfo = &ResourceNetworkingInfo{}
+			}
+			if err := m.NetworkingInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23470,122 +26178,27 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Labels[mapkey] = mapvalue
+			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ingress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23612,16 +26225,18 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ingress = append(m.Ingress, v11.LoadBalancerIngress{})
-			if err := m.Ingress[len(m.Ingress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Health == nil {
+				m.Health = &HealthStatus{}
+			}
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalURLs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23631,23 +26246,27 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalURLs = append(m.ExternalURLs, string(dAtA[iNdEx:postIndex]))
+			if m.CreatedAt == nil {
+				m.CreatedAt = &v1.Time{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23673,7 +26292,7 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceNode) Unmarshal(dAtA []byte) error {
+func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23696,15 +26315,47 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceNode: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceOverride: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceOverride: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthLua", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HealthLua = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreDifferences", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23731,13 +26382,45 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ResourceRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.IgnoreDifferences.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentRefs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actions = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KnownTypeFields", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23761,19 +26444,72 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KnownTypeFields = append(m.KnownTypeFields, KnownTypeField{})
+			if err := m.KnownTypeFields[len(m.KnownTypeFields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParentRefs = append(m.ParentRefs, ResourceRef{})
-			if err := m.ParentRefs[len(m.ParentRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23783,31 +26519,29 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Info = append(m.Info, InfoItem{})
-			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkingInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23817,31 +26551,27 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NetworkingInfo == nil {
-				m.NetworkingInfo = &ResourceNetworkingInfo{}
-			}
-			if err := m.NetworkingInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23869,11 +26599,11 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23901,13 +26631,13 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23917,33 +26647,29 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Health == nil {
-				m.Health = &HealthStatus{}
-			}
-			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23953,27 +26679,23 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &v1.Time{}
-			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.UID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23999,7 +26721,7 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
+func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24022,15 +26744,15 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceOverride: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HealthLua", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24058,13 +26780,13 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HealthLua = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreDifferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24074,28 +26796,27 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IgnoreDifferences.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24123,13 +26844,13 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Actions = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KnownTypeFields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24139,82 +26860,91 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KnownTypeFields = append(m.KnownTypeFields, KnownTypeField{})
-			if err := m.KnownTypeFields[len(m.KnownTypeFields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceRef) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceRef: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceRef: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = github_com_argoproj_gitops_engine_pkg_sync_common.ResultCode(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24242,11 +26972,11 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HookType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24274,11 +27004,11 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.HookType = github_com_argoproj_gitops_engine_pkg_sync_common.HookType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HookPhase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24306,11 +27036,11 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.HookPhase = github_com_argoproj_gitops_engine_pkg_sync_common.OperationPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncPhase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24338,13 +27068,13 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.SyncPhase = github_com_argoproj_gitops_engine_pkg_sync_common.SyncPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24354,27 +27084,31 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.StartedAt == nil {
+				m.StartedAt = &v1.Time{}
+			}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureClass", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24402,7 +27136,7 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UID = string(dAtA[iNdEx:postIndex])
+			m.FailureClass = SyncFailureClass(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24428,7 +27162,7 @@ func (m *ResourceRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceResult) Unmarshal(dAtA []byte) error {
+func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24451,10 +27185,10 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -24647,13 +27381,13 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = github_com_argoproj_gitops_engine_pkg_sync_common.ResultCode(dAtA[iNdEx:postIndex])
+			m.Status = SyncStatusCode(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24663,61 +27397,33 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HookType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if m.Health == nil {
+				m.Health = &HealthStatus{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.HookType = github_com_argoproj_gitops_engine_pkg_sync_common.HookType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HookPhase", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24727,29 +27433,17 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HookPhase = github_com_argoproj_gitops_engine_pkg_sync_common.OperationPhase(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncPhase", wireType)
+			m.Hook = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiresPruning", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24759,24 +27453,12 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SyncPhase = github_com_argoproj_gitops_engine_pkg_sync_common.SyncPhase(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.RequiresPruning = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24801,7 +27483,7 @@ func (m *ResourceResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
+func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24824,17 +27506,17 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetryStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var stringLen uint64
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24844,29 +27526,16 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Limit |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Group = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24876,27 +27545,31 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			if m.Backoff == nil {
+				m.Backoff = &Backoff{}
+			}
+			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryOn", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24924,11 +27597,11 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.RetryOn = append(m.RetryOn, SyncFailureClass(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DoNotRetryOn", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24956,43 +27629,64 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.DoNotRetryOn = append(m.DoNotRetryOn, SyncFailureClass(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevisionHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevisionHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25020,11 +27714,11 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = SyncStatusCode(dAtA[iNdEx:postIndex])
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25051,18 +27745,15 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Health == nil {
-				m.Health = &HealthStatus{}
-			}
-			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DeployedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var v int
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25072,17 +27763,16 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ID |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Hook = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiresPruning", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25092,87 +27782,28 @@ func (m *ResourceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RequiresPruning = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RetryStrategy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
-			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 2:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployStartedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25199,10 +27830,10 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Backoff == nil {
-				m.Backoff = &Backoff{}
+			if m.DeployStartedAt == nil {
+				m.DeployStartedAt = &v1.Time{}
 			}
-			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DeployStartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -25230,7 +27861,7 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
+func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25253,15 +27884,15 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RevisionHistory: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevisionMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RevisionHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevisionMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25289,11 +27920,11 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			m.Author = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeployedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25320,15 +27951,15 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DeployedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Date.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
-			m.ID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25338,16 +27969,29 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25357,30 +28001,114 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureInfo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignatureInfo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 7:
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignatureKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignatureKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignatureKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeployStartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25390,27 +28118,23 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DeployStartedAt == nil {
-				m.DeployStartedAt = &v1.Time{}
-			}
-			if err := m.DeployStartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.KeyID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25436,7 +28160,7 @@ func (m *RevisionHistory) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
+func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25459,15 +28183,15 @@ func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RevisionMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncOperation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RevisionMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncOperation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25495,11 +28219,51 @@ func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Author = string(dAtA[iNdEx:postIndex])
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Prune = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncStrategy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25526,15 +28290,18 @@ func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Date.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SyncStrategy == nil {
+				m.SyncStrategy = &SyncStrategy{}
+			}
+			if err := m.SyncStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25544,29 +28311,31 @@ func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			m.Resources = append(m.Resources, SyncOperationResource{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25576,27 +28345,31 @@ func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.Source == nil {
+				m.Source = &ApplicationSource{}
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignatureInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifests", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25624,64 +28397,11 @@ func (m *RevisionMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SignatureInfo = string(dAtA[iNdEx:postIndex])
+			m.Manifests = append(m.Manifests, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SignatureKey) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SignatureKey: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignatureKey: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncOptions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25709,7 +28429,7 @@ func (m *SignatureKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyID = string(dAtA[iNdEx:postIndex])
+			m.SyncOptions = append(m.SyncOptions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25735,7 +28455,7 @@ func (m *SignatureKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncOperation) Unmarshal(dAtA []byte) error {
+func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25758,15 +28478,15 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncOperation: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncOperationResource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncOperationResource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25794,13 +28514,13 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25810,17 +28530,29 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Prune = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25830,17 +28562,29 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DryRun = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25850,29 +28594,78 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SyncStrategy == nil {
-				m.SyncStrategy = &SyncStrategy{}
-			}
-			if err := m.SyncStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 6:
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncOperationResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncOperationResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
@@ -25901,16 +28694,16 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, SyncOperationResource{})
+			m.Resources = append(m.Resources, &ResourceResult{})
 			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25920,33 +28713,29 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Source == nil {
-				m.Source = &ApplicationSource{}
-			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manifests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25956,29 +28745,30 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manifests = append(m.Manifests, string(dAtA[iNdEx:postIndex]))
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25988,23 +28778,27 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SyncOptions = append(m.SyncOptions, string(dAtA[iNdEx:postIndex]))
+			if m.StartedAt == nil {
+				m.StartedAt = &v1.Time{}
+			}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -26030,7 +28824,7 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
+func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26053,17 +28847,17 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncOperationResource: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncOperationResource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Automated", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26073,27 +28867,31 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			if m.Automated == nil {
+				m.Automated = &SyncPolicyAutomated{}
+			}
+			if err := m.Automated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncOptions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26121,13 +28919,13 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.SyncOptions = append(m.SyncOptions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26137,27 +28935,65 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Retry == nil {
+				m.Retry = &RetryStrategy{}
+			}
+			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, SyncSchedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncTimeout", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26185,7 +29021,7 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.SyncTimeout = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -26211,7 +29047,7 @@ func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
+func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26234,17 +29070,17 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncOperationResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncPolicyAutomated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncOperationResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncPolicyAutomated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26254,31 +29090,17 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Resources = append(m.Resources, &ResourceResult{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Prune = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfHeal", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26288,29 +29110,17 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			m.SelfHeal = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowEmpty", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26320,25 +29130,12 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.AllowEmpty = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -26363,7 +29160,7 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
+func (m *SyncSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26386,17 +29183,17 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Automated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26406,31 +29203,27 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Automated == nil {
-				m.Automated = &SyncPolicyAutomated{}
-			}
-			if err := m.Automated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Schedule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26458,13 +29251,13 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SyncOptions = append(m.SyncOptions, string(dAtA[iNdEx:postIndex]))
+			m.TimeZone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26474,86 +29267,17 @@ func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Retry == nil {
-				m.Retry = &RetryStrategy{}
-			}
-			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SyncPolicyAutomated: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncPolicyAutomated: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
+			m.Prune = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applications", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26563,17 +29287,29 @@ func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Prune = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SelfHeal", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Applications = append(m.Applications, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26583,17 +29319,29 @@ func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SelfHeal = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowEmpty", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespaces = append(m.Namespaces, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26603,12 +29351,24 @@ func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowEmpty = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Clusters = append(m.Clusters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -27308,6 +30068,70 @@ func (m *SyncWindow) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ManualSync = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calendar", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calendar = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
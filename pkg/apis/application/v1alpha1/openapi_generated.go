@@ -40,6 +40,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.ClusterConfig":                    schema_pkg_apis_application_v1alpha1_ClusterConfig(ref),
 		"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.ClusterInfo":                      schema_pkg_apis_application_v1alpha1_ClusterInfo(ref),
 		"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.ClusterList":                      schema_pkg_apis_application_v1alpha1_ClusterList(ref),
+		"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.ClusterNamespaceDiscovery":        schema_pkg_apis_application_v1alpha1_ClusterNamespaceDiscovery(ref),
 		"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.Command":                          schema_pkg_apis_application_v1alpha1_Command(ref),
 		"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.ComparedTo":                       schema_pkg_apis_application_v1alpha1_ComparedTo(ref),
 		"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.ComponentParameter":               schema_pkg_apis_application_v1alpha1_ComponentParameter(ref),
@@ -52,8 +53,12 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.HealthStatus":                     schema_pkg_apis_application_v1alpha1_HealthStatus(ref),
 		"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.HelmFileParameter":                schema_pkg_apis_application_v1alpha1_HelmFileParameter(ref),
 		"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.HelmParameter":                    schema_pkg_apis_application_v1alpha1_HelmParameter(ref),
+		"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.HelmPostRenderer":                 schema_pkg_apis_application_v1alpha1_HelmPostRenderer(ref),
+		"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.HelmValuesRepo":                   schema_pkg_apis_application_v1alpha1_HelmValuesRepo(ref),
 		"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.HostInfo":                         schema_pkg_apis_application_v1alpha1_HostInfo(ref),
 		"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.HostResourceInfo":                 schema_pkg_apis_application_v1alpha1_HostResourceInfo(ref),
+		"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.HydrateTo":                        schema_pkg_apis_application_v1alpha1_HydrateTo(ref),
+		"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.HydratorStatus":                   schema_pkg_apis_application_v1alpha1_HydratorStatus(ref),
 		"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.Info":                             schema_pkg_apis_application_v1alpha1_Info(ref),
 		"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.InfoItem":                         schema_pkg_apis_application_v1alpha1_InfoItem(ref),
 		"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.JWTToken":                         schema_pkg_apis_application_v1alpha1_JWTToken(ref),
@@ -96,6 +101,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.SyncOperationResult":              schema_pkg_apis_application_v1alpha1_SyncOperationResult(ref),
 		"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.SyncPolicy":                       schema_pkg_apis_application_v1alpha1_SyncPolicy(ref),
 		"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.SyncPolicyAutomated":              schema_pkg_apis_application_v1alpha1_SyncPolicyAutomated(ref),
+		"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.SyncSchedule":                     schema_pkg_apis_application_v1alpha1_SyncSchedule(ref),
 		"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.SyncStatus":                       schema_pkg_apis_application_v1alpha1_SyncStatus(ref),
 		"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.SyncStrategy":                     schema_pkg_apis_application_v1alpha1_SyncStrategy(ref),
 		"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.SyncStrategyApply":                schema_pkg_apis_application_v1alpha1_SyncStrategyApply(ref),
@@ -377,11 +383,40 @@ func schema_pkg_apis_application_v1alpha1_AppProjectSpec(ref common.ReferenceCal
 							},
 						},
 					},
+					"syncSchedules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SyncSchedules trigger syncs of the matching apps in this project at the scheduled times",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.SyncSchedule"),
+									},
+								},
+							},
+						},
+					},
+					"helmValueURLs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HelmValueURLs contains list of HTTPS URL patterns of remote Helm value files which can be used for deployment",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.ApplicationDestination", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.OrphanedResourcesMonitorSettings", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.ProjectRole", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.SignatureKey", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.SyncWindow", "k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind"},
+			"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.ApplicationDestination", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.OrphanedResourcesMonitorSettings", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.ProjectRole", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.SignatureKey", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.SyncSchedule", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.SyncWindow", "k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind"},
 	}
 }
 
@@ -772,11 +807,74 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSourceHelm(ref common.Refer
 							Format:      "",
 						},
 					},
+					"skipCrds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SkipCrds skips the custom resource definitions of the chart",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"passCredentials": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PassCredentials passes the credentials of a repository to the repositories of the chart dependencies",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"ignoreMissingValueFiles": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IgnoreMissingValueFiles skips value files which don't exist instead of failing",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"kubeVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeVersion is the Kubernetes version to template with instead of the version of the destination cluster",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersions are the Kubernetes API versions to template with instead of the versions of the destination cluster",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"postRenderer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PostRenderer modifies the manifests rendered by Helm before they are applied",
+							Ref:         ref("github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.HelmPostRenderer"),
+						},
+					},
+					"valuesRepos": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ValuesRepos are additional git repositories whose files can be used as value files, referenced as $alias/path",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.HelmValuesRepo"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.HelmFileParameter", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.HelmParameter"},
+			"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.HelmFileParameter", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.HelmParameter", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.HelmPostRenderer", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.HelmValuesRepo"},
 	}
 }
 
@@ -1058,12 +1156,33 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSpec(ref common.ReferenceCa
 							Format:      "int64",
 						},
 					},
+					"dependsOn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DependsOn is a list of names of applications which must be synced and healthy before the application can be synced",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"hydrateTo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HydrateTo commits the rendered manifests of the application to a branch of a git repository",
+							Ref:         ref("github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.HydrateTo"),
+						},
+					},
 				},
 				Required: []string{"source", "destination", "project"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.ApplicationDestination", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.ApplicationSource", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.Info", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.ResourceIgnoreDifferences", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.SyncPolicy"},
+			"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.ApplicationDestination", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.ApplicationSource", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.HydrateTo", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.Info", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.ResourceIgnoreDifferences", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.SyncPolicy"},
 	}
 }
 
@@ -1154,11 +1273,17 @@ func schema_pkg_apis_application_v1alpha1_ApplicationStatus(ref common.Reference
 							Ref:     ref("github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.ApplicationSummary"),
 						},
 					},
+					"hydrator": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hydrator contains information about the last commit of the rendered manifests",
+							Ref:         ref("github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.HydratorStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.ApplicationCondition", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.ApplicationSummary", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.HealthStatus", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.OperationState", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.ResourceStatus", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.RevisionHistory", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.SyncStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.ApplicationCondition", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.ApplicationSummary", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.HealthStatus", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.HydratorStatus", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.OperationState", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.ResourceStatus", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.RevisionHistory", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.SyncStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -1404,12 +1529,18 @@ func schema_pkg_apis_application_v1alpha1_Cluster(ref common.ReferenceCallback)
 							Format:      "int64",
 						},
 					},
+					"namespaceDiscovery": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespaceDiscovery configures automatic discovery of the namespaces the application controller watches in the cluster",
+							Ref:         ref("github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.ClusterNamespaceDiscovery"),
+						},
+					},
 				},
 				Required: []string{"server", "name", "config"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.ClusterConfig", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.ClusterInfo", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.ConnectionState", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.ClusterConfig", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.ClusterInfo", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.ClusterNamespaceDiscovery", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.ConnectionState", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -1577,6 +1708,33 @@ func schema_pkg_apis_application_v1alpha1_ClusterList(ref common.ReferenceCallba
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_ClusterNamespaceDiscovery(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterNamespaceDiscovery holds settings of the automatic discovery of the namespaces watched in a cluster. Discovered namespaces replace the static list of the cluster namespaces.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"rbac": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RBAC enables discovery of the namespaces in which the cluster credentials are allowed to list resources",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"labelSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LabelSelector limits discovered namespaces to the ones with labels matching the selector",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_Command(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2020,6 +2178,70 @@ func schema_pkg_apis_application_v1alpha1_HelmParameter(ref common.ReferenceCall
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_HelmPostRenderer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HelmPostRenderer modifies the manifests rendered by Helm. Exactly one of its fields must be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kustomize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kustomize is the path of a Kustomize overlay in the repository. The rendered manifests are passed to it as the helm-output.yaml resource.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"plugin": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Plugin is the name of a config management plugin whose generate command receives the rendered manifests on stdin",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_HelmValuesRepo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HelmValuesRepo is a git repository whose files can be used as Helm value files",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"alias": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Alias is the name value files reference the repository by, e.g. values for $values/path/values.yaml",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"repoURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RepoURL is the URL of the repository",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetRevision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetRevision is the branch, tag or commit of the repository. Defaults to HEAD.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"alias", "repoURL"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_HostInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2096,6 +2318,108 @@ func schema_pkg_apis_application_v1alpha1_HostResourceInfo(ref common.ReferenceC
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_HydrateTo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HydrateTo specifies the git repository branch and path the rendered manifests of the application are committed to",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"repoURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RepoURL is the URL of the repository the manifests are committed to. Defaults to the repository of the source.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetBranch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetBranch is the branch the manifests are committed to",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the directory the manifests are written to. Defaults to the path of the source.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"syncFromHydrated": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SyncFromHydrated syncs the application using the committed manifests instead of the manifests rendered from the source",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"targetBranch"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_HydratorStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HydratorStatus contains information about the last commit of the rendered manifests of the application",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"drySHA": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DrySHA is the source revision the manifests were rendered from",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hydratedSHA": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HydratedSHA is the revision of the commit containing the rendered manifests",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hydratedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HydratedAt is the time the manifests were committed",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"repoURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RepoURL is the repository the manifests were committed to",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetBranch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetBranch is the branch the manifests were committed to",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the directory the manifests were written to",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"drySHA", "hydratedSHA"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_Info(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2370,6 +2694,13 @@ func schema_pkg_apis_application_v1alpha1_Operation(ref common.ReferenceCallback
 							Ref:         ref("github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.RetryStrategy"),
 						},
 					},
+					"syncTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SyncTimeout is the maximum duration of a sync attempt before it is terminated and fails (e.g. 2m, 1h). Default unit is seconds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -2460,6 +2791,21 @@ func schema_pkg_apis_application_v1alpha1_OperationState(ref common.ReferenceCal
 							Format:      "int64",
 						},
 					},
+					"failureClasses": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailureClasses are the classes of the failures of the last sync attempt",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"operation", "phase", "startedAt"},
 			},
@@ -3605,10 +3951,25 @@ func schema_pkg_apis_application_v1alpha1_ResourceResult(ref common.ReferenceCal
 							Format:      "",
 						},
 					},
+					"startedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartedAt is the time the resource was first applied or the hook was first created during the sync attempt",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"failureClass": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailureClass is the class of the failure of the resource or hook, empty if it did not fail",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"group", "version", "kind", "namespace", "name"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -3699,6 +4060,36 @@ func schema_pkg_apis_application_v1alpha1_RetryStrategy(ref common.ReferenceCall
 							Ref:         ref("github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.Backoff"),
 						},
 					},
+					"retryOn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryOn is the list of failure classes which are retried. All failure classes are retried if empty",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"doNotRetryOn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DoNotRetryOn is the list of failure classes which are never retried",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -4006,12 +4397,18 @@ func schema_pkg_apis_application_v1alpha1_SyncOperationResult(ref common.Referen
 							Ref:         ref("github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.ApplicationSource"),
 						},
 					},
+					"startedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartedAt is the time the current sync attempt started",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 				},
 				Required: []string{"revision"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.ApplicationSource", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.ResourceResult"},
+			"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.ApplicationSource", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.ResourceResult", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -4049,11 +4446,32 @@ func schema_pkg_apis_application_v1alpha1_SyncPolicy(ref common.ReferenceCallbac
 							Ref:         ref("github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.RetryStrategy"),
 						},
 					},
+					"schedules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedules trigger syncs of the application at the scheduled times",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.SyncSchedule"),
+									},
+								},
+							},
+						},
+					},
+					"syncTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SyncTimeout is the maximum duration of a sync attempt before it is terminated and fails (e.g. 2m, 1h). Default unit is seconds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.RetryStrategy", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.SyncPolicyAutomated"},
+			"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.RetryStrategy", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.SyncPolicyAutomated", "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1.SyncSchedule"},
 	}
 }
 
@@ -4091,6 +4509,87 @@ func schema_pkg_apis_application_v1alpha1_SyncPolicyAutomated(ref common.Referen
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_SyncSchedule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncSchedule triggers syncs of applications on a cron schedule",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule is the time the sync is triggered, specified in cron format",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timeZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeZone is the IANA time zone the schedule is evaluated in (e.g. Europe/Berlin). Defaults to UTC",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"prune": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prune specifies whether to delete resources which are no longer defined in git during the scheduled sync",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"applications": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Applications contains a list of applications that the project schedule applies to",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"namespaces": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespaces contains a list of namespaces that the project schedule applies to",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"clusters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Clusters contains a list of clusters that the project schedule applies to",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"schedule"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_SyncStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4273,6 +4772,20 @@ func schema_pkg_apis_application_v1alpha1_SyncWindow(ref common.ReferenceCallbac
 							Format:      "",
 						},
 					},
+					"timeZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeZone is the IANA time zone the schedule and the floating calendar times are evaluated in (e.g. Europe/Berlin). Defaults to UTC",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"calendar": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Calendar is an iCalendar (RFC 5545) document whose events define when the window is open. Replaces schedule and duration",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -39,6 +39,7 @@ import (
 	"github.com/vathsalashetty96/argo-cd/util/git"
 	"github.com/vathsalashetty96/argo-cd/util/glob"
 	"github.com/vathsalashetty96/argo-cd/util/helm"
+	"github.com/vathsalashetty96/argo-cd/util/ical"
 )
 
 // Application is a definition of Application resource.
@@ -77,6 +78,52 @@ type ApplicationSpec struct {
 	// Increasing will increase the space used to store the history, so we do not recommend increasing it.
 	// Default is 10.
 	RevisionHistoryLimit *int64 `json:"revisionHistoryLimit,omitempty" protobuf:"bytes,7,name=revisionHistoryLimit"`
+	// DependsOn is a list of names of applications which must be synced and healthy before the application can be synced
+	DependsOn []string `json:"dependsOn,omitempty" protobuf:"bytes,8,rep,name=dependsOn"`
+	// HydrateTo commits the rendered manifests of the application to a branch of a git repository
+	HydrateTo *HydrateTo `json:"hydrateTo,omitempty" protobuf:"bytes,9,opt,name=hydrateTo"`
+}
+
+// HydrateTo specifies the git repository branch and path the rendered manifests of the application are committed to
+type HydrateTo struct {
+	// RepoURL is the URL of the repository the manifests are committed to. Defaults to the repository of the source.
+	RepoURL string `json:"repoURL,omitempty" protobuf:"bytes,1,opt,name=repoURL"`
+	// TargetBranch is the branch the manifests are committed to
+	TargetBranch string `json:"targetBranch" protobuf:"bytes,2,opt,name=targetBranch"`
+	// Path is the directory the manifests are written to. Defaults to the path of the source.
+	Path string `json:"path,omitempty" protobuf:"bytes,3,opt,name=path"`
+	// SyncFromHydrated syncs the application using the committed manifests instead of the manifests rendered from the source
+	SyncFromHydrated bool `json:"syncFromHydrated,omitempty" protobuf:"varint,4,opt,name=syncFromHydrated"`
+}
+
+// GetRepoURL returns the repository the manifests are committed to
+func (h *HydrateTo) GetRepoURL(source ApplicationSource) string {
+	if h.RepoURL != "" {
+		return h.RepoURL
+	}
+	return source.RepoURL
+}
+
+// GetPath returns the directory the manifests are written to
+func (h *HydrateTo) GetPath(source ApplicationSource) string {
+	if h.Path != "" {
+		return h.Path
+	}
+	return source.Path
+}
+
+// Target returns the repository, branch and path the manifests are committed to
+func (h *HydrateTo) Target(source ApplicationSource) HydrateTo {
+	return HydrateTo{RepoURL: h.GetRepoURL(source), TargetBranch: h.TargetBranch, Path: h.GetPath(source)}
+}
+
+// HydratedSource returns the source referencing the committed manifests
+func (h *HydrateTo) HydratedSource(source ApplicationSource, revision string) ApplicationSource {
+	return ApplicationSource{
+		RepoURL:        h.GetRepoURL(source),
+		Path:           h.GetPath(source),
+		TargetRevision: revision,
+	}
 }
 
 // ResourceIgnoreDifferences contains resource filter and list of json paths which should be ignored during comparison with live state.
@@ -227,6 +274,39 @@ type ApplicationSourceHelm struct {
 	FileParameters []HelmFileParameter `json:"fileParameters,omitempty" protobuf:"bytes,5,opt,name=fileParameters"`
 	// Version is the Helm version to use for templating with
 	Version string `json:"version,omitempty" protobuf:"bytes,6,opt,name=version"`
+	// SkipCrds skips the custom resource definitions of the chart
+	SkipCrds bool `json:"skipCrds,omitempty" protobuf:"bytes,7,opt,name=skipCrds"`
+	// PassCredentials passes the credentials of a repository to the repositories of the chart dependencies
+	PassCredentials bool `json:"passCredentials,omitempty" protobuf:"bytes,8,opt,name=passCredentials"`
+	// IgnoreMissingValueFiles skips value files which don't exist instead of failing
+	IgnoreMissingValueFiles bool `json:"ignoreMissingValueFiles,omitempty" protobuf:"bytes,9,opt,name=ignoreMissingValueFiles"`
+	// KubeVersion is the Kubernetes version to template with instead of the version of the destination cluster
+	KubeVersion string `json:"kubeVersion,omitempty" protobuf:"bytes,10,opt,name=kubeVersion"`
+	// APIVersions are the Kubernetes API versions to template with instead of the versions of the destination cluster
+	APIVersions []string `json:"apiVersions,omitempty" protobuf:"bytes,11,opt,name=apiVersions"`
+	// PostRenderer modifies the manifests rendered by Helm before they are applied
+	PostRenderer *HelmPostRenderer `json:"postRenderer,omitempty" protobuf:"bytes,12,opt,name=postRenderer"`
+	// ValuesRepos are additional git repositories whose files can be used as value files, referenced as $alias/path
+	ValuesRepos []HelmValuesRepo `json:"valuesRepos,omitempty" protobuf:"bytes,13,rep,name=valuesRepos"`
+}
+
+// HelmValuesRepo is a git repository whose files can be used as Helm value files
+type HelmValuesRepo struct {
+	// Alias is the name value files reference the repository by, e.g. values for $values/path/values.yaml
+	Alias string `json:"alias" protobuf:"bytes,1,opt,name=alias"`
+	// RepoURL is the URL of the repository
+	RepoURL string `json:"repoURL" protobuf:"bytes,2,opt,name=repoURL"`
+	// TargetRevision is the branch, tag or commit of the repository. Defaults to HEAD.
+	TargetRevision string `json:"targetRevision,omitempty" protobuf:"bytes,3,opt,name=targetRevision"`
+}
+
+// HelmPostRenderer modifies the manifests rendered by Helm. Exactly one of its fields must be set.
+type HelmPostRenderer struct {
+	// Kustomize is the path of a Kustomize overlay in the repository. The rendered manifests are passed to it as the
+	// helm-output.yaml resource.
+	Kustomize string `json:"kustomize,omitempty" protobuf:"bytes,1,opt,name=kustomize"`
+	// Plugin is the name of a config management plugin whose generate command receives the rendered manifests on stdin
+	Plugin string `json:"plugin,omitempty" protobuf:"bytes,2,opt,name=plugin"`
 }
 
 // HelmParameter is a parameter to a helm template
@@ -261,6 +341,40 @@ func NewHelmParameter(text string, forceString bool) (*HelmParameter, error) {
 	}, nil
 }
 
+// ParseHelmValuesRepoRef splits a value file of the form $alias/path into the alias of a values repository and the
+// path of the file in it. ok is false if the value file doesn't reference a values repository.
+func ParseHelmValuesRepoRef(valueFile string) (alias string, path string, ok bool) {
+	if !strings.HasPrefix(valueFile, "$") {
+		return "", "", false
+	}
+	parts := strings.SplitN(valueFile[1:], "/", 2)
+	if len(parts) != 2 {
+		return parts[0], "", true
+	}
+	return parts[0], parts[1], true
+}
+
+var helmValuesRepoAliasRx = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// ValidateHelmValuesRepoAlias returns an error if the alias of a values repository contains characters other than
+// letters, digits, '_' and '-'
+func ValidateHelmValuesRepoAlias(alias string) error {
+	if !helmValuesRepoAliasRx.MatchString(alias) {
+		return fmt.Errorf("invalid values repository alias '%s': alias must consist of letters, digits, '_' and '-'", alias)
+	}
+	return nil
+}
+
+// GetValuesRepo returns the values repository with the given alias, or nil if there is none
+func (in *ApplicationSourceHelm) GetValuesRepo(alias string) *HelmValuesRepo {
+	for i := range in.ValuesRepos {
+		if in.ValuesRepos[i].Alias == alias {
+			return &in.ValuesRepos[i]
+		}
+	}
+	return nil
+}
+
 func NewHelmFileParameter(text string) (*HelmFileParameter, error) {
 	parts := strings.SplitN(text, "=", 2)
 	if len(parts) != 2 {
@@ -272,6 +386,32 @@ func NewHelmFileParameter(text string) (*HelmFileParameter, error) {
 	}, nil
 }
 
+// NewHelmValuesRepo parses a values repository of the form alias=repoURL[,targetRevision]
+func NewHelmValuesRepo(text string) (*HelmValuesRepo, error) {
+	parts := strings.SplitN(text, "=", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return nil, fmt.Errorf("Expected helm values repository of the form: alias=repoURL[,targetRevision]. Received: %s", text)
+	}
+	repo := &HelmValuesRepo{Alias: parts[0]}
+	source := strings.SplitN(parts[1], ",", 2)
+	repo.RepoURL = source[0]
+	if len(source) == 2 {
+		repo.TargetRevision = source[1]
+	}
+	return repo, ValidateHelmValuesRepoAlias(repo.Alias)
+}
+
+// AddValuesRepo adds the values repository, or replaces the values repository with the same alias
+func (in *ApplicationSourceHelm) AddValuesRepo(r HelmValuesRepo) {
+	for i := range in.ValuesRepos {
+		if in.ValuesRepos[i].Alias == r.Alias {
+			in.ValuesRepos[i] = r
+			return
+		}
+	}
+	in.ValuesRepos = append(in.ValuesRepos, r)
+}
+
 func (in *ApplicationSourceHelm) AddParameter(p HelmParameter) {
 	found := false
 	for i, cp := range in.Parameters {
@@ -301,7 +441,9 @@ func (in *ApplicationSourceHelm) AddFileParameter(p HelmFileParameter) {
 }
 
 func (h *ApplicationSourceHelm) IsZero() bool {
-	return h == nil || (h.Version == "") && (h.ReleaseName == "") && len(h.ValueFiles) == 0 && len(h.Parameters) == 0 && len(h.FileParameters) == 0 && h.Values == ""
+	return h == nil || (h.Version == "") && (h.ReleaseName == "") && len(h.ValueFiles) == 0 && len(h.Parameters) == 0 && len(h.FileParameters) == 0 && h.Values == "" &&
+		!h.SkipCrds && !h.PassCredentials && !h.IgnoreMissingValueFiles && h.KubeVersion == "" && len(h.APIVersions) == 0 && h.PostRenderer == nil &&
+		len(h.ValuesRepos) == 0
 }
 
 type KustomizeImage string
@@ -495,6 +637,29 @@ type ApplicationStatus struct {
 	ObservedAt *metav1.Time          `json:"observedAt,omitempty" protobuf:"bytes,8,opt,name=observedAt"`
 	SourceType ApplicationSourceType `json:"sourceType,omitempty" protobuf:"bytes,9,opt,name=sourceType"`
 	Summary    ApplicationSummary    `json:"summary,omitempty" protobuf:"bytes,10,opt,name=summary"`
+	// Hydrator contains information about the last commit of the rendered manifests
+	Hydrator *HydratorStatus `json:"hydrator,omitempty" protobuf:"bytes,11,opt,name=hydrator"`
+}
+
+// HydratorStatus contains information about the last commit of the rendered manifests of the application
+type HydratorStatus struct {
+	// DrySHA is the source revision the manifests were rendered from
+	DrySHA string `json:"drySHA" protobuf:"bytes,1,opt,name=drySHA"`
+	// HydratedSHA is the revision of the commit containing the rendered manifests
+	HydratedSHA string `json:"hydratedSHA" protobuf:"bytes,2,opt,name=hydratedSHA"`
+	// HydratedAt is the time the manifests were committed
+	HydratedAt *metav1.Time `json:"hydratedAt,omitempty" protobuf:"bytes,3,opt,name=hydratedAt"`
+	// RepoURL is the repository the manifests were committed to
+	RepoURL string `json:"repoURL,omitempty" protobuf:"bytes,4,opt,name=repoURL"`
+	// TargetBranch is the branch the manifests were committed to
+	TargetBranch string `json:"targetBranch,omitempty" protobuf:"bytes,5,opt,name=targetBranch"`
+	// Path is the directory the manifests were written to
+	Path string `json:"path,omitempty" protobuf:"bytes,6,opt,name=path"`
+}
+
+// IsHydratedTo returns whether the manifests were committed to the given repository, branch and path
+func (s *HydratorStatus) IsHydratedTo(target HydrateTo) bool {
+	return git.SameURL(s.RepoURL, target.RepoURL) && s.TargetBranch == target.TargetBranch && s.Path == target.Path
 }
 
 type JWTTokens struct {
@@ -521,6 +686,8 @@ type Operation struct {
 	Info        []*Info            `json:"info,omitempty" protobuf:"bytes,3,name=info"`
 	// Retry controls failed sync retry behavior
 	Retry RetryStrategy `json:"retry,omitempty" protobuf:"bytes,4,opt,name=retry"`
+	// SyncTimeout is the maximum duration of a sync attempt before it is terminated and fails (e.g. 2m, 1h). Default unit is seconds
+	SyncTimeout string `json:"syncTimeout,omitempty" protobuf:"bytes,5,opt,name=syncTimeout"`
 }
 
 func (o *Operation) DryRun() bool {
@@ -530,6 +697,24 @@ func (o *Operation) DryRun() bool {
 	return false
 }
 
+// GetSyncTimeout returns the maximum duration of a sync attempt, or zero if the operation has no timeout
+func (o *Operation) GetSyncTimeout() (time.Duration, error) {
+	if o.SyncTimeout == "" {
+		return 0, nil
+	}
+	return ParseSyncTimeout(o.SyncTimeout)
+}
+
+// ParseSyncTimeout parses the sync timeout of an operation, sync policy or resource. The timeout is a duration (e.g. 2m,
+// 1h), or a number of seconds if it has no unit.
+func ParseSyncTimeout(timeout string) (time.Duration, error) {
+	duration, err := parseStringToDuration(timeout)
+	if err != nil {
+		return 0, fmt.Errorf("invalid sync timeout: %v", err)
+	}
+	return duration, nil
+}
+
 // SyncOperationResource contains resources to sync.
 type SyncOperationResource struct {
 	Group     string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
@@ -603,6 +788,8 @@ type OperationState struct {
 	FinishedAt *metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,7,opt,name=finishedAt"`
 	// RetryCount contains time of operation retries
 	RetryCount int64 `json:"retryCount,omitempty" protobuf:"bytes,8,opt,name=retryCount"`
+	// FailureClasses are the classes of the failures of the last sync attempt
+	FailureClasses []SyncFailureClass `json:"failureClasses,omitempty" protobuf:"bytes,9,rep,name=failureClasses"`
 }
 
 type Info struct {
@@ -647,10 +834,98 @@ type SyncPolicy struct {
 	SyncOptions SyncOptions `json:"syncOptions,omitempty" protobuf:"bytes,2,opt,name=syncOptions"`
 	// Retry controls failed sync retry behavior
 	Retry *RetryStrategy `json:"retry,omitempty" protobuf:"bytes,3,opt,name=retry"`
+	// Schedules trigger syncs of the application at the scheduled times
+	Schedules []SyncSchedule `json:"schedules,omitempty" protobuf:"bytes,4,rep,name=schedules"`
+	// SyncTimeout is the maximum duration of a sync attempt before it is terminated and fails (e.g. 2m, 1h). Default unit is seconds
+	SyncTimeout string `json:"syncTimeout,omitempty" protobuf:"bytes,5,opt,name=syncTimeout"`
 }
 
 func (p *SyncPolicy) IsZero() bool {
-	return p == nil || (p.Automated == nil && len(p.SyncOptions) == 0)
+	return p == nil || (p.Automated == nil && len(p.SyncOptions) == 0 && len(p.Schedules) == 0 && p.SyncTimeout == "")
+}
+
+// SyncSchedule triggers syncs of applications on a cron schedule
+type SyncSchedule struct {
+	// Schedule is the time the sync is triggered, specified in cron format
+	Schedule string `json:"schedule" protobuf:"bytes,1,opt,name=schedule"`
+	// TimeZone is the IANA time zone the schedule is evaluated in (e.g. Europe/Berlin). Defaults to UTC
+	TimeZone string `json:"timeZone,omitempty" protobuf:"bytes,2,opt,name=timeZone"`
+	// Prune specifies whether to delete resources which are no longer defined in git during the scheduled sync
+	Prune bool `json:"prune,omitempty" protobuf:"varint,3,opt,name=prune"`
+	// Applications contains a list of applications that the project schedule applies to
+	Applications []string `json:"applications,omitempty" protobuf:"bytes,4,rep,name=applications"`
+	// Namespaces contains a list of namespaces that the project schedule applies to
+	Namespaces []string `json:"namespaces,omitempty" protobuf:"bytes,5,rep,name=namespaces"`
+	// Clusters contains a list of clusters that the project schedule applies to
+	Clusters []string `json:"clusters,omitempty" protobuf:"bytes,6,rep,name=clusters"`
+}
+
+func (s *SyncSchedule) parse() (cron.Schedule, *time.Location, error) {
+	loc, err := loadTimeZone(s.TimeZone)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot parse time zone '%s': %s", s.TimeZone, err)
+	}
+	specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+	schedule, err := specParser.Parse(s.Schedule)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot parse schedule '%s': %s", s.Schedule, err)
+	}
+	return schedule, loc, nil
+}
+
+// Validate checks that the schedule and the time zone can be parsed
+func (s *SyncSchedule) Validate() error {
+	_, _, err := s.parse()
+	return err
+}
+
+// Parse returns the function which returns the first scheduled sync time after the given time, or zero time if the
+// schedule never fires.
+func (s *SyncSchedule) Parse() (func(t time.Time) time.Time, error) {
+	schedule, loc, err := s.parse()
+	if err != nil {
+		return nil, err
+	}
+	return func(t time.Time) time.Time {
+		return schedule.Next(t.In(loc))
+	}, nil
+}
+
+// Next returns the first scheduled sync time after the given time. Returns zero time if the schedule never fires.
+func (s *SyncSchedule) Next(t time.Time) (time.Time, error) {
+	next, err := s.Parse()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return next(t), nil
+}
+
+// Matches returns true if the project sync schedule applies to the given application
+func (s *SyncSchedule) Matches(app *Application) bool {
+	for _, a := range s.Applications {
+		if globMatch(a, app.Name) {
+			return true
+		}
+	}
+	for _, c := range s.Clusters {
+		if globMatch(c, app.Spec.Destination.Server) {
+			return true
+		}
+	}
+	for _, n := range s.Namespaces {
+		if globMatch(n, app.Spec.Destination.Namespace) {
+			return true
+		}
+	}
+	return false
+}
+
+// loadTimeZone returns the location of the IANA time zone name, or UTC if the name is empty
+func loadTimeZone(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(name)
 }
 
 type RetryStrategy struct {
@@ -659,6 +934,38 @@ type RetryStrategy struct {
 
 	// Backoff is a backoff strategy
 	Backoff *Backoff `json:"backoff,omitempty" protobuf:"bytes,2,opt,name=backoff,casttype=Backoff"`
+
+	// RetryOn is the list of failure classes which are retried. All failure classes are retried if empty
+	RetryOn []SyncFailureClass `json:"retryOn,omitempty" protobuf:"bytes,3,rep,name=retryOn"`
+
+	// DoNotRetryOn is the list of failure classes which are never retried
+	DoNotRetryOn []SyncFailureClass `json:"doNotRetryOn,omitempty" protobuf:"bytes,4,rep,name=doNotRetryOn"`
+}
+
+// ShouldRetry returns whether a failed sync attempt with the given failure classes is retried. Failures which are not
+// caused by a resource, such as a sync timeout, are classified as Unknown.
+func (r *RetryStrategy) ShouldRetry(classes []SyncFailureClass) bool {
+	if len(classes) == 0 {
+		classes = []SyncFailureClass{SyncFailureClassUnknown}
+	}
+	for _, class := range classes {
+		if containsFailureClass(r.DoNotRetryOn, class) {
+			return false
+		}
+		if len(r.RetryOn) > 0 && !containsFailureClass(r.RetryOn, class) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsFailureClass(classes []SyncFailureClass, class SyncFailureClass) bool {
+	for _, c := range classes {
+		if c == class {
+			return true
+		}
+	}
+	return false
 }
 
 func parseStringToDuration(durationString string) (time.Duration, error) {
@@ -788,6 +1095,8 @@ type SyncOperationResult struct {
 	Revision string `json:"revision" protobuf:"bytes,2,opt,name=revision"`
 	// Source records the application source information of the sync, used for comparing auto-sync
 	Source ApplicationSource `json:"source,omitempty" protobuf:"bytes,3,opt,name=source"`
+	// StartedAt is the time the current sync attempt started
+	StartedAt *metav1.Time `json:"startedAt,omitempty" protobuf:"bytes,4,opt,name=startedAt"`
 }
 
 // ResourceResult holds the operation result details of a specific resource
@@ -808,8 +1117,30 @@ type ResourceResult struct {
 	HookPhase synccommon.OperationPhase `json:"hookPhase,omitempty" protobuf:"bytes,9,opt,name=hookPhase"`
 	// indicates the particular phase of the sync that this is for
 	SyncPhase synccommon.SyncPhase `json:"syncPhase,omitempty" protobuf:"bytes,10,opt,name=syncPhase"`
+	// StartedAt is the time the resource was first applied or the hook was first created during the sync attempt
+	StartedAt *metav1.Time `json:"startedAt,omitempty" protobuf:"bytes,11,opt,name=startedAt"`
+	// FailureClass is the class of the failure of the resource or hook, empty if it did not fail
+	FailureClass SyncFailureClass `json:"failureClass,omitempty" protobuf:"bytes,12,opt,name=failureClass"`
 }
 
+// SyncFailureClass classifies the cause of a failed resource or hook
+type SyncFailureClass string
+
+const (
+	// SyncFailureClassTransient is a network error, a timeout or an unavailable API server or webhook
+	SyncFailureClassTransient SyncFailureClass = "Transient"
+	// SyncFailureClassConflict is a conflicting modification of the resource
+	SyncFailureClassConflict SyncFailureClass = "Conflict"
+	// SyncFailureClassValidation is an invalid manifest or a resource rejected by an admission webhook
+	SyncFailureClassValidation SyncFailureClass = "Validation"
+	// SyncFailureClassRBAC is a resource forbidden by Kubernetes RBAC or the project
+	SyncFailureClassRBAC SyncFailureClass = "RBAC"
+	// SyncFailureClassHookFailed is a hook which ran and failed
+	SyncFailureClassHookFailed SyncFailureClass = "HookFailed"
+	// SyncFailureClassUnknown is any other failure
+	SyncFailureClassUnknown SyncFailureClass = "Unknown"
+)
+
 func (r *ResourceResult) GroupVersionKind() schema.GroupVersionKind {
 	return schema.GroupVersionKind{
 		Group:   r.Group,
@@ -838,6 +1169,17 @@ func (r ResourceResults) PruningRequired() (num int) {
 	return num
 }
 
+// FailureClasses returns the distinct failure classes of the results in the order of their first occurrence
+func (r ResourceResults) FailureClasses() []SyncFailureClass {
+	var classes []SyncFailureClass
+	for _, res := range r {
+		if res.FailureClass != "" && !containsFailureClass(classes, res.FailureClass) {
+			classes = append(classes, res.FailureClass)
+		}
+	}
+	return classes
+}
+
 // RevisionHistory contains information relevant to an application deployment
 type RevisionHistory struct {
 	// Revision holds the revision of the sync
@@ -901,6 +1243,8 @@ const (
 	ApplicationConditionInvalidSpecError = "InvalidSpecError"
 	// ApplicationConditionComparisonError indicates controller failed to compare application state
 	ApplicationConditionComparisonError = "ComparisonError"
+	// ApplicationConditionManifestLimitError indicates controller failed to compare application state because manifest generation exceeded a resource limit
+	ApplicationConditionManifestLimitError = "ManifestLimitError"
 	// ApplicationConditionSyncError indicates controller failed to automatically sync the application
 	ApplicationConditionSyncError = "SyncError"
 	// ApplicationConditionUnknownError indicates an unknown controller error
@@ -913,6 +1257,10 @@ const (
 	ApplicationConditionExcludedResourceWarning = "ExcludedResourceWarning"
 	// ApplicationConditionOrphanedResourceWarning indicates that application has orphaned resources
 	ApplicationConditionOrphanedResourceWarning = "OrphanedResourceWarning"
+	// ApplicationConditionDependencyNotReady indicates that application dependencies are not synced and healthy or form a cycle
+	ApplicationConditionDependencyNotReady = "DependencyNotReady"
+	// ApplicationConditionHydrationError indicates that controller failed to commit the rendered manifests of the application
+	ApplicationConditionHydrationError = "HydrationError"
 )
 
 // ApplicationCondition contains details about current application condition
@@ -1138,6 +1486,8 @@ type Cluster struct {
 	Info ClusterInfo `json:"info,omitempty" protobuf:"bytes,8,opt,name=info"`
 	// Shard contains optional shard number. Calculated on the fly by the application controller if not specified.
 	Shard *int64 `json:"shard,omitempty" protobuf:"bytes,9,opt,name=shard"`
+	// NamespaceDiscovery configures automatic discovery of the namespaces the application controller watches in the cluster
+	NamespaceDiscovery *ClusterNamespaceDiscovery `json:"namespaceDiscovery,omitempty" protobuf:"bytes,10,opt,name=namespaceDiscovery"`
 }
 
 func (c *Cluster) Equals(other *Cluster) bool {
@@ -1161,9 +1511,26 @@ func (c *Cluster) Equals(other *Cluster) bool {
 	if shard != otherShard {
 		return false
 	}
+	if !reflect.DeepEqual(c.NamespaceDiscovery, other.NamespaceDiscovery) {
+		return false
+	}
 	return reflect.DeepEqual(c.Config, other.Config)
 }
 
+// ClusterNamespaceDiscovery holds settings of the automatic discovery of the namespaces watched in a cluster.
+// Discovered namespaces replace the static list of the cluster namespaces.
+type ClusterNamespaceDiscovery struct {
+	// RBAC enables discovery of the namespaces in which the cluster credentials are allowed to list resources
+	RBAC bool `json:"rbac,omitempty" protobuf:"varint,1,opt,name=rbac"`
+	// LabelSelector limits discovered namespaces to the ones with labels matching the selector
+	LabelSelector string `json:"labelSelector,omitempty" protobuf:"bytes,2,opt,name=labelSelector"`
+}
+
+// IsEnabled returns true if namespace discovery is configured
+func (d *ClusterNamespaceDiscovery) IsEnabled() bool {
+	return d != nil && (d.RBAC || d.LabelSelector != "")
+}
+
 type ClusterInfo struct {
 	ConnectionState   ConnectionState  `json:"connectionState,omitempty" protobuf:"bytes,1,opt,name=connectionState"`
 	ServerVersion     string           `json:"serverVersion,omitempty" protobuf:"bytes,2,opt,name=serverVersion"`
@@ -1699,6 +2066,11 @@ func (p *AppProject) ValidateProject() error {
 		}
 		srcRepos[src] = true
 	}
+	for _, valueURL := range p.Spec.HelmValueURLs {
+		if !strings.HasPrefix(strings.ToLower(valueURL), "https://") {
+			return status.Errorf(codes.InvalidArgument, "helm value URL '%s' must be an HTTPS URL", valueURL)
+		}
+	}
 
 	roleNames := make(map[string]bool)
 	for _, role := range p.Spec.Roles {
@@ -1734,7 +2106,7 @@ func (p *AppProject) ValidateProject() error {
 	if p.Spec.SyncWindows.HasWindows() {
 		existingWindows := make(map[string]bool)
 		for _, window := range p.Spec.SyncWindows {
-			if _, ok := existingWindows[window.Kind+window.Schedule+window.Duration]; ok {
+			if _, ok := existingWindows[window.Kind+window.Schedule+window.Duration+window.TimeZone+window.Calendar]; ok {
 				return status.Errorf(codes.AlreadyExists, "window '%s':'%s':'%s' already exists, update or edit", window.Kind, window.Schedule, window.Duration)
 			}
 			err := window.Validate()
@@ -1744,7 +2116,16 @@ func (p *AppProject) ValidateProject() error {
 			if len(window.Applications) == 0 && len(window.Namespaces) == 0 && len(window.Clusters) == 0 {
 				return status.Errorf(codes.OutOfRange, "window '%s':'%s':'%s' requires one of application, cluster or namespace", window.Kind, window.Schedule, window.Duration)
 			}
-			existingWindows[window.Kind+window.Schedule+window.Duration] = true
+			existingWindows[window.Kind+window.Schedule+window.Duration+window.TimeZone+window.Calendar] = true
+		}
+	}
+
+	for _, schedule := range p.Spec.SyncSchedules {
+		if err := schedule.Validate(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid sync schedule: %s", err)
+		}
+		if len(schedule.Applications) == 0 && len(schedule.Namespaces) == 0 && len(schedule.Clusters) == 0 {
+			return status.Errorf(codes.OutOfRange, "sync schedule '%s' requires one of application, cluster or namespace", schedule.Schedule)
 		}
 	}
 
@@ -1764,6 +2145,9 @@ var validActions = map[string]bool{
 
 var validActionPatterns = []*regexp.Regexp{
 	regexp.MustCompile("action/.*"),
+	regexp.MustCompile("get/.*"),
+	regexp.MustCompile("update/.*"),
+	regexp.MustCompile("delete/.*"),
 }
 
 func isValidAction(action string) bool {
@@ -1937,11 +2321,29 @@ type AppProjectSpec struct {
 	SignatureKeys []SignatureKey `json:"signatureKeys,omitempty" protobuf:"bytes,10,opt,name=signatureKeys"`
 	// ClusterResourceBlacklist contains list of blacklisted cluster level resources
 	ClusterResourceBlacklist []metav1.GroupKind `json:"clusterResourceBlacklist,omitempty" protobuf:"bytes,11,opt,name=clusterResourceBlacklist"`
+	// SyncSchedules trigger syncs of the matching apps in this project at the scheduled times
+	SyncSchedules []SyncSchedule `json:"syncSchedules,omitempty" protobuf:"bytes,12,rep,name=syncSchedules"`
+	// HelmValueURLs contains list of HTTPS URL patterns of remote Helm value files which can be used for deployment
+	HelmValueURLs []string `json:"helmValueURLs,omitempty" protobuf:"bytes,13,rep,name=helmValueURLs"`
+}
+
+// MatchingSyncSchedules returns the project sync schedules which apply to the given application
+func (s *AppProjectSpec) MatchingSyncSchedules(app *Application) []SyncSchedule {
+	var schedules []SyncSchedule
+	for _, schedule := range s.SyncSchedules {
+		if schedule.Matches(app) {
+			schedules = append(schedules, schedule)
+		}
+	}
+	return schedules
 }
 
 // SyncWindows is a collection of sync windows in this project
 type SyncWindows []*SyncWindow
 
+// maxScheduleIterations limits the number of schedule occurrences evaluated to find upcoming window periods
+const maxScheduleIterations = 10000
+
 // SyncWindow contains the kind, time, duration and attributes that are used to assign the syncWindows to apps
 type SyncWindow struct {
 	// Kind defines if the window allows or blocks syncs
@@ -1958,6 +2360,10 @@ type SyncWindow struct {
 	Clusters []string `json:"clusters,omitempty" protobuf:"bytes,6,opt,name=clusters"`
 	// ManualSync enables manual syncs when they would otherwise be blocked
 	ManualSync bool `json:"manualSync,omitempty" protobuf:"bytes,7,opt,name=manualSync"`
+	// TimeZone is the IANA time zone the schedule and the floating calendar times are evaluated in (e.g. Europe/Berlin). Defaults to UTC
+	TimeZone string `json:"timeZone,omitempty" protobuf:"bytes,8,opt,name=timeZone"`
+	// Calendar is an iCalendar (RFC 5545) document whose events define when the window is open. Replaces schedule and duration
+	Calendar string `json:"calendar,omitempty" protobuf:"bytes,9,opt,name=calendar"`
 }
 
 func (s *SyncWindows) HasWindows() bool {
@@ -1969,19 +2375,10 @@ func (s *SyncWindows) Active() *SyncWindows {
 }
 
 func (s *SyncWindows) active(currentTime time.Time) *SyncWindows {
-
-	// If SyncWindows.Active() is called outside of a UTC locale, it should be
-	// first converted to UTC before we scan through the SyncWindows.
-	currentTime = currentTime.In(time.UTC)
-
 	if s.HasWindows() {
 		var active SyncWindows
-		specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
 		for _, w := range *s {
-			schedule, _ := specParser.Parse(w.Schedule)
-			duration, _ := time.ParseDuration(w.Duration)
-			nextWindow := schedule.Next(currentTime.Add(-duration))
-			if nextWindow.Before(currentTime) {
+			if isActive, _ := w.isActive(currentTime); isActive {
 				active = append(active, w)
 			}
 		}
@@ -1997,20 +2394,11 @@ func (s *SyncWindows) InactiveAllows() *SyncWindows {
 }
 
 func (s *SyncWindows) inactiveAllows(currentTime time.Time) *SyncWindows {
-
-	// If SyncWindows.InactiveAllows() is called outside of a UTC locale, it should be
-	// first converted to UTC before we scan through the SyncWindows.
-	currentTime = currentTime.In(time.UTC)
-
 	if s.HasWindows() {
 		var inactive SyncWindows
-		specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
 		for _, w := range *s {
 			if w.Kind == "allow" {
-				schedule, sErr := specParser.Parse(w.Schedule)
-				duration, dErr := time.ParseDuration(w.Duration)
-				nextWindow := schedule.Next(currentTime.Add(-duration))
-				if !nextWindow.Before(currentTime) && sErr == nil && dErr == nil {
+				if isActive, err := w.isActive(currentTime); err == nil && !isActive {
 					inactive = append(inactive, w)
 				}
 			}
@@ -2022,9 +2410,9 @@ func (s *SyncWindows) inactiveAllows(currentTime time.Time) *SyncWindows {
 	return nil
 }
 
-func (s *AppProjectSpec) AddWindow(knd string, sch string, dur string, app []string, ns []string, cl []string, ms bool) error {
-	if len(knd) == 0 || len(sch) == 0 || len(dur) == 0 {
-		return fmt.Errorf("cannot create window: require kind, schedule, duration and one or more of applications, namespaces and clusters")
+func (s *AppProjectSpec) AddWindow(knd string, sch string, dur string, app []string, ns []string, cl []string, ms bool, tz string, cal string) error {
+	if len(knd) == 0 || ((len(sch) == 0 || len(dur) == 0) && len(cal) == 0) {
+		return fmt.Errorf("cannot create window: require kind, schedule and duration or calendar, and one or more of applications, namespaces and clusters")
 
 	}
 	window := &SyncWindow{
@@ -2032,6 +2420,8 @@ func (s *AppProjectSpec) AddWindow(knd string, sch string, dur string, app []str
 		Schedule:   sch,
 		Duration:   dur,
 		ManualSync: ms,
+		TimeZone:   tz,
+		Calendar:   cal,
 	}
 
 	if len(app) > 0 {
@@ -2184,22 +2574,92 @@ func (w SyncWindow) Active() bool {
 }
 
 func (w SyncWindow) active(currentTime time.Time) bool {
+	isActive, _ := w.isActive(currentTime)
+	return isActive
+}
 
-	// If SyncWindow.Active() is called outside of a UTC locale, it should be
-	// first converted to UTC before search
-	currentTime = currentTime.UTC()
+// isActive returns true if the window is open at the given time. The schedule is evaluated in the time zone of the
+// window regardless of the locale of the given time.
+func (w *SyncWindow) isActive(currentTime time.Time) (bool, error) {
+	loc, err := loadTimeZone(w.TimeZone)
+	if err != nil {
+		return false, err
+	}
+	currentTime = currentTime.In(loc)
 
-	specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
-	schedule, _ := specParser.Parse(w.Schedule)
-	duration, _ := time.ParseDuration(w.Duration)
+	if w.Calendar != "" {
+		calendar, err := ical.ParseCached(w.Calendar, loc)
+		if err != nil {
+			return false, err
+		}
+		return calendar.Contains(currentTime), nil
+	}
 
+	schedule, duration, err := w.parseSchedule()
+	if err != nil {
+		return false, err
+	}
 	nextWindow := schedule.Next(currentTime.Add(-duration))
-	return nextWindow.Before(currentTime)
+	return nextWindow.Before(currentTime), nil
+}
+
+func (w *SyncWindow) parseSchedule() (cron.Schedule, time.Duration, error) {
+	specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+	schedule, err := specParser.Parse(w.Schedule)
+	if err != nil {
+		return nil, 0, fmt.Errorf("cannot parse schedule '%s': %s", w.Schedule, err)
+	}
+	duration, err := time.ParseDuration(w.Duration)
+	if err != nil {
+		return nil, 0, fmt.Errorf("cannot parse duration '%s': %s", w.Duration, err)
+	}
+	return schedule, duration, nil
+}
+
+// Upcoming returns up to count periods of the window which end after the given time, ordered by start time.
+// Overlapping periods of the schedule are merged.
+func (w *SyncWindow) Upcoming(currentTime time.Time, count int) ([]ical.Period, error) {
+	loc, err := loadTimeZone(w.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+	currentTime = currentTime.In(loc)
+
+	if w.Calendar != "" {
+		calendar, err := ical.ParseCached(w.Calendar, loc)
+		if err != nil {
+			return nil, err
+		}
+		return calendar.Upcoming(currentTime, count), nil
+	}
+
+	schedule, duration, err := w.parseSchedule()
+	if err != nil {
+		return nil, err
+	}
+	var periods []ical.Period
+	start := schedule.Next(currentTime.Add(-duration))
+	// limit the iterations in case the periods keep overlapping, e.g. a long window opening every minute
+	for i := 0; i < maxScheduleIterations && !start.IsZero(); i++ {
+		end := start.Add(duration)
+		if last := len(periods) - 1; last >= 0 && !start.After(periods[last].End) {
+			if end.After(periods[last].End) {
+				periods[last].End = end
+			}
+		} else {
+			if len(periods) == count {
+				break
+			}
+			periods = append(periods, ical.Period{Start: start, End: end})
+		}
+		start = schedule.Next(start)
+	}
+	return periods, nil
 }
 
-func (w *SyncWindow) Update(s string, d string, a []string, n []string, c []string) error {
-	if len(s) == 0 && len(d) == 0 && len(a) == 0 && len(n) == 0 && len(c) == 0 {
-		return fmt.Errorf("cannot update: require one or more of schedule, duration, application, namespace, or cluster")
+func (w *SyncWindow) Update(s string, d string, a []string, n []string, c []string, tz string, cal string) error {
+	if len(s) == 0 && len(d) == 0 && len(a) == 0 && len(n) == 0 && len(c) == 0 && len(tz) == 0 && len(cal) == 0 {
+		return fmt.Errorf("cannot update: require one or more of schedule, duration, application, namespace, cluster, time zone or calendar")
 	}
 
 	if len(s) > 0 {
@@ -2210,6 +2670,16 @@ func (w *SyncWindow) Update(s string, d string, a []string, n []string, c []stri
 		w.Duration = d
 	}
 
+	if len(tz) > 0 {
+		w.TimeZone = tz
+	}
+
+	if len(cal) > 0 {
+		w.Calendar = cal
+		w.Schedule = ""
+		w.Duration = ""
+	}
+
 	if len(a) > 0 {
 		w.Applications = a
 	}
@@ -2227,16 +2697,21 @@ func (w *SyncWindow) Validate() error {
 	if w.Kind != "allow" && w.Kind != "deny" {
 		return fmt.Errorf("kind '%s' mismatch: can only be allow or deny", w.Kind)
 	}
-	specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
-	_, err := specParser.Parse(w.Schedule)
+	loc, err := loadTimeZone(w.TimeZone)
 	if err != nil {
-		return fmt.Errorf("cannot parse schedule '%s': %s", w.Schedule, err)
+		return fmt.Errorf("cannot parse time zone '%s': %s", w.TimeZone, err)
 	}
-	_, err = time.ParseDuration(w.Duration)
-	if err != nil {
-		return fmt.Errorf("cannot parse duration '%s': %s", w.Duration, err)
+	if w.Calendar != "" {
+		if w.Schedule != "" || w.Duration != "" {
+			return fmt.Errorf("calendar cannot be combined with schedule and duration")
+		}
+		if _, err := ical.Parse(w.Calendar, loc); err != nil {
+			return fmt.Errorf("cannot parse calendar: %s", err)
+		}
+		return nil
 	}
-	return nil
+	_, _, err = w.parseSchedule()
+	return err
 }
 
 func (d AppProjectSpec) DestinationClusters() []string {
@@ -2555,6 +3030,30 @@ func (proj AppProject) IsSourcePermitted(src ApplicationSource) bool {
 	return false
 }
 
+// IsHelmValueURLPermitted validates if the provided URL of a remote Helm value file is one of the allowed URLs for the
+// project. Only HTTPS URLs are permitted.
+func (proj AppProject) IsHelmValueURLPermitted(valueURL string) bool {
+	return MatchHelmValueURL(proj.Spec.HelmValueURLs, valueURL)
+}
+
+// IsHelmValueURL returns whether a Helm value file is a remote file, i.e. an HTTPS URL. All other value files are paths.
+func IsHelmValueURL(valueFile string) bool {
+	return strings.HasPrefix(strings.ToLower(valueFile), "https://")
+}
+
+// MatchHelmValueURL returns whether the URL of a remote Helm value file is an HTTPS URL matching one of the patterns
+func MatchHelmValueURL(patterns []string, valueURL string) bool {
+	if !IsHelmValueURL(valueURL) {
+		return false
+	}
+	for _, pattern := range patterns {
+		if globMatch(pattern, valueURL, '/') {
+			return true
+		}
+	}
+	return false
+}
+
 // IsDestinationPermitted validates if the provided application's destination is one of the allowed destinations for the project
 func (proj AppProject) IsDestinationPermitted(dst ApplicationDestination) bool {
 	for _, item := range proj.Spec.Destinations {
@@ -56,6 +56,37 @@ func TestAppProject_IsSourcePermitted(t *testing.T) {
 	}
 }
 
+func TestAppProject_IsHelmValueURLPermitted(t *testing.T) {
+	testData := []struct {
+		projURLs    []string
+		valueURL    string
+		isPermitted bool
+	}{{
+		projURLs: nil, valueURL: "https://example.com/values.yaml", isPermitted: false,
+	}, {
+		projURLs: []string{"https://example.com/values.yaml"}, valueURL: "https://example.com/values.yaml", isPermitted: true,
+	}, {
+		projURLs: []string{"https://example.com/*"}, valueURL: "https://example.com/values.yaml", isPermitted: true,
+	}, {
+		projURLs: []string{"https://example.com/*"}, valueURL: "https://example.com/team/values.yaml", isPermitted: false,
+	}, {
+		projURLs: []string{"https://example.com/**"}, valueURL: "https://example.com/team/values.yaml", isPermitted: true,
+	}, {
+		projURLs: []string{"*"}, valueURL: "http://example.com/values.yaml", isPermitted: false,
+	}, {
+		projURLs: []string{"**"}, valueURL: "file:///etc/passwd", isPermitted: false,
+	}}
+
+	for _, data := range testData {
+		proj := AppProject{
+			Spec: AppProjectSpec{
+				HelmValueURLs: data.projURLs,
+			},
+		}
+		assert.Equal(t, data.isPermitted, proj.IsHelmValueURLPermitted(data.valueURL), data.valueURL)
+	}
+}
+
 func TestAppProject_IsDestinationPermitted(t *testing.T) {
 	testData := []struct {
 		projDest    []ApplicationDestination
@@ -265,6 +296,14 @@ func TestAppProject_ValidateRoleName(t *testing.T) {
 	}
 }
 
+func TestAppProject_ValidateHelmValueURLs(t *testing.T) {
+	p := newTestProject()
+	p.Spec.HelmValueURLs = []string{"https://example.com/**"}
+	assert.NoError(t, p.ValidateProject())
+	p.Spec.HelmValueURLs = []string{"http://example.com/**"}
+	assert.EqualError(t, p.ValidateProject(), "rpc error: code = InvalidArgument desc = helm value URL 'http://example.com/**' must be an HTTPS URL")
+}
+
 // TestValidateGroupName tests for an invalid group name
 func TestAppProject_ValidateGroupName(t *testing.T) {
 	p := newTestProject()
@@ -361,6 +400,9 @@ func TestAppProject_ValidPolicyRules(t *testing.T) {
 		"p, proj:my-proj:my-role, applications, delete, my-proj/foo, allow",
 		"p, proj:my-proj:my-role, applications, action/*, my-proj/foo, allow",
 		"p, proj:my-proj:my-role, applications, action/apps/Deployment/restart, my-proj/foo, allow",
+		"p, proj:my-proj:my-role, applications, action/apps/Deployment/restart/default/*, my-proj/foo, allow",
+		"p, proj:my-proj:my-role, applications, delete/*/Secret/*/*, my-proj/foo, deny",
+		"p, proj:my-proj:my-role, applications, get//Pod/*/guestbook-*, my-proj/foo, allow",
 	}
 	for _, good := range goodPolicies {
 		p.Spec.Roles[0].Policies = []string{good}
@@ -866,6 +908,35 @@ func TestNewHelmParameter(t *testing.T) {
 	})
 }
 
+func TestParseHelmValuesRepoRef(t *testing.T) {
+	tests := []struct {
+		valueFile string
+		alias     string
+		path      string
+		ok        bool
+	}{
+		{"values.yaml", "", "", false},
+		{"https://example.com/values.yaml", "", "", false},
+		{"$values/envs/prod.yaml", "values", "envs/prod.yaml", true},
+		{"$values", "values", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.valueFile, func(t *testing.T) {
+			alias, path, ok := ParseHelmValuesRepoRef(tt.valueFile)
+			assert.Equal(t, tt.alias, alias)
+			assert.Equal(t, tt.path, path)
+			assert.Equal(t, tt.ok, ok)
+		})
+	}
+}
+
+func TestValidateHelmValuesRepoAlias(t *testing.T) {
+	assert.NoError(t, ValidateHelmValuesRepoAlias("team-values_1"))
+	assert.Error(t, ValidateHelmValuesRepoAlias(""))
+	assert.Error(t, ValidateHelmValuesRepoAlias("../values"))
+	assert.Error(t, ValidateHelmValuesRepoAlias("$values"))
+}
+
 func TestApplicationSourceHelm_IsZero(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -878,6 +949,7 @@ func TestApplicationSourceHelm_IsZero(t *testing.T) {
 		{"Parameters", &ApplicationSourceHelm{Parameters: []HelmParameter{{}}}, false},
 		{"ReleaseName", &ApplicationSourceHelm{ReleaseName: "foa"}, false},
 		{"FileParameters", &ApplicationSourceHelm{FileParameters: []HelmFileParameter{{}}}, false},
+		{"ValuesRepos", &ApplicationSourceHelm{ValuesRepos: []HelmValuesRepo{{}}}, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1363,9 +1435,9 @@ func TestAppProjectSpec_AddWindow(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			switch tt.want {
 			case "error":
-				assert.Error(t, tt.p.Spec.AddWindow(tt.k, tt.s, tt.d, tt.a, tt.n, tt.c, tt.m))
+				assert.Error(t, tt.p.Spec.AddWindow(tt.k, tt.s, tt.d, tt.a, tt.n, tt.c, tt.m, "", ""))
 			case "noError":
-				assert.NoError(t, tt.p.Spec.AddWindow(tt.k, tt.s, tt.d, tt.a, tt.n, tt.c, tt.m))
+				assert.NoError(t, tt.p.Spec.AddWindow(tt.k, tt.s, tt.d, tt.a, tt.n, tt.c, tt.m, "", ""))
 				assert.NoError(t, tt.p.Spec.DeleteWindow(0))
 			}
 		})
@@ -1699,31 +1771,31 @@ func TestSyncWindow_Active(t *testing.T) {
 func TestSyncWindow_Update(t *testing.T) {
 	e := SyncWindow{Kind: "allow", Schedule: "* * * * *", Duration: "1h", Applications: []string{"app1"}}
 	t.Run("AddApplication", func(t *testing.T) {
-		err := e.Update("", "", []string{"app1", "app2"}, []string{}, []string{})
+		err := e.Update("", "", []string{"app1", "app2"}, []string{}, []string{}, "", "")
 		assert.NoError(t, err)
 		assert.Equal(t, []string{"app1", "app2"}, e.Applications)
 	})
 	t.Run("AddNamespace", func(t *testing.T) {
-		err := e.Update("", "", []string{}, []string{"namespace1"}, []string{})
+		err := e.Update("", "", []string{}, []string{"namespace1"}, []string{}, "", "")
 		assert.NoError(t, err)
 		assert.Equal(t, []string{"namespace1"}, e.Namespaces)
 	})
 	t.Run("AddCluster", func(t *testing.T) {
-		err := e.Update("", "", []string{}, []string{}, []string{"cluster1"})
+		err := e.Update("", "", []string{}, []string{}, []string{"cluster1"}, "", "")
 		assert.NoError(t, err)
 		assert.Equal(t, []string{"cluster1"}, e.Clusters)
 	})
 	t.Run("MissingConfig", func(t *testing.T) {
-		err := e.Update("", "", []string{}, []string{}, []string{})
-		assert.EqualError(t, err, "cannot update: require one or more of schedule, duration, application, namespace, or cluster")
+		err := e.Update("", "", []string{}, []string{}, []string{}, "", "")
+		assert.EqualError(t, err, "cannot update: require one or more of schedule, duration, application, namespace, cluster, time zone or calendar")
 	})
 	t.Run("ChangeDuration", func(t *testing.T) {
-		err := e.Update("", "10h", []string{}, []string{}, []string{})
+		err := e.Update("", "10h", []string{}, []string{}, []string{}, "", "")
 		assert.NoError(t, err)
 		assert.Equal(t, "10h", e.Duration)
 	})
 	t.Run("ChangeSchedule", func(t *testing.T) {
-		err := e.Update("* 1 0 0 *", "", []string{}, []string{}, []string{})
+		err := e.Update("* 1 0 0 *", "", []string{}, []string{}, []string{}, "", "")
 		assert.NoError(t, err)
 		assert.Equal(t, "* 1 0 0 *", e.Schedule)
 	})
@@ -1751,6 +1823,109 @@ func TestSyncWindow_Validate(t *testing.T) {
 	})
 }
 
+func TestSyncWindow_ValidateTimeZoneAndCalendar(t *testing.T) {
+	t.Run("TimeZone", func(t *testing.T) {
+		window := &SyncWindow{Kind: "allow", Schedule: "* * * * *", Duration: "1h", TimeZone: "Europe/Berlin"}
+		assert.NoError(t, window.Validate())
+		window.TimeZone = "Mars/Olympus"
+		assert.Error(t, window.Validate())
+	})
+	t.Run("Calendar", func(t *testing.T) {
+		window := &SyncWindow{Kind: "deny", Calendar: testFreezeCalendar}
+		assert.NoError(t, window.Validate())
+		window.Schedule = "* * * * *"
+		assert.Error(t, window.Validate())
+	})
+	t.Run("IncorrectCalendar", func(t *testing.T) {
+		window := &SyncWindow{Kind: "deny", Calendar: "BEGIN:VCALENDAR\nBEGIN:VEVENT\nSUMMARY:no start\nEND:VEVENT\nEND:VCALENDAR"}
+		assert.Error(t, window.Validate())
+	})
+}
+
+const testFreezeCalendar = `BEGIN:VCALENDAR
+BEGIN:VEVENT
+SUMMARY:Year end freeze
+DTSTART;VALUE=DATE:20201220
+DTEND;VALUE=DATE:20210104
+END:VEVENT
+END:VCALENDAR`
+
+func TestSyncWindow_ActiveTimeZone(t *testing.T) {
+	window := SyncWindow{Kind: "deny", Schedule: "0 22 * * *", Duration: "1h", TimeZone: "America/New_York"}
+	// 10:30PM EDT
+	assert.True(t, window.active(time.Date(2021, 7, 16, 2, 30, 0, 0, time.UTC)))
+	assert.False(t, window.active(time.Date(2021, 7, 15, 22, 30, 0, 0, time.UTC)))
+	// 10:30PM EST
+	assert.True(t, window.active(time.Date(2021, 1, 16, 3, 30, 0, 0, time.UTC)))
+
+	window.TimeZone = "Mars/Olympus"
+	assert.False(t, window.active(time.Date(2021, 7, 16, 2, 30, 0, 0, time.UTC)))
+}
+
+func TestSyncWindow_ActiveCalendar(t *testing.T) {
+	window := SyncWindow{Kind: "deny", Calendar: testFreezeCalendar, TimeZone: "Europe/Berlin"}
+	assert.True(t, window.active(time.Date(2020, 12, 24, 12, 0, 0, 0, time.UTC)))
+	// the all-day events start at midnight of the window time zone
+	assert.True(t, window.active(time.Date(2020, 12, 19, 23, 30, 0, 0, time.UTC)))
+	assert.False(t, window.active(time.Date(2021, 1, 4, 12, 0, 0, 0, time.UTC)))
+
+	windows := SyncWindows{&window}
+	assert.Len(t, *windows.active(time.Date(2020, 12, 24, 12, 0, 0, 0, time.UTC)), 1)
+	assert.Nil(t, windows.active(time.Date(2021, 1, 4, 12, 0, 0, 0, time.UTC)))
+}
+
+func TestSyncWindow_Upcoming(t *testing.T) {
+	currentTime := time.Date(2021, 7, 15, 12, 0, 0, 0, time.UTC)
+	t.Run("Schedule", func(t *testing.T) {
+		window := &SyncWindow{Kind: "allow", Schedule: "0 22 * * *", Duration: "1h", TimeZone: "Europe/Berlin"}
+		periods, err := window.Upcoming(currentTime, 2)
+		assert.NoError(t, err)
+		if assert.Len(t, periods, 2) {
+			assert.Equal(t, time.Date(2021, 7, 15, 20, 0, 0, 0, time.UTC), periods[0].Start.UTC())
+			assert.Equal(t, time.Date(2021, 7, 15, 21, 0, 0, 0, time.UTC), periods[0].End.UTC())
+			assert.Equal(t, time.Date(2021, 7, 16, 20, 0, 0, 0, time.UTC), periods[1].Start.UTC())
+		}
+	})
+	t.Run("OverlappingSchedule", func(t *testing.T) {
+		window := &SyncWindow{Kind: "allow", Schedule: "*/30 * * * *", Duration: "1h"}
+		periods, err := window.Upcoming(currentTime, 2)
+		assert.NoError(t, err)
+		assert.Len(t, periods, 1)
+	})
+	t.Run("Calendar", func(t *testing.T) {
+		window := &SyncWindow{Kind: "deny", Calendar: testFreezeCalendar}
+		periods, err := window.Upcoming(time.Date(2020, 12, 1, 0, 0, 0, 0, time.UTC), 2)
+		assert.NoError(t, err)
+		if assert.Len(t, periods, 1) {
+			assert.Equal(t, time.Date(2020, 12, 20, 0, 0, 0, 0, time.UTC), periods[0].Start)
+		}
+	})
+}
+
+func TestSyncSchedule_Next(t *testing.T) {
+	schedule := SyncSchedule{Schedule: "0 2 * * *", TimeZone: "Europe/Berlin"}
+	next, err := schedule.Next(time.Date(2021, 7, 15, 12, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2021, 7, 16, 0, 0, 0, 0, time.UTC), next.UTC())
+
+	assert.Error(t, (&SyncSchedule{Schedule: "* * *"}).Validate())
+	assert.Error(t, (&SyncSchedule{Schedule: "* * * * *", TimeZone: "Mars/Olympus"}).Validate())
+}
+
+func TestAppProjectSpec_MatchingSyncSchedules(t *testing.T) {
+	app := newTestApp()
+	spec := AppProjectSpec{SyncSchedules: []SyncSchedule{
+		{Schedule: "0 1 * * *", Applications: []string{"test-*"}},
+		{Schedule: "0 2 * * *", Namespaces: []string{"default"}},
+		{Schedule: "0 3 * * *", Clusters: []string{"other"}},
+	}}
+	schedules := spec.MatchingSyncSchedules(app)
+	if assert.Len(t, schedules, 2) {
+		assert.Equal(t, "0 1 * * *", schedules[0].Schedule)
+		assert.Equal(t, "0 2 * * *", schedules[1].Schedule)
+	}
+}
+
 func TestApplicationStatus_GetConditions(t *testing.T) {
 	status := ApplicationStatus{
 		Conditions: []ApplicationCondition{
@@ -1987,6 +2162,24 @@ func TestSyncPolicy_IsZero(t *testing.T) {
 	assert.True(t, (&SyncPolicy{}).IsZero())
 	assert.False(t, (&SyncPolicy{Automated: &SyncPolicyAutomated{}}).IsZero())
 	assert.False(t, (&SyncPolicy{SyncOptions: SyncOptions{""}}).IsZero())
+	assert.False(t, (&SyncPolicy{SyncTimeout: "30m"}).IsZero())
+}
+
+func TestOperation_GetSyncTimeout(t *testing.T) {
+	timeout, err := (&Operation{}).GetSyncTimeout()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Duration(0), timeout)
+
+	timeout, err = (&Operation{SyncTimeout: "30m"}).GetSyncTimeout()
+	assert.NoError(t, err)
+	assert.Equal(t, 30*time.Minute, timeout)
+
+	timeout, err = (&Operation{SyncTimeout: "90"}).GetSyncTimeout()
+	assert.NoError(t, err)
+	assert.Equal(t, 90*time.Second, timeout)
+
+	_, err = (&Operation{SyncTimeout: "abc"}).GetSyncTimeout()
+	assert.Error(t, err)
 }
 
 func TestSyncOptions_HasOption(t *testing.T) {
@@ -2132,6 +2325,32 @@ func TestRetryStrategy_NextRetryAtCustomBackoff(t *testing.T) {
 	}
 }
 
+func TestRetryStrategy_ShouldRetry(t *testing.T) {
+	retry := RetryStrategy{Limit: 5}
+	assert.True(t, retry.ShouldRetry(nil))
+	assert.True(t, retry.ShouldRetry([]SyncFailureClass{SyncFailureClassValidation}))
+
+	retry.DoNotRetryOn = []SyncFailureClass{SyncFailureClassValidation, SyncFailureClassRBAC}
+	assert.True(t, retry.ShouldRetry([]SyncFailureClass{SyncFailureClassTransient}))
+	assert.False(t, retry.ShouldRetry([]SyncFailureClass{SyncFailureClassTransient, SyncFailureClassRBAC}))
+
+	retry = RetryStrategy{Limit: 5, RetryOn: []SyncFailureClass{SyncFailureClassTransient, SyncFailureClassConflict}}
+	assert.True(t, retry.ShouldRetry([]SyncFailureClass{SyncFailureClassTransient, SyncFailureClassConflict}))
+	assert.False(t, retry.ShouldRetry([]SyncFailureClass{SyncFailureClassTransient, SyncFailureClassHookFailed}))
+	assert.False(t, retry.ShouldRetry(nil))
+}
+
+func TestResourceResults_FailureClasses(t *testing.T) {
+	results := ResourceResults{
+		{Name: "a", FailureClass: SyncFailureClassConflict},
+		{Name: "b"},
+		{Name: "c", FailureClass: SyncFailureClassTransient},
+		{Name: "d", FailureClass: SyncFailureClassConflict},
+	}
+	assert.Equal(t, []SyncFailureClass{SyncFailureClassConflict, SyncFailureClassTransient}, results.FailureClasses())
+	assert.Nil(t, ResourceResults{}.FailureClasses())
+}
+
 func TestSourceAllowsConcurrentProcessing_KsonnetNoParams(t *testing.T) {
 	src := ApplicationSource{Path: "."}
 
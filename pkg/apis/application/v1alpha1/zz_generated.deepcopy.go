@@ -148,6 +148,18 @@ func (in *AppProjectSpec) DeepCopyInto(out *AppProjectSpec) {
 		*out = make([]v1.GroupKind, len(*in))
 		copy(*out, *in)
 	}
+	if in.SyncSchedules != nil {
+		in, out := &in.SyncSchedules, &out.SyncSchedules
+		*out = make([]SyncSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HelmValueURLs != nil {
+		in, out := &in.HelmValueURLs, &out.HelmValueURLs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -362,6 +374,21 @@ func (in *ApplicationSourceHelm) DeepCopyInto(out *ApplicationSourceHelm) {
 		*out = make([]HelmFileParameter, len(*in))
 		copy(*out, *in)
 	}
+	if in.APIVersions != nil {
+		in, out := &in.APIVersions, &out.APIVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PostRenderer != nil {
+		in, out := &in.PostRenderer, &out.PostRenderer
+		*out = new(HelmPostRenderer)
+		**out = **in
+	}
+	if in.ValuesRepos != nil {
+		in, out := &in.ValuesRepos, &out.ValuesRepos
+		*out = make([]HelmValuesRepo, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -516,6 +543,16 @@ func (in *ApplicationSpec) DeepCopyInto(out *ApplicationSpec) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.HydrateTo != nil {
+		in, out := &in.HydrateTo, &out.HydrateTo
+		*out = new(HydrateTo)
+		**out = **in
+	}
 	return
 }
 
@@ -569,6 +606,11 @@ func (in *ApplicationStatus) DeepCopyInto(out *ApplicationStatus) {
 		*out = (*in).DeepCopy()
 	}
 	in.Summary.DeepCopyInto(&out.Summary)
+	if in.Hydrator != nil {
+		in, out := &in.Hydrator, &out.Hydrator
+		*out = new(HydratorStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -703,6 +745,11 @@ func (in *Cluster) DeepCopyInto(out *Cluster) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.NamespaceDiscovery != nil {
+		in, out := &in.NamespaceDiscovery, &out.NamespaceDiscovery
+		*out = new(ClusterNamespaceDiscovery)
+		**out = **in
+	}
 	return
 }
 
@@ -805,6 +852,22 @@ func (in *ClusterList) DeepCopy() *ClusterList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterNamespaceDiscovery) DeepCopyInto(out *ClusterNamespaceDiscovery) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterNamespaceDiscovery.
+func (in *ClusterNamespaceDiscovery) DeepCopy() *ClusterNamespaceDiscovery {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterNamespaceDiscovery)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Command) DeepCopyInto(out *Command) {
 	*out = *in
@@ -1063,6 +1126,38 @@ func (in *HelmParameter) DeepCopy() *HelmParameter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmPostRenderer) DeepCopyInto(out *HelmPostRenderer) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmPostRenderer.
+func (in *HelmPostRenderer) DeepCopy() *HelmPostRenderer {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmPostRenderer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmValuesRepo) DeepCopyInto(out *HelmValuesRepo) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmValuesRepo.
+func (in *HelmValuesRepo) DeepCopy() *HelmValuesRepo {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmValuesRepo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostInfo) DeepCopyInto(out *HostInfo) {
 	*out = *in
@@ -1101,6 +1196,42 @@ func (in *HostResourceInfo) DeepCopy() *HostResourceInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HydrateTo) DeepCopyInto(out *HydrateTo) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HydrateTo.
+func (in *HydrateTo) DeepCopy() *HydrateTo {
+	if in == nil {
+		return nil
+	}
+	out := new(HydrateTo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HydratorStatus) DeepCopyInto(out *HydratorStatus) {
+	*out = *in
+	if in.HydratedAt != nil {
+		in, out := &in.HydratedAt, &out.HydratedAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HydratorStatus.
+func (in *HydratorStatus) DeepCopy() *HydratorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HydratorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Info) DeepCopyInto(out *Info) {
 	*out = *in
@@ -1318,6 +1449,11 @@ func (in *OperationState) DeepCopyInto(out *OperationState) {
 		in, out := &in.FinishedAt, &out.FinishedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.FailureClasses != nil {
+		in, out := &in.FailureClasses, &out.FailureClasses
+		*out = make([]SyncFailureClass, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1824,6 +1960,10 @@ func (in *ResourceRef) DeepCopy() *ResourceRef {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceResult) DeepCopyInto(out *ResourceResult) {
 	*out = *in
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -1846,7 +1986,7 @@ func (in ResourceResults) DeepCopyInto(out *ResourceResults) {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
 				*out = new(ResourceResult)
-				**out = **in
+				(*in).DeepCopyInto(*out)
 			}
 		}
 		return
@@ -1892,6 +2032,16 @@ func (in *RetryStrategy) DeepCopyInto(out *RetryStrategy) {
 		*out = new(Backoff)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RetryOn != nil {
+		in, out := &in.RetryOn, &out.RetryOn
+		*out = make([]SyncFailureClass, len(*in))
+		copy(*out, *in)
+	}
+	if in.DoNotRetryOn != nil {
+		in, out := &in.DoNotRetryOn, &out.DoNotRetryOn
+		*out = make([]SyncFailureClass, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2054,11 +2204,15 @@ func (in *SyncOperationResult) DeepCopyInto(out *SyncOperationResult) {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
 				*out = new(ResourceResult)
-				**out = **in
+				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
 	in.Source.DeepCopyInto(&out.Source)
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -2110,6 +2264,13 @@ func (in *SyncPolicy) DeepCopyInto(out *SyncPolicy) {
 		*out = new(RetryStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Schedules != nil {
+		in, out := &in.Schedules, &out.Schedules
+		*out = make([]SyncSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2139,6 +2300,37 @@ func (in *SyncPolicyAutomated) DeepCopy() *SyncPolicyAutomated {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncSchedule) DeepCopyInto(out *SyncSchedule) {
+	*out = *in
+	if in.Applications != nil {
+		in, out := &in.Applications, &out.Applications
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncSchedule.
+func (in *SyncSchedule) DeepCopy() *SyncSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncStatus) DeepCopyInto(out *SyncStatus) {
 	*out = *in
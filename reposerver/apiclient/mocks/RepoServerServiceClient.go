@@ -198,3 +198,33 @@ func (_m *RepoServerServiceClient) ListRefs(ctx context.Context, in *apiclient.L
 
 	return r0, r1
 }
+
+// Warmup provides a mock function with given fields: ctx, in, opts
+func (_m *RepoServerServiceClient) Warmup(ctx context.Context, in *apiclient.WarmupRequest, opts ...grpc.CallOption) (*apiclient.WarmupResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *apiclient.WarmupResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *apiclient.WarmupRequest, ...grpc.CallOption) *apiclient.WarmupResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*apiclient.WarmupResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *apiclient.WarmupRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
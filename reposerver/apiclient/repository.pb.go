@@ -47,7 +47,13 @@ type ManifestRequest struct {
 	KubeVersion       string                             `protobuf:"bytes,14,opt,name=kubeVersion,proto3" json:"kubeVersion,omitempty"`
 	ApiVersions       []string                           `protobuf:"bytes,15,rep,name=apiVersions,proto3" json:"apiVersions,omitempty"`
 	// Request to verify the signature when generating the manifests (only for Git repositories)
-	VerifySignature      bool     `protobuf:"varint,16,opt,name=verifySignature,proto3" json:"verifySignature,omitempty"`
+	VerifySignature bool `protobuf:"varint,16,opt,name=verifySignature,proto3" json:"verifySignature,omitempty"`
+	// The method used to track the resources of the application: label, annotation or annotation+label
+	TrackingMethod string `protobuf:"bytes,17,opt,name=trackingMethod,proto3" json:"trackingMethod,omitempty"`
+	// Credentials of the values repositories of the Helm source, if they are configured
+	ValuesRepos []*v1alpha1.Repository `protobuf:"bytes,18,rep,name=valuesRepos,proto3" json:"valuesRepos,omitempty"`
+	// URL patterns of the remote Helm value files permitted by the project of the application
+	HelmValueURLs        []string `protobuf:"bytes,19,rep,name=helmValueURLs,proto3" json:"helmValueURLs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -177,6 +183,27 @@ func (m *ManifestRequest) GetVerifySignature() bool {
 	return false
 }
 
+func (m *ManifestRequest) GetTrackingMethod() string {
+	if m != nil {
+		return m.TrackingMethod
+	}
+	return ""
+}
+
+func (m *ManifestRequest) GetValuesRepos() []*v1alpha1.Repository {
+	if m != nil {
+		return m.ValuesRepos
+	}
+	return nil
+}
+
+func (m *ManifestRequest) GetHelmValueURLs() []string {
+	if m != nil {
+		return m.HelmValueURLs
+	}
+	return nil
+}
+
 type ManifestResponse struct {
 	Manifests []string `protobuf:"bytes,1,rep,name=manifests,proto3" json:"manifests,omitempty"`
 	Namespace string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
@@ -773,10 +800,22 @@ type HelmAppSpec struct {
 	// the contents of values.yaml
 	Values string `protobuf:"bytes,5,opt,name=values,proto3" json:"values,omitempty"`
 	// helm file parameters
-	FileParameters       []*v1alpha1.HelmFileParameter `protobuf:"bytes,6,rep,name=fileParameters,proto3" json:"fileParameters,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
-	XXX_unrecognized     []byte                        `json:"-"`
-	XXX_sizecache        int32                         `json:"-"`
+	FileParameters []*v1alpha1.HelmFileParameter `protobuf:"bytes,6,rep,name=fileParameters,proto3" json:"fileParameters,omitempty"`
+	// whether the custom resource definitions of the chart are skipped
+	SkipCrds bool `protobuf:"varint,7,opt,name=skipCrds,proto3" json:"skipCrds,omitempty"`
+	// whether repository credentials are passed to the repositories of the chart dependencies
+	PassCredentials bool `protobuf:"varint,8,opt,name=passCredentials,proto3" json:"passCredentials,omitempty"`
+	// whether missing value files are ignored
+	IgnoreMissingValueFiles bool `protobuf:"varint,9,opt,name=ignoreMissingValueFiles,proto3" json:"ignoreMissingValueFiles,omitempty"`
+	// the Kubernetes version overriding the version of the destination cluster
+	KubeVersion string `protobuf:"bytes,10,opt,name=kubeVersion,proto3" json:"kubeVersion,omitempty"`
+	// the Kubernetes API versions overriding the versions of the destination cluster
+	ApiVersions []string `protobuf:"bytes,11,rep,name=apiVersions,proto3" json:"apiVersions,omitempty"`
+	// the post-renderer of the rendered manifests
+	PostRenderer         *v1alpha1.HelmPostRenderer `protobuf:"bytes,12,opt,name=postRenderer,proto3" json:"postRenderer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
 }
 
 func (m *HelmAppSpec) Reset()         { *m = HelmAppSpec{} }
@@ -847,6 +886,48 @@ func (m *HelmAppSpec) GetFileParameters() []*v1alpha1.HelmFileParameter {
 	return nil
 }
 
+func (m *HelmAppSpec) GetSkipCrds() bool {
+	if m != nil {
+		return m.SkipCrds
+	}
+	return false
+}
+
+func (m *HelmAppSpec) GetPassCredentials() bool {
+	if m != nil {
+		return m.PassCredentials
+	}
+	return false
+}
+
+func (m *HelmAppSpec) GetIgnoreMissingValueFiles() bool {
+	if m != nil {
+		return m.IgnoreMissingValueFiles
+	}
+	return false
+}
+
+func (m *HelmAppSpec) GetKubeVersion() string {
+	if m != nil {
+		return m.KubeVersion
+	}
+	return ""
+}
+
+func (m *HelmAppSpec) GetApiVersions() []string {
+	if m != nil {
+		return m.ApiVersions
+	}
+	return nil
+}
+
+func (m *HelmAppSpec) GetPostRenderer() *v1alpha1.HelmPostRenderer {
+	if m != nil {
+		return m.PostRenderer
+	}
+	return nil
+}
+
 // KustomizeAppSpec contains kustomize images
 type KustomizeAppSpec struct {
 	// images is a list of available images.
@@ -1208,6 +1289,101 @@ func (m *HelmChartsResponse) GetItems() []*HelmChart {
 	return nil
 }
 
+// WarmupRequest is a request to fetch the repository or Helm chart of an application source into the disk cache
+type WarmupRequest struct {
+	Repo                 *v1alpha1.Repository        `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Source               *v1alpha1.ApplicationSource `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *WarmupRequest) Reset()         { *m = WarmupRequest{} }
+func (m *WarmupRequest) String() string { return proto.CompactTextString(m) }
+func (*WarmupRequest) ProtoMessage()    {}
+func (*WarmupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{18}
+}
+func (m *WarmupRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WarmupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WarmupRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WarmupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WarmupRequest.Merge(m, src)
+}
+func (m *WarmupRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WarmupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WarmupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WarmupRequest proto.InternalMessageInfo
+
+func (m *WarmupRequest) GetRepo() *v1alpha1.Repository {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+func (m *WarmupRequest) GetSource() *v1alpha1.ApplicationSource {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+type WarmupResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WarmupResponse) Reset()         { *m = WarmupResponse{} }
+func (m *WarmupResponse) String() string { return proto.CompactTextString(m) }
+func (*WarmupResponse) ProtoMessage()    {}
+func (*WarmupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{19}
+}
+func (m *WarmupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WarmupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WarmupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WarmupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WarmupResponse.Merge(m, src)
+}
+func (m *WarmupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *WarmupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WarmupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WarmupResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ManifestRequest)(nil), "repository.ManifestRequest")
 	proto.RegisterType((*ManifestResponse)(nil), "repository.ManifestResponse")
@@ -1229,6 +1405,8 @@ func init() {
 	proto.RegisterType((*HelmChartsRequest)(nil), "repository.HelmChartsRequest")
 	proto.RegisterType((*HelmChart)(nil), "repository.HelmChart")
 	proto.RegisterType((*HelmChartsResponse)(nil), "repository.HelmChartsResponse")
+	proto.RegisterType((*WarmupRequest)(nil), "repository.WarmupRequest")
+	proto.RegisterType((*WarmupResponse)(nil), "repository.WarmupResponse")
 }
 
 func init() {
@@ -1236,88 +1414,99 @@ func init() {
 }
 
 var fileDescriptor_dd8723cfcc820480 = []byte{
-	// 1292 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xdd, 0x6e, 0x1b, 0xc5,
-	0x17, 0xcf, 0xda, 0xce, 0x87, 0x8f, 0xdb, 0xc4, 0x99, 0xf6, 0xdf, 0xff, 0x62, 0x52, 0x2b, 0xac,
-	0x44, 0x15, 0x28, 0xb5, 0x69, 0xa8, 0xa0, 0x6a, 0xa5, 0x4a, 0xa1, 0x9f, 0x28, 0x29, 0x4d, 0x37,
-	0x80, 0xc4, 0x87, 0x54, 0x4d, 0xd6, 0x27, 0xeb, 0xc1, 0xeb, 0xdd, 0x61, 0x67, 0x6c, 0x94, 0xbe,
-	0x00, 0xdc, 0x23, 0x9e, 0x01, 0xf1, 0x08, 0xdc, 0x22, 0x84, 0xb8, 0xe0, 0x82, 0x47, 0x40, 0x7d,
-	0x12, 0x34, 0xb3, 0x5f, 0xe3, 0xb5, 0x93, 0x1b, 0x37, 0xed, 0x4d, 0x32, 0x73, 0xbe, 0xcf, 0x99,
-	0xdf, 0x9c, 0x39, 0x5e, 0xb8, 0x12, 0x23, 0x8f, 0x04, 0xc6, 0x63, 0x8c, 0xbb, 0x7a, 0xc9, 0x64,
-	0x14, 0x1f, 0x1b, 0xcb, 0x0e, 0x8f, 0x23, 0x19, 0x11, 0x28, 0x28, 0xad, 0x8b, 0x7e, 0xe4, 0x47,
-	0x9a, 0xdc, 0x55, 0xab, 0x44, 0xa2, 0xb5, 0xe1, 0x47, 0x91, 0x1f, 0x60, 0x97, 0x72, 0xd6, 0xa5,
-	0x61, 0x18, 0x49, 0x2a, 0x59, 0x14, 0x8a, 0x94, 0xeb, 0x0c, 0x6e, 0x8a, 0x0e, 0x8b, 0x34, 0xd7,
-	0x8b, 0x62, 0xec, 0x8e, 0xaf, 0x77, 0x7d, 0x0c, 0x31, 0xa6, 0x12, 0x7b, 0xa9, 0xcc, 0x27, 0x3e,
-	0x93, 0xfd, 0xd1, 0x61, 0xc7, 0x8b, 0x86, 0x5d, 0x1a, 0x6b, 0x17, 0xdf, 0xea, 0xc5, 0x35, 0xaf,
-	0xd7, 0xe5, 0x03, 0x5f, 0x29, 0x8b, 0x2e, 0xe5, 0x3c, 0x60, 0x9e, 0x36, 0xde, 0x1d, 0x5f, 0xa7,
-	0x01, 0xef, 0xd3, 0x29, 0x53, 0xce, 0xaf, 0x4b, 0xb0, 0xf6, 0x98, 0x86, 0xec, 0x08, 0x85, 0x74,
-	0xf1, 0xbb, 0x11, 0x0a, 0x49, 0xbe, 0x84, 0x9a, 0x4a, 0xc2, 0xb6, 0x36, 0xad, 0xad, 0xc6, 0xf6,
-	0xfd, 0x4e, 0xe1, 0xad, 0x93, 0x79, 0xd3, 0x8b, 0x67, 0x5e, 0xaf, 0xc3, 0x07, 0x7e, 0x47, 0x79,
-	0xeb, 0x18, 0xde, 0x3a, 0x99, 0xb7, 0x8e, 0x9b, 0xd7, 0xc2, 0xd5, 0x26, 0x49, 0x0b, 0x56, 0x62,
-	0x1c, 0x33, 0xc1, 0xa2, 0xd0, 0xae, 0x6c, 0x5a, 0x5b, 0x75, 0x37, 0xdf, 0x13, 0x1b, 0x96, 0xc3,
-	0xe8, 0x2e, 0xf5, 0xfa, 0x68, 0x57, 0x37, 0xad, 0xad, 0x15, 0x37, 0xdb, 0x92, 0x4d, 0x68, 0x50,
-	0xce, 0xf7, 0xe8, 0x21, 0x06, 0xbb, 0x78, 0x6c, 0xd7, 0xb4, 0xa2, 0x49, 0x52, 0xba, 0x94, 0xf3,
-	0x4f, 0xe9, 0x10, 0xed, 0x45, 0xcd, 0xcd, 0xb6, 0x64, 0x03, 0xea, 0x21, 0x1d, 0xa2, 0xe0, 0xd4,
-	0x43, 0x7b, 0x45, 0xf3, 0x0a, 0x02, 0x79, 0x0e, 0xeb, 0x46, 0xe0, 0x07, 0xd1, 0x28, 0xf6, 0xd0,
-	0x06, 0x9d, 0xf7, 0xde, 0x1c, 0x79, 0xef, 0x94, 0x6d, 0xba, 0xd3, 0x6e, 0xc8, 0xd7, 0xb0, 0xa8,
-	0xb1, 0x62, 0x37, 0x36, 0xab, 0x2f, 0xaf, 0xce, 0x89, 0x4d, 0x32, 0x80, 0x65, 0x1e, 0x8c, 0x7c,
-	0x16, 0x0a, 0xfb, 0x9c, 0x36, 0xff, 0x74, 0x0e, 0xf3, 0x77, 0xa3, 0xf0, 0x88, 0xf9, 0x8f, 0x69,
-	0x48, 0x7d, 0x1c, 0x62, 0x28, 0xf7, 0xb5, 0x65, 0x37, 0xf3, 0x40, 0xbe, 0x87, 0xe6, 0x60, 0x24,
-	0x64, 0x34, 0x64, 0xcf, 0xf1, 0x09, 0xd7, 0x68, 0xb6, 0xcf, 0xeb, 0x22, 0xee, 0xce, 0xe1, 0x75,
-	0xb7, 0x64, 0xd2, 0x9d, 0x72, 0xa2, 0x80, 0x31, 0x18, 0x1d, 0xe2, 0x17, 0x18, 0x6b, 0x44, 0xad,
-	0x26, 0xc0, 0x30, 0x48, 0x09, 0x74, 0x58, 0xba, 0x13, 0xf6, 0xda, 0x66, 0x35, 0x81, 0x4e, 0x4e,
-	0x22, 0x5b, 0xb0, 0x36, 0xc6, 0x98, 0x1d, 0x1d, 0x1f, 0x30, 0x3f, 0xa4, 0x72, 0x14, 0xa3, 0xdd,
-	0xd4, 0xf0, 0x2b, 0x93, 0x9d, 0x3f, 0x2d, 0x68, 0x16, 0x77, 0x45, 0xf0, 0x28, 0x14, 0x1a, 0x5f,
-	0xc3, 0x94, 0x26, 0x6c, 0x4b, 0x9b, 0x2f, 0x08, 0x93, 0xe8, 0xab, 0x94, 0xd1, 0x77, 0x09, 0x96,
-	0x92, 0x8e, 0xa2, 0x01, 0x5f, 0x77, 0xd3, 0xdd, 0xc4, 0x2d, 0xa9, 0x95, 0x6e, 0x49, 0x1b, 0x40,
-	0x68, 0xfc, 0x7c, 0x76, 0xcc, 0xd1, 0x5e, 0xd2, 0x5c, 0x83, 0x42, 0x1c, 0x38, 0x97, 0xc4, 0xed,
-	0xa2, 0x18, 0x05, 0xd2, 0x5e, 0xd6, 0x12, 0x13, 0x34, 0x27, 0x80, 0xb5, 0x3d, 0xa6, 0x72, 0x38,
-	0x12, 0x67, 0x7f, 0xe7, 0x9d, 0x0f, 0xa1, 0xa6, 0x3c, 0xa9, 0xac, 0x0e, 0x63, 0x1a, 0x7a, 0x7d,
-	0xcc, 0x0a, 0x95, 0xef, 0x09, 0x81, 0x9a, 0xa4, 0xbe, 0xb0, 0x2b, 0x9a, 0xae, 0xd7, 0xce, 0x8f,
-	0x56, 0x12, 0xe6, 0x0e, 0xe7, 0xe2, 0xf5, 0xb6, 0x26, 0x67, 0x04, 0xcb, 0x3b, 0x9c, 0xab, 0x60,
-	0xc8, 0x75, 0xa8, 0x51, 0xce, 0x93, 0x0c, 0x1a, 0xdb, 0x97, 0x3b, 0xc6, 0x03, 0x90, 0x8a, 0xa8,
-	0xff, 0xe2, 0x7e, 0x28, 0x95, 0x65, 0x25, 0xda, 0xfa, 0x08, 0xea, 0x39, 0x89, 0x34, 0xa1, 0x3a,
-	0xc0, 0x63, 0x9d, 0x40, 0xdd, 0x55, 0x4b, 0x72, 0x11, 0x16, 0xc7, 0x34, 0x18, 0x65, 0xf8, 0x48,
-	0x36, 0xb7, 0x2a, 0x37, 0x2d, 0xe7, 0xef, 0x2a, 0xbc, 0xa1, 0xe2, 0x3c, 0xd0, 0xb0, 0xd8, 0xe1,
-	0xfc, 0x1e, 0x4a, 0xca, 0x02, 0xf1, 0x74, 0x84, 0xf1, 0xf1, 0x59, 0xd6, 0xa2, 0x07, 0x4b, 0x09,
-	0xa4, 0x74, 0x4c, 0x2f, 0xbb, 0x17, 0xa6, 0xb6, 0x8b, 0x06, 0x58, 0x3d, 0x83, 0x06, 0x38, 0xab,
-	0x27, 0xd5, 0x5e, 0x45, 0x4f, 0x3a, 0xf1, 0x29, 0x72, 0x7e, 0xa8, 0xc0, 0x25, 0x15, 0x68, 0x71,
-	0x90, 0x79, 0x17, 0x51, 0xf8, 0x57, 0xf7, 0x39, 0x81, 0x85, 0x5e, 0x93, 0x1b, 0xb0, 0x3c, 0x10,
-	0x51, 0x18, 0xa2, 0x4c, 0x4f, 0xa1, 0x65, 0x82, 0x6d, 0x37, 0x61, 0xed, 0x70, 0x7e, 0xc0, 0xd1,
-	0x73, 0x33, 0x51, 0x72, 0x15, 0x6a, 0x7d, 0x0c, 0x86, 0xba, 0xa3, 0x34, 0xb6, 0xff, 0x6f, 0xaa,
-	0x3c, 0xc2, 0x60, 0x98, 0xc9, 0x6b, 0x21, 0x72, 0x0b, 0xea, 0x79, 0xfc, 0x69, 0x75, 0x36, 0x26,
-	0x9c, 0x64, 0xcc, 0x4c, 0xad, 0x10, 0x57, 0xba, 0x3d, 0x16, 0xa3, 0xa7, 0x04, 0x75, 0xa6, 0x25,
-	0xdd, 0x7b, 0x19, 0x33, 0xd7, 0xcd, 0xc5, 0x9d, 0xdf, 0x2d, 0x78, 0xab, 0x00, 0xb6, 0x9b, 0x5e,
-	0xb3, 0xc7, 0x28, 0x69, 0x8f, 0x4a, 0xfa, 0x9a, 0xe7, 0x90, 0x2b, 0xb0, 0xea, 0xf5, 0xd1, 0x1b,
-	0x14, 0xef, 0x41, 0x32, 0x8e, 0x94, 0xa8, 0xce, 0x1f, 0x15, 0x58, 0x9d, 0x3c, 0x05, 0x75, 0x8c,
-	0xaa, 0xbb, 0x67, 0xc7, 0xa8, 0xd6, 0x64, 0x1f, 0xce, 0x61, 0x38, 0x66, 0x71, 0x14, 0xaa, 0xa7,
-	0x33, 0x03, 0xfb, 0x7b, 0x27, 0x9f, 0x65, 0xe7, 0xbe, 0x21, 0x9e, 0xf4, 0x91, 0x09, 0x0b, 0x64,
-	0x00, 0xc0, 0x69, 0x4c, 0x87, 0x28, 0x31, 0x56, 0xa0, 0xae, 0xce, 0x0b, 0xea, 0xc4, 0xfd, 0x7e,
-	0x66, 0xd3, 0x35, 0xcc, 0xb7, 0x9e, 0xc1, 0xfa, 0x54, 0x3c, 0x33, 0x9a, 0xd8, 0x0d, 0xb3, 0x89,
-	0x35, 0xb6, 0xdb, 0x33, 0xd2, 0x33, 0xcc, 0x98, 0x4d, 0xee, 0xb7, 0x0a, 0x34, 0x0c, 0x64, 0xce,
-	0xac, 0x61, 0x1b, 0x40, 0x2b, 0x3c, 0x60, 0x01, 0x26, 0x15, 0xac, 0xbb, 0x06, 0x85, 0xf4, 0x67,
-	0x54, 0xe4, 0xd1, 0x1c, 0x15, 0x51, 0xf1, 0xcc, 0x2c, 0x87, 0x7a, 0xb2, 0xb5, 0x5f, 0x91, 0x5e,
-	0xee, 0x74, 0x47, 0x24, 0xac, 0x1e, 0xb1, 0x00, 0xf7, 0x8b, 0x28, 0x96, 0x74, 0x14, 0x7b, 0x73,
-	0x46, 0xf1, 0xc0, 0x34, 0xea, 0x96, 0x7c, 0x38, 0xef, 0x42, 0xb3, 0x7c, 0x45, 0x55, 0x84, 0x6c,
-	0x48, 0xfd, 0xbc, 0x4e, 0xe9, 0xce, 0xf9, 0xd9, 0x02, 0x32, 0x7d, 0x12, 0x27, 0x95, 0x7b, 0x70,
-	0x53, 0x64, 0x53, 0x55, 0x72, 0x3f, 0x0c, 0x0a, 0xd9, 0x85, 0x46, 0x0f, 0x85, 0x64, 0xa1, 0x0e,
-	0x38, 0x6d, 0x1c, 0xef, 0x9c, 0x7e, 0xe4, 0xf7, 0x0a, 0x05, 0xd7, 0xd4, 0x76, 0x3e, 0x87, 0xcb,
-	0xa7, 0x4a, 0x1b, 0x53, 0x92, 0x35, 0x31, 0x25, 0x9d, 0x3a, 0x5b, 0x39, 0x04, 0x9a, 0xe5, 0x0e,
-	0xe4, 0x84, 0xb0, 0xae, 0x6a, 0x7a, 0xb7, 0x4f, 0x63, 0xf9, 0x2a, 0x26, 0x9f, 0xdb, 0x50, 0xcf,
-	0xfd, 0xcd, 0x2c, 0x74, 0x0b, 0x56, 0xc6, 0xd9, 0x68, 0x9a, 0x8c, 0x3e, 0xf9, 0xde, 0xd9, 0x01,
-	0x62, 0x06, 0x9b, 0x3e, 0x14, 0x57, 0x61, 0x91, 0x49, 0x1c, 0x66, 0xf3, 0xc7, 0xff, 0xca, 0xfd,
-	0x5d, 0x8b, 0xbb, 0x89, 0xcc, 0xf6, 0x2f, 0x35, 0x58, 0x2f, 0xda, 0xac, 0xfa, 0xcb, 0x3c, 0x24,
-	0x4f, 0xa0, 0xf9, 0x30, 0xfd, 0x15, 0x98, 0x4d, 0xb3, 0xe4, 0x4d, 0xd3, 0x4e, 0xe9, 0xf7, 0x60,
-	0x6b, 0x63, 0x36, 0x33, 0x89, 0xc8, 0x59, 0x20, 0xb7, 0x61, 0x25, 0x1b, 0x27, 0x27, 0x0d, 0x95,
-	0x86, 0xcc, 0x56, 0xd3, 0x64, 0x2a, 0x86, 0xb3, 0x40, 0xee, 0x24, 0xca, 0x6a, 0x40, 0x9a, 0x56,
-	0x36, 0x46, 0xbf, 0xd6, 0x85, 0x19, 0xa3, 0x96, 0xb3, 0x40, 0xbe, 0x81, 0xf3, 0x0f, 0x75, 0xeb,
-	0x4c, 0x9f, 0x54, 0xf2, 0xf6, 0xa4, 0x93, 0x13, 0xa6, 0xa7, 0x96, 0x53, 0x16, 0x9b, 0x7e, 0x95,
-	0x9d, 0x05, 0xf2, 0x93, 0x05, 0x17, 0x1e, 0xa2, 0x2c, 0xbf, 0x50, 0xe4, 0xda, 0x6c, 0x27, 0x27,
-	0xbc, 0x64, 0xad, 0xdd, 0xb9, 0x50, 0x35, 0x69, 0xd3, 0x59, 0x20, 0xfb, 0x3a, 0xe7, 0x02, 0x1d,
-	0xe4, 0xf2, 0x4c, 0x18, 0xe4, 0xa5, 0x6b, 0x9f, 0xc4, 0xce, 0xf2, 0xfc, 0xf8, 0xce, 0x5f, 0x2f,
-	0xda, 0xd6, 0x3f, 0x2f, 0xda, 0xd6, 0xbf, 0x2f, 0xda, 0xd6, 0x57, 0xef, 0x9f, 0xf6, 0x7d, 0xc1,
-	0xf8, 0x0e, 0x42, 0x39, 0xf3, 0x02, 0x86, 0xa1, 0x3c, 0x5c, 0xd2, 0x5f, 0x13, 0x3e, 0xf8, 0x2f,
-	0x00, 0x00, 0xff, 0xff, 0x73, 0xa9, 0x07, 0xe6, 0x26, 0x11, 0x00, 0x00,
+	// 1467 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x5b, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xc6, 0xce, 0xc5, 0xc7, 0xb9, 0x38, 0xd3, 0xfe, 0xdb, 0xad, 0xff, 0x69, 0x14, 0x56,
+	0x50, 0x05, 0x4a, 0x6d, 0x1a, 0x2a, 0x88, 0x5a, 0xa9, 0x52, 0x48, 0x6f, 0x28, 0x09, 0x4d, 0x37,
+	0xb4, 0x88, 0x8b, 0x54, 0x4d, 0xd6, 0x27, 0xeb, 0xc1, 0xeb, 0xdd, 0x61, 0x67, 0x6c, 0x94, 0x7e,
+	0x01, 0x78, 0x47, 0x7c, 0x21, 0x84, 0x10, 0x0f, 0x3c, 0xf4, 0x23, 0x54, 0x7d, 0xe4, 0x81, 0xcf,
+	0x80, 0x66, 0xf6, 0xea, 0xb5, 0x1d, 0x1e, 0xdc, 0xa4, 0x2f, 0xc9, 0xcc, 0xb9, 0xce, 0x39, 0xf3,
+	0x3b, 0x67, 0x8e, 0x17, 0xae, 0x85, 0xc8, 0x03, 0x81, 0x61, 0x1f, 0xc3, 0xa6, 0x5e, 0x32, 0x19,
+	0x84, 0x27, 0xb9, 0x65, 0x83, 0x87, 0x81, 0x0c, 0x08, 0x64, 0x94, 0xfa, 0x45, 0x37, 0x70, 0x03,
+	0x4d, 0x6e, 0xaa, 0x55, 0x24, 0x51, 0x5f, 0x75, 0x83, 0xc0, 0xf5, 0xb0, 0x49, 0x39, 0x6b, 0x52,
+	0xdf, 0x0f, 0x24, 0x95, 0x2c, 0xf0, 0x45, 0xcc, 0xb5, 0x3a, 0x5b, 0xa2, 0xc1, 0x02, 0xcd, 0x75,
+	0x82, 0x10, 0x9b, 0xfd, 0x9b, 0x4d, 0x17, 0x7d, 0x0c, 0xa9, 0xc4, 0x56, 0x2c, 0xf3, 0xb9, 0xcb,
+	0x64, 0xbb, 0x77, 0xd4, 0x70, 0x82, 0x6e, 0x93, 0x86, 0xda, 0xc5, 0xf7, 0x7a, 0x71, 0xc3, 0x69,
+	0x35, 0x79, 0xc7, 0x55, 0xca, 0xa2, 0x49, 0x39, 0xf7, 0x98, 0xa3, 0x8d, 0x37, 0xfb, 0x37, 0xa9,
+	0xc7, 0xdb, 0x74, 0xc8, 0x94, 0xf5, 0x6a, 0x0e, 0x96, 0xf7, 0xa9, 0xcf, 0x8e, 0x51, 0x48, 0x1b,
+	0x7f, 0xe8, 0xa1, 0x90, 0xe4, 0x6b, 0x28, 0xab, 0x20, 0x4c, 0x63, 0xdd, 0xd8, 0xa8, 0x6e, 0xde,
+	0x6f, 0x64, 0xde, 0x1a, 0x89, 0x37, 0xbd, 0x78, 0xee, 0xb4, 0x1a, 0xbc, 0xe3, 0x36, 0x94, 0xb7,
+	0x46, 0xce, 0x5b, 0x23, 0xf1, 0xd6, 0xb0, 0xd3, 0x5c, 0xd8, 0xda, 0x24, 0xa9, 0xc3, 0x7c, 0x88,
+	0x7d, 0x26, 0x58, 0xe0, 0x9b, 0xd3, 0xeb, 0xc6, 0x46, 0xc5, 0x4e, 0xf7, 0xc4, 0x84, 0x39, 0x3f,
+	0xd8, 0xa1, 0x4e, 0x1b, 0xcd, 0xd2, 0xba, 0xb1, 0x31, 0x6f, 0x27, 0x5b, 0xb2, 0x0e, 0x55, 0xca,
+	0xf9, 0x1e, 0x3d, 0x42, 0x6f, 0x17, 0x4f, 0xcc, 0xb2, 0x56, 0xcc, 0x93, 0x94, 0x2e, 0xe5, 0xfc,
+	0x0b, 0xda, 0x45, 0x73, 0x46, 0x73, 0x93, 0x2d, 0x59, 0x85, 0x8a, 0x4f, 0xbb, 0x28, 0x38, 0x75,
+	0xd0, 0x9c, 0xd7, 0xbc, 0x8c, 0x40, 0x5e, 0xc0, 0x4a, 0xee, 0xe0, 0x87, 0x41, 0x2f, 0x74, 0xd0,
+	0x04, 0x1d, 0xf7, 0xde, 0x04, 0x71, 0x6f, 0x17, 0x6d, 0xda, 0xc3, 0x6e, 0xc8, 0xb7, 0x30, 0xa3,
+	0xb1, 0x62, 0x56, 0xd7, 0x4b, 0x6f, 0x2e, 0xcf, 0x91, 0x4d, 0xd2, 0x81, 0x39, 0xee, 0xf5, 0x5c,
+	0xe6, 0x0b, 0x73, 0x41, 0x9b, 0x7f, 0x32, 0x81, 0xf9, 0x9d, 0xc0, 0x3f, 0x66, 0xee, 0x3e, 0xf5,
+	0xa9, 0x8b, 0x5d, 0xf4, 0xe5, 0x81, 0xb6, 0x6c, 0x27, 0x1e, 0xc8, 0x8f, 0x50, 0xeb, 0xf4, 0x84,
+	0x0c, 0xba, 0xec, 0x05, 0x3e, 0xe6, 0x4a, 0x57, 0x98, 0x8b, 0x3a, 0x89, 0xbb, 0x13, 0x78, 0xdd,
+	0x2d, 0x98, 0xb4, 0x87, 0x9c, 0x28, 0x60, 0x74, 0x7a, 0x47, 0xf8, 0x0c, 0x43, 0x8d, 0xa8, 0xa5,
+	0x08, 0x18, 0x39, 0x52, 0x04, 0x1d, 0x16, 0xef, 0x84, 0xb9, 0xbc, 0x5e, 0x8a, 0xa0, 0x93, 0x92,
+	0xc8, 0x06, 0x2c, 0xf7, 0x31, 0x64, 0xc7, 0x27, 0x87, 0xcc, 0xf5, 0xa9, 0xec, 0x85, 0x68, 0xd6,
+	0x34, 0xfc, 0x8a, 0x64, 0x72, 0x0d, 0x96, 0x64, 0x48, 0x9d, 0x0e, 0xf3, 0xdd, 0x7d, 0x94, 0xed,
+	0xa0, 0x65, 0xae, 0x68, 0x87, 0x05, 0x2a, 0x71, 0xa1, 0xda, 0xa7, 0x5e, 0x0f, 0x85, 0xbe, 0x16,
+	0x93, 0xbc, 0xc9, 0xeb, 0xcd, 0x5b, 0x26, 0xef, 0xc2, 0x62, 0x1b, 0xbd, 0xee, 0x33, 0x45, 0x7a,
+	0x6a, 0xef, 0x09, 0xf3, 0x82, 0x0e, 0x6f, 0x90, 0x68, 0xfd, 0x61, 0x40, 0x2d, 0x2b, 0x71, 0xc1,
+	0x03, 0x5f, 0xe8, 0xb2, 0xe8, 0xc6, 0x34, 0x61, 0x1a, 0x5a, 0x2d, 0x23, 0x0c, 0x16, 0xcd, 0x74,
+	0xb1, 0x68, 0x2e, 0xc1, 0x6c, 0xd4, 0x08, 0x75, 0x9d, 0x56, 0xec, 0x78, 0x37, 0x50, 0xdc, 0xe5,
+	0x42, 0x71, 0xaf, 0x01, 0x08, 0x0d, 0xfb, 0x2f, 0x4f, 0x38, 0x9a, 0xb3, 0x9a, 0x9b, 0xa3, 0x10,
+	0x0b, 0x16, 0xa2, 0x74, 0xdb, 0x28, 0x7a, 0x9e, 0x34, 0xe7, 0xb4, 0xc4, 0x00, 0xcd, 0xf2, 0x60,
+	0x79, 0x8f, 0xa9, 0x18, 0x8e, 0xc5, 0xd9, 0xb7, 0x2a, 0xeb, 0x13, 0x28, 0x2b, 0x4f, 0x2a, 0xaa,
+	0xa3, 0x90, 0xfa, 0x4e, 0x1b, 0x93, 0x44, 0xa5, 0x7b, 0x42, 0xa0, 0x2c, 0xa9, 0x2b, 0xcc, 0x69,
+	0x4d, 0xd7, 0x6b, 0xeb, 0x67, 0x23, 0x3a, 0xe6, 0x36, 0xe7, 0xe2, 0xed, 0x76, 0x54, 0xab, 0x07,
+	0x73, 0xdb, 0x9c, 0xab, 0xc3, 0x90, 0x9b, 0x50, 0xa6, 0x9c, 0x47, 0x11, 0x54, 0x37, 0xaf, 0x36,
+	0x72, 0xef, 0x56, 0x2c, 0xa2, 0xfe, 0x8b, 0xfb, 0xbe, 0x54, 0x96, 0x95, 0x68, 0xfd, 0x53, 0xa8,
+	0xa4, 0x24, 0x52, 0x83, 0x52, 0x07, 0x4f, 0x74, 0x00, 0x15, 0x5b, 0x2d, 0xc9, 0x45, 0x98, 0xd1,
+	0x58, 0x8c, 0xbd, 0x46, 0x9b, 0xdb, 0xd3, 0x5b, 0x86, 0xf5, 0x57, 0x09, 0xae, 0xa8, 0x73, 0x1e,
+	0x6a, 0x58, 0x6c, 0x73, 0x7e, 0x0f, 0x25, 0x65, 0x9e, 0x78, 0xd2, 0xc3, 0xf0, 0xe4, 0x2c, 0x73,
+	0xd1, 0x82, 0xd9, 0x08, 0x52, 0xe6, 0xf4, 0x19, 0xb4, 0xf0, 0x59, 0x51, 0xe8, 0xdb, 0xa5, 0x33,
+	0xe8, 0xdb, 0xa3, 0x5a, 0x69, 0xf9, 0x3c, 0x5a, 0xe9, 0xd8, 0x17, 0xd4, 0xfa, 0x69, 0x1a, 0x2e,
+	0xa9, 0x83, 0x66, 0x17, 0x99, 0x76, 0x11, 0x85, 0x7f, 0x55, 0xcf, 0x11, 0x2c, 0xf4, 0x9a, 0xdc,
+	0x82, 0xb9, 0x8e, 0x08, 0x7c, 0x1f, 0x65, 0x7c, 0x0b, 0xf5, 0x3c, 0xd8, 0x76, 0x23, 0xd6, 0x36,
+	0xe7, 0x87, 0x1c, 0x1d, 0x3b, 0x11, 0x25, 0xd7, 0xa1, 0xac, 0xba, 0x96, 0xee, 0x28, 0xd5, 0xcd,
+	0xcb, 0x79, 0x95, 0x47, 0xe8, 0x75, 0x13, 0x79, 0x2d, 0x44, 0x6e, 0x43, 0x25, 0x3d, 0x7f, 0x9c,
+	0x9d, 0xd5, 0x01, 0x27, 0x09, 0x33, 0x51, 0xcb, 0xc4, 0x95, 0x6e, 0x8b, 0x85, 0xe8, 0x28, 0x41,
+	0x73, 0x66, 0x58, 0xf7, 0x5e, 0xc2, 0x4c, 0x75, 0x53, 0x71, 0xeb, 0x37, 0x03, 0xde, 0xc9, 0x80,
+	0x6d, 0xc7, 0x65, 0xb6, 0x8f, 0x92, 0xb6, 0xa8, 0xa4, 0x6f, 0x79, 0x7c, 0xba, 0x06, 0x4b, 0x4e,
+	0x1b, 0x9d, 0x4e, 0xf6, 0x8c, 0x45, 0x53, 0x54, 0x81, 0x6a, 0xfd, 0x3e, 0x0d, 0x4b, 0x83, 0xb7,
+	0xa0, 0xae, 0x51, 0x75, 0xf7, 0xe4, 0x1a, 0xd5, 0x9a, 0x1c, 0xc0, 0x02, 0xfa, 0x7d, 0x16, 0x06,
+	0xbe, 0x7a, 0xf1, 0x13, 0xb0, 0x7f, 0x38, 0xfe, 0x2e, 0x1b, 0xf7, 0x73, 0xe2, 0x51, 0x1f, 0x19,
+	0xb0, 0x40, 0x3a, 0x00, 0x9c, 0x86, 0xb4, 0x8b, 0x12, 0x43, 0x05, 0xea, 0xd2, 0xa4, 0xa0, 0x8e,
+	0xdc, 0x1f, 0x24, 0x36, 0xed, 0x9c, 0xf9, 0xfa, 0x73, 0x58, 0x19, 0x3a, 0xcf, 0x88, 0x26, 0x76,
+	0x2b, 0xdf, 0xc4, 0xaa, 0x9b, 0x6b, 0x23, 0xc2, 0xcb, 0x99, 0xc9, 0x37, 0xb9, 0x7f, 0xca, 0x50,
+	0xcd, 0x21, 0x73, 0x64, 0x0e, 0xd7, 0x00, 0xb4, 0xc2, 0x03, 0xe6, 0x61, 0x94, 0xc1, 0x8a, 0x9d,
+	0xa3, 0x90, 0xf6, 0x88, 0x8c, 0x3c, 0x9a, 0x20, 0x23, 0xea, 0x3c, 0x23, 0xd3, 0xa1, 0x9e, 0x6c,
+	0xed, 0x57, 0xc4, 0xc5, 0x1d, 0xef, 0x88, 0x84, 0xa5, 0x63, 0xe6, 0xe1, 0x41, 0x76, 0x8a, 0xd9,
+	0xf5, 0xd2, 0x84, 0x9d, 0x53, 0x9d, 0xe2, 0x41, 0xde, 0xa8, 0x5d, 0xf0, 0xa1, 0x60, 0x2c, 0x3a,
+	0x8c, 0xef, 0x84, 0x2d, 0xa1, 0x1f, 0xfa, 0x79, 0x3b, 0xdd, 0xab, 0x71, 0x8c, 0x53, 0x21, 0x76,
+	0x42, 0x6c, 0xa1, 0x2f, 0x19, 0xf5, 0x84, 0x9e, 0xda, 0xe7, 0xed, 0x22, 0x99, 0x6c, 0xc1, 0x65,
+	0xe6, 0xfa, 0x41, 0x88, 0xfb, 0x4c, 0x08, 0xe6, 0xbb, 0xcf, 0xb2, 0x54, 0x57, 0xb4, 0xc6, 0x38,
+	0x76, 0x71, 0x6c, 0x84, 0xff, 0x1c, 0x1b, 0xab, 0xc3, 0x63, 0x63, 0x00, 0x0b, 0x3c, 0x50, 0xc3,
+	0x88, 0xdf, 0xc2, 0x10, 0x43, 0x73, 0x61, 0xe2, 0x26, 0xad, 0x6f, 0x2f, 0x67, 0xd2, 0x1e, 0x70,
+	0x60, 0x7d, 0x00, 0xb5, 0x62, 0x5f, 0x53, 0xd7, 0xca, 0xba, 0xd4, 0x4d, 0xc1, 0x15, 0xef, 0xac,
+	0x5f, 0x0d, 0x20, 0xc3, 0xf0, 0x1d, 0x87, 0xd1, 0xce, 0x96, 0x48, 0x52, 0x11, 0x35, 0x95, 0x1c,
+	0x85, 0xec, 0x42, 0xb5, 0x85, 0x42, 0x32, 0x5f, 0x9f, 0x36, 0xee, 0xb6, 0xef, 0x9f, 0x5e, 0x27,
+	0xf7, 0x32, 0x05, 0x3b, 0xaf, 0x6d, 0x3d, 0x85, 0xab, 0xa7, 0x4a, 0xe7, 0x46, 0x4b, 0x63, 0x60,
+	0xb4, 0x3c, 0x75, 0x20, 0xb5, 0x08, 0xd4, 0x8a, 0x6d, 0xdb, 0xf2, 0x61, 0x45, 0x25, 0x74, 0xa7,
+	0x4d, 0x43, 0x79, 0x1e, 0xe3, 0xe2, 0x1d, 0xa8, 0xa4, 0xfe, 0x46, 0x26, 0xba, 0x0e, 0xf3, 0xfd,
+	0x04, 0x4f, 0xd1, 0xbc, 0x98, 0xee, 0xad, 0x6d, 0x20, 0xf9, 0xc3, 0xc6, 0xaf, 0xeb, 0x75, 0x98,
+	0x61, 0x12, 0xbb, 0xc9, 0xd0, 0xf6, 0xbf, 0xe2, 0xa3, 0xa8, 0xc5, 0xed, 0x48, 0xc6, 0x7a, 0x69,
+	0xc0, 0xe2, 0x57, 0x34, 0xec, 0xf6, 0xf8, 0x39, 0xbc, 0x43, 0xe7, 0x32, 0x68, 0x59, 0x35, 0x58,
+	0x4a, 0x22, 0x8a, 0x32, 0xb2, 0xf9, 0x77, 0x19, 0x56, 0xb2, 0x07, 0x58, 0xfd, 0x65, 0x0e, 0x92,
+	0xc7, 0x50, 0x7b, 0x18, 0x7f, 0xd6, 0x48, 0x7e, 0xe7, 0x90, 0xff, 0xe7, 0x93, 0x55, 0xf8, 0xc0,
+	0x51, 0x5f, 0x1d, 0xcd, 0x8c, 0x9c, 0x58, 0x53, 0xe4, 0x0e, 0xcc, 0x27, 0x3f, 0x34, 0x06, 0x0d,
+	0x15, 0x7e, 0x7e, 0xd4, 0x6b, 0x79, 0xa6, 0x62, 0x58, 0x53, 0xe4, 0x6e, 0xa4, 0xac, 0x46, 0xe7,
+	0x61, 0xe5, 0xdc, 0x8f, 0x82, 0xfa, 0x85, 0x11, 0x43, 0xb8, 0x35, 0x45, 0xbe, 0x83, 0xc5, 0x87,
+	0x28, 0xb3, 0x61, 0x8b, 0xbc, 0x37, 0xe8, 0x64, 0xcc, 0x5c, 0x5d, 0xb7, 0x8a, 0x62, 0xc3, 0xf3,
+	0x9a, 0x35, 0x45, 0x7e, 0x31, 0xe0, 0xc2, 0x43, 0x94, 0xc5, 0xd9, 0x85, 0xdc, 0x18, 0xed, 0x64,
+	0xcc, 0x8c, 0x53, 0xdf, 0x9d, 0x08, 0x4d, 0x83, 0x36, 0xad, 0x29, 0x72, 0xa0, 0x63, 0xce, 0x4a,
+	0x80, 0x5c, 0x1d, 0x89, 0xf5, 0x34, 0x75, 0x6b, 0xe3, 0xd8, 0x69, 0x9c, 0xdb, 0x30, 0x1b, 0x61,
+	0x87, 0x5c, 0xc9, 0xcb, 0x0e, 0x54, 0x48, 0xbd, 0x3e, 0x8a, 0x95, 0x98, 0xf8, 0xec, 0xee, 0x9f,
+	0xaf, 0xd7, 0x8c, 0x97, 0xaf, 0xd7, 0x8c, 0x57, 0xaf, 0xd7, 0x8c, 0x6f, 0x3e, 0x3a, 0xed, 0x9b,
+	0x5b, 0xee, 0xdb, 0x20, 0xe5, 0xcc, 0xf1, 0x18, 0xfa, 0xf2, 0x68, 0x56, 0x7f, 0x61, 0xfb, 0xf8,
+	0xdf, 0x01, 0x00, 0x0b, 0xb0, 0xf3, 0xc8, 0x3a, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1344,6 +1533,8 @@ type RepoServerServiceClient interface {
 	GetRevisionMetadata(ctx context.Context, in *RepoServerRevisionMetadataRequest, opts ...grpc.CallOption) (*v1alpha1.RevisionMetadata, error)
 	// GetHelmCharts returns list of helm charts in the specified repository
 	GetHelmCharts(ctx context.Context, in *HelmChartsRequest, opts ...grpc.CallOption) (*HelmChartsResponse, error)
+	// Warmup fetches the repository or Helm chart of an application source into the disk cache
+	Warmup(ctx context.Context, in *WarmupRequest, opts ...grpc.CallOption) (*WarmupResponse, error)
 }
 
 type repoServerServiceClient struct {
@@ -1408,6 +1599,15 @@ func (c *repoServerServiceClient) GetHelmCharts(ctx context.Context, in *HelmCha
 	return out, nil
 }
 
+func (c *repoServerServiceClient) Warmup(ctx context.Context, in *WarmupRequest, opts ...grpc.CallOption) (*WarmupResponse, error) {
+	out := new(WarmupResponse)
+	err := c.cc.Invoke(ctx, "/repository.RepoServerService/Warmup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RepoServerServiceServer is the server API for RepoServerService service.
 type RepoServerServiceServer interface {
 	// GenerateManifest generates manifest for application in specified repo name and revision
@@ -1422,6 +1622,8 @@ type RepoServerServiceServer interface {
 	GetRevisionMetadata(context.Context, *RepoServerRevisionMetadataRequest) (*v1alpha1.RevisionMetadata, error)
 	// GetHelmCharts returns list of helm charts in the specified repository
 	GetHelmCharts(context.Context, *HelmChartsRequest) (*HelmChartsResponse, error)
+	// Warmup fetches the repository or Helm chart of an application source into the disk cache
+	Warmup(context.Context, *WarmupRequest) (*WarmupResponse, error)
 }
 
 // UnimplementedRepoServerServiceServer can be embedded to have forward compatible implementations.
@@ -1446,6 +1648,9 @@ func (*UnimplementedRepoServerServiceServer) GetRevisionMetadata(ctx context.Con
 func (*UnimplementedRepoServerServiceServer) GetHelmCharts(ctx context.Context, req *HelmChartsRequest) (*HelmChartsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetHelmCharts not implemented")
 }
+func (*UnimplementedRepoServerServiceServer) Warmup(ctx context.Context, req *WarmupRequest) (*WarmupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Warmup not implemented")
+}
 
 func RegisterRepoServerServiceServer(s *grpc.Server, srv RepoServerServiceServer) {
 	s.RegisterService(&_RepoServerService_serviceDesc, srv)
@@ -1559,6 +1764,24 @@ func _RepoServerService_GetHelmCharts_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepoServerService_Warmup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WarmupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepoServerServiceServer).Warmup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repository.RepoServerService/Warmup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepoServerServiceServer).Warmup(ctx, req.(*WarmupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _RepoServerService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "repository.RepoServerService",
 	HandlerType: (*RepoServerServiceServer)(nil),
@@ -1587,6 +1810,10 @@ var _RepoServerService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetHelmCharts",
 			Handler:    _RepoServerService_GetHelmCharts_Handler,
 		},
+		{
+			MethodName: "Warmup",
+			Handler:    _RepoServerService_Warmup_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "reposerver/repository/repository.proto",
@@ -1616,6 +1843,42 @@ func (m *ManifestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.HelmValueURLs) > 0 {
+		for iNdEx := len(m.HelmValueURLs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.HelmValueURLs[iNdEx])
+			copy(dAtA[i:], m.HelmValueURLs[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.HelmValueURLs[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.ValuesRepos) > 0 {
+		for iNdEx := len(m.ValuesRepos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValuesRepos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.TrackingMethod) > 0 {
+		i -= len(m.TrackingMethod)
+		copy(dAtA[i:], m.TrackingMethod)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.TrackingMethod)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
 	if m.VerifySignature {
 		i--
 		if m.VerifySignature {
@@ -2316,6 +2579,64 @@ func (m *HelmAppSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.PostRenderer != nil {
+		{
+			size, err := m.PostRenderer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.ApiVersions) > 0 {
+		for iNdEx := len(m.ApiVersions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ApiVersions[iNdEx])
+			copy(dAtA[i:], m.ApiVersions[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.ApiVersions[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.KubeVersion) > 0 {
+		i -= len(m.KubeVersion)
+		copy(dAtA[i:], m.KubeVersion)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.KubeVersion)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.IgnoreMissingValueFiles {
+		i--
+		if m.IgnoreMissingValueFiles {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PassCredentials {
+		i--
+		if m.PassCredentials {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SkipCrds {
+		i--
+		if m.SkipCrds {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.FileParameters) > 0 {
 		for iNdEx := len(m.FileParameters) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2650,35 +2971,113 @@ func (m *HelmChartsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintRepository(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRepository(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *WarmupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ManifestRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *WarmupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WarmupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovRepository(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Revision)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
+	if m.Source != nil {
+		{
+			size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.NoCache {
-		n += 2
+	if m.Repo != nil {
+		{
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.AppLabelKey)
+	return len(dAtA) - i, nil
+}
+
+func (m *WarmupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WarmupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WarmupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRepository(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRepository(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ManifestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Revision)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.NoCache {
+		n += 2
+	}
+	l = len(m.AppLabelKey)
 	if l > 0 {
 		n += 1 + l + sovRepository(uint64(l))
 	}
@@ -2723,6 +3122,22 @@ func (m *ManifestRequest) Size() (n int) {
 	if m.VerifySignature {
 		n += 3
 	}
+	l = len(m.TrackingMethod)
+	if l > 0 {
+		n += 2 + l + sovRepository(uint64(l))
+	}
+	if len(m.ValuesRepos) > 0 {
+		for _, e := range m.ValuesRepos {
+			l = e.Size()
+			n += 2 + l + sovRepository(uint64(l))
+		}
+	}
+	if len(m.HelmValueURLs) > 0 {
+		for _, s := range m.HelmValueURLs {
+			l = len(s)
+			n += 2 + l + sovRepository(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3003,6 +3418,29 @@ func (m *HelmAppSpec) Size() (n int) {
 			n += 1 + l + sovRepository(uint64(l))
 		}
 	}
+	if m.SkipCrds {
+		n += 2
+	}
+	if m.PassCredentials {
+		n += 2
+	}
+	if m.IgnoreMissingValueFiles {
+		n += 2
+	}
+	l = len(m.KubeVersion)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if len(m.ApiVersions) > 0 {
+		for _, s := range m.ApiVersions {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.PostRenderer != nil {
+		l = m.PostRenderer.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3139,6 +3577,38 @@ func (m *HelmChartsResponse) Size() (n int) {
 	return n
 }
 
+func (m *WarmupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.Source != nil {
+		l = m.Source.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WarmupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovRepository(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3582,16 +4052,111 @@ func (m *ManifestRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.VerifySignature = bool(v != 0)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackingMethod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrackingMethod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValuesRepos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValuesRepos = append(m.ValuesRepos, &v1alpha1.Repository{})
+			if err := m.ValuesRepos[len(m.ValuesRepos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HelmValueURLs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HelmValueURLs = append(m.HelmValueURLs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if (iNdEx + skippy) > l {
@@ -3834,10 +4399,7 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if (iNdEx + skippy) > l {
@@ -3924,10 +4486,7 @@ func (m *ListRefsRequest) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if (iNdEx + skippy) > l {
@@ -4042,10 +4601,7 @@ func (m *Refs) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if (iNdEx + skippy) > l {
@@ -4164,10 +4720,7 @@ func (m *ListAppsRequest) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if (iNdEx + skippy) > l {
@@ -4328,7 +4881,7 @@ func (m *AppList) Unmarshal(dAtA []byte) error {
 					if err != nil {
 						return err
 					}
-					if skippy < 0 {
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
 						return ErrInvalidLengthRepository
 					}
 					if (iNdEx + skippy) > postIndex {
@@ -4345,10 +4898,7 @@ func (m *AppList) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if (iNdEx + skippy) > l {
@@ -4573,10 +5123,7 @@ func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if (iNdEx + skippy) > l {
@@ -4803,10 +5350,7 @@ func (m *RepoAppDetailsResponse) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if (iNdEx + skippy) > l {
@@ -4945,10 +5489,7 @@ func (m *RepoServerRevisionMetadataRequest) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if (iNdEx + skippy) > l {
@@ -5143,7 +5684,7 @@ func (m *KsonnetAppSpec) Unmarshal(dAtA []byte) error {
 					if err != nil {
 						return err
 					}
-					if skippy < 0 {
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
 						return ErrInvalidLengthRepository
 					}
 					if (iNdEx + skippy) > postIndex {
@@ -5194,10 +5735,7 @@ func (m *KsonnetAppSpec) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if (iNdEx + skippy) > l {
@@ -5406,29 +5944,186 @@ func (m *HelmAppSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRepository(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRepository
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipCrds", wireType)
 			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRepository
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.SkipCrds = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PassCredentials", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PassCredentials = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreMissingValueFiles", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IgnoreMissingValueFiles = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubeVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KubeVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiVersions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiVersions = append(m.ApiVersions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostRenderer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PostRenderer == nil {
+				m.PostRenderer = &v1alpha1.HelmPostRenderer{}
+			}
+			if err := m.PostRenderer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
 	return nil
 }
 func (m *KustomizeAppSpec) Unmarshal(dAtA []byte) error {
@@ -5498,10 +6193,7 @@ func (m *KustomizeAppSpec) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if (iNdEx + skippy) > l {
@@ -5652,10 +6344,7 @@ func (m *KsonnetEnvironment) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if (iNdEx + skippy) > l {
@@ -5770,10 +6459,7 @@ func (m *KsonnetEnvironmentDestination) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if (iNdEx + skippy) > l {
@@ -5824,10 +6510,7 @@ func (m *DirectoryAppSpec) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if (iNdEx + skippy) > l {
@@ -5914,10 +6597,7 @@ func (m *HelmChartsRequest) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if (iNdEx + skippy) > l {
@@ -6032,10 +6712,7 @@ func (m *HelmChart) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if (iNdEx + skippy) > l {
@@ -6120,10 +6797,181 @@ func (m *HelmChartsResponse) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRepository
 			}
-			if (iNdEx + skippy) < 0 {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WarmupRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WarmupRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WarmupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &v1alpha1.Repository{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Source == nil {
+				m.Source = &v1alpha1.ApplicationSource{}
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WarmupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WarmupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WarmupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if (iNdEx + skippy) > l {
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"hash/fnv"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/go-redis/redis/v8"
@@ -69,12 +71,36 @@ func (c *Cache) SetApps(repoUrl, revision string, apps map[string]string) error
 	return c.cache.SetItem(listApps(repoUrl, revision), apps, c.repoCacheExpiration, apps == nil)
 }
 
-func manifestCacheKey(revision string, appSrc *appv1.ApplicationSource, namespace string, appLabelKey string, appName string) string {
-	return fmt.Sprintf("mfst|%s|%s|%s|%s|%d", appLabelKey, appName, revision, namespace, appSourceKey(appSrc))
+// trackingKey returns the part of the manifest cache key which identifies how the manifests are marked as part of the
+// application. The key of the label tracking method is unchanged, so the existing cache entries remain valid.
+func trackingKey(appLabelKey string, trackingMethod string) string {
+	if trackingMethod == "" || trackingMethod == "label" {
+		return appLabelKey
+	}
+	return trackingMethod + ":" + appLabelKey
+}
+
+// valuesReposKey returns the part of the manifest cache key which identifies the revisions of the repositories of the
+// Helm value files, keyed by their aliases. It is empty without such repositories, so the existing cache entries
+// remain valid.
+func valuesReposKey(valuesRepoRevisions map[string]string) string {
+	if len(valuesRepoRevisions) == 0 {
+		return ""
+	}
+	revisions := make([]string, 0, len(valuesRepoRevisions))
+	for alias, revision := range valuesRepoRevisions {
+		revisions = append(revisions, alias+"="+revision)
+	}
+	sort.Strings(revisions)
+	return "|" + strings.Join(revisions, ",")
+}
+
+func manifestCacheKey(revision string, appSrc *appv1.ApplicationSource, valuesRepoRevisions map[string]string, namespace string, trackingMethod string, appLabelKey string, appName string) string {
+	return fmt.Sprintf("mfst|%s|%s|%s%s|%s|%d", trackingKey(appLabelKey, trackingMethod), appName, revision, valuesReposKey(valuesRepoRevisions), namespace, appSourceKey(appSrc))
 }
 
-func (c *Cache) GetManifests(revision string, appSrc *appv1.ApplicationSource, namespace string, appLabelKey string, appName string, res *CachedManifestResponse) error {
-	err := c.cache.GetItem(manifestCacheKey(revision, appSrc, namespace, appLabelKey, appName), res)
+func (c *Cache) GetManifests(revision string, appSrc *appv1.ApplicationSource, valuesRepoRevisions map[string]string, namespace string, trackingMethod string, appLabelKey string, appName string, res *CachedManifestResponse) error {
+	err := c.cache.GetItem(manifestCacheKey(revision, appSrc, valuesRepoRevisions, namespace, trackingMethod, appLabelKey, appName), res)
 
 	if err != nil {
 		return err
@@ -89,7 +115,7 @@ func (c *Cache) GetManifests(revision string, appSrc *appv1.ApplicationSource, n
 	if hash != res.CacheEntryHash {
 		log.Warnf("Manifest hash did not match expected value, treating as a cache miss: %s", appName)
 
-		err = c.DeleteManifests(revision, appSrc, namespace, appLabelKey, appName)
+		err = c.DeleteManifests(revision, appSrc, valuesRepoRevisions, namespace, trackingMethod, appLabelKey, appName)
 		if err != nil {
 			return fmt.Errorf("Unable to delete manifest after hash mismatch, %v", err)
 		}
@@ -104,7 +130,7 @@ func (c *Cache) GetManifests(revision string, appSrc *appv1.ApplicationSource, n
 	return nil
 }
 
-func (c *Cache) SetManifests(revision string, appSrc *appv1.ApplicationSource, namespace string, appLabelKey string, appName string, res *CachedManifestResponse) error {
+func (c *Cache) SetManifests(revision string, appSrc *appv1.ApplicationSource, valuesRepoRevisions map[string]string, namespace string, trackingMethod string, appLabelKey string, appName string, res *CachedManifestResponse) error {
 
 	// Generate and apply the cache entry hash, before writing
 	if res != nil {
@@ -116,11 +142,11 @@ func (c *Cache) SetManifests(revision string, appSrc *appv1.ApplicationSource, n
 		res.CacheEntryHash = hash
 	}
 
-	return c.cache.SetItem(manifestCacheKey(revision, appSrc, namespace, appLabelKey, appName), res, c.repoCacheExpiration, res == nil)
+	return c.cache.SetItem(manifestCacheKey(revision, appSrc, valuesRepoRevisions, namespace, trackingMethod, appLabelKey, appName), res, c.repoCacheExpiration, res == nil)
 }
 
-func (c *Cache) DeleteManifests(revision string, appSrc *appv1.ApplicationSource, namespace string, appLabelKey string, appName string) error {
-	return c.cache.SetItem(manifestCacheKey(revision, appSrc, namespace, appLabelKey, appName), "", c.repoCacheExpiration, true)
+func (c *Cache) DeleteManifests(revision string, appSrc *appv1.ApplicationSource, valuesRepoRevisions map[string]string, namespace string, trackingMethod string, appLabelKey string, appName string) error {
+	return c.cache.SetItem(manifestCacheKey(revision, appSrc, valuesRepoRevisions, namespace, trackingMethod, appLabelKey, appName), "", c.repoCacheExpiration, true)
 }
 
 func appDetailsCacheKey(revision string, appSrc *appv1.ApplicationSource) string {
@@ -158,6 +184,7 @@ func (cmr *CachedManifestResponse) shallowCopy() *CachedManifestResponse {
 		FirstFailureTimestamp:           cmr.FirstFailureTimestamp,
 		ManifestResponse:                cmr.ManifestResponse,
 		MostRecentError:                 cmr.MostRecentError,
+		MostRecentErrorCode:             cmr.MostRecentErrorCode,
 		NumberOfCachedResponsesReturned: cmr.NumberOfCachedResponsesReturned,
 		NumberOfConsecutiveFailures:     cmr.NumberOfConsecutiveFailures,
 	}
@@ -193,6 +220,7 @@ type CachedManifestResponse struct {
 	CacheEntryHash                  string                      `json:"cacheEntryHash"`
 	ManifestResponse                *apiclient.ManifestResponse `json:"manifestResponse"`
 	MostRecentError                 string                      `json:"mostRecentError"`
+	MostRecentErrorCode             uint32                      `json:"mostRecentErrorCode,omitempty"`
 	FirstFailureTimestamp           int64                       `json:"firstFailureTimestamp"`
 	NumberOfConsecutiveFailures     int                         `json:"numberOfConsecutiveFailures"`
 	NumberOfCachedResponsesReturned int                         `json:"numberOfCachedResponsesReturned"`
@@ -0,0 +1,228 @@
+package diskcache
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/vathsalashetty96/argo-cd/util/io"
+)
+
+// sizeRefreshInterval is how often the size of an entry is recomputed when it is released. Walking a large repository
+// is expensive, so it is not done after every request.
+var sizeRefreshInterval = time.Minute
+
+// removeAll removes an evicted entry; replaced in tests
+var removeAll = os.RemoveAll
+
+// Cache keeps track of the repository clones, worktrees and Helm charts which the repo server keeps on disk. Once their
+// total size exceeds the budget, the least recently used entries which are not in use are removed.
+type Cache struct {
+	dir     string
+	maxSize int64
+	onUsage func(size int64)
+
+	lock    sync.Mutex
+	entries map[string]*entry
+	size    int64
+	// evicting are the entries which are being removed by their paths. Their channels are closed once they are gone.
+	evicting map[string]chan struct{}
+}
+
+type entry struct {
+	size     int64
+	sizedAt  time.Time
+	lastUsed time.Time
+	users    int
+}
+
+// NewCache returns a cache of the entries stored in the given directory. A maxSize of zero disables eviction. onUsage,
+// if not nil, is called with the total size of the entries whenever it changes.
+func NewCache(dir string, maxSize int64, onUsage func(size int64)) *Cache {
+	return &Cache{
+		dir:      dir,
+		maxSize:  maxSize,
+		onUsage:  onUsage,
+		entries:  map[string]*entry{},
+		evicting: map[string]chan struct{}{},
+	}
+}
+
+// Path returns the path of an entry in the cache directory
+func (c *Cache) Path(elem ...string) string {
+	return filepath.Join(append([]string{c.dir}, elem...)...)
+}
+
+// Size returns the total size of the entries in bytes
+func (c *Cache) Size() int64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.size
+}
+
+// Load registers the entries left over from a previous run, e.g. before a restart. The patterns are matched against
+// paths relative to the cache directory, and the modification time of an entry is used as its last use.
+func (c *Cache) Load(patterns ...string) error {
+	c.lock.Lock()
+	for _, pattern := range patterns {
+		paths, err := filepath.Glob(c.Path(pattern))
+		if err != nil {
+			c.lock.Unlock()
+			return err
+		}
+		for _, path := range paths {
+			if _, ok := c.entries[path]; ok {
+				continue
+			}
+			info, err := os.Stat(path)
+			if err != nil {
+				c.lock.Unlock()
+				return err
+			}
+			size, err := diskUsage(path)
+			if err != nil {
+				c.lock.Unlock()
+				return err
+			}
+			c.entries[path] = &entry{size: size, sizedAt: time.Now(), lastUsed: info.ModTime()}
+			c.size += size
+		}
+	}
+	log.Infof("Loaded %d disk cache entries using %d bytes from %s", len(c.entries), c.size, c.dir)
+	evicted := c.evict("")
+	c.notify()
+	c.lock.Unlock()
+	c.remove(evicted)
+	return nil
+}
+
+// Use marks the entry at the given path as in use, which protects it from eviction until the returned closer is
+// closed. Closing updates the size of the entry and evicts the least recently used entries if the budget is exceeded.
+func (c *Cache) Use(path string) io.Closer {
+	c.lock.Lock()
+	// an entry which is being removed is created again once it is gone
+	for removed, ok := c.evicting[path]; ok; removed, ok = c.evicting[path] {
+		c.lock.Unlock()
+		<-removed
+		c.lock.Lock()
+	}
+	defer c.lock.Unlock()
+	e, ok := c.entries[path]
+	if !ok {
+		e = &entry{}
+		c.entries[path] = e
+	}
+	e.users++
+	e.lastUsed = time.Now()
+	return io.NewCloser(func() error {
+		c.release(path, e)
+		return nil
+	})
+}
+
+func (c *Cache) release(path string, e *entry) {
+	c.lock.Lock()
+	refresh := time.Since(e.sizedAt) >= sizeRefreshInterval
+	c.lock.Unlock()
+
+	// the entry is still marked as in use, so it can't be removed while its size is computed
+	var size int64
+	var err error
+	if refresh {
+		size, err = diskUsage(path)
+	}
+
+	c.lock.Lock()
+	e.users--
+	e.lastUsed = time.Now()
+	if refresh {
+		if err != nil {
+			log.Warnf("Failed to compute disk usage of %s: %v", path, err)
+		} else {
+			c.size += size - e.size
+			e.size = size
+			e.sizedAt = time.Now()
+		}
+	}
+	if refresh && err == nil && size == 0 && e.users == 0 {
+		// nothing was written, e.g. because the clone failed
+		delete(c.entries, path)
+	}
+	evicted := c.evict(path)
+	c.notify()
+	c.lock.Unlock()
+	c.remove(evicted)
+}
+
+// evict selects the least recently used entries which are not in use for removal until the total size is within the
+// budget, and returns their paths. The entry at keep is retained even if it is the only one left, so that an entry
+// exceeding the budget on its own is not removed right after each use. The selected entries are marked as being
+// removed, so that they are not used again before they are gone, and must be removed using remove after the lock is
+// released.
+func (c *Cache) evict(keep string) []string {
+	var evicted []string
+	for c.maxSize > 0 && c.size > c.maxSize {
+		var oldestPath string
+		var oldest *entry
+		for path, e := range c.entries {
+			if e.users > 0 || path == keep {
+				continue
+			}
+			if oldest == nil || e.lastUsed.Before(oldest.lastUsed) {
+				oldestPath, oldest = path, e
+			}
+		}
+		if oldest == nil {
+			log.Warnf("Disk cache uses %d bytes, which exceeds the budget of %d bytes, but all entries are in use", c.size, c.maxSize)
+			break
+		}
+		log.Infof("Evicting %s (%d bytes, last used %v) from disk cache", oldestPath, oldest.size, oldest.lastUsed)
+		delete(c.entries, oldestPath)
+		c.size -= oldest.size
+		c.evicting[oldestPath] = make(chan struct{})
+		evicted = append(evicted, oldestPath)
+	}
+	return evicted
+}
+
+// remove removes the evicted entries from disk without holding the lock, so that requests using other entries don't
+// wait for the removal of large repositories
+func (c *Cache) remove(evicted []string) {
+	for _, path := range evicted {
+		if err := removeAll(path); err != nil {
+			log.Warnf("Failed to evict %s from disk cache: %v", path, err)
+		}
+		c.lock.Lock()
+		close(c.evicting[path])
+		delete(c.evicting, path)
+		c.lock.Unlock()
+	}
+}
+
+func (c *Cache) notify() {
+	if c.onUsage != nil {
+		c.onUsage(c.size)
+	}
+}
+
+// diskUsage returns the total size of the files at the given path
+func diskUsage(path string) (int64, error) {
+	var size int64
+	err := filepath.Walk(path, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			// files may be removed while walking, e.g. by a concurrent git gc
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info.Mode().IsRegular() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
+}
@@ -0,0 +1,142 @@
+package diskcache
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vathsalashetty96/argo-cd/util/io"
+)
+
+func writeEntry(t *testing.T, path string, size int) {
+	require.NoError(t, os.MkdirAll(path, 0700))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(path, "data"), make([]byte, size), 0600))
+}
+
+func exists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+func TestCache_UseEvictsLeastRecentlyUsed(t *testing.T) {
+	dir, err := ioutil.TempDir("", "diskcache")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	var usage int64
+	c := NewCache(dir, 250, func(size int64) { usage = size })
+
+	for _, name := range []string{"a", "b", "c"} {
+		closer := c.Use(c.Path(name))
+		writeEntry(t, c.Path(name), 100)
+		io.Close(closer)
+	}
+
+	assert.False(t, exists(c.Path("a")))
+	assert.True(t, exists(c.Path("b")))
+	assert.True(t, exists(c.Path("c")))
+	assert.Equal(t, int64(200), c.Size())
+	assert.Equal(t, int64(200), usage)
+}
+
+func TestCache_UseProtectsEntriesInUse(t *testing.T) {
+	dir, err := ioutil.TempDir("", "diskcache")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	c := NewCache(dir, 150, nil)
+
+	inUse := c.Use(c.Path("a"))
+	writeEntry(t, c.Path("a"), 100)
+	closer := c.Use(c.Path("b"))
+	writeEntry(t, c.Path("b"), 100)
+	io.Close(closer)
+
+	// a was used first but hasn't been released yet, and b was released last
+	assert.True(t, exists(c.Path("a")))
+	assert.True(t, exists(c.Path("b")))
+
+	io.Close(inUse)
+	assert.True(t, exists(c.Path("a")))
+	assert.False(t, exists(c.Path("b")))
+}
+
+func TestCache_UseForgetsEmptyEntries(t *testing.T) {
+	dir, err := ioutil.TempDir("", "diskcache")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	c := NewCache(dir, 0, nil)
+	io.Close(c.Use(c.Path("missing")))
+
+	assert.Empty(t, c.entries)
+}
+
+func TestCache_Load(t *testing.T) {
+	dir, err := ioutil.TempDir("", "diskcache")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	writeEntry(t, filepath.Join(dir, "git", "old"), 100)
+	writeEntry(t, filepath.Join(dir, "git", "new"), 100)
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "helm"), 0700))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "helm", "chart-1.0.0.tgz"), make([]byte, 10), 0600))
+	past := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(filepath.Join(dir, "git", "old"), past, past))
+
+	c := NewCache(dir, 150, nil)
+	require.NoError(t, c.Load("git/*", "helm/*.tgz"))
+
+	assert.False(t, exists(filepath.Join(dir, "git", "old")))
+	assert.True(t, exists(filepath.Join(dir, "git", "new")))
+	assert.True(t, exists(filepath.Join(dir, "helm", "chart-1.0.0.tgz")))
+	assert.Equal(t, int64(110), c.Size())
+}
+
+func TestCache_RemovesEvictedEntriesWithoutLock(t *testing.T) {
+	dir, err := ioutil.TempDir("", "diskcache")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	removing := make(chan string)
+	unblock := make(chan struct{})
+	removeAll = func(path string) error {
+		removing <- path
+		<-unblock
+		return os.RemoveAll(path)
+	}
+	defer func() { removeAll = os.RemoveAll }()
+
+	c := NewCache(dir, 150, nil)
+	closer := c.Use(c.Path("a"))
+	writeEntry(t, c.Path("a"), 100)
+	io.Close(closer)
+	closer = c.Use(c.Path("b"))
+	writeEntry(t, c.Path("b"), 100)
+	go io.Close(closer)
+
+	assert.Equal(t, c.Path("a"), <-removing)
+	// other entries are usable while an evicted entry is removed
+	assert.Equal(t, int64(100), c.Size())
+	io.Close(c.Use(c.Path("c")))
+
+	used := make(chan struct{})
+	go func() {
+		closer := c.Use(c.Path("a"))
+		assert.False(t, exists(c.Path("a")))
+		close(used)
+		io.Close(closer)
+	}()
+	select {
+	case <-used:
+		t.Fatal("evicted entry was used before it was removed")
+	case <-time.After(100 * time.Millisecond):
+	}
+	close(unblock)
+	<-used
+}
@@ -69,3 +69,23 @@ func (w *gitClientWrapper) RevisionMetadata(revision string) (*git.RevisionMetad
 func (w *gitClientWrapper) VerifyCommitSignature(revision string) (string, error) {
 	return w.client.VerifyCommitSignature(revision)
 }
+
+func (w *gitClientWrapper) SetAuthor(name string, email string) error {
+	return w.client.SetAuthor(name, email)
+}
+
+func (w *gitClientWrapper) CheckoutOrOrphan(branch string) error {
+	return w.client.CheckoutOrOrphan(branch)
+}
+
+func (w *gitClientWrapper) CommitAndPush(branch string, message string) (string, error) {
+	return w.client.CommitAndPush(branch, message)
+}
+
+func (w *gitClientWrapper) SparseCheckout(paths []string) error {
+	return w.client.SparseCheckout(paths)
+}
+
+func (w *gitClientWrapper) Worktree(path string) git.Client {
+	return WrapGitClient(w.repo, w.metricsServer, w.client.Worktree(path))
+}
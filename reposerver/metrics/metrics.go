@@ -16,6 +16,7 @@ type MetricsServer struct {
 	repoPendingRequestsGauge *prometheus.GaugeVec
 	redisRequestCounter      *prometheus.CounterVec
 	redisRequestHistogram    *prometheus.HistogramVec
+	diskCacheSizeGauge       prometheus.Gauge
 }
 
 type GitRequestType string
@@ -78,6 +79,14 @@ func NewMetricsServer() *MetricsServer {
 	)
 	registry.MustRegister(redisRequestHistogram)
 
+	diskCacheSizeGauge := prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "argocd_repo_disk_cache_size_bytes",
+			Help: "Size of the repositories and Helm charts stored on disk by repo server",
+		},
+	)
+	registry.MustRegister(diskCacheSizeGauge)
+
 	return &MetricsServer{
 		handler:                  promhttp.HandlerFor(registry, promhttp.HandlerOpts{}),
 		gitRequestCounter:        gitRequestCounter,
@@ -85,6 +94,7 @@ func NewMetricsServer() *MetricsServer {
 		repoPendingRequestsGauge: repoPendingRequestsGauge,
 		redisRequestCounter:      redisRequestCounter,
 		redisRequestHistogram:    redisRequestHistogram,
+		diskCacheSizeGauge:       diskCacheSizeGauge,
 	}
 }
 
@@ -116,3 +126,8 @@ func (m *MetricsServer) IncRedisRequest(failed bool) {
 func (m *MetricsServer) ObserveRedisRequestDuration(duration time.Duration) {
 	m.redisRequestHistogram.WithLabelValues("argocd-repo-server").Observe(duration.Seconds())
 }
+
+// SetDiskCacheSize sets the size of the repositories and Helm charts stored on disk
+func (m *MetricsServer) SetDiskCacheSize(size int64) {
+	m.diskCacheSizeGauge.Set(float64(size))
+}
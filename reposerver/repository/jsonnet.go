@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1"
+	executil "github.com/vathsalashetty96/argo-cd/util/exec"
+)
+
+// jsonnetEvaluatorEnv is set in the environment of the processes the repo server starts to evaluate Jsonnet
+const jsonnetEvaluatorEnv = "ARGOCD_JSONNET_EVALUATOR"
+
+// jsonnetEvaluation describes the evaluation of a Jsonnet file. It is passed to the evaluator process on its stdin.
+type jsonnetEvaluation struct {
+	File    string                            `json:"file"`
+	Jsonnet v1alpha1.ApplicationSourceJsonnet `json:"jsonnet"`
+	Env     *v1alpha1.Env                     `json:"env"`
+	// JPaths are the directories imports are resolved in
+	JPaths   []string `json:"jpaths"`
+	MaxStack int      `json:"maxStack"`
+	// MaxFiles is the maximum number of files read to generate the manifests of the application and FilesRead the
+	// number of files read before the evaluation
+	MaxFiles  int `json:"maxFiles"`
+	FilesRead int `json:"filesRead"`
+	// MaxOutputSize is the maximum size in bytes of the generated JSON
+	MaxOutputSize int64 `json:"maxOutputSize"`
+}
+
+// jsonnetResult is the result of the evaluation of a Jsonnet file. It is written by the evaluator process to its stdout.
+type jsonnetResult struct {
+	JSON string `json:"json"`
+	// FilesRead is the number of files read to generate the manifests of the application after the evaluation
+	FilesRead int `json:"filesRead"`
+	// Error is the error the evaluation failed with, if any, and LimitExceeded whether it exceeded one of its limits
+	Error         string `json:"error,omitempty"`
+	LimitExceeded bool   `json:"limitExceeded,omitempty"`
+}
+
+// run evaluates the Jsonnet file in the current process
+func (e jsonnetEvaluation) run() *jsonnetResult {
+	files := &fileLimit{max: e.MaxFiles, count: e.FilesRead}
+	vm := makeJsonnetVm(e.Jsonnet, e.Env, e.JPaths, e.MaxStack, files)
+	jsonStr, err := vm.EvaluateFile(e.File)
+	res := &jsonnetResult{FilesRead: files.count}
+	switch {
+	case err != nil:
+		res.Error = err.Error()
+		res.LimitExceeded = files.exceeded() || strings.Contains(err.Error(), "max stack frames exceeded")
+	case e.MaxOutputSize > 0 && int64(len(jsonStr)) > e.MaxOutputSize:
+		res.Error = fmt.Sprintf("generated manifests exceed %s", resource.NewQuantity(e.MaxOutputSize, resource.BinarySI))
+		res.LimitExceeded = true
+	default:
+		res.JSON = jsonStr
+	}
+	return res
+}
+
+// evaluateJsonnet evaluates a Jsonnet file. go-jsonnet can neither be cancelled nor limited in the memory it allocates,
+// so if config management tools are run with memory or CPU time limits, the file is evaluated by a child process of the
+// current executable, which is run with the same limits. A LimitExceededError is returned if the process was terminated
+// because it exceeded one of them. Without limits, or if the manifests are generated locally, the file is evaluated in
+// the current process, which avoids starting a process per file.
+func evaluateJsonnet(e jsonnetEvaluation, isLocal bool) (*jsonnetResult, error) {
+	if isLocal || !executil.LimitsConfigured() {
+		return e.run(), nil
+	}
+	executable, err := os.Executable()
+	if err != nil {
+		return nil, err
+	}
+	input, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+	cmd := exec.Command(executable)
+	// the evaluator doesn't get the environment of the repo server, e.g. its credentials
+	cmd.Env = []string{jsonnetEvaluatorEnv + "=true"}
+	cmd.Stdin = bytes.NewReader(input)
+	out, err := executil.RunLimited(cmd, nil)
+	if err != nil {
+		return nil, err
+	}
+	var res jsonnetResult
+	if err := json.Unmarshal([]byte(out), &res); err != nil {
+		return nil, fmt.Errorf("failed to read result of jsonnet evaluation: %v", err)
+	}
+	return &res, nil
+}
+
+// IsJsonnetEvaluator returns whether the current process was started by the repo server to evaluate Jsonnet, in which
+// case RunJsonnetEvaluator must be called instead of running the command of the executable
+func IsJsonnetEvaluator() bool {
+	return os.Getenv(jsonnetEvaluatorEnv) == "true"
+}
+
+// RunJsonnetEvaluator evaluates the Jsonnet file described on stdin and writes the result to stdout
+func RunJsonnetEvaluator() error {
+	var e jsonnetEvaluation
+	if err := json.NewDecoder(os.Stdin).Decode(&e); err != nil {
+		return err
+	}
+	return json.NewEncoder(os.Stdout).Encode(e.run())
+}
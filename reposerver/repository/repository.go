@@ -6,13 +6,14 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"hash/fnv"
 	"io/ioutil"
-	"net/url"
 	"os"
 	"os/exec"
 	"path"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -25,9 +26,12 @@ import (
 	"github.com/ghodss/yaml"
 	"github.com/google/go-jsonnet"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
 	"golang.org/x/sync/semaphore"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -37,9 +41,11 @@ import (
 	"github.com/vathsalashetty96/argo-cd/reposerver/apiclient"
 	"github.com/vathsalashetty96/argo-cd/reposerver/cache"
 	reposervercache "github.com/vathsalashetty96/argo-cd/reposerver/cache"
+	"github.com/vathsalashetty96/argo-cd/reposerver/diskcache"
 	"github.com/vathsalashetty96/argo-cd/reposerver/metrics"
 	"github.com/vathsalashetty96/argo-cd/util/app/discovery"
 	argopath "github.com/vathsalashetty96/argo-cd/util/app/path"
+	"github.com/vathsalashetty96/argo-cd/util/argo"
 	executil "github.com/vathsalashetty96/argo-cd/util/exec"
 	"github.com/vathsalashetty96/argo-cd/util/git"
 	"github.com/vathsalashetty96/argo-cd/util/glob"
@@ -47,10 +53,10 @@ import (
 	"github.com/vathsalashetty96/argo-cd/util/helm"
 	"github.com/vathsalashetty96/argo-cd/util/io"
 	"github.com/vathsalashetty96/argo-cd/util/ksonnet"
-	argokube "github.com/vathsalashetty96/argo-cd/util/kube"
 	"github.com/vathsalashetty96/argo-cd/util/kustomize"
 	"github.com/vathsalashetty96/argo-cd/util/security"
 	"github.com/vathsalashetty96/argo-cd/util/text"
+	"github.com/vathsalashetty96/argo-cd/util/trace"
 )
 
 const (
@@ -59,6 +65,9 @@ const (
 	allowConcurrencyFile           = ".argocd-allow-concurrency"
 	repoSourceFile                 = ".argocd-source.yaml"
 	appSourceFile                  = ".argocd-source-%s.yaml"
+	// helmPostRendererOutputFile is the resource of a Kustomize post-renderer overlay which holds the manifests rendered
+	// by Helm
+	helmPostRendererOutputFile = "helm-output.yaml"
 )
 
 // Service implements ManifestService interface
@@ -67,7 +76,8 @@ type Service struct {
 	cache                     *reposervercache.Cache
 	parallelismLimitSemaphore *semaphore.Weighted
 	metricsServer             *metrics.MetricsServer
-	newGitClient              func(rawRepoURL string, creds git.Creds, insecure bool, enableLfs bool) (git.Client, error)
+	diskCache                 *diskcache.Cache
+	newGitClient              func(rawRepoURL string, root string, creds git.Creds, insecure bool, enableLfs bool, opts ...git.ClientOpts) (git.Client, error)
 	newHelmClient             func(repoURL string, creds helm.Creds, enableOci bool) helm.Client
 	initConstants             RepoServerInitConstants
 	// now is usually just time.Now, but may be replaced by unit tests for testing purposes
@@ -79,6 +89,37 @@ type RepoServerInitConstants struct {
 	PauseGenerationAfterFailedGenerationAttempts int
 	PauseGenerationOnFailureForMinutes           int
 	PauseGenerationOnFailureForRequests          int
+	// GitPartialClone fetches commits and trees only, the contents of files are fetched when they are checked out
+	GitPartialClone bool
+	// GitObjectStore is the directory of the bare repositories keeping the objects of the repositories, if they are
+	// shared. Objects are only shared by the clones of the same repository, so that a commit of a repository can never
+	// be checked out from a clone of another one.
+	GitObjectStore string
+	// GitWorktrees is the number of worktrees per repository in which different revisions are checked out
+	// concurrently. Revisions are checked out in the repository itself if it is zero.
+	GitWorktrees int
+	// GitSparseCheckout limits the files checked out in worktrees to the directories of the application source
+	GitSparseCheckout bool
+	// DiskCachePath is the directory in which repositories, worktrees and Helm charts are stored. A directory in the
+	// temp dir is used if it is empty.
+	DiskCachePath string
+	// DiskCacheSize is the number of bytes the disk cache may use before the least recently used repositories,
+	// worktrees and Helm charts are removed. The disk cache is unlimited if it is zero.
+	DiskCacheSize int64
+	// ManifestGenerationLimits limit the resources used to generate the manifests of an application
+	ManifestGenerationLimits ManifestGenerationLimits
+}
+
+// ManifestGenerationLimits limit the resources used to generate the manifests of an application. The memory and CPU
+// time of config management tools are limited by the ARGOCD_EXEC_MAX_MEMORY and ARGOCD_EXEC_MAX_CPU_TIME env
+// variables. Zero values mean no limit.
+type ManifestGenerationLimits struct {
+	// MaxManifestsSize is the maximum total size in bytes of the generated manifests
+	MaxManifestsSize int64
+	// MaxFiles is the maximum number of files read from directories of plain manifests, including Jsonnet imports
+	MaxFiles int
+	// MaxJsonnetStack is the maximum number of stack frames of a Jsonnet evaluation
+	MaxJsonnetStack int
 }
 
 // NewService returns a new instance of the Manifest service
@@ -88,14 +129,26 @@ func NewService(metricsServer *metrics.MetricsServer, cache *reposervercache.Cac
 		parallelismLimitSemaphore = semaphore.NewWeighted(initConstants.ParallelismLimit)
 	}
 	repoLock := NewRepositoryLock()
+	diskCachePath := initConstants.DiskCachePath
+	if diskCachePath == "" {
+		diskCachePath = filepath.Join(os.TempDir(), "_argocd-repo-server")
+	}
+	diskCache := diskcache.NewCache(diskCachePath, initConstants.DiskCacheSize, metricsServer.SetDiskCacheSize)
+	// entries left over from before a restart
+	if err := diskCache.Load("git/*", "worktrees/*/*", "values/*", "values-worktrees/*/*", "helm/*/*.tgz"); err != nil {
+		log.Warnf("Failed to load disk cache from %s: %v", diskCachePath, err)
+	}
 	return &Service{
 		parallelismLimitSemaphore: parallelismLimitSemaphore,
 		repoLock:                  repoLock,
 		cache:                     cache,
 		metricsServer:             metricsServer,
-		newGitClient:              git.NewClient,
+		diskCache:                 diskCache,
+		newGitClient:              newGitClient,
 		newHelmClient: func(repoURL string, creds helm.Creds, enableOci bool) helm.Client {
-			return helm.NewClientWithLock(repoURL, creds, sync.NewKeyLock(), enableOci)
+			return helm.NewClientWithLock(repoURL, creds, sync.NewKeyLock(), enableOci,
+				helm.WithRepoPath(diskCache.Path("helm", strings.Replace(repoURL, "/", "_", -1))),
+				helm.WithChartUsage(diskCache.Use))
 		},
 		initConstants: initConstants,
 		now:           time.Now,
@@ -138,6 +191,7 @@ func (s *Service) ListApps(ctx context.Context, q *apiclient.ListAppsRequest) (*
 
 	s.metricsServer.IncPendingRepoRequest(q.Repo.Repo)
 	defer s.metricsServer.DecPendingRepoRequest(q.Repo.Repo)
+	defer io.Close(s.diskCache.Use(gitClient.Root()))
 
 	closer, err := s.repoLock.Lock(gitClient.Root(), commitSHA, true, func() error {
 		return checkoutRevision(gitClient, commitSHA)
@@ -194,13 +248,16 @@ func (s *Service) runRepoOperation(
 	source *v1alpha1.ApplicationSource,
 	verifyCommit bool,
 	getCached func(cacheKey string, firstInvocation bool) (bool, interface{}, error),
-	operation func(repoRoot, commitSHA, cacheKey string, ctxSrc operationContextSrc) (interface{}, error),
+	operation func(ctx context.Context, repoRoot, commitSHA, cacheKey string, ctxSrc operationContextSrc) (interface{}, error),
 	settings operationSettings) (interface{}, error) {
 
 	var gitClient git.Client
 	var helmClient helm.Client
 	var err error
 	revision = textutils.FirstNonEmpty(revision, source.TargetRevision)
+	ctx, span := trace.StartSpan(ctx, "runRepoOperation", oteltrace.WithAttributes(
+		attribute.String("repo", repo.Repo), attribute.String("revision", revision), attribute.String("path", source.Path), attribute.String("chart", source.Chart)))
+	defer span.End()
 	if source.IsHelm() {
 		helmClient, revision, err = s.newHelmClientResolveRevision(repo, revision, source.Chart)
 		if err != nil {
@@ -242,17 +299,41 @@ func (s *Service) runRepoOperation(
 				return nil, err
 			}
 		}
+		_, extractSpan := trace.StartSpan(ctx, "extractChart")
 		chartPath, closer, err := helmClient.ExtractChart(source.Chart, version)
+		trace.FinishSpan(extractSpan, err)
 		if err != nil {
 			return nil, err
 		}
 		defer io.Close(closer)
-		return operation(chartPath, revision, revision, func() (*operationContext, error) {
+		return operation(ctx, chartPath, revision, revision, func() (*operationContext, error) {
 			return &operationContext{chartPath, ""}, nil
 		})
 	} else {
-		closer, err := s.repoLock.Lock(gitClient.Root(), revision, settings.allowConcurrent, func() error {
-			return checkoutRevision(gitClient, revision)
+		defer io.Close(s.diskCache.Use(gitClient.Root()))
+		checkout := checkoutRevision
+		lockRevision := revision
+		if s.initConstants.GitWorktrees > 0 {
+			// different revisions are checked out in different worktrees and don't wait for each other, unless they
+			// share the worktree
+			worktrees := s.diskCache.Path("worktrees", filepath.Base(gitClient.Root()))
+			gitClient = gitClient.Worktree(worktreePath(worktrees, revision, s.initConstants.GitWorktrees))
+			defer io.Close(s.diskCache.Use(gitClient.Root()))
+			var paths []string
+			if s.initConstants.GitSparseCheckout {
+				paths = sparseCheckoutPaths(source)
+				// sources with other paths need to extend the sparse checkout first
+				lockRevision = revision + "|" + strings.Join(paths, ",")
+			}
+			checkout = func(gitClient git.Client, revision string) error {
+				return checkoutWorktree(gitClient, revision, paths)
+			}
+		}
+		closer, err := s.repoLock.Lock(gitClient.Root(), lockRevision, settings.allowConcurrent, func() error {
+			_, checkoutSpan := trace.StartSpan(ctx, "checkoutRevision")
+			err := checkout(gitClient, revision)
+			trace.FinishSpan(checkoutSpan, err)
+			return err
 		})
 
 		if err != nil {
@@ -275,7 +356,7 @@ func (s *Service) runRepoOperation(
 		}
 		// Here commitSHA refers to the SHA of the actual commit, whereas revision refers to the branch/tag name etc
 		// We use the commitSHA to generate manifests and store them in cache, and revision to retrieve them from cache
-		return operation(gitClient.Root(), commitSHA, revision, func() (*operationContext, error) {
+		return operation(ctx, gitClient.Root(), commitSHA, revision, func() (*operationContext, error) {
 			var signature string
 			if verifyCommit {
 				signature, err = gitClient.VerifyCommitSignature(revision)
@@ -293,11 +374,17 @@ func (s *Service) runRepoOperation(
 }
 
 func (s *Service) GenerateManifest(ctx context.Context, q *apiclient.ManifestRequest) (*apiclient.ManifestResponse, error) {
+	// the manifests are generated again if one of the values repositories changed
+	valuesRepos, err := s.resolveValuesRepos(q)
+	if err != nil {
+		return nil, err
+	}
+	valuesRepoRevisions := valuesRepos.revisions()
 	resultUncast, err := s.runRepoOperation(ctx, q.Revision, q.Repo, q.ApplicationSource, q.VerifySignature,
 		func(cacheKey string, firstInvocation bool) (bool, interface{}, error) {
-			return s.getManifestCacheEntry(cacheKey, q, firstInvocation)
-		}, func(repoRoot, commitSHA, cacheKey string, ctxSrc operationContextSrc) (interface{}, error) {
-			return s.runManifestGen(repoRoot, commitSHA, cacheKey, ctxSrc, q)
+			return s.getManifestCacheEntry(cacheKey, q, valuesRepoRevisions, firstInvocation)
+		}, func(ctx context.Context, repoRoot, commitSHA, cacheKey string, ctxSrc operationContextSrc) (interface{}, error) {
+			return s.runManifestGen(ctx, repoRoot, commitSHA, cacheKey, ctxSrc, q, valuesRepos)
 		}, operationSettings{sem: s.parallelismLimitSemaphore, noCache: q.NoCache, allowConcurrent: q.ApplicationSource.AllowsConcurrentProcessing()})
 	result, ok := resultUncast.(*apiclient.ManifestResponse)
 	if result != nil && !ok {
@@ -312,11 +399,20 @@ func (s *Service) GenerateManifest(ctx context.Context, q *apiclient.ManifestReq
 // - or, the cache does contain a value for this key, but it is an expired manifest generation entry
 // - or, NoCache is true
 // Returns a ManifestResponse, or an error, but not both
-func (s *Service) runManifestGen(repoRoot, commitSHA, cacheKey string, ctxSrc operationContextSrc, q *apiclient.ManifestRequest) (interface{}, error) {
+func (s *Service) runManifestGen(ctx context.Context, repoRoot, commitSHA, cacheKey string, ctxSrc operationContextSrc, q *apiclient.ManifestRequest, valuesRepos valuesRepos) (interface{}, error) {
 	var manifestGenResult *apiclient.ManifestResponse
-	ctx, err := ctxSrc()
+	valuesRepoRevisions := valuesRepos.revisions()
+	opContext, err := ctxSrc()
 	if err == nil {
-		manifestGenResult, err = GenerateManifests(ctx.appPath, repoRoot, commitSHA, q, false)
+		var valuesRepoRoots map[string]string
+		var closer io.Closer
+		valuesRepoRoots, closer, err = s.checkoutValuesRepos(ctx, valuesRepos)
+		if err == nil {
+			defer io.Close(closer)
+			_, span := trace.StartSpan(ctx, "GenerateManifests", oteltrace.WithAttributes(attribute.String("application", q.AppName), attribute.String("revision", commitSHA)))
+			manifestGenResult, err = GenerateManifests(opContext.appPath, repoRoot, commitSHA, q, valuesRepoRoots, false, s.initConstants.ManifestGenerationLimits)
+			trace.FinishSpan(span, err)
+		}
 	}
 	if err != nil {
 
@@ -326,7 +422,7 @@ func (s *Service) runManifestGen(repoRoot, commitSHA, cacheKey string, ctxSrc op
 			// Retrieve a new copy (if available) of the cached response: this ensures we are updating the latest copy of the cache,
 			// rather than a copy of the cache that occurred before (a potentially lengthy) manifest generation.
 			innerRes := &cache.CachedManifestResponse{}
-			cacheErr := s.cache.GetManifests(cacheKey, q.ApplicationSource, q.Namespace, q.AppLabelKey, q.AppName, innerRes)
+			cacheErr := s.cache.GetManifests(cacheKey, q.ApplicationSource, valuesRepoRevisions, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, innerRes)
 			if cacheErr != nil && cacheErr != reposervercache.ErrCacheMiss {
 				log.Warnf("manifest cache set error %s: %v", q.ApplicationSource.String(), cacheErr)
 				return nil, cacheErr
@@ -341,7 +437,8 @@ func (s *Service) runManifestGen(repoRoot, commitSHA, cacheKey string, ctxSrc op
 			// Update the cache to include failure information
 			innerRes.NumberOfConsecutiveFailures++
 			innerRes.MostRecentError = err.Error()
-			cacheErr = s.cache.SetManifests(cacheKey, q.ApplicationSource, q.Namespace, q.AppLabelKey, q.AppName, innerRes)
+			innerRes.MostRecentErrorCode = uint32(status.Code(err))
+			cacheErr = s.cache.SetManifests(cacheKey, q.ApplicationSource, valuesRepoRevisions, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, innerRes)
 			if cacheErr != nil {
 				log.Warnf("manifest cache set error %s: %v", q.ApplicationSource.String(), cacheErr)
 				return nil, cacheErr
@@ -359,23 +456,37 @@ func (s *Service) runManifestGen(repoRoot, commitSHA, cacheKey string, ctxSrc op
 		MostRecentError:                 "",
 	}
 	manifestGenResult.Revision = commitSHA
-	manifestGenResult.VerifyResult = ctx.verificationResult
-	err = s.cache.SetManifests(cacheKey, q.ApplicationSource, q.Namespace, q.AppLabelKey, q.AppName, &manifestGenCacheEntry)
+	manifestGenResult.VerifyResult = opContext.verificationResult
+	err = s.cache.SetManifests(cacheKey, q.ApplicationSource, valuesRepoRevisions, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &manifestGenCacheEntry)
 	if err != nil {
 		log.Warnf("manifest cache set error %s/%s: %v", q.ApplicationSource.String(), cacheKey, err)
 	}
 	return manifestGenCacheEntry.ManifestResponse, nil
 }
 
+// cachedError is a cached manifest generation error which keeps the gRPC code of the original error
+type cachedError struct {
+	code    codes.Code
+	message string
+}
+
+func (e *cachedError) Error() string {
+	return e.message
+}
+
+func (e *cachedError) GRPCStatus() *status.Status {
+	return status.New(e.code, e.message)
+}
+
 // getManifestCacheEntry returns false if the 'generate manifests' operation should be run by runRepoOperation, eg:
 // - If the cache result is empty for the requested key
 // - If the cache is not empty, but the cached value is a manifest generation error AND we have not yet met the failure threshold (eg res.NumberOfConsecutiveFailures > 0 && res.NumberOfConsecutiveFailures <  s.initConstants.PauseGenerationAfterFailedGenerationAttempts)
 // - If the cache is not empty, but the cache value is an error AND that generation error has expired
 // and returns true otherwise.
 // If true is returned, either the second or third parameter (but not both) will contain a value from the cache (a ManifestResponse, or error, respectively)
-func (s *Service) getManifestCacheEntry(cacheKey string, q *apiclient.ManifestRequest, firstInvocation bool) (bool, interface{}, error) {
+func (s *Service) getManifestCacheEntry(cacheKey string, q *apiclient.ManifestRequest, valuesRepoRevisions map[string]string, firstInvocation bool) (bool, interface{}, error) {
 	res := cache.CachedManifestResponse{}
-	err := s.cache.GetManifests(cacheKey, q.ApplicationSource, q.Namespace, q.AppLabelKey, q.AppName, &res)
+	err := s.cache.GetManifests(cacheKey, q.ApplicationSource, valuesRepoRevisions, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &res)
 	if err == nil {
 
 		// The cache contains an existing value
@@ -394,7 +505,7 @@ func (s *Service) getManifestCacheEntry(cacheKey string, q *apiclient.ManifestRe
 					// After X minutes, reset the cache and retry the operation (eg perhaps the error is ephemeral and has passed)
 					if elapsedTimeInMinutes >= s.initConstants.PauseGenerationOnFailureForMinutes {
 						// We can now try again, so reset the cache state and run the operation below
-						err = s.cache.DeleteManifests(cacheKey, q.ApplicationSource, q.Namespace, q.AppLabelKey, q.AppName)
+						err = s.cache.DeleteManifests(cacheKey, q.ApplicationSource, valuesRepoRevisions, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName)
 						if err != nil {
 							log.Warnf("manifest cache set error %s/%s: %v", q.ApplicationSource.String(), cacheKey, err)
 						}
@@ -408,7 +519,7 @@ func (s *Service) getManifestCacheEntry(cacheKey string, q *apiclient.ManifestRe
 
 					if res.NumberOfCachedResponsesReturned >= s.initConstants.PauseGenerationOnFailureForRequests {
 						// We can now try again, so reset the error cache state and run the operation below
-						err = s.cache.DeleteManifests(cacheKey, q.ApplicationSource, q.Namespace, q.AppLabelKey, q.AppName)
+						err = s.cache.DeleteManifests(cacheKey, q.ApplicationSource, valuesRepoRevisions, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName)
 						if err != nil {
 							log.Warnf("manifest cache set error %s/%s: %v", q.ApplicationSource.String(), cacheKey, err)
 						}
@@ -421,12 +532,16 @@ func (s *Service) getManifestCacheEntry(cacheKey string, q *apiclient.ManifestRe
 				log.Infof("manifest error cache hit: %s/%s", q.ApplicationSource.String(), cacheKey)
 
 				cachedErrorResponse := fmt.Errorf(cachedManifestGenerationPrefix+": %s", res.MostRecentError)
+				if code := codes.Code(res.MostRecentErrorCode); code != codes.OK {
+					// keep the code of the error, e.g. to report exceeded limits as such
+					cachedErrorResponse = &cachedError{code: code, message: cachedErrorResponse.Error()}
+				}
 
 				if firstInvocation {
 					// Increment the number of returned cached responses and push that new value to the cache
 					// (if we have not already done so previously in this function)
 					res.NumberOfCachedResponsesReturned++
-					err = s.cache.SetManifests(cacheKey, q.ApplicationSource, q.Namespace, q.AppLabelKey, q.AppName, &res)
+					err = s.cache.SetManifests(cacheKey, q.ApplicationSource, valuesRepoRevisions, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &res)
 					if err != nil {
 						log.Warnf("manifest cache set error %s/%s: %v", q.ApplicationSource.String(), cacheKey, err)
 					}
@@ -498,7 +613,7 @@ func runHelmBuild(appPath string, h helm.Helm) error {
 	return ioutil.WriteFile(markerFile, []byte("marker"), 0644)
 }
 
-func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclient.ManifestRequest, isLocal bool) ([]*unstructured.Unstructured, error) {
+func helmTemplate(appPath string, repoRoot string, valuesRepoRoots map[string]string, env *v1alpha1.Env, q *apiclient.ManifestRequest, isLocal bool) ([]*unstructured.Unstructured, error) {
 	concurrencyAllowed := isConcurrencyAllowed(appPath)
 	if !concurrencyAllowed {
 		manifestGenerateLock.Lock(appPath)
@@ -516,6 +631,7 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 	}
 
 	appHelm := q.ApplicationSource.Helm
+	repos := getHelmRepos(q.Repos)
 	var version string
 	if appHelm != nil {
 		if appHelm.Version != "" {
@@ -524,33 +640,74 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 		if appHelm.ReleaseName != "" {
 			templateOpts.Name = appHelm.ReleaseName
 		}
+		if appHelm.KubeVersion != "" {
+			templateOpts.KubeVersion = text.SemVer(appHelm.KubeVersion)
+		}
+		if len(appHelm.APIVersions) > 0 {
+			templateOpts.APIVersions = appHelm.APIVersions
+		}
+		templateOpts.SkipCrds = appHelm.SkipCrds
+		if appHelm.PassCredentials {
+			for i := range repos {
+				repos[i].PassCredentials = true
+			}
+		}
 
 		for _, val := range appHelm.ValueFiles {
-			// If val is not a URL, run it against the directory enforcer. If it is a URL, use it without checking
-			if _, err := url.ParseRequestURI(val); err != nil {
-
-				// Ensure that the repo root provided is absolute
-				absRepoPath, err := filepath.Abs(repoRoot)
+			// If val references a values repository, run it against the directory enforcer of that repository
+			if alias, file, ok := v1alpha1.ParseHelmValuesRepoRef(val); ok {
+				path, err := valuesRepoFile(valuesRepoRoots, alias, file)
 				if err != nil {
 					return nil, err
 				}
-
-				// If the path to the file is relative, join it with the current working directory (appPath)
-				path := val
-				if !filepath.IsAbs(path) {
-					absWorkDir, err := filepath.Abs(appPath)
-					if err != nil {
-						return nil, err
+				if appHelm.IgnoreMissingValueFiles {
+					if _, err := os.Stat(path); os.IsNotExist(err) {
+						log.Debugf("Ignoring missing value file %s", val)
+						continue
 					}
-					path = filepath.Join(absWorkDir, path)
 				}
+				templateOpts.Values = append(templateOpts.Values, path)
+				continue
+			}
+			// Only HTTPS URLs are remote value files, which must be permitted by the project unless the manifests are
+			// generated locally. Everything else is a path which is run against the directory enforcer, so that helm
+			// is never passed a file outside the repository or a URL of another scheme.
+			if v1alpha1.IsHelmValueURL(val) {
+				if !isLocal && !v1alpha1.MatchHelmValueURL(q.HelmValueURLs, val) {
+					return nil, status.Errorf(codes.PermissionDenied, "value file URL %s is not permitted in the project of the application", val)
+				}
+				templateOpts.Values = append(templateOpts.Values, val)
+				continue
+			}
 
-				_, err = security.EnforceToCurrentRoot(absRepoPath, path)
+			// Ensure that the repo root provided is absolute
+			absRepoPath, err := filepath.Abs(repoRoot)
+			if err != nil {
+				return nil, err
+			}
+
+			// If the path to the file is relative, join it with the current working directory (appPath)
+			path := val
+			if !filepath.IsAbs(path) {
+				absWorkDir, err := filepath.Abs(appPath)
 				if err != nil {
 					return nil, err
 				}
+				path = filepath.Join(absWorkDir, path)
+			}
+
+			_, err = security.EnforceToCurrentRoot(absRepoPath, path)
+			if err != nil {
+				return nil, err
 			}
-			templateOpts.Values = append(templateOpts.Values, val)
+
+			if appHelm.IgnoreMissingValueFiles {
+				if _, err := os.Stat(path); os.IsNotExist(err) {
+					log.Debugf("Ignoring missing value file %s", val)
+					continue
+				}
+			}
+			templateOpts.Values = append(templateOpts.Values, path)
 		}
 
 		if appHelm.Values != "" {
@@ -590,7 +747,7 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 	for i, j := range templateOpts.SetFile {
 		templateOpts.SetFile[i] = env.Envsubst(j)
 	}
-	h, err := helm.NewHelmApp(appPath, getHelmRepos(q.Repos), isLocal, version)
+	h, err := helm.NewHelmApp(appPath, repos, isLocal, version)
 
 	if err != nil {
 		return nil, err
@@ -622,11 +779,136 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 			return nil, err
 		}
 	}
+	if appHelm != nil && appHelm.PostRenderer != nil {
+		return helmPostRender(out, appHelm.PostRenderer, appPath, repoRoot, env, q)
+	}
 	return kube.SplitYAML([]byte(out))
 }
 
-// GenerateManifests generates manifests from a path
-func GenerateManifests(appPath, repoRoot, revision string, q *apiclient.ManifestRequest, isLocal bool) (*apiclient.ManifestResponse, error) {
+// valuesRepoFile returns the absolute path of a file of the values repository with the given alias. The file must be in
+// the repository.
+func valuesRepoFile(valuesRepoRoots map[string]string, alias string, file string) (string, error) {
+	root, ok := valuesRepoRoots[alias]
+	if !ok {
+		return "", fmt.Errorf("values repository %s is not available", alias)
+	}
+	absRoot, err := filepath.Abs(root)
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(absRoot, file)
+	if _, err := security.EnforceToCurrentRoot(absRoot, path); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// helmPostRender passes the manifests rendered by Helm through either a Kustomize overlay or a config management
+// plugin
+func helmPostRender(out string, postRenderer *v1alpha1.HelmPostRenderer, appPath, repoRoot string, env *v1alpha1.Env, q *apiclient.ManifestRequest) ([]*unstructured.Unstructured, error) {
+	switch {
+	case postRenderer.Kustomize != "" && postRenderer.Plugin != "":
+		return nil, fmt.Errorf("Helm post-renderer must be either a Kustomize overlay or a plugin, not both")
+	case postRenderer.Kustomize != "":
+		return kustomizePostRender(out, postRenderer.Kustomize, repoRoot, q)
+	case postRenderer.Plugin != "":
+		plugin := findPlugin(q.Plugins, postRenderer.Plugin)
+		if plugin == nil {
+			return nil, fmt.Errorf("Config management plugin with name '%s' is not supported.", postRenderer.Plugin)
+		}
+		environ := append(os.Environ(), env.Environ()...)
+		environ = append(environ, "KUBE_VERSION="+q.KubeVersion)
+		environ = append(environ, "KUBE_API_VERSIONS="+strings.Join(q.ApiVersions, ","))
+		if plugin.Init != nil {
+			if _, err := runCommand(*plugin.Init, appPath, environ); err != nil {
+				return nil, err
+			}
+		}
+		rendered, err := runCommandWithInput(plugin.Generate, appPath, environ, out)
+		if err != nil {
+			return nil, err
+		}
+		return kube.SplitYAML([]byte(rendered))
+	}
+	return nil, fmt.Errorf("Helm post-renderer must be either a Kustomize overlay or a plugin")
+}
+
+// kustomizePostRender builds the Kustomize overlay at the given path in the repository with the manifests rendered by
+// Helm as its helm-output.yaml resource. The overlay is copied next to itself, so that relative references to its
+// bases still resolve, and the manifests are written to the copy, so that concurrent requests don't interfere.
+func kustomizePostRender(out string, overlay string, repoRoot string, q *apiclient.ManifestRequest) ([]*unstructured.Unstructured, error) {
+	absRepoRoot, err := filepath.Abs(repoRoot)
+	if err != nil {
+		return nil, err
+	}
+	overlayPath := filepath.Join(absRepoRoot, overlay)
+	if _, err := security.EnforceToCurrentRoot(absRepoRoot, overlayPath); err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(filepath.Join(overlayPath, helmPostRendererOutputFile)); err == nil {
+		return nil, fmt.Errorf("Kustomize post-renderer overlay %s must not contain %s", overlay, helmPostRendererOutputFile)
+	}
+
+	tmpPath, err := ioutil.TempDir(filepath.Dir(overlayPath), ".helm-post-renderer-")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = os.RemoveAll(tmpPath) }()
+	if err := copyDir(overlayPath, tmpPath); err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(tmpPath, helmPostRendererOutputFile), []byte(out), 0644); err != nil {
+		return nil, err
+	}
+
+	repoURL := ""
+	if q.Repo != nil {
+		repoURL = q.Repo.Repo
+	}
+	kustomizeBinary := ""
+	if q.KustomizeOptions != nil {
+		kustomizeBinary = q.KustomizeOptions.BinaryPath
+	}
+	k := kustomize.NewKustomizeApp(tmpPath, q.Repo.GetGitCreds(), repoURL, kustomizeBinary)
+	objs, _, err := k.Build(nil, q.KustomizeOptions)
+	return objs, err
+}
+
+// copyDir copies the files, directories and symlinks in src to dst, which must exist
+func copyDir(src string, dst string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, relPath)
+		switch {
+		case relPath == ".":
+			return nil
+		case info.IsDir():
+			return os.Mkdir(target, info.Mode())
+		case info.Mode()&os.ModeSymlink != 0:
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			return os.Symlink(link, target)
+		default:
+			data, err := ioutil.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			return ioutil.WriteFile(target, data, info.Mode())
+		}
+	})
+}
+
+// GenerateManifests generates manifests from a path. An error with the ResourceExhausted code is returned if the
+// generation exceeded one of the limits.
+func GenerateManifests(appPath, repoRoot, revision string, q *apiclient.ManifestRequest, valuesRepoRoots map[string]string, isLocal bool, limits ManifestGenerationLimits) (*apiclient.ManifestResponse, error) {
 	var targetObjs []*unstructured.Unstructured
 	var dest *v1alpha1.ApplicationDestination
 
@@ -644,7 +926,7 @@ func GenerateManifests(appPath, repoRoot, revision string, q *apiclient.Manifest
 	case v1alpha1.ApplicationSourceTypeKsonnet:
 		targetObjs, dest, err = ksShow(q.AppLabelKey, appPath, q.ApplicationSource.Ksonnet)
 	case v1alpha1.ApplicationSourceTypeHelm:
-		targetObjs, err = helmTemplate(appPath, repoRoot, env, q, isLocal)
+		targetObjs, err = helmTemplate(appPath, repoRoot, valuesRepoRoots, env, q, isLocal)
 	case v1alpha1.ApplicationSourceTypeKustomize:
 		kustomizeBinary := ""
 		if q.KustomizeOptions != nil {
@@ -659,13 +941,18 @@ func GenerateManifests(appPath, repoRoot, revision string, q *apiclient.Manifest
 		if directory = q.ApplicationSource.Directory; directory == nil {
 			directory = &v1alpha1.ApplicationSourceDirectory{}
 		}
-		targetObjs, err = findManifests(appPath, repoRoot, env, *directory)
+		targetObjs, err = findManifests(appPath, repoRoot, env, *directory, isLocal, limits)
 	}
 	if err != nil {
+		var limitErr *executil.LimitExceededError
+		if errors.As(err, &limitErr) {
+			return nil, newLimitExceededError("%v", err)
+		}
 		return nil, err
 	}
 
 	manifests := make([]string, 0)
+	var manifestsSize int64
 	for _, obj := range targetObjs {
 		var targets []*unstructured.Unstructured
 		if obj.IsList() {
@@ -688,7 +975,7 @@ func GenerateManifests(appPath, repoRoot, revision string, q *apiclient.Manifest
 
 		for _, target := range targets {
 			if q.AppLabelKey != "" && q.AppName != "" && !kube.IsCRD(target) {
-				err = argokube.SetAppInstanceLabel(target, q.AppLabelKey, q.AppName)
+				err = argo.SetAppInstance(target, q.AppLabelKey, q.AppName, q.Namespace, argo.TrackingMethod(q.TrackingMethod))
 				if err != nil {
 					return nil, err
 				}
@@ -697,6 +984,10 @@ func GenerateManifests(appPath, repoRoot, revision string, q *apiclient.Manifest
 			if err != nil {
 				return nil, err
 			}
+			manifestsSize += int64(len(manifestStr))
+			if limits.MaxManifestsSize > 0 && manifestsSize > limits.MaxManifestsSize {
+				return nil, newLimitExceededError("generated manifests exceed %s", resource.NewQuantity(limits.MaxManifestsSize, resource.BinarySI))
+			}
 			manifests = append(manifests, string(manifestStr))
 		}
 	}
@@ -856,10 +1147,51 @@ func ksShow(appLabelKey, appPath string, ksonnetOpts *v1alpha1.ApplicationSource
 
 var manifestFile = regexp.MustCompile(`^.*\.(yaml|yml|json|jsonnet)$`)
 
+// newLimitExceededError returns the error reported if manifest generation exceeded one of its limits
+func newLimitExceededError(format string, args ...interface{}) error {
+	return status.Errorf(codes.ResourceExhausted, "manifest generation limit exceeded: "+format, args...)
+}
+
+// fileLimit counts the files read to generate manifests
+type fileLimit struct {
+	max   int
+	count int
+}
+
+func (l *fileLimit) read(path string) error {
+	l.count++
+	if l.exceeded() {
+		return newLimitExceededError("more than %d files read, e.g. %s", l.max, path)
+	}
+	return nil
+}
+
+func (l *fileLimit) exceeded() bool {
+	return l.max > 0 && l.count > l.max
+}
+
+// limitedImporter counts the files imported by Jsonnet towards the limit of files read
+type limitedImporter struct {
+	jsonnet.Importer
+	files *fileLimit
+}
+
+func (i *limitedImporter) Import(importedFrom, importedPath string) (jsonnet.Contents, string, error) {
+	if err := i.files.read(importedPath); err != nil {
+		return jsonnet.Contents{}, "", err
+	}
+	return i.Importer.Import(importedFrom, importedPath)
+}
+
 // findManifests looks at all yaml files in a directory and unmarshals them into a list of unstructured objects
-func findManifests(appPath string, repoRoot string, env *v1alpha1.Env, directory v1alpha1.ApplicationSourceDirectory) ([]*unstructured.Unstructured, error) {
+func findManifests(appPath string, repoRoot string, env *v1alpha1.Env, directory v1alpha1.ApplicationSourceDirectory, isLocal bool, limits ManifestGenerationLimits) ([]*unstructured.Unstructured, error) {
 	var objs []*unstructured.Unstructured
-	err := filepath.Walk(appPath, func(path string, f os.FileInfo, err error) error {
+	files := &fileLimit{max: limits.MaxFiles}
+	jpaths, err := jsonnetPaths(appPath, repoRoot, directory.Jsonnet.Libs)
+	if err != nil {
+		return nil, err
+	}
+	err = filepath.Walk(appPath, func(path string, f os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
@@ -884,15 +1216,32 @@ func findManifests(appPath string, repoRoot string, env *v1alpha1.Env, directory
 			return nil
 		}
 
+		if err := files.read(path); err != nil {
+			return err
+		}
+
 		if strings.HasSuffix(f.Name(), ".jsonnet") {
-			vm, err := makeJsonnetVm(appPath, repoRoot, directory.Jsonnet, env)
+			res, err := evaluateJsonnet(jsonnetEvaluation{
+				File:          path,
+				Jsonnet:       directory.Jsonnet,
+				Env:           env,
+				JPaths:        jpaths,
+				MaxStack:      limits.MaxJsonnetStack,
+				MaxFiles:      files.max,
+				FilesRead:     files.count,
+				MaxOutputSize: limits.MaxManifestsSize,
+			}, isLocal)
 			if err != nil {
 				return err
 			}
-			jsonStr, err := vm.EvaluateFile(path)
-			if err != nil {
-				return status.Errorf(codes.FailedPrecondition, "Failed to evaluate jsonnet %q: %v", f.Name(), err)
+			files.count = res.FilesRead
+			if res.Error != "" {
+				if res.LimitExceeded {
+					return newLimitExceededError("failed to evaluate jsonnet %q: %s", f.Name(), res.Error)
+				}
+				return status.Errorf(codes.FailedPrecondition, "Failed to evaluate jsonnet %q: %s", f.Name(), res.Error)
 			}
+			jsonStr := res.JSON
 
 			// attempt to unmarshal either array or single object
 			var jsonObjs []*unstructured.Unstructured
@@ -948,9 +1297,26 @@ func findManifests(appPath string, repoRoot string, env *v1alpha1.Env, directory
 	return objs, nil
 }
 
-func makeJsonnetVm(appPath string, repoRoot string, sourceJsonnet v1alpha1.ApplicationSourceJsonnet, env *v1alpha1.Env) (*jsonnet.VM, error) {
+// jsonnetPaths returns the directories Jsonnet imports are resolved in: the application path and the libraries, which
+// must be inside the repository
+func jsonnetPaths(appPath string, repoRoot string, libs []string) ([]string, error) {
+	jpaths := []string{appPath}
+	for _, p := range libs {
+		jpath := path.Join(repoRoot, p)
+		if !strings.HasPrefix(jpath, repoRoot) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s: referenced library points outside the repository", p)
+		}
+		jpaths = append(jpaths, jpath)
+	}
+	return jpaths, nil
+}
+
+func makeJsonnetVm(sourceJsonnet v1alpha1.ApplicationSourceJsonnet, env *v1alpha1.Env, jpaths []string, maxStack int, files *fileLimit) *jsonnet.VM {
 
 	vm := jsonnet.MakeVM()
+	if maxStack > 0 {
+		vm.MaxStack = maxStack
+	}
 	for i, j := range sourceJsonnet.TLAs {
 		sourceJsonnet.TLAs[i].Value = env.Envsubst(j.Value)
 	}
@@ -972,31 +1338,30 @@ func makeJsonnetVm(appPath string, repoRoot string, sourceJsonnet v1alpha1.Appli
 		}
 	}
 
-	// Jsonnet Imports relative to the repository path
-	jpaths := []string{appPath}
-	for _, p := range sourceJsonnet.Libs {
-		jpath := path.Join(repoRoot, p)
-		if !strings.HasPrefix(jpath, repoRoot) {
-			return nil, status.Errorf(codes.FailedPrecondition, "%s: referenced library points outside the repository", p)
-		}
-		jpaths = append(jpaths, jpath)
-	}
-
-	vm.Importer(&jsonnet.FileImporter{
-		JPaths: jpaths,
+	vm.Importer(&limitedImporter{
+		Importer: &jsonnet.FileImporter{
+			JPaths: jpaths,
+		},
+		files: files,
 	})
 
-	return vm, nil
+	return vm
 }
 
 func runCommand(command v1alpha1.Command, path string, env []string) (string, error) {
+	return runCommandWithInput(command, path, env, "")
+}
+
+// runCommandWithInput runs the command with the given input on its stdin
+func runCommandWithInput(command v1alpha1.Command, path string, env []string, input string) (string, error) {
 	if len(command.Command) == 0 {
 		return "", fmt.Errorf("Command is empty")
 	}
 	cmd := exec.Command(command.Command[0], append(command.Command[1:], command.Args...)...)
 	cmd.Env = env
 	cmd.Dir = path
-	return executil.Run(cmd)
+	cmd.Stdin = strings.NewReader(input)
+	return executil.RunLimited(cmd, nil)
 }
 
 func findPlugin(plugins []*v1alpha1.ConfigManagementPlugin, name string) *v1alpha1.ConfigManagementPlugin {
@@ -1063,7 +1428,7 @@ func (s *Service) GetAppDetails(ctx context.Context, q *apiclient.RepoServerAppD
 
 	}
 
-	resultUncast, err := s.runRepoOperation(ctx, q.Source.TargetRevision, q.Repo, q.Source, false, getCached, func(repoRoot, commitSHA, revision string, ctxSrc operationContextSrc) (interface{}, error) {
+	resultUncast, err := s.runRepoOperation(ctx, q.Source.TargetRevision, q.Repo, q.Source, false, getCached, func(_ context.Context, repoRoot, commitSHA, revision string, ctxSrc operationContextSrc) (interface{}, error) {
 		ctx, err := ctxSrc()
 		if err != nil {
 			return nil, err
@@ -1142,7 +1507,11 @@ func (s *Service) GetAppDetails(ctx context.Context, q *apiclient.RepoServerAppD
 				}
 				res.Helm.Values = string(bytes)
 			}
-			params, err := h.GetParameters(valueFiles(q))
+			paths, err := valueFiles(q, repoRoot, appPath)
+			if err != nil {
+				return nil, err
+			}
+			params, err := h.GetParameters(paths)
 			if err != nil {
 				return nil, err
 			}
@@ -1158,6 +1527,14 @@ func (s *Service) GetAppDetails(ctx context.Context, q *apiclient.RepoServerAppD
 					Path: v.Path, //filepath.Join(appPath, v.Path),
 				})
 			}
+			if q.Source.Helm != nil {
+				res.Helm.SkipCrds = q.Source.Helm.SkipCrds
+				res.Helm.PassCredentials = q.Source.Helm.PassCredentials
+				res.Helm.IgnoreMissingValueFiles = q.Source.Helm.IgnoreMissingValueFiles
+				res.Helm.KubeVersion = q.Source.Helm.KubeVersion
+				res.Helm.ApiVersions = q.Source.Helm.APIVersions
+				res.Helm.PostRenderer = q.Source.Helm.PostRenderer
+			}
 		case v1alpha1.ApplicationSourceTypeKustomize:
 			res.Kustomize = &apiclient.KustomizeAppSpec{}
 			kustomizeBinary := ""
@@ -1218,6 +1595,7 @@ func (s *Service) GetRevisionMetadata(ctx context.Context, q *apiclient.RepoServ
 
 	s.metricsServer.IncPendingRepoRequest(q.Repo.Repo)
 	defer s.metricsServer.DecPendingRepoRequest(q.Repo.Repo)
+	defer io.Close(s.diskCache.Use(gitClient.Root()))
 
 	closer, err := s.repoLock.Lock(gitClient.Root(), q.Revision, true, func() error {
 		return checkoutRevision(gitClient, q.Revision)
@@ -1262,11 +1640,41 @@ func (s *Service) GetRevisionMetadata(ctx context.Context, q *apiclient.RepoServ
 	return metadata, nil
 }
 
-func valueFiles(q *apiclient.RepoServerAppDetailsQuery) []string {
+// valueFiles returns the absolute paths of the value files of the source in the repository. Remote value files are
+// skipped, since the details of an app are requested without the URLs permitted by its project.
+func valueFiles(q *apiclient.RepoServerAppDetailsQuery, repoRoot string, appPath string) ([]string, error) {
 	if q.Source.Helm == nil {
-		return nil
+		return nil, nil
 	}
-	return q.Source.Helm.ValueFiles
+	absRepoPath, err := filepath.Abs(repoRoot)
+	if err != nil {
+		return nil, err
+	}
+	absWorkDir, err := filepath.Abs(appPath)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, file := range q.Source.Helm.ValueFiles {
+		// values repositories are not checked out to get the details of an app
+		if _, _, ok := v1alpha1.ParseHelmValuesRepoRef(file); ok || v1alpha1.IsHelmValueURL(file) {
+			continue
+		}
+		path := file
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(absWorkDir, path)
+		}
+		if _, err := security.EnforceToCurrentRoot(absRepoPath, path); err != nil {
+			return nil, err
+		}
+		if q.Source.Helm.IgnoreMissingValueFiles {
+			if _, err := os.Stat(path); os.IsNotExist(err) {
+				continue
+			}
+		}
+		files = append(files, path)
+	}
+	return files, nil
 }
 
 func fileParameters(q *apiclient.RepoServerAppDetailsQuery) []v1alpha1.HelmFileParameter {
@@ -1277,7 +1685,29 @@ func fileParameters(q *apiclient.RepoServerAppDetailsQuery) []v1alpha1.HelmFileP
 }
 
 func (s *Service) newClient(repo *v1alpha1.Repository) (git.Client, error) {
-	gitClient, err := s.newGitClient(repo.Repo, repo.GetGitCreds(), repo.IsInsecure(), repo.EnableLFS)
+	return s.newClientIn(repo, "git")
+}
+
+// newGitClient returns a client of the clone of the repository in the given root directory of the disk cache, which
+// must be a directory of the repository rather than the directory of all clones
+func newGitClient(rawRepoURL string, root string, creds git.Creds, insecure bool, enableLfs bool, opts ...git.ClientOpts) (git.Client, error) {
+	if git.NormalizeGitURL(rawRepoURL) == "" {
+		return nil, fmt.Errorf("Repository '%s' cannot be initialized, because its root would be the disk cache directory of all clones at %s", rawRepoURL, root)
+	}
+	return git.NewClientExt(rawRepoURL, root, creds, insecure, enableLfs, opts...)
+}
+
+// newClientIn returns a client of the clone of the repository in the given directory of the disk cache
+func (s *Service) newClientIn(repo *v1alpha1.Repository, dir string) (git.Client, error) {
+	name := strings.Replace(git.NormalizeGitURL(repo.Repo), "/", "_", -1)
+	var opts []git.ClientOpts
+	if s.initConstants.GitPartialClone {
+		opts = append(opts, git.WithPartialClone())
+	}
+	if s.initConstants.GitObjectStore != "" {
+		opts = append(opts, git.WithObjectStore(filepath.Join(s.initConstants.GitObjectStore, name)))
+	}
+	gitClient, err := s.newGitClient(repo.Repo, s.diskCache.Path(dir, name), repo.GetGitCreds(), repo.IsInsecure(), repo.EnableLFS, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1344,6 +1774,61 @@ func checkoutRevision(gitClient git.Client, revision string) error {
 	return err
 }
 
+// checkoutWorktree is a convenience function to initialize a repo, fetch a revision and check it out in a worktree of
+// the repo, limited to the given directories if any
+func checkoutWorktree(worktree git.Client, revision string, paths []string) error {
+	err := worktree.Init()
+	if err != nil {
+		return status.Errorf(codes.Internal, "Failed to initialize git repo: %v", err)
+	}
+	err = worktree.Fetch(revision)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Failed to fetch %s: %v", revision, err)
+	}
+	err = worktree.SparseCheckout(paths)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Failed to limit checkout to %s: %v", strings.Join(paths, ", "), err)
+	}
+	err = worktree.Checkout(revision)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Failed to checkout %s: %v", revision, err)
+	}
+	return nil
+}
+
+// worktreePath returns the path of the worktree of a repository in which the revision is checked out, given the
+// directory of the worktrees of the repository. Revisions are spread over a fixed number of worktrees, which limits the
+// disk space used by each repository.
+func worktreePath(dir string, revision string, worktrees int) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(revision))
+	return filepath.Join(dir, strconv.Itoa(int(h.Sum32()%uint32(worktrees))))
+}
+
+// sparseCheckoutPaths returns the directories of the repository which are needed to generate the manifests of the
+// source: the path of the source, the directories of its Helm value files and its Kustomize post-renderer overlay.
+// Files outside of them, e.g. Kustomize bases, are not available if the checkout is limited to these directories.
+func sparseCheckoutPaths(source *v1alpha1.ApplicationSource) []string {
+	paths := []string{source.Path}
+	if source.Helm != nil {
+		for _, file := range source.Helm.ValueFiles {
+			if _, _, ok := v1alpha1.ParseHelmValuesRepoRef(file); ok {
+				continue
+			}
+			if v1alpha1.IsHelmValueURL(file) {
+				continue
+			}
+			if dir := path.Dir(path.Join(source.Path, file)); dir != ".." && !strings.HasPrefix(dir, "../") {
+				paths = append(paths, dir)
+			}
+		}
+		if source.Helm.PostRenderer != nil && source.Helm.PostRenderer.Kustomize != "" {
+			paths = append(paths, path.Clean(source.Helm.PostRenderer.Kustomize))
+		}
+	}
+	return paths
+}
+
 func (s *Service) GetHelmCharts(ctx context.Context, q *apiclient.HelmChartsRequest) (*apiclient.HelmChartsResponse, error) {
 	index, err := s.newHelmClient(q.Repo.Repo, q.Repo.GetHelmCreds(), q.Repo.EnableOCI).GetIndex()
 	if err != nil {
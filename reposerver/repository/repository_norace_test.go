@@ -3,15 +3,20 @@
 package repository
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	argoappv1 "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/vathsalashetty96/argo-cd/reposerver/apiclient"
+	executil "github.com/vathsalashetty96/argo-cd/util/exec"
 )
 
 func TestHelmDependencyWithConcurrency(t *testing.T) {
@@ -32,7 +37,7 @@ func TestHelmDependencyWithConcurrency(t *testing.T) {
 	wg.Add(3)
 	for i := 0; i < 3; i++ {
 		go func() {
-			res, err := helmTemplate("../../util/helm/testdata/helm2-dependency", "../..", nil, &apiclient.ManifestRequest{
+			res, err := helmTemplate("../../util/helm/testdata/helm2-dependency", "../..", nil, nil, &apiclient.ManifestRequest{
 				ApplicationSource: &argoappv1.ApplicationSource{},
 				Repos:             []*argoappv1.Repository{&helmRepo},
 			}, false)
@@ -44,3 +49,47 @@ func TestHelmDependencyWithConcurrency(t *testing.T) {
 	}
 	wg.Wait()
 }
+
+func TestGenerateManifestsLimits_JsonnetEvaluator(t *testing.T) {
+
+	// !race:
+	// The memory limit is too low for the shadow memory of the race detector in the Jsonnet evaluator process
+
+	// the Go runtime of the evaluator process starts under the data segment size limit, so that exceeding the limit
+	// is caused by the evaluation rather than the start of the process
+	executil.SetLimits(256*1024*1024, 30*time.Second)
+	defer executil.SetLimits(0, 0)
+
+	service := newService(".")
+	q := apiclient.ManifestRequest{
+		Repo: &argoappv1.Repository{},
+		ApplicationSource: &argoappv1.ApplicationSource{
+			Path: "./testdata/jsonnet",
+			Directory: &argoappv1.ApplicationSourceDirectory{
+				Jsonnet: argoappv1.ApplicationSourceJsonnet{
+					ExtVars: []argoappv1.JsonnetVar{{Name: "extVarString", Value: "extVarString"}, {Name: "extVarCode", Value: "\"extVarCode\"", Code: true}},
+					TLAs:    []argoappv1.JsonnetVar{{Name: "tlaString", Value: "tlaString"}, {Name: "tlaCode", Value: "\"tlaCode\"", Code: true}},
+					Libs:    []string{"testdata/jsonnet/vendor"},
+				},
+			},
+		},
+		NoCache: true,
+	}
+	res, err := service.GenerateManifest(context.Background(), &q)
+	assert.NoError(t, err)
+	assert.Len(t, res.Manifests, 2)
+}
+
+func TestGenerateManifestsLimits_RunawayJsonnet(t *testing.T) {
+
+	// !race:
+	// The memory limit is too low for the shadow memory of the race detector in the Jsonnet evaluator process
+
+	executil.SetLimits(256*1024*1024, 30*time.Second)
+	defer executil.SetLimits(0, 0)
+
+	service := newService(".")
+	q := apiclient.ManifestRequest{Repo: &argoappv1.Repository{}, ApplicationSource: &argoappv1.ApplicationSource{Path: "./testdata/jsonnet-runaway"}}
+	_, err := service.GenerateManifest(context.Background(), &q)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err), "%v", err)
+}
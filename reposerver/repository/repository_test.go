@@ -19,6 +19,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -41,6 +43,18 @@ gpg:                using RSA key 4AEE18F83AFDEB23
 gpg: Good signature from "GitHub (web-flow commit signing) <noreply@github.com>" [ultimate]
 `
 
+func TestMain(m *testing.M) {
+	// Jsonnet is evaluated by the test binary in place of the repo server
+	if IsJsonnetEvaluator() {
+		if err := RunJsonnetEvaluator(); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
 type clientFunc func(*gitmocks.Client)
 
 func newServiceWithMocks(root string, signed bool) (*Service, *gitmocks.Client) {
@@ -84,7 +98,7 @@ func newServiceWithOpt(cf clientFunc) (*Service, *gitmocks.Client) {
 	helmClient.On("ExtractChart", chart, version).Return("./testdata/my-chart", io.NopCloser, nil)
 	helmClient.On("CleanChartCache", chart, version).Return(nil)
 
-	service.newGitClient = func(rawRepoURL string, creds git.Creds, insecure bool, enableLfs bool) (client git.Client, e error) {
+	service.newGitClient = func(rawRepoURL string, root string, creds git.Creds, insecure bool, enableLfs bool, opts ...git.ClientOpts) (client git.Client, e error) {
 		return gitClient, nil
 	}
 	service.newHelmClient = func(repoURL string, creds helm.Creds, enableOci bool) helm.Client {
@@ -120,7 +134,7 @@ func newServiceWithCommitSHA(root, revision string) *Service {
 		gitClient.On("Root").Return(root)
 	})
 
-	service.newGitClient = func(rawRepoURL string, creds git.Creds, insecure bool, enableLfs bool) (client git.Client, e error) {
+	service.newGitClient = func(rawRepoURL string, root string, creds git.Creds, insecure bool, enableLfs bool, opts ...git.ClientOpts) (client git.Client, e error) {
 		return gitClient, nil
 	}
 
@@ -142,7 +156,7 @@ func TestGenerateYamlManifestInDir(t *testing.T) {
 	assert.Equal(t, countOfManifests, len(res1.Manifests))
 
 	// this will test concatenated manifests to verify we split YAMLs correctly
-	res2, err := GenerateManifests("./testdata/concatenated", "/", "", &q, false)
+	res2, err := GenerateManifests("./testdata/concatenated", "/", "", &q, nil, false, ManifestGenerationLimits{})
 	assert.NoError(t, err)
 	assert.Equal(t, 3, len(res2.Manifests))
 }
@@ -177,6 +191,92 @@ func TestGenerateManifestsUseExactRevision(t *testing.T) {
 	assert.Equal(t, gitClient.Calls[0].Arguments[0], "abc")
 }
 
+func TestGenerateManifestsInWorktree(t *testing.T) {
+	root, err := filepath.Abs(".")
+	assert.NoError(t, err)
+	worktree := &gitmocks.Client{}
+	worktree.On("Init").Return(nil)
+	worktree.On("Fetch", "abc").Return(nil)
+	worktree.On("SparseCheckout", []string{"testdata/recurse"}).Return(nil)
+	worktree.On("Checkout", "abc").Return(nil)
+	worktree.On("CommitSHA").Return("abc", nil)
+	worktree.On("Root").Return(root)
+	service, gitClient := newServiceWithOpt(func(gitClient *gitmocks.Client) {
+		gitClient.On("LsRemote", "abc").Return("abc", nil)
+		gitClient.On("Root").Return(root)
+	})
+	gitClient.On("Worktree", worktreePath(service.diskCache.Path("worktrees", filepath.Base(root)), "abc", 2)).Return(worktree)
+	service.initConstants.GitWorktrees = 2
+	service.initConstants.GitSparseCheckout = true
+
+	src := argoappv1.ApplicationSource{Path: "testdata/recurse", Directory: &argoappv1.ApplicationSourceDirectory{Recurse: true}}
+	q := apiclient.ManifestRequest{Repo: &argoappv1.Repository{}, ApplicationSource: &src, Revision: "abc"}
+
+	res, err := service.GenerateManifest(context.Background(), &q)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(res.Manifests))
+	gitClient.AssertNotCalled(t, "Checkout", mock.Anything)
+	worktree.AssertCalled(t, "SparseCheckout", []string{"testdata/recurse"})
+	worktree.AssertCalled(t, "Checkout", "abc")
+}
+
+func TestWorktreePath(t *testing.T) {
+	path := worktreePath("/tmp/worktrees/repo", "abc", 4)
+	assert.Equal(t, path, worktreePath("/tmp/worktrees/repo", "abc", 4))
+	assert.Equal(t, "/tmp/worktrees/repo", filepath.Dir(path))
+	assert.Equal(t, "/tmp/worktrees/repo/0", worktreePath("/tmp/worktrees/repo", "abc", 1))
+}
+
+func TestSparseCheckoutPaths(t *testing.T) {
+	assert.Equal(t, []string{"apps/guestbook"}, sparseCheckoutPaths(&argoappv1.ApplicationSource{Path: "apps/guestbook"}))
+	assert.Equal(t, []string{"charts/app", "charts/app", "values/prod"}, sparseCheckoutPaths(&argoappv1.ApplicationSource{
+		Path: "charts/app",
+		Helm: &argoappv1.ApplicationSourceHelm{ValueFiles: []string{
+			"values.yaml",
+			"../../values/prod/values.yaml",
+			"../../../outside.yaml",
+			"https://example.com/values.yaml",
+			"$values/prod/values.yaml",
+		}},
+	}))
+	assert.Equal(t, []string{"charts/app", "overlays/app"}, sparseCheckoutPaths(&argoappv1.ApplicationSource{
+		Path: "charts/app",
+		Helm: &argoappv1.ApplicationSourceHelm{PostRenderer: &argoappv1.HelmPostRenderer{Kustomize: "overlays/app/"}},
+	}))
+}
+
+func TestWarmup(t *testing.T) {
+	root, err := filepath.Abs(".")
+	assert.NoError(t, err)
+	service, gitClient := newServiceWithOpt(func(gitClient *gitmocks.Client) {
+		gitClient.On("LsRemote", "main").Return("abc", nil)
+		gitClient.On("Init").Return(nil)
+		gitClient.On("Fetch", "abc").Return(nil)
+		gitClient.On("Checkout", "FETCH_HEAD").Return(nil)
+		gitClient.On("Root").Return(root)
+	})
+	helmClient := service.newHelmClient("", helm.Creds{}, false).(*helmmocks.Client)
+
+	repo := &argoappv1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps"}
+	helmRepo := &argoappv1.Repository{Repo: "https://charts.example.com", Type: "helm"}
+	_, err = service.Warmup(context.Background(), &apiclient.WarmupRequest{
+		Repo:   repo,
+		Source: &argoappv1.ApplicationSource{RepoURL: repo.Repo, Path: "guestbook", TargetRevision: "main"},
+	})
+	assert.NoError(t, err)
+	_, err = service.Warmup(context.Background(), &apiclient.WarmupRequest{
+		Repo:   helmRepo,
+		Source: &argoappv1.ApplicationSource{RepoURL: helmRepo.Repo, Chart: "my-chart", TargetRevision: "1.1.0"},
+	})
+	assert.NoError(t, err)
+	_, err = service.Warmup(context.Background(), &apiclient.WarmupRequest{Repo: repo})
+	assert.Error(t, err)
+
+	gitClient.AssertNumberOfCalls(t, "Fetch", 1)
+	gitClient.AssertNumberOfCalls(t, "Checkout", 1)
+	helmClient.AssertCalled(t, "ExtractChart", "my-chart", semver.MustParse("1.1.0"))
+}
+
 func TestRecurseManifestsInDir(t *testing.T) {
 	service := newService(".")
 
@@ -221,6 +321,44 @@ func TestGenerateJsonnetManifestInDir(t *testing.T) {
 	assert.Equal(t, 2, len(res1.Manifests))
 }
 
+func TestGenerateManifestsLimits(t *testing.T) {
+	jsonnetSource := argoappv1.ApplicationSource{
+		Path: "./testdata/jsonnet",
+		Directory: &argoappv1.ApplicationSourceDirectory{
+			Jsonnet: argoappv1.ApplicationSourceJsonnet{
+				ExtVars: []argoappv1.JsonnetVar{{Name: "extVarString", Value: "extVarString"}, {Name: "extVarCode", Value: "\"extVarCode\"", Code: true}},
+				TLAs:    []argoappv1.JsonnetVar{{Name: "tlaString", Value: "tlaString"}, {Name: "tlaCode", Value: "\"tlaCode\"", Code: true}},
+				Libs:    []string{"testdata/jsonnet/vendor"},
+			},
+		},
+	}
+	tests := []struct {
+		name   string
+		source argoappv1.ApplicationSource
+		limits ManifestGenerationLimits
+	}{
+		{"ManifestsSize", argoappv1.ApplicationSource{Path: "./testdata/recurse", Directory: &argoappv1.ApplicationSourceDirectory{Recurse: true}}, ManifestGenerationLimits{MaxManifestsSize: 50}},
+		{"Files", argoappv1.ApplicationSource{Path: "./testdata/recurse", Directory: &argoappv1.ApplicationSourceDirectory{Recurse: true}}, ManifestGenerationLimits{MaxFiles: 1}},
+		{"JsonnetImports", jsonnetSource, ManifestGenerationLimits{MaxFiles: 2}},
+		{"JsonnetStack", argoappv1.ApplicationSource{Path: "./testdata/jsonnet-recursion"}, ManifestGenerationLimits{MaxJsonnetStack: 50}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := newService(".")
+			q := apiclient.ManifestRequest{Repo: &argoappv1.Repository{}, ApplicationSource: tt.source.DeepCopy()}
+
+			_, err := service.GenerateManifest(context.Background(), &q)
+			assert.NoError(t, err)
+
+			service = newService(".")
+			service.initConstants.ManifestGenerationLimits = tt.limits
+			q = apiclient.ManifestRequest{Repo: &argoappv1.Repository{}, ApplicationSource: tt.source.DeepCopy()}
+			_, err = service.GenerateManifest(context.Background(), &q)
+			assert.Equal(t, codes.ResourceExhausted, status.Code(err), "%v", err)
+		})
+	}
+}
+
 func TestGenerateKsonnetManifest(t *testing.T) {
 	service := newService("../..")
 
@@ -271,7 +409,7 @@ func TestManifestGenErrorCacheByNumRequests(t *testing.T) {
 		assert.NotNil(t, manifestRequest)
 
 		cachedManifestResponse := &cache.CachedManifestResponse{}
-		err := service.cache.GetManifests(mock.Anything, manifestRequest.ApplicationSource, manifestRequest.Namespace, manifestRequest.AppLabelKey, manifestRequest.AppName, cachedManifestResponse)
+		err := service.cache.GetManifests(mock.Anything, manifestRequest.ApplicationSource, nil, manifestRequest.Namespace, manifestRequest.TrackingMethod, manifestRequest.AppLabelKey, manifestRequest.AppName, cachedManifestResponse)
 		assert.Nil(t, err)
 		return cachedManifestResponse
 	}
@@ -695,18 +833,47 @@ func TestHelmManifestFromChartRepoWithValueFileOutsideRepo(t *testing.T) {
 func TestGenerateHelmWithURL(t *testing.T) {
 	service := newService("../..")
 
-	_, err := service.GenerateManifest(context.Background(), &apiclient.ManifestRequest{
-		Repo:    &argoappv1.Repository{},
-		AppName: "test",
-		ApplicationSource: &argoappv1.ApplicationSource{
-			Path: "./util/helm/testdata/redis",
-			Helm: &argoappv1.ApplicationSourceHelm{
-				ValueFiles: []string{"https://raw.githubusercontent.com/argoproj/argocd-example-apps/master/helm-guestbook/values.yaml"},
-				Values:     `cluster: {slaveCount: 2}`,
+	generate := func(helmValueURLs []string) error {
+		_, err := service.GenerateManifest(context.Background(), &apiclient.ManifestRequest{
+			Repo:    &argoappv1.Repository{},
+			AppName: "test",
+			ApplicationSource: &argoappv1.ApplicationSource{
+				Path: "./util/helm/testdata/redis",
+				Helm: &argoappv1.ApplicationSourceHelm{
+					ValueFiles: []string{"https://raw.githubusercontent.com/argoproj/argocd-example-apps/master/helm-guestbook/values.yaml"},
+					Values:     `cluster: {slaveCount: 2}`,
+				},
 			},
-		},
-	})
-	assert.NoError(t, err)
+			HelmValueURLs: helmValueURLs,
+			NoCache:       true,
+		})
+		return err
+	}
+
+	assert.NoError(t, generate([]string{"https://raw.githubusercontent.com/argoproj/**"}))
+
+	err := generate([]string{"https://example.com/**"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "%v", err)
+}
+
+// Value files which are not HTTPS URLs are paths, which must be in the repository
+func TestGenerateHelmWithValueFilesOutsideRepo(t *testing.T) {
+	service := newService("../..")
+	for _, valueFile := range []string{"/etc/passwd", "file:///etc/passwd", "http://example.com/values.yaml"} {
+		t.Run(valueFile, func(t *testing.T) {
+			_, err := service.GenerateManifest(context.Background(), &apiclient.ManifestRequest{
+				Repo:    &argoappv1.Repository{},
+				AppName: "test",
+				ApplicationSource: &argoappv1.ApplicationSource{
+					Path: "./util/helm/testdata/redis",
+					Helm: &argoappv1.ApplicationSourceHelm{ValueFiles: []string{valueFile}},
+				},
+				HelmValueURLs: []string{"**"},
+				NoCache:       true,
+			})
+			assert.Error(t, err)
+		})
+	}
 }
 
 // The requested value file (`../../../../../minio/values.yaml`) is outside the repo directory
@@ -803,6 +970,195 @@ func TestGenerateHelmWithFileParameter(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestGenerateHelmWithIgnoreMissingValueFiles(t *testing.T) {
+	service := newService("../..")
+	req := &apiclient.ManifestRequest{
+		Repo:    &argoappv1.Repository{},
+		AppName: "test",
+		ApplicationSource: &argoappv1.ApplicationSource{
+			Path: "./util/helm/testdata/redis",
+			Helm: &argoappv1.ApplicationSourceHelm{
+				ValueFiles: []string{"values-production.yaml", "values-missing.yaml"},
+			},
+		},
+		NoCache: true,
+	}
+
+	_, err := service.GenerateManifest(context.Background(), req)
+	assert.Error(t, err)
+
+	req.ApplicationSource.Helm.IgnoreMissingValueFiles = true
+	_, err = service.GenerateManifest(context.Background(), req)
+	assert.NoError(t, err)
+}
+
+func TestGenerateHelmKubeVersion(t *testing.T) {
+	service := newService(".")
+
+	generate := func(helm *argoappv1.ApplicationSourceHelm) string {
+		res, err := service.GenerateManifest(context.Background(), &apiclient.ManifestRequest{
+			Repo:    &argoappv1.Repository{},
+			AppName: "test",
+			ApplicationSource: &argoappv1.ApplicationSource{
+				Path: "./testdata/helm-kube-version",
+				Helm: helm,
+			},
+			KubeVersion: "1.16.3",
+			NoCache:     true,
+		})
+		if !assert.NoError(t, err) || !assert.Len(t, res.Manifests, 1) {
+			return ""
+		}
+		return res.Manifests[0]
+	}
+
+	// the chart is rendered with the version of the destination cluster unless the application overrides it
+	assert.Contains(t, generate(nil), `"kubeVersion":"v1.16.3"`)
+	assert.Contains(t, generate(&argoappv1.ApplicationSourceHelm{KubeVersion: "1.20.0"}), `"kubeVersion":"v1.20.0"`)
+}
+
+func TestGenerateHelmWithPostRenderer(t *testing.T) {
+	service := newService(".")
+
+	t.Run("Kustomize", func(t *testing.T) {
+		res, err := service.GenerateManifest(context.Background(), &apiclient.ManifestRequest{
+			Repo:    &argoappv1.Repository{},
+			AppName: "test",
+			ApplicationSource: &argoappv1.ApplicationSource{
+				Path: "./testdata/my-chart",
+				Helm: &argoappv1.ApplicationSourceHelm{
+					PostRenderer: &argoappv1.HelmPostRenderer{Kustomize: "testdata/helm-post-renderer"},
+				},
+			},
+		})
+		assert.NoError(t, err)
+		assert.Len(t, res.Manifests, 1)
+		assert.Contains(t, res.Manifests[0], `"post-rendered":"true"`)
+		_, err = os.Stat("./testdata/helm-post-renderer/helm-output.yaml")
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("KustomizeOutsideRepo", func(t *testing.T) {
+		_, err := service.GenerateManifest(context.Background(), &apiclient.ManifestRequest{
+			Repo:    &argoappv1.Repository{},
+			AppName: "test",
+			ApplicationSource: &argoappv1.ApplicationSource{
+				Path: "./testdata/my-chart",
+				Helm: &argoappv1.ApplicationSourceHelm{
+					PostRenderer: &argoappv1.HelmPostRenderer{Kustomize: "../../util/helm/testdata/redis"},
+				},
+			},
+			NoCache: true,
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("Plugin", func(t *testing.T) {
+		res, err := service.GenerateManifest(context.Background(), &apiclient.ManifestRequest{
+			Repo:    &argoappv1.Repository{},
+			AppName: "test",
+			ApplicationSource: &argoappv1.ApplicationSource{
+				Path: "./testdata/my-chart",
+				Helm: &argoappv1.ApplicationSourceHelm{
+					PostRenderer: &argoappv1.HelmPostRenderer{Plugin: "rename"},
+				},
+			},
+			Plugins: []*argoappv1.ConfigManagementPlugin{{
+				Name: "rename",
+				Generate: argoappv1.Command{
+					Command: []string{"sed", "s/my-map/post-rendered-map/"},
+				},
+			}},
+		})
+		assert.NoError(t, err)
+		assert.Len(t, res.Manifests, 1)
+		assert.Contains(t, res.Manifests[0], `"name":"post-rendered-map"`)
+	})
+}
+
+func TestGenerateHelmWithValuesRepo(t *testing.T) {
+	service := newService("../..")
+	valuesRepoURL := "https://github.com/argoproj/values"
+	valuesRoot, err := filepath.Abs("../../util/helm/testdata/redis")
+	assert.NoError(t, err)
+	valuesClient := &gitmocks.Client{}
+	valuesClient.On("LsRemote", "main").Return("1e67a504d03def3a6a1125d934cb511680f72555", nil)
+	valuesClient.On("Init").Return(nil)
+	valuesClient.On("Fetch", "1e67a504d03def3a6a1125d934cb511680f72555").Return(nil)
+	valuesClient.On("Checkout", "FETCH_HEAD").Return(nil)
+	valuesClient.On("Root").Return(valuesRoot)
+	newGitClient := service.newGitClient
+	service.newGitClient = func(rawRepoURL string, root string, creds git.Creds, insecure bool, enableLfs bool, opts ...git.ClientOpts) (git.Client, error) {
+		if rawRepoURL == valuesRepoURL {
+			return valuesClient, nil
+		}
+		return newGitClient(rawRepoURL, root, creds, insecure, enableLfs, opts...)
+	}
+	generate := func(valueFile string) error {
+		_, err := service.GenerateManifest(context.Background(), &apiclient.ManifestRequest{
+			Repo:    &argoappv1.Repository{},
+			AppName: "test",
+			ApplicationSource: &argoappv1.ApplicationSource{
+				Path: "./util/helm/testdata/redis",
+				Helm: &argoappv1.ApplicationSourceHelm{
+					ValuesRepos: []argoappv1.HelmValuesRepo{{Alias: "values", RepoURL: valuesRepoURL, TargetRevision: "main"}},
+					ValueFiles:  []string{valueFile},
+				},
+			},
+			NoCache: true,
+		})
+		return err
+	}
+
+	assert.NoError(t, generate("$values/values-production.yaml"))
+	valuesClient.AssertCalled(t, "Checkout", "FETCH_HEAD")
+
+	err = generate("$values/../../../../../values.yaml")
+	assert.Error(t, err)
+
+	err = generate("$other/values-production.yaml")
+	assert.EqualError(t, err, "values repository other is not available")
+}
+
+func TestObjectStoreIsNotSharedByRepositories(t *testing.T) {
+	dir, err := ioutil.TempDir("", "object-store")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	service := NewService(metrics.NewMetricsServer(), cache.NewCache(
+		cacheutil.NewCache(cacheutil.NewInMemoryCache(1*time.Minute)),
+		1*time.Minute,
+	), RepoServerInitConstants{ParallelismLimit: 1, DiskCachePath: filepath.Join(dir, "cache"), GitObjectStore: filepath.Join(dir, "objects")})
+
+	alternates := func(repoURL string, dir string) string {
+		client, err := service.newClientIn(&argoappv1.Repository{Repo: repoURL}, dir)
+		assert.NoError(t, err)
+		assert.NoError(t, client.Init())
+		data, err := ioutil.ReadFile(filepath.Join(client.Root(), ".git", "objects", "info", "alternates"))
+		assert.NoError(t, err)
+		return strings.TrimSpace(string(data))
+	}
+
+	app := alternates("https://github.com/argoproj/argocd-example-apps", "git")
+	assert.Equal(t, app, alternates("https://github.com/argoproj/argocd-example-apps.git", "values"))
+	assert.NotEqual(t, app, alternates("https://github.com/someone/argocd-example-apps", "git"))
+}
+
+func TestValuesRepoFile(t *testing.T) {
+	root, err := filepath.Abs("./testdata")
+	assert.NoError(t, err)
+	roots := map[string]string{"values": "./testdata"}
+
+	path, err := valuesRepoFile(roots, "values", "my-chart/my-chart-values.yaml")
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(root, "my-chart/my-chart-values.yaml"), path)
+
+	_, err = valuesRepoFile(roots, "values", "../repository.go")
+	assert.Error(t, err)
+
+	_, err = valuesRepoFile(roots, "other", "my-chart/my-chart-values.yaml")
+	assert.EqualError(t, err, "values repository other is not available")
+}
+
 func TestGenerateNullList(t *testing.T) {
 	service := newService(".")
 
@@ -885,7 +1241,7 @@ func TestGenerateFromUTF16(t *testing.T) {
 		Repo:              &argoappv1.Repository{},
 		ApplicationSource: &argoappv1.ApplicationSource{},
 	}
-	res1, err := GenerateManifests("./testdata/utf-16", "/", "", &q, false)
+	res1, err := GenerateManifests("./testdata/utf-16", "/", "", &q, nil, false, ManifestGenerationLimits{})
 	assert.Nil(t, err)
 	assert.Equal(t, 2, len(res1.Manifests))
 }
@@ -901,6 +1257,8 @@ func TestListApps(t *testing.T) {
 		"app-parameters/multi":           "Kustomize",
 		"app-parameters/single-app-only": "Kustomize",
 		"app-parameters/single-global":   "Kustomize",
+		"helm-kube-version":              "Helm",
+		"helm-post-renderer":             "Kustomize",
 		"invalid-helm":                   "Helm",
 		"invalid-kustomize":              "Kustomize",
 		"kustomization_yaml":             "Kustomize",
@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	textutils "github.com/vathsalashetty96/gitops-engine/pkg/utils/text"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/vathsalashetty96/argo-cd/reposerver/apiclient"
+	"github.com/vathsalashetty96/argo-cd/util/git"
+	"github.com/vathsalashetty96/argo-cd/util/io"
+	"github.com/vathsalashetty96/argo-cd/util/trace"
+)
+
+// valuesRepo is a git repository of Helm value files, which value files reference as $alias/path
+type valuesRepo struct {
+	alias    string
+	client   git.Client
+	revision string
+}
+
+type valuesRepos []valuesRepo
+
+// revisions returns the commit SHAs of the repositories by their aliases
+func (r valuesRepos) revisions() map[string]string {
+	if len(r) == 0 {
+		return nil
+	}
+	revisions := map[string]string{}
+	for _, repo := range r {
+		revisions[repo.alias] = repo.revision
+	}
+	return revisions
+}
+
+// resolveValuesRepos resolves the target revisions of the values repositories of the source of the request to commit
+// SHAs. The credentials of a repository are taken from the request, if they are configured.
+func (s *Service) resolveValuesRepos(q *apiclient.ManifestRequest) (valuesRepos, error) {
+	if q.ApplicationSource == nil || q.ApplicationSource.Helm == nil {
+		return nil, nil
+	}
+	var repos valuesRepos
+	roots := map[string]string{}
+	for _, ref := range q.ApplicationSource.Helm.ValuesRepos {
+		if err := v1alpha1.ValidateHelmValuesRepoAlias(ref.Alias); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		revision := textutils.FirstNonEmpty(ref.TargetRevision, "HEAD")
+		client, commitSHA, err := s.newClientInResolveRevision(getValuesRepo(q.ValuesRepos, ref.RepoURL), revision)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve revision %s of values repository %s: %v", revision, ref.Alias, err)
+		}
+		// the clone of a repository is checked out at one revision at a time
+		if alias, ok := roots[client.Root()]; ok {
+			return nil, status.Errorf(codes.InvalidArgument, "values repositories %s and %s have the same URL %s", alias, ref.Alias, ref.RepoURL)
+		}
+		roots[client.Root()] = ref.Alias
+		repos = append(repos, valuesRepo{alias: ref.Alias, client: client, revision: commitSHA})
+	}
+	return repos, nil
+}
+
+func (s *Service) newClientInResolveRevision(repo *v1alpha1.Repository, revision string) (git.Client, string, error) {
+	gitClient, err := s.newClientIn(repo, "values")
+	if err != nil {
+		return nil, "", err
+	}
+	commitSHA, err := gitClient.LsRemote(revision)
+	if err != nil {
+		return nil, "", err
+	}
+	return gitClient, commitSHA, nil
+}
+
+// getValuesRepo returns the repository with the given URL, including its credentials, or a repository without
+// credentials if it is not configured
+func getValuesRepo(repos []*v1alpha1.Repository, repoURL string) *v1alpha1.Repository {
+	for _, repo := range repos {
+		if git.SameURL(repo.Repo, repoURL) {
+			return repo
+		}
+	}
+	return &v1alpha1.Repository{Repo: repoURL}
+}
+
+// checkoutValuesRepos checks out the values repositories at their revisions and returns their roots by their aliases.
+// Values repositories are cloned separately from the repositories of applications, so that they never wait for the
+// checkout of an application. They are locked in the order of their roots, so that concurrent requests don't wait for
+// each other.
+func (s *Service) checkoutValuesRepos(ctx context.Context, repos valuesRepos) (map[string]string, io.Closer, error) {
+	if len(repos) == 0 {
+		return nil, io.NopCloser, nil
+	}
+	type checkout struct {
+		alias    string
+		revision string
+		client   git.Client
+		paths    []string
+	}
+	var checkouts []checkout
+	for _, repo := range repos {
+		c := checkout{alias: repo.alias, revision: repo.revision, client: repo.client, paths: []string{repo.client.Root()}}
+		if s.initConstants.GitWorktrees > 0 {
+			worktrees := s.diskCache.Path("values-worktrees", filepath.Base(repo.client.Root()))
+			c.client = repo.client.Worktree(worktreePath(worktrees, repo.revision, s.initConstants.GitWorktrees))
+			c.paths = append(c.paths, c.client.Root())
+		}
+		checkouts = append(checkouts, c)
+	}
+	sort.Slice(checkouts, func(i, j int) bool {
+		return checkouts[i].client.Root() < checkouts[j].client.Root()
+	})
+
+	var closers []io.Closer
+	closer := io.NewCloser(func() error {
+		for i := len(closers) - 1; i >= 0; i-- {
+			io.Close(closers[i])
+		}
+		return nil
+	})
+	roots := map[string]string{}
+	for _, c := range checkouts {
+		for _, p := range c.paths {
+			closers = append(closers, s.diskCache.Use(p))
+		}
+		lock, err := s.repoLock.Lock(c.client.Root(), c.revision, true, func() error {
+			_, checkoutSpan := trace.StartSpan(ctx, "checkoutValuesRepo")
+			var err error
+			if s.initConstants.GitWorktrees > 0 {
+				err = checkoutWorktree(c.client, c.revision, nil)
+			} else {
+				err = checkoutRevision(c.client, c.revision)
+			}
+			trace.FinishSpan(checkoutSpan, err)
+			return err
+		})
+		if err != nil {
+			io.Close(closer)
+			return nil, nil, fmt.Errorf("failed to checkout values repository %s: %v", c.alias, err)
+		}
+		closers = append(closers, lock)
+		roots[c.alias] = c.client.Root()
+	}
+	return roots, closer, nil
+}
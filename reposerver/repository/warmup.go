@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Masterminds/semver"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/vathsalashetty96/argo-cd/reposerver/apiclient"
+	"github.com/vathsalashetty96/argo-cd/util/io"
+)
+
+// Warmup fetches the repository or Helm chart of the given source into the disk cache, so that the first manifest
+// generation requests after a start don't have to wait for it. The application controller requests it for the sources of
+// all applications, since the repo server itself has no access to the applications and repository credentials.
+func (s *Service) Warmup(_ context.Context, q *apiclient.WarmupRequest) (*apiclient.WarmupResponse, error) {
+	repo, source := q.Repo, q.Source
+	if repo == nil || source == nil {
+		return nil, status.Error(codes.InvalidArgument, "repository and source are required")
+	}
+	if source.IsHelm() {
+		helmClient, revision, err := s.newHelmClientResolveRevision(repo, source.TargetRevision, source.Chart)
+		if err != nil {
+			return nil, err
+		}
+		version, err := semver.NewVersion(revision)
+		if err != nil {
+			return nil, err
+		}
+		_, closer, err := helmClient.ExtractChart(source.Chart, version)
+		if err != nil {
+			return nil, err
+		}
+		io.Close(closer)
+		return &apiclient.WarmupResponse{}, nil
+	}
+
+	gitClient, revision, err := s.newClientResolveRevision(repo, source.TargetRevision)
+	if err != nil {
+		return nil, err
+	}
+	defer io.Close(s.diskCache.Use(gitClient.Root()))
+	closer, err := s.repoLock.Lock(gitClient.Root(), revision, true, func() error {
+		return checkoutRevision(gitClient, revision)
+	})
+	if err != nil {
+		return nil, err
+	}
+	io.Close(closer)
+	return &apiclient.WarmupResponse{}, nil
+}
@@ -124,7 +124,7 @@ func toApiAccount(name string, a settings.Account) *account.Account {
 	}
 	var tokens []*account.Token
 	for _, t := range a.Tokens {
-		tokens = append(tokens, &account.Token{Id: t.ID, ExpiresAt: t.ExpiresAt, IssuedAt: t.IssuedAt})
+		tokens = append(tokens, &account.Token{Id: t.ID, ExpiresAt: t.ExpiresAt, IssuedAt: t.IssuedAt, Scopes: t.Scopes, LastUsedAt: t.LastUsedAt})
 	}
 	sort.Slice(tokens, func(i, j int) bool {
 		return tokens[i].IssuedAt > tokens[j].IssuedAt
@@ -183,6 +183,9 @@ func (s *Server) CreateToken(ctx context.Context, r *account.CreateTokenRequest)
 	if err := s.ensureHasAccountPermission(ctx, rbacpolicy.ActionUpdate, r.Name); err != nil {
 		return nil, err
 	}
+	if err := rbac.ValidateScopes(r.Scopes); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	id := r.Id
 	if id == "" {
@@ -204,7 +207,7 @@ func (s *Server) CreateToken(ctx context.Context, r *account.CreateTokenRequest)
 
 		now := time.Now()
 		var err error
-		tokenString, err = s.sessionMgr.Create(r.Name, r.ExpiresIn, id)
+		tokenString, err = s.sessionMgr.CreateWithScopes(r.Name, r.ExpiresIn, id, r.Scopes)
 		if err != nil {
 			return err
 		}
@@ -217,6 +220,7 @@ func (s *Server) CreateToken(ctx context.Context, r *account.CreateTokenRequest)
 			ID:        id,
 			IssuedAt:  now.Unix(),
 			ExpiresAt: expiresAt,
+			Scopes:    r.Scopes,
 		})
 		return nil
 	})
@@ -244,3 +248,18 @@ func (s *Server) DeleteToken(ctx context.Context, r *account.DeleteTokenRequest)
 	}
 	return &account.EmptyResponse{}, nil
 }
+
+// RevokeSessions revokes all login sessions of a local account or SSO user
+func (s *Server) RevokeSessions(ctx context.Context, r *account.RevokeSessionsRequest) (*account.EmptyResponse, error) {
+	if r.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name is required")
+	}
+	if err := s.ensureHasAccountPermission(ctx, rbacpolicy.ActionUpdate, r.Name); err != nil {
+		return nil, err
+	}
+	if err := s.sessionMgr.RevokeSessions(r.Name); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke sessions of '%s': %v", r.Name, err)
+	}
+	log.Infof("Revoked all sessions of '%s'", r.Name)
+	return &account.EmptyResponse{}, nil
+}
@@ -269,6 +269,24 @@ func TestCreateToken_SuccessfullyCreated(t *testing.T) {
 	assert.Len(t, acc.Tokens, 1)
 }
 
+func TestCreateToken_Scoped(t *testing.T) {
+	ctx := adminContext(context.Background())
+	accountServer, _ := newTestAccountServer(ctx, func(cm *v1.ConfigMap, secret *v1.Secret) {
+		cm.Data["accounts.account1"] = "apiKey"
+	})
+
+	_, err := accountServer.CreateToken(ctx, &account.CreateTokenRequest{Name: "account1", Id: "ci", Scopes: []string{"applications:sync:proj/*"}})
+	assert.NoError(t, err)
+
+	acc, err := accountServer.GetAccount(ctx, &account.GetAccountRequest{Name: "account1"})
+	assert.NoError(t, err)
+	assert.Len(t, acc.Tokens, 1)
+	assert.Equal(t, []string{"applications:sync:proj/*"}, acc.Tokens[0].Scopes)
+
+	_, err = accountServer.CreateToken(ctx, &account.CreateTokenRequest{Name: "account1", Scopes: []string{"applications"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
 func TestCreateToken_DoesNotHaveCapability(t *testing.T) {
 	ctx := adminContext(context.Background())
 	accountServer, _ := newTestAccountServer(ctx, func(cm *v1.ConfigMap, secret *v1.Secret) {
@@ -310,3 +328,26 @@ func TestDeleteToken_SuccessfullyRemoved(t *testing.T) {
 
 	assert.Len(t, acc.Tokens, 0)
 }
+
+func TestRevokeSessions(t *testing.T) {
+	ctx := adminContext(context.Background())
+	accountServer, _ := newTestAccountServer(ctx, func(cm *v1.ConfigMap, secret *v1.Secret) {
+		cm.Data["accounts.account1"] = "apiKey, login"
+		secret.Data["accounts.account1.tokens"] = []byte(`[{"id":"123","iat":1583789194}]`)
+	})
+	loginToken, err := accountServer.sessionMgr.Create("account1", 0, "")
+	assert.NoError(t, err)
+	apiToken, err := accountServer.sessionMgr.Create("account1", 0, "123")
+	assert.NoError(t, err)
+
+	_, err = accountServer.RevokeSessions(ctx, &account.RevokeSessionsRequest{Name: "account1"})
+	assert.NoError(t, err)
+
+	_, err = accountServer.sessionMgr.VerifyToken(loginToken)
+	assert.EqualError(t, err, "session is revoked, please re-login")
+	_, err = accountServer.sessionMgr.VerifyToken(apiToken)
+	assert.NoError(t, err)
+
+	_, err = accountServer.RevokeSessions(ctx, &account.RevokeSessionsRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,204 @@
+package admission
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/vathsalashetty96/gitops-engine/pkg/utils/kube"
+	"google.golang.org/grpc/status"
+	admissionv1 "k8s.io/api/admission/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vathsalashetty96/argo-cd/pkg/apis/application"
+	appv1 "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/vathsalashetty96/argo-cd/pkg/client/clientset/versioned"
+	"github.com/vathsalashetty96/argo-cd/reposerver/apiclient"
+	"github.com/vathsalashetty96/argo-cd/util/argo"
+	"github.com/vathsalashetty96/argo-cd/util/db"
+	"github.com/vathsalashetty96/argo-cd/util/settings"
+)
+
+// maxRequestSize is the maximum size of the admission review accepted by the handler
+const maxRequestSize = 3 * 1024 * 1024
+
+// NewHandler creates a handler serving the validating admission webhook of the Application and AppProject resources.
+// In dry-run mode the invalid resources are admitted, the violations are logged and returned as warnings.
+func NewHandler(namespace string, appClientset versioned.Interface, repoClientset apiclient.Clientset, db db.ArgoDB, settingsMgr *settings.SettingsManager, kubectl kube.Kubectl, dryRun bool) *Handler {
+	return &Handler{
+		namespace:     namespace,
+		appClientset:  appClientset,
+		repoClientset: repoClientset,
+		db:            db,
+		settingsMgr:   settingsMgr,
+		kubectl:       kubectl,
+		dryRun:        dryRun,
+	}
+}
+
+// Handler validates the Application and AppProject resources submitted to the Kubernetes API server
+type Handler struct {
+	namespace     string
+	appClientset  versioned.Interface
+	repoClientset apiclient.Clientset
+	db            db.ArgoDB
+	settingsMgr   *settings.SettingsManager
+	kubectl       kube.Kubectl
+	dryRun        bool
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "admission review must be sent using POST", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestSize))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to read admission review: %v", err), http.StatusBadRequest)
+		return
+	}
+	var review admissionv1.AdmissionReview
+	if err := json.Unmarshal(body, &review); err != nil || review.Request == nil {
+		http.Error(w, "failed to parse admission review", http.StatusBadRequest)
+		return
+	}
+
+	violation, err := h.validate(r.Context(), review.Request)
+	if err != nil {
+		// responding with an error lets the API server apply the failure policy of the webhook configuration
+		log.Errorf("Failed to validate %s %s/%s: %v", review.Request.Kind.Kind, review.Request.Namespace, review.Request.Name, err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	response := &admissionv1.AdmissionResponse{UID: review.Request.UID, Allowed: true}
+	if violation != "" {
+		logCtx := log.WithFields(log.Fields{"kind": review.Request.Kind.Kind, "name": review.Request.Name, "user": review.Request.UserInfo.Username})
+		if h.dryRun {
+			logCtx.Warnf("Admitting invalid resource in dry-run mode: %s", violation)
+			response.Warnings = []string{fmt.Sprintf("Argo CD admission webhook (dry-run): %s", violation)}
+		} else {
+			logCtx.Infof("Denied invalid resource: %s", violation)
+			response.Allowed = false
+			response.Result = &metav1.Status{
+				Status:  metav1.StatusFailure,
+				Reason:  metav1.StatusReasonInvalid,
+				Message: violation,
+				Code:    http.StatusUnprocessableEntity,
+			}
+		}
+	}
+
+	review.Request = nil
+	review.Response = response
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(review); err != nil {
+		log.Errorf("Failed to write admission review: %v", err)
+	}
+}
+
+// validate returns the reason the resource must be denied or an empty string if the resource is valid
+func (h *Handler) validate(ctx context.Context, req *admissionv1.AdmissionRequest) (string, error) {
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return "", nil
+	}
+	// resources outside of the Argo CD namespace are ignored by the controller
+	if req.Namespace != "" && req.Namespace != h.namespace {
+		return "", nil
+	}
+	switch req.Kind.Kind {
+	case application.ApplicationKind:
+		var app appv1.Application
+		if err := json.Unmarshal(req.Object.Raw, &app); err != nil {
+			return fmt.Sprintf("failed to parse application: %v", err), nil
+		}
+		if req.Operation == admissionv1.Update {
+			var oldApp appv1.Application
+			if err := json.Unmarshal(req.OldObject.Raw, &oldApp); err != nil {
+				return "", err
+			}
+			// the controller updates the status of the application frequently, so the spec is validated only when it changes
+			if reflect.DeepEqual(oldApp.Spec, app.Spec) {
+				return "", nil
+			}
+		}
+		if app.DeletionTimestamp != nil {
+			return "", nil
+		}
+		return h.validateApplication(ctx, &app)
+	case application.AppProjectKind:
+		var proj appv1.AppProject
+		if err := json.Unmarshal(req.Object.Raw, &proj); err != nil {
+			return fmt.Sprintf("failed to parse project: %v", err), nil
+		}
+		if err := proj.ValidateProject(); err != nil {
+			return fmt.Sprintf("project spec is invalid: %s", status.Convert(err).Message()), nil
+		}
+		return "", nil
+	}
+	return "", nil
+}
+
+// validateApplication performs the same validation as the API server when the application is created or updated. The
+// project must permit the source and the destination of the application before the source is validated by the repo
+// server, so that the webhook never accesses repositories on behalf of projects which don't permit them.
+func (h *Handler) validateApplication(ctx context.Context, app *appv1.Application) (string, error) {
+	proj, err := h.appClientset.ArgoprojV1alpha1().AppProjects(h.namespace).Get(ctx, app.Spec.GetProject(), metav1.GetOptions{})
+	if err != nil {
+		if apierr.IsNotFound(err) {
+			return fmt.Sprintf("application references project %s which does not exist", app.Spec.GetProject()), nil
+		}
+		return "", err
+	}
+
+	if err := argo.ValidateDestination(ctx, &app.Spec.Destination, h.db); err != nil {
+		return fmt.Sprintf("application destination spec is invalid: %v", err), nil
+	}
+
+	conditions, err := argo.ValidatePermissions(ctx, &app.Spec, proj, h.db)
+	if err != nil {
+		return "", err
+	}
+	if len(conditions) > 0 {
+		return fmt.Sprintf("application spec is invalid: %s", argo.FormatAppConditions(conditions)), nil
+	}
+
+	kustomizeSettings, err := h.settingsMgr.GetKustomizeSettings()
+	if err != nil {
+		return "", err
+	}
+	kustomizeOptions, err := kustomizeSettings.GetOptions(app.Spec.Source)
+	if err != nil {
+		return err.Error(), nil
+	}
+	plugins, err := h.plugins()
+	if err != nil {
+		return "", err
+	}
+	conditions, err = argo.ValidateRepo(ctx, app, proj, h.repoClientset, h.db, kustomizeOptions, plugins, h.kubectl)
+	if err != nil {
+		return "", err
+	}
+	if len(conditions) > 0 {
+		return fmt.Sprintf("application spec is invalid: %s", argo.FormatAppConditions(conditions)), nil
+	}
+	return "", nil
+}
+
+func (h *Handler) plugins() ([]*appv1.ConfigManagementPlugin, error) {
+	plugins, err := h.settingsMgr.GetConfigManagementPlugins()
+	if err != nil {
+		return nil, err
+	}
+	tools := make([]*appv1.ConfigManagementPlugin, len(plugins))
+	for i, p := range plugins {
+		p := p
+		tools[i] = &p
+	}
+	return tools, nil
+}
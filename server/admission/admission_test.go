@@ -0,0 +1,184 @@
+package admission
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/vathsalashetty96/argo-cd/pkg/apis/application"
+	"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1"
+	apps "github.com/vathsalashetty96/argo-cd/pkg/client/clientset/versioned/fake"
+	"github.com/vathsalashetty96/argo-cd/reposerver/apiclient/mocks"
+	"github.com/vathsalashetty96/argo-cd/util/db"
+	"github.com/vathsalashetty96/argo-cd/util/settings"
+)
+
+const testNamespace = "argocd"
+
+func newTestHandler(dryRun bool) *Handler {
+	kubeclientset := fake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      "argocd-cm",
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+	}, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "argocd-secret",
+			Namespace: testNamespace,
+		},
+		Data: map[string][]byte{
+			"admin.password":   []byte("test"),
+			"server.secretkey": []byte("test"),
+		},
+	})
+	appClientset := apps.NewSimpleClientset(&v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: testNamespace},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+		},
+	}, &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "restricted", Namespace: testNamespace},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:  []string{"https://github.com/my-org/*"},
+			Destinations: []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "my-namespace"}},
+		},
+	})
+	settingsMgr := settings.NewSettingsManager(context.Background(), kubeclientset, testNamespace)
+	return NewHandler(testNamespace, appClientset, &mocks.Clientset{}, db.NewDB(testNamespace, settingsMgr, kubeclientset), settingsMgr, nil, dryRun)
+}
+
+func newTestApp(project string, destinationName string) *v1alpha1.Application {
+	return &v1alpha1.Application{
+		TypeMeta:   metav1.TypeMeta{Kind: "Application", APIVersion: application.Group + "/v1alpha1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: testNamespace},
+		Spec: v1alpha1.ApplicationSpec{
+			Project:     project,
+			Source:      v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", Path: "guestbook"},
+			Destination: v1alpha1.ApplicationDestination{Name: destinationName, Namespace: "default"},
+		},
+	}
+}
+
+func review(t *testing.T, handler *Handler, operation admissionv1.Operation, kind string, obj runtime.Object, oldObj runtime.Object) *admissionv1.AdmissionResponse {
+	req := &admissionv1.AdmissionRequest{
+		UID:       "5e1a2ca9-5b41-4c6d-8a2a-3a1c8bd8e1c5",
+		Kind:      metav1.GroupVersionKind{Group: application.Group, Version: "v1alpha1", Kind: kind},
+		Namespace: testNamespace,
+		Name:      "guestbook",
+		Operation: operation,
+	}
+	if obj != nil {
+		data, err := json.Marshal(obj)
+		assert.NoError(t, err)
+		req.Object = runtime.RawExtension{Raw: data}
+	}
+	if oldObj != nil {
+		data, err := json.Marshal(oldObj)
+		assert.NoError(t, err)
+		req.OldObject = runtime.RawExtension{Raw: data}
+	}
+	body, err := json.Marshal(admissionv1.AdmissionReview{
+		TypeMeta: metav1.TypeMeta{Kind: "AdmissionReview", APIVersion: "admission.k8s.io/v1"},
+		Request:  req,
+	})
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/api/admission", bytes.NewReader(body)))
+	assert.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+	var res admissionv1.AdmissionReview
+	assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &res))
+	assert.Nil(t, res.Request)
+	if assert.NotNil(t, res.Response) {
+		assert.Equal(t, req.UID, res.Response.UID)
+	}
+	return res.Response
+}
+
+func TestHandler_ServeHTTP_InvalidRequest(t *testing.T) {
+	handler := newTestHandler(false)
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/api/admission", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/api/admission", bytes.NewReader([]byte("{}"))))
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestHandler_Application(t *testing.T) {
+	t.Run("ProjectDoesNotExist", func(t *testing.T) {
+		res := review(t, newTestHandler(false), admissionv1.Create, "Application", newTestApp("does-not-exist", "in-cluster"), nil)
+		assert.False(t, res.Allowed)
+		assert.Equal(t, "application references project does-not-exist which does not exist", res.Result.Message)
+	})
+	t.Run("ForbiddenByProject", func(t *testing.T) {
+		// the repo server mock fails the test if the source is validated
+		res := review(t, newTestHandler(false), admissionv1.Create, "Application", newTestApp("restricted", "in-cluster"), nil)
+		assert.False(t, res.Allowed)
+		assert.Contains(t, res.Result.Message, "application repo https://github.com/argoproj/argocd-example-apps.git is not permitted in project 'restricted'")
+		assert.Contains(t, res.Result.Message, "application destination {https://kubernetes.default.svc default} is not permitted in project 'restricted'")
+	})
+	t.Run("InvalidDestination", func(t *testing.T) {
+		res := review(t, newTestHandler(false), admissionv1.Create, "Application", newTestApp("default", "does-not-exist"), nil)
+		assert.False(t, res.Allowed)
+		assert.Contains(t, res.Result.Message, "application destination spec is invalid")
+	})
+	t.Run("DryRun", func(t *testing.T) {
+		res := review(t, newTestHandler(true), admissionv1.Create, "Application", newTestApp("does-not-exist", "in-cluster"), nil)
+		assert.True(t, res.Allowed)
+		assert.Nil(t, res.Result)
+		assert.Equal(t, []string{"Argo CD admission webhook (dry-run): application references project does-not-exist which does not exist"}, res.Warnings)
+	})
+	t.Run("UnchangedSpec", func(t *testing.T) {
+		app := newTestApp("does-not-exist", "in-cluster")
+		updated := app.DeepCopy()
+		updated.Status.Sync.Status = v1alpha1.SyncStatusCodeSynced
+		res := review(t, newTestHandler(false), admissionv1.Update, "Application", updated, app)
+		assert.True(t, res.Allowed)
+	})
+	t.Run("Delete", func(t *testing.T) {
+		res := review(t, newTestHandler(false), admissionv1.Delete, "Application", nil, newTestApp("does-not-exist", "in-cluster"))
+		assert.True(t, res.Allowed)
+	})
+}
+
+func TestHandler_AppProject(t *testing.T) {
+	proj := &v1alpha1.AppProject{
+		TypeMeta:   metav1.TypeMeta{Kind: "AppProject", APIVersion: application.Group + "/v1alpha1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "my-proj", Namespace: testNamespace},
+		Spec: v1alpha1.AppProjectSpec{
+			Destinations: []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			Roles:        []v1alpha1.ProjectRole{{Name: "ci"}},
+		},
+	}
+	res := review(t, newTestHandler(false), admissionv1.Create, "AppProject", proj, nil)
+	assert.True(t, res.Allowed)
+
+	proj.Spec.Roles = append(proj.Spec.Roles, v1alpha1.ProjectRole{Name: "ci"})
+	res = review(t, newTestHandler(false), admissionv1.Create, "AppProject", proj, nil)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, "project spec is invalid: role 'ci' already exists", res.Result.Message)
+
+	proj.Spec.Roles = nil
+	proj.Spec.SyncWindows = v1alpha1.SyncWindows{{Kind: "deny", Schedule: "* * * * *", Duration: "1h"}}
+	res = review(t, newTestHandler(false), admissionv1.Create, "AppProject", proj, nil)
+	assert.False(t, res.Allowed)
+	assert.Contains(t, res.Result.Message, "requires one of application, cluster or namespace")
+}
@@ -1,16 +1,13 @@
 package application
 
 import (
-	"bufio"
 	"encoding/json"
 	"errors"
 	"fmt"
-	goio "io"
 	"math"
 	"reflect"
 	"sort"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/Masterminds/semver"
@@ -221,6 +218,38 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, appRBACName(*a)); err != nil {
 		return nil, err
 	}
+	revision := a.Spec.Source.TargetRevision
+	if q.Revision != "" {
+		revision = q.Revision
+	}
+	manifestInfo, err := s.generateManifests(ctx, a, revision)
+	if err != nil {
+		return nil, err
+	}
+	for i, manifest := range manifestInfo.Manifests {
+		obj := &unstructured.Unstructured{}
+		err = json.Unmarshal([]byte(manifest), obj)
+		if err != nil {
+			return nil, err
+		}
+		if obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == "" {
+			obj, _, err = diff.HideSecretData(obj, nil)
+			if err != nil {
+				return nil, err
+			}
+			data, err := json.Marshal(obj)
+			if err != nil {
+				return nil, err
+			}
+			manifestInfo.Manifests[i] = string(data)
+		}
+	}
+
+	return manifestInfo, nil
+}
+
+// generateManifests generates the application manifests for the given revision using the repo server
+func (s *Server) generateManifests(ctx context.Context, a *appv1.Application, revision string) (*apiclient.ManifestResponse, error) {
 	repo, err := s.db.GetRepository(ctx, a.Spec.Source.RepoURL)
 	if err != nil {
 		return nil, err
@@ -230,18 +259,26 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 		return nil, err
 	}
 	defer io.Close(conn)
-	revision := a.Spec.Source.TargetRevision
-	if q.Revision != "" {
-		revision = q.Revision
-	}
 	appInstanceLabelKey, err := s.settingsMgr.GetAppInstanceLabelKey()
 	if err != nil {
 		return nil, err
 	}
+	trackingMethod, err := argo.GetTrackingMethod(s.settingsMgr)
+	if err != nil {
+		return nil, err
+	}
 	helmRepos, err := s.db.ListHelmRepositories(ctx)
 	if err != nil {
 		return nil, err
 	}
+	valuesRepos, err := argo.GetValuesRepos(ctx, &a.Spec.Source, s.db)
+	if err != nil {
+		return nil, err
+	}
+	proj, err := argo.GetAppProject(&a.Spec, applisters.NewAppProjectLister(s.projInformer.GetIndexer()), a.Namespace, s.settingsMgr)
+	if err != nil {
+		return nil, err
+	}
 
 	plugins, err := s.plugins()
 	if err != nil {
@@ -270,10 +307,11 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 	if err != nil {
 		return nil, err
 	}
-	manifestInfo, err := repoClient.GenerateManifest(ctx, &apiclient.ManifestRequest{
+	return repoClient.GenerateManifest(ctx, &apiclient.ManifestRequest{
 		Repo:              repo,
 		Revision:          revision,
 		AppLabelKey:       appInstanceLabelKey,
+		TrackingMethod:    string(trackingMethod),
 		AppName:           a.Name,
 		Namespace:         a.Spec.Destination.Namespace,
 		ApplicationSource: &a.Spec.Source,
@@ -282,30 +320,9 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 		KustomizeOptions:  kustomizeOptions,
 		KubeVersion:       serverVersion,
 		ApiVersions:       argo.APIGroupsToVersions(apiGroups),
+		ValuesRepos:       valuesRepos,
+		HelmValueURLs:     proj.Spec.HelmValueURLs,
 	})
-	if err != nil {
-		return nil, err
-	}
-	for i, manifest := range manifestInfo.Manifests {
-		obj := &unstructured.Unstructured{}
-		err = json.Unmarshal([]byte(manifest), obj)
-		if err != nil {
-			return nil, err
-		}
-		if obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == "" {
-			obj, _, err = diff.HideSecretData(obj, nil)
-			if err != nil {
-				return nil, err
-			}
-			data, err := json.Marshal(obj)
-			if err != nil {
-				return nil, err
-			}
-			manifestInfo.Manifests[i] = string(data)
-		}
-	}
-
-	return manifestInfo, nil
 }
 
 // Get returns an application by name
@@ -760,7 +777,7 @@ func (s *Server) validateAndNormalizeApp(ctx context.Context, app *appv1.Applica
 
 	var conditions []appv1.ApplicationCondition
 	if validate {
-		conditions, err = argo.ValidateRepo(ctx, app, s.repoClientset, s.db, kustomizeOptions, plugins, s.kubectl)
+		conditions, err = argo.ValidateRepo(ctx, app, proj, s.repoClientset, s.db, kustomizeOptions, plugins, s.kubectl)
 		if err != nil {
 			return err
 		}
@@ -798,8 +815,9 @@ func (s *Server) getCachedAppState(ctx context.Context, a *appv1.Application, ge
 	err := getFromCache()
 	if err != nil && err == servercache.ErrCacheMiss {
 		conditions := a.Status.GetConditions(map[appv1.ApplicationConditionType]bool{
-			appv1.ApplicationConditionComparisonError:  true,
-			appv1.ApplicationConditionInvalidSpecError: true,
+			appv1.ApplicationConditionComparisonError:    true,
+			appv1.ApplicationConditionManifestLimitError: true,
+			appv1.ApplicationConditionInvalidSpecError:   true,
 		})
 		if len(conditions) > 0 {
 			return errors.New(argoutil.FormatAppConditions(conditions))
@@ -824,6 +842,8 @@ func (s *Server) getAppResources(ctx context.Context, a *appv1.Application) (*ap
 	return &tree, err
 }
 
+// getAppResource returns the resource of the application after enforcing the resource-level RBAC action, which is
+// either built using rbacpolicy.ResourceAction or rbacpolicy.RunAction
 func (s *Server) getAppResource(ctx context.Context, action string, q *application.ApplicationResourceRequest) (*appv1.ResourceNode, *rest.Config, *appv1.Application, error) {
 	a, err := s.appLister.Get(*q.Name)
 	if err != nil {
@@ -850,7 +870,7 @@ func (s *Server) getAppResource(ctx context.Context, action string, q *applicati
 }
 
 func (s *Server) GetResource(ctx context.Context, q *application.ApplicationResourceRequest) (*application.ApplicationResourceResponse, error) {
-	res, config, _, err := s.getAppResource(ctx, rbacpolicy.ActionGet, q)
+	res, config, _, err := s.getAppResource(ctx, rbacpolicy.ResourceAction(rbacpolicy.ActionGet, q.Group, q.Kind, q.Namespace, q.ResourceName), q)
 	if err != nil {
 		return nil, err
 	}
@@ -895,13 +915,10 @@ func (s *Server) PatchResource(ctx context.Context, q *application.ApplicationRe
 		Version:      q.Version,
 		Group:        q.Group,
 	}
-	res, config, a, err := s.getAppResource(ctx, rbacpolicy.ActionUpdate, resourceRequest)
+	res, config, a, err := s.getAppResource(ctx, rbacpolicy.ResourceAction(rbacpolicy.ActionUpdate, q.Group, q.Kind, q.Namespace, q.ResourceName), resourceRequest)
 	if err != nil {
 		return nil, err
 	}
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionUpdate, appRBACName(*a)); err != nil {
-		return nil, err
-	}
 
 	manifest, err := s.kubectl.PatchResource(ctx, config, res.GroupKindVersion(), res.Name, res.Namespace, types.PatchType(q.PatchType), []byte(q.Patch))
 	if err != nil {
@@ -931,14 +948,11 @@ func (s *Server) DeleteResource(ctx context.Context, q *application.ApplicationR
 		Version:      q.Version,
 		Group:        q.Group,
 	}
-	res, config, a, err := s.getAppResource(ctx, rbacpolicy.ActionDelete, resourceRequest)
+	res, config, a, err := s.getAppResource(ctx, rbacpolicy.ResourceAction(rbacpolicy.ActionDelete, q.Group, q.Kind, q.Namespace, q.ResourceName), resourceRequest)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionDelete, appRBACName(*a)); err != nil {
-		return nil, err
-	}
 	var force bool
 	if q.Force != nil {
 		force = *q.Force
@@ -1045,102 +1059,6 @@ func (s *Server) ManagedResources(ctx context.Context, q *application.ResourcesQ
 	return res, nil
 }
 
-func (s *Server) PodLogs(q *application.ApplicationPodLogsQuery, ws application.ApplicationService_PodLogsServer) error {
-	pod, config, _, err := s.getAppResource(ws.Context(), rbacpolicy.ActionGet, &application.ApplicationResourceRequest{
-		Name:         q.Name,
-		Namespace:    q.Namespace,
-		Kind:         kube.PodKind,
-		Group:        "",
-		Version:      "v1",
-		ResourceName: *q.PodName,
-	})
-
-	if err != nil {
-		return err
-	}
-
-	kubeClientset, err := kubernetes.NewForConfig(config)
-	if err != nil {
-		return err
-	}
-
-	var sinceSeconds, tailLines *int64
-	if q.SinceSeconds > 0 {
-		sinceSeconds = &q.SinceSeconds
-	}
-	if q.TailLines > 0 {
-		tailLines = &q.TailLines
-	}
-	stream, err := kubeClientset.CoreV1().Pods(pod.Namespace).GetLogs(*q.PodName, &v1.PodLogOptions{
-		Container:    q.Container,
-		Follow:       q.Follow,
-		Timestamps:   true,
-		SinceSeconds: sinceSeconds,
-		SinceTime:    q.SinceTime,
-		TailLines:    tailLines,
-	}).Stream(ws.Context())
-	if err != nil {
-		return err
-	}
-	logCtx := log.WithField("application", q.Name)
-	defer io.Close(stream)
-	done := make(chan bool)
-	reachedEOF := false
-	gracefulExit := false
-	go func() {
-		bufReader := bufio.NewReader(stream)
-
-		for {
-			line, err := bufReader.ReadString('\n')
-			if err != nil {
-				// Error or io.EOF
-				break
-			}
-			line = strings.TrimSpace(line) // Remove trailing line ending
-			parts := strings.Split(line, " ")
-			logTime, err := time.Parse(time.RFC3339, parts[0])
-			metaLogTime := metav1.NewTime(logTime)
-			if err == nil {
-				lines := strings.Join(parts[1:], " ")
-				for _, line := range strings.Split(lines, "\r") {
-					if line != "" {
-						err = ws.Send(&application.LogEntry{
-							Content:   line,
-							TimeStamp: metaLogTime,
-						})
-						if err != nil {
-							logCtx.Warnf("Unable to send stream message: %v", err)
-						}
-					}
-				}
-			}
-		}
-		if gracefulExit {
-			logCtx.Info("k8s pod logs reader completed due to closed grpc context")
-		} else if err != nil && err != goio.EOF {
-			logCtx.Warnf("k8s pod logs reader failed with error: %v", err)
-		} else {
-			logCtx.Info("k8s pod logs reader completed with EOF")
-			reachedEOF = true
-		}
-		close(done)
-	}()
-
-	select {
-	case <-ws.Context().Done():
-		logCtx.Info("client pod logs grpc context closed")
-		gracefulExit = true
-	case <-done:
-	}
-
-	if reachedEOF || gracefulExit {
-		if err := ws.Send(&application.LogEntry{Last: true}); err != nil {
-			logCtx.Warnf("Unable to send stream message notifying about last log message: %v", err)
-		}
-	}
-	return nil
-}
-
 // Sync syncs an application to its target state
 func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncRequest) (*appv1.Application, error) {
 	appIf := s.appclientset.ArgoprojV1alpha1().Applications(s.ns)
@@ -1175,6 +1093,11 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	if a.DeletionTimestamp != nil {
 		return nil, status.Errorf(codes.FailedPrecondition, "application is deleting")
 	}
+	if !syncReq.DryRun {
+		if dependenciesCond := argo.GetDependenciesCondition(a, s.appLister); dependenciesCond != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "Cannot sync: %s", dependenciesCond.Message)
+		}
+	}
 	if a.Spec.SyncPolicy != nil && a.Spec.SyncPolicy.Automated != nil {
 		if syncReq.Revision != "" && syncReq.Revision != text.FirstNonEmpty(a.Spec.Source.TargetRevision, "HEAD") {
 			return nil, status.Errorf(codes.FailedPrecondition, "Cannot sync to %s: auto-sync currently set to %s", syncReq.Revision, a.Spec.Source.TargetRevision)
@@ -1187,13 +1110,18 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 
 	var retry *appv1.RetryStrategy
 	var syncOptions appv1.SyncOptions
+	var syncTimeout string
 	if a.Spec.SyncPolicy != nil {
 		syncOptions = a.Spec.SyncPolicy.SyncOptions
 		retry = a.Spec.SyncPolicy.Retry
+		syncTimeout = a.Spec.SyncPolicy.SyncTimeout
 	}
 	if syncReq.RetryStrategy != nil {
 		retry = syncReq.RetryStrategy
 	}
+	if syncReq.SyncTimeout != "" {
+		syncTimeout = syncReq.SyncTimeout
+	}
 
 	// We cannot use local manifests if we're only allowed to sync to signed commits
 	if syncReq.Manifests != nil && len(proj.Spec.SignatureKeys) > 0 {
@@ -1212,10 +1140,14 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 		},
 		InitiatedBy: appv1.OperationInitiator{Username: session.Username(ctx)},
 		Info:        syncReq.Infos,
+		SyncTimeout: syncTimeout,
 	}
 	if retry != nil {
 		op.Retry = *retry
 	}
+	if _, err := op.GetSyncTimeout(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	a, err = argo.SetAppOperation(appIf, *syncReq.Name, &op)
 	if err == nil {
@@ -1244,41 +1176,61 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *application.Applicat
 		return nil, status.Errorf(codes.FailedPrecondition, "rollback cannot be initiated when auto-sync is enabled")
 	}
 
-	var deploymentInfo *appv1.RevisionHistory
-	for _, info := range a.Status.History {
-		if info.ID == rollbackReq.ID {
-			deploymentInfo = &info
-			break
+	var revision, displayRevision string
+	var source appv1.ApplicationSource
+	if rollbackReq.Revision != "" {
+		// rollback to an arbitrary revision of the current source, which is not necessarily in the truncated history
+		revision, displayRevision, err = s.resolveRevision(ctx, a, &application.ApplicationSyncRequest{Revision: rollbackReq.Revision})
+		if err != nil {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
 		}
-	}
-	if deploymentInfo == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "application %s does not have deployment with id %v", a.Name, rollbackReq.ID)
-	}
-	if deploymentInfo.Source.IsZero() {
-		// Since source type was introduced to history starting with v0.12, and is now required for
-		// rollback, we cannot support rollback to revisions deployed using Argo CD v0.11 or below
-		return nil, status.Errorf(codes.FailedPrecondition, "cannot rollback to revision deployed with Argo CD v0.11 or lower. sync to revision instead.")
+		source = a.Spec.Source
+	} else {
+		var deploymentInfo *appv1.RevisionHistory
+		for _, info := range a.Status.History {
+			if info.ID == rollbackReq.ID {
+				deploymentInfo = &info
+				break
+			}
+		}
+		if deploymentInfo == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "application %s does not have deployment with id %v", a.Name, rollbackReq.ID)
+		}
+		if deploymentInfo.Source.IsZero() {
+			// Since source type was introduced to history starting with v0.12, and is now required for
+			// rollback, we cannot support rollback to revisions deployed using Argo CD v0.11 or below
+			return nil, status.Errorf(codes.FailedPrecondition, "cannot rollback to revision deployed with Argo CD v0.11 or lower. sync to revision instead.")
+		}
+		revision = deploymentInfo.Revision
+		displayRevision = strconv.FormatInt(rollbackReq.ID, 10)
+		source = deploymentInfo.Source
 	}
 
 	var syncOptions appv1.SyncOptions
+	var syncTimeout string
 	if a.Spec.SyncPolicy != nil {
 		syncOptions = a.Spec.SyncPolicy.SyncOptions
+		syncTimeout = a.Spec.SyncPolicy.SyncTimeout
 	}
 
 	// Rollback is just a convenience around Sync
 	op := appv1.Operation{
 		Sync: &appv1.SyncOperation{
-			Revision:     deploymentInfo.Revision,
+			Revision:     revision,
 			DryRun:       rollbackReq.DryRun,
 			Prune:        rollbackReq.Prune,
 			SyncOptions:  syncOptions,
 			SyncStrategy: &appv1.SyncStrategy{Apply: &appv1.SyncStrategyApply{}},
-			Source:       &deploymentInfo.Source,
+			Source:       &source,
 		},
+		SyncTimeout: syncTimeout,
+	}
+	if _, err := op.GetSyncTimeout(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 	a, err = argo.SetAppOperation(appIf, *rollbackReq.Name, &op)
 	if err == nil {
-		s.logAppEvent(a, ctx, argo.EventReasonOperationStarted, fmt.Sprintf("initiated rollback to %d", rollbackReq.ID))
+		s.logAppEvent(a, ctx, argo.EventReasonOperationStarted, fmt.Sprintf("initiated rollback to %s", displayRevision))
 	}
 	return a, err
 }
@@ -1392,7 +1344,7 @@ func (s *Server) logResourceEvent(res *appv1.ResourceNode, ctx context.Context,
 }
 
 func (s *Server) ListResourceActions(ctx context.Context, q *application.ApplicationResourceRequest) (*application.ResourceActionsListResponse, error) {
-	res, config, _, err := s.getAppResource(ctx, rbacpolicy.ActionGet, q)
+	res, config, _, err := s.getAppResource(ctx, rbacpolicy.ResourceAction(rbacpolicy.ActionGet, q.Group, q.Kind, q.Namespace, q.ResourceName), q)
 	if err != nil {
 		return nil, err
 	}
@@ -1442,7 +1394,7 @@ func (s *Server) RunResourceAction(ctx context.Context, q *application.ResourceA
 		Version:      q.Version,
 		Group:        q.Group,
 	}
-	actionRequest := fmt.Sprintf("%s/%s/%s/%s", rbacpolicy.ActionAction, q.Group, q.Kind, q.Action)
+	actionRequest := rbacpolicy.RunAction(q.Group, q.Kind, q.Action, q.Namespace, q.ResourceName)
 	res, config, a, err := s.getAppResource(ctx, actionRequest, resourceRequest)
 	if err != nil {
 		return nil, err
@@ -447,6 +447,16 @@ func TestSyncHelm(t *testing.T) {
 	assert.Equal(t, "Unknown user initiated sync to 0.7.* (0.7.2)", events.Items[1].Message)
 }
 
+func TestSyncDependenciesNotReady(t *testing.T) {
+	testApp := newTestApp()
+	testApp.Spec.DependsOn = []string{"database"}
+	appServer := newTestAppServer(testApp)
+
+	_, err := appServer.Sync(context.Background(), &application.ApplicationSyncRequest{Name: &testApp.Name})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, err.Error(), "database (not found)")
+}
+
 func TestRollbackApp(t *testing.T) {
 	testApp := newTestApp()
 	testApp.Status.History = []appsv1.RevisionHistory{{
@@ -454,6 +464,7 @@ func TestRollbackApp(t *testing.T) {
 		Revision: "abc",
 		Source:   *testApp.Spec.Source.DeepCopy(),
 	}}
+	testApp.Spec.SyncPolicy = &appsv1.SyncPolicy{SyncTimeout: "30m"}
 	appServer := newTestAppServer(testApp)
 
 	updatedApp, err := appServer.Rollback(context.Background(), &application.ApplicationRollbackRequest{
@@ -467,6 +478,29 @@ func TestRollbackApp(t *testing.T) {
 	assert.NotNil(t, updatedApp.Operation.Sync)
 	assert.NotNil(t, updatedApp.Operation.Sync.Source)
 	assert.Equal(t, "abc", updatedApp.Operation.Sync.Revision)
+	assert.Equal(t, "30m", updatedApp.Operation.SyncTimeout)
+}
+
+func TestRollbackAppToRevision(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(testApp)
+	revision := "0123456789abcdef0123456789abcdef01234567"
+
+	updatedApp, err := appServer.Rollback(context.Background(), &application.ApplicationRollbackRequest{
+		Name:     &testApp.Name,
+		Revision: revision,
+	})
+
+	assert.NoError(t, err)
+	if assert.NotNil(t, updatedApp.Operation) && assert.NotNil(t, updatedApp.Operation.Sync) {
+		assert.Equal(t, revision, updatedApp.Operation.Sync.Revision)
+		assert.Equal(t, testApp.Spec.Source, *updatedApp.Operation.Sync.Source)
+	}
+	events, err := appServer.kubeclientset.CoreV1().Events(appServer.ns).List(context.Background(), metav1.ListOptions{})
+	assert.NoError(t, err)
+	if assert.NotEmpty(t, events.Items) {
+		assert.Contains(t, events.Items[len(events.Items)-1].Message, "initiated rollback to "+revision)
+	}
 }
 
 func TestUpdateAppProject(t *testing.T) {
@@ -522,6 +556,35 @@ p, admin, applications, update, my-proj/test-app, allow
 	assert.Equal(t, "my-proj", updatedApp.Spec.Project)
 }
 
+func TestResourceLevelRBAC(t *testing.T) {
+	testApp := newTestApp()
+	ctx := context.Background()
+	// nolint:staticcheck
+	ctx = context.WithValue(ctx, "claims", &jwt.StandardClaims{Subject: "admin"})
+	appServer := newTestAppServer(testApp)
+	appServer.enf.SetDefaultRole("")
+	_ = appServer.enf.SetBuiltinPolicy(`
+p, admin, applications, get, default/*, allow
+p, admin, applications, delete, default/*, allow
+p, admin, applications, delete/*/Secret/*/*, default/*, deny
+p, admin, applications, get/*/Secret/*/*, default/*, deny
+p, admin, applications, action/apps/Deployment/restart, default/*, allow
+`)
+
+	_, err := appServer.DeleteResource(ctx, &application.ApplicationResourceDeleteRequest{Name: &testApp.Name, Kind: "Secret", Namespace: "default", ResourceName: "my-secret"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Contains(t, err.Error(), "delete//Secret/default/my-secret")
+
+	_, err = appServer.GetResource(ctx, &application.ApplicationResourceRequest{Name: &testApp.Name, Kind: "Secret", Namespace: "default", ResourceName: "my-secret"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = appServer.PatchResource(ctx, &application.ApplicationResourcePatchRequest{Name: &testApp.Name, Group: "apps", Kind: "Deployment", Namespace: "default", ResourceName: "guestbook"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = appServer.RunResourceAction(ctx, &application.ResourceActionRunRequest{Name: &testApp.Name, Group: "apps", Kind: "StatefulSet", Namespace: "default", ResourceName: "guestbook", Action: "restart"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
 func TestAppJsonPatch(t *testing.T) {
 	testApp := newTestAppWithAnnotations()
 	ctx := context.Background()
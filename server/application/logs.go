@@ -0,0 +1,457 @@
+package application
+
+import (
+	"bufio"
+	"fmt"
+	goio "io"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/vathsalashetty96/gitops-engine/pkg/utils/kube"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	watchtools "k8s.io/client-go/tools/watch"
+
+	"github.com/vathsalashetty96/argo-cd/pkg/apiclient/application"
+	appv1 "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/vathsalashetty96/argo-cd/server/rbacpolicy"
+	"github.com/vathsalashetty96/argo-cd/util/io"
+)
+
+const (
+	// maxPodLogStreams is the maximum number of container logs streamed by a single request
+	maxPodLogStreams = 100
+	// podLogsTreeRefreshInterval is the minimum interval in which the resource tree is read again to pick up pods which
+	// appeared while following logs
+	podLogsTreeRefreshInterval = 5 * time.Second
+)
+
+// PodLogs streams the logs of the containers of a single pod, or of all pods matching the query. The logs of multiple
+// containers are merged, every entry carries the pod and container it was logged by. While following the logs, the
+// pods are watched, so containers which are started or restarted are streamed (again).
+func (s *Server) PodLogs(q *application.ApplicationPodLogsQuery, ws application.ApplicationService_PodLogsServer) error {
+	ctx := ws.Context()
+	a, err := s.appLister.Get(q.GetName())
+	if err != nil {
+		return err
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, appRBACName(*a)); err != nil {
+		return err
+	}
+	if q.GetPodName() != "" {
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ResourceAction(rbacpolicy.ActionGet, "", kube.PodKind, q.Namespace, q.GetPodName()), appRBACName(*a)); err != nil {
+			return err
+		}
+	}
+
+	config, err := s.getApplicationClusterConfig(ctx, a)
+	if err != nil {
+		return err
+	}
+	kubeClientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	tree, err := s.getAppResources(ctx, a)
+	if err != nil {
+		return err
+	}
+	nodes, err := selectLogPods(tree, q)
+	if err != nil {
+		return err
+	}
+	treeRefreshedAt := time.Now()
+
+	// the pods are listed once per namespace instead of getting every pod, and are kept up to date by watches while
+	// following the logs
+	listOpts := podLogsListOptions(q)
+	pods := map[string]*v1.Pod{}
+	resourceVersions := map[string]string{}
+	for _, namespace := range logPodNamespaces(a, nodes, q) {
+		list, err := kubeClientset.CoreV1().Pods(namespace).List(ctx, listOpts)
+		if err != nil {
+			return err
+		}
+		for i := range list.Items {
+			pod := &list.Items[i]
+			pods[pod.Namespace+"/"+pod.Name] = pod
+		}
+		resourceVersions[namespace] = list.ResourceVersion
+	}
+
+	logCtx := log.WithField("application", q.GetName())
+	entries := make(chan *application.LogEntry)
+	finished := make(chan string)
+	// started holds the ID of the last streamed instance of each container, running holds the containers which are
+	// currently streamed; both are keyed by namespace, pod and container name
+	started := map[string]string{}
+	running := map[string]bool{}
+
+	// startStreams starts streaming the logs of the containers of all matching pods which are not streamed yet
+	startStreams := func() error {
+		permitted := 0
+		for _, node := range nodes {
+			action := rbacpolicy.ResourceAction(rbacpolicy.ActionGet, "", kube.PodKind, node.Namespace, node.Name)
+			if !s.enf.Enforce(ctx.Value("claims"), rbacpolicy.ResourceApplications, action, appRBACName(*a)) {
+				continue
+			}
+			permitted++
+			pod, ok := pods[node.Namespace+"/"+node.Name]
+			if !ok {
+				if q.GetPodName() != "" {
+					return status.Errorf(codes.NotFound, "pod %s not found", q.GetPodName())
+				}
+				continue
+			}
+			containers := getLogContainers(pod, q.Container)
+			if len(containers) == 0 && q.GetPodName() != "" {
+				return status.Errorf(codes.InvalidArgument, "container %s not found in pod %s", q.Container, pod.Name)
+			}
+			for _, container := range containers {
+				key := fmt.Sprintf("%s/%s/%s", pod.Namespace, pod.Name, container)
+				containerID := getContainerID(pod, container)
+				previousID, streamed := started[key]
+				if running[key] || streamed && previousID == containerID {
+					continue
+				}
+				if len(running) >= maxPodLogStreams {
+					logCtx.Warnf("Not streaming logs of container %s: the logs of at most %d containers are streamed", key, maxPodLogStreams)
+					continue
+				}
+				opts := podLogOptions(q, container)
+				if streamed {
+					// the container was restarted, all logs of the new instance are streamed, but none of the previous one
+					opts.TailLines, opts.SinceSeconds, opts.SinceTime, opts.Previous = nil, nil, nil, false
+				}
+				stream, err := kubeClientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, opts).Stream(ctx)
+				if err != nil {
+					if q.GetPodName() != "" && !q.Follow {
+						return err
+					}
+					// the container might not have been started yet, it is retried once the pod changes
+					logCtx.Warnf("Unable to stream logs of container %s: %v", key, err)
+					continue
+				}
+				started[key] = containerID
+				running[key] = true
+				go func(pod, container, key string) {
+					defer io.Close(stream)
+					err := readPodLogs(stream, q.Filter, func(entry *application.LogEntry) {
+						entry.PodName = pod
+						entry.Container = container
+						select {
+						case entries <- entry:
+						case <-ctx.Done():
+						}
+					})
+					if err != nil && err != goio.EOF && ctx.Err() == nil {
+						logCtx.Warnf("k8s pod logs reader of container %s failed with error: %v", key, err)
+					}
+					select {
+					case finished <- key:
+					case <-ctx.Done():
+					}
+				}(pod.Name, container, key)
+			}
+		}
+		if len(nodes) > 0 && permitted == 0 {
+			return status.Errorf(codes.PermissionDenied, "permission denied: not allowed to get the logs of any matching pod")
+		}
+		return nil
+	}
+	if err := startStreams(); err != nil {
+		return err
+	}
+
+	following := q.Follow
+	podEvents := make(chan watch.Event)
+	if following {
+		for namespace, resourceVersion := range resourceVersions {
+			namespace := namespace
+			watcher, err := watchtools.NewRetryWatcher(resourceVersion, &cache.ListWatch{
+				WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+					options.LabelSelector, options.FieldSelector = listOpts.LabelSelector, listOpts.FieldSelector
+					return kubeClientset.CoreV1().Pods(namespace).Watch(ctx, options)
+				},
+			})
+			if err != nil {
+				return err
+			}
+			defer watcher.Stop()
+			go func() {
+				for event := range watcher.ResultChan() {
+					select {
+					case podEvents <- event:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}()
+		}
+	}
+
+	sendLast := func() {
+		if err := ws.Send(&application.LogEntry{Last: true}); err != nil {
+			logCtx.Warnf("Unable to send stream message notifying about last log message: %v", err)
+		}
+	}
+	for {
+		if len(running) == 0 && !following {
+			logCtx.Info("k8s pod logs readers completed")
+			sendLast()
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			logCtx.Info("client pod logs grpc context closed")
+			sendLast()
+			return nil
+		case entry := <-entries:
+			if err := ws.Send(entry); err != nil {
+				logCtx.Warnf("Unable to send stream message: %v", err)
+			}
+		case key := <-finished:
+			delete(running, key)
+			if following {
+				// the container might have been restarted before its stream ended
+				if err := startStreams(); err != nil {
+					logCtx.Warnf("Unable to stream logs of restarted containers: %v", err)
+				}
+			}
+		case event := <-podEvents:
+			pod, ok := event.Object.(*v1.Pod)
+			if !ok {
+				if event.Type == watch.Error {
+					logCtx.Warnf("Watching pods to stream logs of failed: %v", apierrors.FromObject(event.Object))
+				}
+				continue
+			}
+			key := pod.Namespace + "/" + pod.Name
+			if event.Type == watch.Deleted {
+				delete(pods, key)
+				if q.GetPodName() != "" {
+					// the logs of the pod are streamed until its containers are gone
+					following = false
+				}
+				continue
+			}
+			pods[key] = pod
+			if q.GetPodName() == "" && !containsLogPod(nodes, pod) && time.Since(treeRefreshedAt) >= podLogsTreeRefreshInterval {
+				// new pods become part of the resource tree once the application controller processed them
+				treeRefreshedAt = time.Now()
+				if tree, err := s.getAppResources(ctx, a); err != nil {
+					logCtx.Warnf("Unable to refresh pods to stream logs of: %v", err)
+				} else if selected, err := selectLogPods(tree, q); err == nil {
+					nodes = selected
+				}
+			}
+			if err := startStreams(); err != nil {
+				logCtx.Warnf("Unable to stream logs of changed pods: %v", err)
+			}
+		}
+	}
+}
+
+// podLogsListOptions returns the options to list and watch the pods whose logs are streamed: the pod with the requested
+// name, or the pods matching the label selector of the query
+func podLogsListOptions(q *application.ApplicationPodLogsQuery) metav1.ListOptions {
+	opts := metav1.ListOptions{LabelSelector: q.LabelSelector}
+	if q.GetPodName() != "" {
+		opts.LabelSelector = ""
+		opts.FieldSelector = fields.OneTermEqualSelector("metadata.name", q.GetPodName()).String()
+	}
+	return opts
+}
+
+// logPodNamespaces returns the namespaces in which the pods whose logs are streamed are listed and watched: the
+// namespaces of the matching pods and, unless a single pod or another namespace is requested, the destination
+// namespace of the application, in which new pods are expected to appear
+func logPodNamespaces(a *appv1.Application, nodes []appv1.ResourceNode, q *application.ApplicationPodLogsQuery) []string {
+	namespaces := map[string]bool{}
+	for _, node := range nodes {
+		namespaces[node.Namespace] = true
+	}
+	if destination := a.Spec.Destination.Namespace; q.GetPodName() == "" && destination != "" && (q.Namespace == "" || q.Namespace == destination) {
+		namespaces[destination] = true
+	}
+	var result []string
+	for namespace := range namespaces {
+		result = append(result, namespace)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// containsLogPod returns whether the pod is one of the given nodes
+func containsLogPod(nodes []appv1.ResourceNode, pod *v1.Pod) bool {
+	for _, node := range nodes {
+		if node.Namespace == pod.Namespace && node.Name == pod.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// podLogOptions returns the options to stream the logs of a container
+func podLogOptions(q *application.ApplicationPodLogsQuery, container string) *v1.PodLogOptions {
+	var sinceSeconds, tailLines *int64
+	if q.SinceSeconds > 0 {
+		sinceSeconds = &q.SinceSeconds
+	}
+	if q.TailLines > 0 {
+		tailLines = &q.TailLines
+	}
+	return &v1.PodLogOptions{
+		Container:    container,
+		Follow:       q.Follow,
+		Previous:     q.Previous,
+		Timestamps:   true,
+		SinceSeconds: sinceSeconds,
+		SinceTime:    q.SinceTime,
+		TailLines:    tailLines,
+	}
+}
+
+// readPodLogs reads the timestamped log lines of a container and passes the lines matching the filter to the send
+// function until the stream ends
+func readPodLogs(stream goio.Reader, filter string, send func(entry *application.LogEntry)) error {
+	bufReader := bufio.NewReader(stream)
+	for {
+		line, err := bufReader.ReadString('\n')
+		if err != nil {
+			// Error or io.EOF
+			return err
+		}
+		line = strings.TrimSpace(line) // Remove trailing line ending
+		parts := strings.Split(line, " ")
+		logTime, err := time.Parse(time.RFC3339, parts[0])
+		if err != nil {
+			continue
+		}
+		metaLogTime := metav1.NewTime(logTime)
+		lines := strings.Join(parts[1:], " ")
+		for _, line := range strings.Split(lines, "\r") {
+			if line != "" && matchesLogFilter(line, filter) {
+				send(&application.LogEntry{Content: line, TimeStamp: metaLogTime})
+			}
+		}
+	}
+}
+
+// matchesLogFilter returns whether the log line contains the filter, or does not contain it if the filter is prefixed
+// with '!'
+func matchesLogFilter(line string, filter string) bool {
+	if strings.HasPrefix(filter, "!") {
+		return !strings.Contains(line, filter[1:])
+	}
+	return strings.Contains(line, filter)
+}
+
+// getContainerID returns the ID of the current instance of the (init) container of the pod, which changes when the
+// container is restarted, or an empty string if it was not started yet
+func getContainerID(pod *v1.Pod, container string) string {
+	for _, statuses := range [][]v1.ContainerStatus{pod.Status.ContainerStatuses, pod.Status.InitContainerStatuses} {
+		for _, s := range statuses {
+			if s.Name == container {
+				return s.ContainerID
+			}
+		}
+	}
+	return ""
+}
+
+// getLogContainers returns the containers of the pod whose logs are streamed, i.e. all containers or the (init)
+// container with the given name
+func getLogContainers(pod *v1.Pod, container string) []string {
+	var containers []string
+	for _, c := range pod.Spec.Containers {
+		if container == "" || container == c.Name {
+			containers = append(containers, c.Name)
+		}
+	}
+	for _, c := range pod.Spec.InitContainers {
+		if container != "" && container == c.Name {
+			containers = append(containers, c.Name)
+		}
+	}
+	return containers
+}
+
+// selectLogPods returns the pods of the application tree matching the logs query, sorted by namespace and name. The
+// pod with the given name is returned if podName is set, otherwise the pods (transitively) owned by the resources
+// matching the group, kind and resource name, or all pods of the application if neither is set. Pods are further
+// filtered by the namespace and the label selector.
+func selectLogPods(tree *appv1.ApplicationTree, q *application.ApplicationPodLogsQuery) ([]appv1.ResourceNode, error) {
+	selector := labels.Everything()
+	if q.LabelSelector != "" {
+		var err error
+		if selector, err = labels.Parse(q.LabelSelector); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid label selector '%s': %v", q.LabelSelector, err)
+		}
+	}
+	group, kind, name := q.Group, q.Kind, q.ResourceName
+	if q.GetPodName() != "" {
+		group, kind, name = "", kube.PodKind, q.GetPodName()
+	}
+
+	children := map[kube.ResourceKey][]appv1.ResourceNode{}
+	for _, node := range tree.Nodes {
+		for _, parent := range node.ParentRefs {
+			key := kube.NewResourceKey(parent.Group, parent.Kind, parent.Namespace, parent.Name)
+			children[key] = append(children[key], node)
+		}
+	}
+
+	var queue []appv1.ResourceNode
+	for _, node := range tree.Nodes {
+		if (kind == "" || node.Kind == kind && (group == "" || node.Group == group)) &&
+			(name == "" || node.Name == name) &&
+			(q.Namespace == "" || node.Namespace == q.Namespace) {
+			queue = append(queue, node)
+		}
+	}
+	if len(queue) == 0 && (kind != "" || name != "") {
+		return nil, status.Errorf(codes.InvalidArgument, "%s %s %s not found as part of application %s", kind, group, name, q.GetName())
+	}
+
+	visited := map[kube.ResourceKey]bool{}
+	var pods []appv1.ResourceNode
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		key := kube.NewResourceKey(node.Group, node.Kind, node.Namespace, node.Name)
+		if visited[key] {
+			continue
+		}
+		visited[key] = true
+		queue = append(queue, children[key]...)
+		if node.Kind != kube.PodKind || node.Group != "" {
+			continue
+		}
+		var podLabels map[string]string
+		if node.NetworkingInfo != nil {
+			podLabels = node.NetworkingInfo.Labels
+		}
+		if selector.Matches(labels.Set(podLabels)) {
+			pods = append(pods, node)
+		}
+	}
+	sort.Slice(pods, func(i, j int) bool {
+		if pods[i].Namespace != pods[j].Namespace {
+			return pods[i].Namespace < pods[j].Namespace
+		}
+		return pods[i].Name < pods[j].Name
+	})
+	return pods, nil
+}
@@ -0,0 +1,186 @@
+package application
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/dgrijalva/jwt-go/v4"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/vathsalashetty96/argo-cd/pkg/apiclient/application"
+	appsv1 "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+func newLogsTestTree() *appsv1.ApplicationTree {
+	deploy := appsv1.ResourceRef{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook"}
+	rs := appsv1.ResourceRef{Group: "apps", Kind: "ReplicaSet", Namespace: "default", Name: "guestbook-6f8b"}
+	job := appsv1.ResourceRef{Group: "batch", Kind: "Job", Namespace: "default", Name: "migrate"}
+	pod := func(name string, parent appsv1.ResourceRef, labels map[string]string) appsv1.ResourceNode {
+		return appsv1.ResourceNode{
+			ResourceRef:    appsv1.ResourceRef{Kind: "Pod", Namespace: "default", Name: name},
+			ParentRefs:     []appsv1.ResourceRef{parent},
+			NetworkingInfo: &appsv1.ResourceNetworkingInfo{Labels: labels},
+		}
+	}
+	return &appsv1.ApplicationTree{Nodes: []appsv1.ResourceNode{
+		{ResourceRef: deploy},
+		{ResourceRef: rs, ParentRefs: []appsv1.ResourceRef{deploy}},
+		pod("guestbook-6f8b-b", rs, map[string]string{"app": "guestbook", "tier": "web"}),
+		pod("guestbook-6f8b-a", rs, map[string]string{"app": "guestbook", "tier": "web"}),
+		{ResourceRef: job},
+		pod("migrate-x", job, map[string]string{"app": "migrate"}),
+	}}
+}
+
+func logPodNames(pods []appsv1.ResourceNode) []string {
+	var names []string
+	for _, pod := range pods {
+		names = append(names, pod.Name)
+	}
+	return names
+}
+
+func TestSelectLogPods(t *testing.T) {
+	tree := newLogsTestTree()
+
+	t.Run("App", func(t *testing.T) {
+		pods, err := selectLogPods(tree, &application.ApplicationPodLogsQuery{Name: pointer.StringPtr("guestbook")})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"guestbook-6f8b-a", "guestbook-6f8b-b", "migrate-x"}, logPodNames(pods))
+	})
+	t.Run("Pod", func(t *testing.T) {
+		pods, err := selectLogPods(tree, &application.ApplicationPodLogsQuery{Name: pointer.StringPtr("guestbook"), PodName: pointer.StringPtr("migrate-x")})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"migrate-x"}, logPodNames(pods))
+	})
+	t.Run("Resource", func(t *testing.T) {
+		pods, err := selectLogPods(tree, &application.ApplicationPodLogsQuery{Name: pointer.StringPtr("guestbook"), Kind: "Deployment", ResourceName: "guestbook"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"guestbook-6f8b-a", "guestbook-6f8b-b"}, logPodNames(pods))
+
+		pods, err = selectLogPods(tree, &application.ApplicationPodLogsQuery{Name: pointer.StringPtr("guestbook"), Group: "batch", Kind: "Job"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"migrate-x"}, logPodNames(pods))
+	})
+	t.Run("LabelSelector", func(t *testing.T) {
+		pods, err := selectLogPods(tree, &application.ApplicationPodLogsQuery{Name: pointer.StringPtr("guestbook"), LabelSelector: "app in (migrate)"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"migrate-x"}, logPodNames(pods))
+
+		pods, err = selectLogPods(tree, &application.ApplicationPodLogsQuery{Name: pointer.StringPtr("guestbook"), Kind: "Deployment", LabelSelector: "app=migrate"})
+		assert.NoError(t, err)
+		assert.Empty(t, pods)
+
+		_, err = selectLogPods(tree, &application.ApplicationPodLogsQuery{Name: pointer.StringPtr("guestbook"), LabelSelector: "app in"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := selectLogPods(tree, &application.ApplicationPodLogsQuery{Name: pointer.StringPtr("guestbook"), Kind: "StatefulSet", ResourceName: "guestbook"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = selectLogPods(tree, &application.ApplicationPodLogsQuery{Name: pointer.StringPtr("guestbook"), Kind: "Deployment", ResourceName: "guestbook", Namespace: "kube-system"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestReadPodLogs(t *testing.T) {
+	stream := strings.NewReader(`2021-05-01T10:00:00Z GET /health 200
+2021-05-01T10:00:01Z GET /api 500
+not a log line
+2021-05-01T10:00:02Z POST /api 201` + "\r" + `POST /api 200
+`)
+	var entries []string
+	err := readPodLogs(stream, "/api", func(entry *application.LogEntry) {
+		entries = append(entries, entry.TimeStamp.UTC().Format("15:04:05")+" "+entry.Content)
+	})
+	assert.EqualError(t, err, "EOF")
+	assert.Equal(t, []string{"10:00:01 GET /api 500", "10:00:02 POST /api 201", "10:00:02 POST /api 200"}, entries)
+}
+
+func TestMatchesLogFilter(t *testing.T) {
+	assert.True(t, matchesLogFilter("GET /api 500", ""))
+	assert.True(t, matchesLogFilter("GET /api 500", "500"))
+	assert.False(t, matchesLogFilter("GET /api 200", "500"))
+	assert.True(t, matchesLogFilter("GET /api 200", "!/health"))
+	assert.False(t, matchesLogFilter("GET /health 200", "!/health"))
+}
+
+func TestGetLogContainers(t *testing.T) {
+	pod := &v1.Pod{Spec: v1.PodSpec{
+		InitContainers: []v1.Container{{Name: "init"}},
+		Containers:     []v1.Container{{Name: "main"}, {Name: "sidecar"}},
+	}}
+	assert.Equal(t, []string{"main", "sidecar"}, getLogContainers(pod, ""))
+	assert.Equal(t, []string{"sidecar"}, getLogContainers(pod, "sidecar"))
+	assert.Equal(t, []string{"init"}, getLogContainers(pod, "init"))
+	assert.Empty(t, getLogContainers(pod, "unknown"))
+}
+
+func TestGetContainerID(t *testing.T) {
+	pod := &v1.Pod{Status: v1.PodStatus{
+		InitContainerStatuses: []v1.ContainerStatus{{Name: "init", ContainerID: "containerd://1"}},
+		ContainerStatuses:     []v1.ContainerStatus{{Name: "main", ContainerID: "containerd://2"}, {Name: "sidecar"}},
+	}}
+	assert.Equal(t, "containerd://2", getContainerID(pod, "main"))
+	assert.Equal(t, "containerd://1", getContainerID(pod, "init"))
+	assert.Empty(t, getContainerID(pod, "sidecar"))
+	assert.Empty(t, getContainerID(pod, "unknown"))
+}
+
+func TestPodLogsListOptions(t *testing.T) {
+	opts := podLogsListOptions(&application.ApplicationPodLogsQuery{LabelSelector: "app=guestbook"})
+	assert.Equal(t, "app=guestbook", opts.LabelSelector)
+	assert.Empty(t, opts.FieldSelector)
+
+	opts = podLogsListOptions(&application.ApplicationPodLogsQuery{PodName: pointer.StringPtr("guestbook-1"), LabelSelector: "app=guestbook"})
+	assert.Empty(t, opts.LabelSelector)
+	assert.Equal(t, "metadata.name=guestbook-1", opts.FieldSelector)
+}
+
+func TestLogPodNamespaces(t *testing.T) {
+	app := &appsv1.Application{Spec: appsv1.ApplicationSpec{Destination: appsv1.ApplicationDestination{Namespace: "default"}}}
+	nodes := []appsv1.ResourceNode{
+		{ResourceRef: appsv1.ResourceRef{Kind: "Pod", Namespace: "jobs", Name: "migrate-1"}},
+		{ResourceRef: appsv1.ResourceRef{Kind: "Pod", Namespace: "default", Name: "guestbook-1"}},
+	}
+	assert.Equal(t, []string{"default", "jobs"}, logPodNamespaces(app, nodes, &application.ApplicationPodLogsQuery{}))
+	assert.Equal(t, []string{"default"}, logPodNamespaces(app, nil, &application.ApplicationPodLogsQuery{}))
+	assert.Empty(t, logPodNamespaces(app, nil, &application.ApplicationPodLogsQuery{Namespace: "jobs"}))
+	assert.Equal(t, []string{"jobs"}, logPodNamespaces(app, nodes[:1], &application.ApplicationPodLogsQuery{PodName: pointer.StringPtr("migrate-1")}))
+}
+
+type fakePodLogsServer struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakePodLogsServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakePodLogsServer) Send(*application.LogEntry) error {
+	return nil
+}
+
+func TestPodLogsRequiresApplicationGet(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(testApp)
+	appServer.enf.SetDefaultRole("")
+	_ = appServer.enf.SetBuiltinPolicy(`
+p, admin, applications, get/*/Pod/*/*, default/*, allow
+`)
+	// nolint:staticcheck
+	ctx := context.WithValue(context.Background(), "claims", &jwt.StandardClaims{Subject: "admin"})
+
+	// the logs of all pods are requested, so no pod specific permission is checked
+	err := appServer.PodLogs(&application.ApplicationPodLogsQuery{Name: &testApp.Name, Follow: true}, &fakePodLogsServer{ctx: ctx})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	err = appServer.PodLogs(&application.ApplicationPodLogsQuery{Name: &testApp.Name, PodName: pointer.StringPtr("guestbook-6f8b-a")}, &fakePodLogsServer{ctx: ctx})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
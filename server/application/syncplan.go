@@ -0,0 +1,328 @@
+package application
+
+import (
+	"sort"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/vathsalashetty96/gitops-engine/pkg/sync"
+	"github.com/vathsalashetty96/gitops-engine/pkg/sync/common"
+	"github.com/vathsalashetty96/gitops-engine/pkg/sync/hook"
+	resourceutil "github.com/vathsalashetty96/gitops-engine/pkg/sync/resource"
+	"github.com/vathsalashetty96/gitops-engine/pkg/sync/syncwaves"
+	"github.com/vathsalashetty96/gitops-engine/pkg/utils/kube"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vathsalashetty96/argo-cd/pkg/apiclient/application"
+	appv1 "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1"
+	applisters "github.com/vathsalashetty96/argo-cd/pkg/client/listers/application/v1alpha1"
+	"github.com/vathsalashetty96/argo-cd/server/rbacpolicy"
+	"github.com/vathsalashetty96/argo-cd/util/argo"
+	logutils "github.com/vathsalashetty96/argo-cd/util/log"
+)
+
+const (
+	syncTaskActionCreate = "create"
+	syncTaskActionApply  = "apply"
+	syncTaskActionPrune  = "prune"
+	syncTaskActionDelete = "delete"
+
+	// maxSyncPlanIterations limits the number of dry-run sync iterations. In dry-run mode the sync context applies the
+	// tasks of all phases and waves with kubectl dry-run in a single iteration, so the limit only guards against a sync
+	// context which never completes.
+	maxSyncPlanIterations = 1000
+)
+
+var syncPhaseOrder = map[common.SyncPhase]int{
+	common.SyncPhasePreSync:  -1,
+	common.SyncPhaseSync:     0,
+	common.SyncPhasePostSync: 1,
+	common.SyncPhaseSyncFail: 2,
+}
+
+// kindScope answers if resources of a kind are namespaced. The kinds of the cluster level managed resources are cluster
+// scoped and everything else is assumed to be namespaced.
+type kindScope map[schema.GroupKind]bool
+
+func (s kindScope) IsNamespaced(gk schema.GroupKind) (bool, error) {
+	return !s[gk], nil
+}
+
+// SyncPlan returns the ordered list of tasks a sync operation with the given parameters would execute. The tasks are
+// produced by the gitops-engine sync context running in dry-run mode, so neither the application nor the cluster is
+// modified and no operation is created.
+func (s *Server) SyncPlan(ctx context.Context, syncReq *application.ApplicationSyncRequest) (*application.ApplicationSyncPlanResponse, error) {
+	a, err := s.appLister.Get(*syncReq.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionSync, appRBACName(*a)); err != nil {
+		return nil, err
+	}
+	if syncReq.Manifests != nil {
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionOverride, appRBACName(*a)); err != nil {
+			return nil, err
+		}
+	}
+	proj, err := argo.GetAppProject(&a.Spec, applisters.NewAppProjectLister(s.projInformer.GetIndexer()), a.Namespace, s.settingsMgr)
+	if err != nil {
+		if apierr.IsNotFound(err) {
+			return nil, status.Errorf(codes.InvalidArgument, "application references project %s which does not exist", a.Spec.Project)
+		}
+		return nil, err
+	}
+
+	revision := ""
+	manifests := syncReq.Manifests
+	if manifests == nil {
+		revision, _, err = s.resolveRevision(ctx, a, syncReq)
+		if err != nil {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		manifestInfo, err := s.generateManifests(ctx, a, revision)
+		if err != nil {
+			return nil, err
+		}
+		manifests = manifestInfo.Manifests
+	}
+	var targets []*unstructured.Unstructured
+	for _, manifest := range manifests {
+		obj, err := appv1.UnmarshalToUnstructured(manifest)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid manifest: %v", err)
+		}
+		if obj != nil {
+			targets = append(targets, obj)
+		}
+	}
+
+	items := make([]*appv1.ResourceDiff, 0)
+	err = s.getCachedAppState(ctx, a, func() error {
+		return s.cache.GetAppManagedResources(a.Name, &items)
+	})
+	if err != nil {
+		return nil, err
+	}
+	var live []*unstructured.Unstructured
+	clusterScoped := kindScope{}
+	for _, item := range items {
+		if item.Namespace == "" {
+			clusterScoped[schema.GroupKind{Group: item.Group, Kind: item.Kind}] = true
+		}
+		obj, err := item.LiveObject()
+		if err != nil {
+			return nil, err
+		}
+		if obj != nil {
+			live = append(live, obj)
+		}
+	}
+	// the controller resolves namespaces of the generated manifests, so cluster scoped kinds are inferred from the
+	// managed resources and everything else is assumed to be namespaced
+	for _, obj := range targets {
+		if obj.GetNamespace() == "" && !clusterScoped[obj.GroupVersionKind().GroupKind()] {
+			obj.SetNamespace(a.Spec.Destination.Namespace)
+		}
+	}
+
+	syncOp := appv1.SyncOperation{
+		Revision:     revision,
+		Prune:        syncReq.Prune,
+		SyncStrategy: syncReq.Strategy,
+		Resources:    syncReq.Resources,
+	}
+	if a.Spec.SyncPolicy != nil {
+		syncOp.SyncOptions = a.Spec.SyncPolicy.SyncOptions
+	}
+	liveByKey := make(map[kube.ResourceKey]*unstructured.Unstructured)
+	for _, obj := range live {
+		liveByKey[kube.GetResourceKey(obj)] = obj
+	}
+	reconciliationResult := sync.Reconcile(targets, liveByKey, a.Spec.Destination.Namespace, clusterScoped)
+	results, err := s.dryRunSync(ctx, a, proj, revision, syncOp, reconciliationResult)
+	if err != nil {
+		return nil, err
+	}
+	return &application.ApplicationSyncPlanResponse{
+		Revision: revision,
+		Tasks:    syncPlanTasks(syncOp, results, targets, live),
+	}, nil
+}
+
+// dryRunSync runs the sync operation in dry-run mode using the gitops-engine sync context, which validates the tasks
+// against the project and applies the resources with kubectl dry-run. Returns the results of all tasks.
+func (s *Server) dryRunSync(ctx context.Context, a *appv1.Application, proj *appv1.AppProject, revision string, syncOp appv1.SyncOperation, reconciliationResult sync.ReconciliationResult) ([]common.ResourceSyncResult, error) {
+	clst, err := s.db.GetCluster(ctx, a.Spec.Destination.Server)
+	if err != nil {
+		return nil, err
+	}
+	logEntry := log.WithFields(log.Fields{"application": a.Name, "syncPlan": true})
+	syncCtx, err := sync.NewSyncContext(
+		revision,
+		reconciliationResult,
+		clst.RESTConfig(),
+		clst.RawRestConfig(),
+		s.kubectl,
+		a.Spec.Destination.Namespace,
+		sync.WithLogr(logutils.NewLogrusLogger(logEntry)),
+		sync.WithPermissionValidator(argo.ProjectPermissionValidator(proj, a.Spec.Destination.Server)),
+		sync.WithOperationSettings(true, syncOp.Prune, syncOp.SyncStrategy.Force(), syncOp.IsApplyStrategy() || len(syncOp.Resources) > 0),
+		sync.WithResourcesFilter(func(key kube.ResourceKey, target *unstructured.Unstructured, live *unstructured.Unstructured) bool {
+			return len(syncOp.Resources) == 0 || argo.ContainsSyncResource(key.Name, key.Namespace, schema.GroupVersionKind{Kind: key.Kind, Group: key.Group}, syncOp.Resources)
+		}),
+		sync.WithManifestValidation(!syncOp.SyncOptions.HasOption("Validate=false")),
+		sync.WithNamespaceCreation(syncOp.SyncOptions.HasOption("CreateNamespace=true"), argo.UnsetManagedNamespaceTracking),
+		sync.WithPruneLast(syncOp.SyncOptions.HasOption("PruneLast=true")),
+	)
+	if err != nil {
+		return nil, err
+	}
+	// the dry run normally completes in the first iteration
+	for i := 0; i < maxSyncPlanIterations; i++ {
+		syncCtx.Sync()
+		if phase, _, _ := syncCtx.GetState(); phase.Completed() {
+			break
+		}
+	}
+	phase, message, results := syncCtx.GetState()
+	if phase == common.OperationError {
+		return nil, status.Errorf(codes.FailedPrecondition, "dry-run sync failed: %s", message)
+	}
+	return results, nil
+}
+
+// syncPlanTasks converts the results of the dry-run sync into the plan tasks ordered by phase and wave. Tasks of the
+// same phase and wave keep the order in which the sync context ran them. Prune tasks skipped by the sync options and
+// tasks which failed the project checks or the dry-run apply are marked as skipped. SyncFail hooks are included as
+// skipped since they only run if the sync fails. Hooks deleted according to their delete policy get delete tasks: before
+// the hook is created for the BeforeHookCreation policy, and at the end of the plan for the HookSucceeded and HookFailed
+// policies, the latter marked as skipped.
+func syncPlanTasks(syncOp appv1.SyncOperation, results []common.ResourceSyncResult, targets []*unstructured.Unstructured, live []*unstructured.Unstructured) []*application.ApplicationSyncTask {
+	targetByKey := make(map[kube.ResourceKey]*unstructured.Unstructured)
+	for _, obj := range targets {
+		targetByKey[kube.GetResourceKey(obj)] = obj
+	}
+	liveByKey := make(map[kube.ResourceKey]*unstructured.Unstructured)
+	for _, obj := range live {
+		liveByKey[kube.GetResourceKey(obj)] = obj
+	}
+
+	var tasks []*application.ApplicationSyncTask
+	var pruneTasks []*application.ApplicationSyncTask
+	var hookDeleteTasks []*application.ApplicationSyncTask
+	lastWave := int64(0)
+	hasResult := make(map[kube.ResourceKey]bool)
+	for _, res := range results {
+		hasResult[res.ResourceKey] = true
+		task := &application.ApplicationSyncTask{
+			Phase:     string(res.SyncPhase),
+			HookType:  string(res.HookType),
+			Action:    syncTaskActionApply,
+			Group:     res.ResourceKey.Group,
+			Kind:      res.ResourceKey.Kind,
+			Namespace: res.ResourceKey.Namespace,
+			Name:      res.ResourceKey.Name,
+		}
+		if res.Status == common.ResultCodePruneSkipped || res.Status == common.ResultCodeSyncFailed {
+			task.Skipped = true
+			task.Message = res.Message
+		}
+		target, liveObj := targetByKey[res.ResourceKey], liveByKey[res.ResourceKey]
+		obj := target
+		switch {
+		case target == nil && liveObj != nil:
+			task.Action = syncTaskActionPrune
+			obj = liveObj
+		case res.HookType != "" || liveObj == nil:
+			task.Action = syncTaskActionCreate
+		}
+		if obj != nil {
+			task.Wave = int64(syncwaves.Wave(obj))
+		}
+		if task.Action == syncTaskActionPrune {
+			if syncOp.SyncOptions.HasOption("PruneLast=true") || resourceutil.HasAnnotationOption(obj, common.AnnotationSyncOptions, "PruneLast=true") {
+				pruneTasks = append(pruneTasks, task)
+			}
+		} else if task.Phase == string(common.SyncPhaseSync) && task.Wave > lastWave {
+			lastWave = task.Wave
+		}
+		var deletePolicies []common.HookDeletePolicy
+		if res.HookType != "" && target != nil && !task.Skipped {
+			deletePolicies = hook.DeletePolicies(target)
+		}
+		// the sync context deletes the live instance of the hook before creating it again
+		if liveObj != nil && hasHookDeletePolicy(deletePolicies, common.HookDeletePolicyBeforeHookCreation) {
+			tasks = append(tasks, hookDeleteTask(task, ""))
+		}
+		tasks = append(tasks, task)
+		if hasHookDeletePolicy(deletePolicies, common.HookDeletePolicyHookSucceeded) {
+			hookDeleteTasks = append(hookDeleteTasks, hookDeleteTask(task, ""))
+		}
+		if hasHookDeletePolicy(deletePolicies, common.HookDeletePolicyHookFailed) {
+			hookDeleteTasks = append(hookDeleteTasks, hookDeleteTask(task, "runs only if the sync fails"))
+		}
+	}
+	// the sync context prunes these resources after the last wave of the sync phase
+	for _, task := range pruneTasks {
+		task.Wave = lastWave + 1
+	}
+
+	if !syncOp.IsApplyStrategy() && len(syncOp.Resources) == 0 {
+		for _, obj := range targets {
+			if !hook.IsHook(obj) || hook.Skip(obj) || hasResult[kube.GetResourceKey(obj)] {
+				continue
+			}
+			for _, hookType := range hook.Types(obj) {
+				if hookType != common.HookTypeSyncFail {
+					continue
+				}
+				gvk := obj.GroupVersionKind()
+				tasks = append(tasks, &application.ApplicationSyncTask{
+					Phase:     string(common.SyncPhaseSyncFail),
+					Wave:      int64(syncwaves.Wave(obj)),
+					HookType:  string(hookType),
+					Action:    syncTaskActionCreate,
+					Group:     gvk.Group,
+					Kind:      gvk.Kind,
+					Namespace: obj.GetNamespace(),
+					Name:      obj.GetName(),
+					Skipped:   true,
+					Message:   "runs only if the sync fails",
+				})
+			}
+		}
+	}
+
+	sort.SliceStable(tasks, func(i, j int) bool {
+		a, b := tasks[i], tasks[j]
+		if d := syncPhaseOrder[common.SyncPhase(a.Phase)] - syncPhaseOrder[common.SyncPhase(b.Phase)]; d != 0 {
+			return d < 0
+		}
+		return a.Wave < b.Wave
+	})
+	// the sync context deletes completed hooks once all phases and waves completed
+	return append(tasks, hookDeleteTasks...)
+}
+
+// hookDeleteTask returns the task deleting the live instance of the hook created by the given task. The task is marked
+// as skipped if a skip message is given.
+func hookDeleteTask(task *application.ApplicationSyncTask, skipMessage string) *application.ApplicationSyncTask {
+	deleteTask := *task
+	deleteTask.Action = syncTaskActionDelete
+	deleteTask.Skipped = skipMessage != ""
+	deleteTask.Message = skipMessage
+	return &deleteTask
+}
+
+func hasHookDeletePolicy(policies []common.HookDeletePolicy, policy common.HookDeletePolicy) bool {
+	for _, p := range policies {
+		if p == policy {
+			return true
+		}
+	}
+	return false
+}
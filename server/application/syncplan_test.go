@@ -0,0 +1,144 @@
+package application
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	synccommon "github.com/vathsalashetty96/gitops-engine/pkg/sync/common"
+	"github.com/vathsalashetty96/gitops-engine/pkg/utils/kube"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vathsalashetty96/argo-cd/pkg/apiclient/application"
+	appsv1 "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+func newPlanObject(apiVersion string, kind string, namespace string, name string, annotations map[string]string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	obj.SetAnnotations(annotations)
+	return obj
+}
+
+func formatTasks(tasks []*application.ApplicationSyncTask) []string {
+	var res []string
+	for _, task := range tasks {
+		line := fmt.Sprintf("%s/%d/%s %s %s/%s", task.Phase, task.Wave, task.HookType, task.Action, task.Kind, task.Name)
+		if task.Skipped {
+			line += " skipped: " + task.Message
+		}
+		res = append(res, line)
+	}
+	return res
+}
+
+func newResult(phase synccommon.SyncPhase, hookType synccommon.HookType, status synccommon.ResultCode, message string, obj *unstructured.Unstructured) synccommon.ResourceSyncResult {
+	return synccommon.ResourceSyncResult{
+		ResourceKey: kube.GetResourceKey(obj),
+		SyncPhase:   phase,
+		HookType:    hookType,
+		Status:      status,
+		Message:     message,
+	}
+}
+
+func TestSyncPlanTasks(t *testing.T) {
+	deploy := newPlanObject("apps/v1", "Deployment", "default", "guestbook", nil)
+	svc := newPlanObject("v1", "Service", "default", "guestbook", nil)
+	ns := newPlanObject("v1", "Namespace", "", "guestbook", nil)
+	migrate := newPlanObject("batch/v1", "Job", "default", "migrate", map[string]string{synccommon.AnnotationKeyHook: "PreSync"})
+	smoke := newPlanObject("batch/v1", "Job", "default", "smoke", map[string]string{synccommon.AnnotationKeyHook: "PostSync"})
+	rollback := newPlanObject("batch/v1", "Job", "default", "rollback", map[string]string{synccommon.AnnotationKeyHook: "SyncFail"})
+	cm := newPlanObject("v1", "ConfigMap", "default", "config", map[string]string{synccommon.AnnotationSyncWave: "-1"})
+	orphan := newPlanObject("v1", "ConfigMap", "default", "orphan", nil)
+	kept := newPlanObject("v1", "ConfigMap", "default", "kept", map[string]string{synccommon.AnnotationSyncOptions: "Prune=false"})
+
+	targets := []*unstructured.Unstructured{deploy, svc, ns, migrate, smoke, rollback, cm}
+	live := []*unstructured.Unstructured{deploy.DeepCopy(), orphan, kept}
+
+	t.Run("Default", func(t *testing.T) {
+		// results are reported in the order the dry-run pass ran the tasks, which is not the order of phases and waves
+		results := []synccommon.ResourceSyncResult{
+			newResult(synccommon.SyncPhaseSync, "", synccommon.ResultCodePruneSkipped, "ignored (no prune)", kept),
+			newResult(synccommon.SyncPhaseSync, "", synccommon.ResultCodePruneSkipped, "ignored (requires pruning)", orphan),
+			newResult(synccommon.SyncPhaseSync, "", synccommon.ResultCodeSynced, "namespace/guestbook created (dry run)", ns),
+			newResult(synccommon.SyncPhaseSync, "", synccommon.ResultCodeSynced, "service/guestbook created (dry run)", svc),
+			newResult(synccommon.SyncPhaseSync, "", synccommon.ResultCodeSynced, "deployment.apps/guestbook configured (dry run)", deploy),
+			newResult(synccommon.SyncPhasePostSync, synccommon.HookTypePostSync, synccommon.ResultCodeSynced, "job.batch/smoke created (dry run)", smoke),
+			newResult(synccommon.SyncPhasePreSync, synccommon.HookTypePreSync, synccommon.ResultCodeSynced, "job.batch/migrate created (dry run)", migrate),
+			newResult(synccommon.SyncPhaseSync, "", synccommon.ResultCodeSynced, "configmap/config created (dry run)", cm),
+		}
+		tasks := syncPlanTasks(appsv1.SyncOperation{}, results, targets, live)
+		assert.Equal(t, []string{
+			"PreSync/0/PreSync create Job/migrate",
+			"Sync/-1/ create ConfigMap/config",
+			"Sync/0/ prune ConfigMap/kept skipped: ignored (no prune)",
+			"Sync/0/ prune ConfigMap/orphan skipped: ignored (requires pruning)",
+			"Sync/0/ create Namespace/guestbook",
+			"Sync/0/ create Service/guestbook",
+			"Sync/0/ apply Deployment/guestbook",
+			"PostSync/0/PostSync create Job/smoke",
+			"SyncFail/0/SyncFail create Job/rollback skipped: runs only if the sync fails",
+		}, formatTasks(tasks))
+	})
+
+	t.Run("PruneLast", func(t *testing.T) {
+		syncOp := appsv1.SyncOperation{Prune: true, SyncOptions: appsv1.SyncOptions{"PruneLast=true"}}
+		results := []synccommon.ResourceSyncResult{
+			newResult(synccommon.SyncPhaseSync, "", synccommon.ResultCodePruned, "pruned (dry run)", orphan),
+			newResult(synccommon.SyncPhaseSync, "", synccommon.ResultCodeSynced, "deployment.apps/guestbook configured (dry run)", deploy),
+		}
+		tasks := syncPlanTasks(syncOp, results, []*unstructured.Unstructured{deploy}, []*unstructured.Unstructured{deploy.DeepCopy(), orphan})
+		assert.Equal(t, []string{
+			"Sync/0/ apply Deployment/guestbook",
+			"Sync/1/ prune ConfigMap/orphan",
+		}, formatTasks(tasks))
+	})
+
+	t.Run("SelectiveSync", func(t *testing.T) {
+		syncOp := appsv1.SyncOperation{Resources: []appsv1.SyncOperationResource{{Group: "apps", Kind: "Deployment", Name: "guestbook"}}}
+		results := []synccommon.ResourceSyncResult{
+			newResult(synccommon.SyncPhaseSync, "", synccommon.ResultCodeSynced, "deployment.apps/guestbook configured (dry run)", deploy),
+		}
+		tasks := syncPlanTasks(syncOp, results, targets, live)
+		assert.Equal(t, []string{"Sync/0/ apply Deployment/guestbook"}, formatTasks(tasks))
+	})
+
+	t.Run("ProjectCheckFailed", func(t *testing.T) {
+		results := []synccommon.ResourceSyncResult{
+			newResult(synccommon.SyncPhaseSync, "", synccommon.ResultCodeSyncFailed, "Resource :Namespace is not permitted in project default.", ns),
+		}
+		tasks := syncPlanTasks(appsv1.SyncOperation{}, results, []*unstructured.Unstructured{ns}, nil)
+		assert.Equal(t, []string{"Sync/0/ create Namespace/guestbook skipped: Resource :Namespace is not permitted in project default."}, formatTasks(tasks))
+	})
+
+	t.Run("HookDeletePolicies", func(t *testing.T) {
+		recreated := newPlanObject("batch/v1", "Job", "default", "recreated", map[string]string{synccommon.AnnotationKeyHook: "PreSync"})
+		succeeded := newPlanObject("batch/v1", "Job", "default", "succeeded", map[string]string{
+			synccommon.AnnotationKeyHook:             "PostSync",
+			synccommon.AnnotationKeyHookDeletePolicy: "HookSucceeded,HookFailed",
+		})
+		created := newPlanObject("batch/v1", "Job", "default", "created", map[string]string{synccommon.AnnotationKeyHook: "PreSync"})
+		results := []synccommon.ResourceSyncResult{
+			newResult(synccommon.SyncPhasePreSync, synccommon.HookTypePreSync, synccommon.ResultCodeSynced, "job.batch/recreated created (dry run)", recreated),
+			newResult(synccommon.SyncPhasePreSync, synccommon.HookTypePreSync, synccommon.ResultCodeSynced, "job.batch/created created (dry run)", created),
+			newResult(synccommon.SyncPhasePostSync, synccommon.HookTypePostSync, synccommon.ResultCodeSynced, "job.batch/succeeded created (dry run)", succeeded),
+			newResult(synccommon.SyncPhaseSync, "", synccommon.ResultCodeSynced, "deployment.apps/guestbook configured (dry run)", deploy),
+		}
+		tasks := syncPlanTasks(appsv1.SyncOperation{}, results,
+			[]*unstructured.Unstructured{deploy, recreated, succeeded, created},
+			[]*unstructured.Unstructured{deploy.DeepCopy(), recreated.DeepCopy(), succeeded.DeepCopy()})
+		assert.Equal(t, []string{
+			"PreSync/0/PreSync delete Job/recreated",
+			"PreSync/0/PreSync create Job/recreated",
+			"PreSync/0/PreSync create Job/created",
+			"Sync/0/ apply Deployment/guestbook",
+			"PostSync/0/PostSync create Job/succeeded",
+			"PostSync/0/PostSync delete Job/succeeded",
+			"PostSync/0/PostSync delete Job/succeeded skipped: runs only if the sync fails",
+		}, formatTasks(tasks))
+	})
+}
@@ -2,14 +2,20 @@ package badge
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"html"
 	"net/http"
 	"regexp"
+	"strings"
+	"time"
 
 	healthutil "github.com/vathsalashetty96/gitops-engine/pkg/health"
 	"k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
 
+	"github.com/vathsalashetty96/argo-cd/common"
 	appv1 "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/vathsalashetty96/argo-cd/pkg/client/clientset/versioned"
 	"github.com/vathsalashetty96/argo-cd/util/argo"
@@ -35,15 +41,48 @@ var (
 	leftRectColorPattern     = regexp.MustCompile(`id="leftRect" fill="([^"]*)"`)
 	rightRectColorPattern    = regexp.MustCompile(`id="rightRect" fill="([^"]*)"`)
 	revisionRectColorPattern = regexp.MustCompile(`id="revisionRect" fill="([^"]*)"`)
+	revisionRectWidthPattern = regexp.MustCompile(`(id="revisionRect" [^>]*width=")[^"]*`)
 	leftTextPattern          = regexp.MustCompile(`id="leftText" [^>]*>([^<]*)`)
 	rightTextPattern         = regexp.MustCompile(`id="rightText" [^>]*>([^<]*)`)
 	revisionTextPattern      = regexp.MustCompile(`id="revisionText" [^>]*>([^<]*)`)
+	revisionTextXPattern     = regexp.MustCompile(`(id="revisionText" x=")[^"]*`)
 )
 
 const (
 	svgWidthWithRevision = 192
+	// revisionRectWidth is the width of the revision rect of the badge template
+	revisionRectWidth = 62
+	// revisionTextX is the position of the revision text of the badge template, scaled by 10
+	revisionTextX = 1550
+	// revisionCharWidth is the approximate width of a character of the revision text in tenths of a pixel, which is
+	// rendered in monospace
+	revisionCharWidth = 66
+	// revisionTextPadding is the horizontal space around the revision text
+	revisionTextPadding = 3
+	// maxTextLength is the maximum number of characters of a text of the badge. Longer texts are truncated.
+	maxTextLength = 64
+	// resourceFieldCount is the number of fields of the resource query parameter, formatted as GROUP:KIND:[NAMESPACE/]NAME
+	resourceFieldCount = 3
 )
 
+// badgeStatus is the status shown by a badge, which is returned as is if the JSON format is requested
+type badgeStatus struct {
+	Health         healthutil.HealthStatusCode `json:"health"`
+	Sync           appv1.SyncStatusCode        `json:"sync"`
+	Revision       string                      `json:"revision,omitempty"`
+	TargetRevision string                      `json:"targetRevision,omitempty"`
+	LastSyncedAt   *v1.Time                    `json:"lastSyncedAt,omitempty"`
+	Applications   *applicationCounts          `json:"applications,omitempty"`
+	notFound       bool
+}
+
+// applicationCounts holds the number of healthy and synced applications of the projects of a badge
+type applicationCounts struct {
+	Total   int `json:"total"`
+	Healthy int `json:"healthy"`
+	Synced  int `json:"synced"`
+}
+
 func replaceFirstGroupSubMatch(re *regexp.Regexp, str string, repl string) string {
 	result := ""
 	lastIndex := 0
@@ -61,74 +100,211 @@ func replaceFirstGroupSubMatch(re *regexp.Regexp, str string, repl string) strin
 	return result + str[lastIndex:]
 }
 
-//ServeHTTP returns badge with health and sync status for application
-//(or an error badge if wrong query or application name is given)
-func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	health := healthutil.HealthStatusUnknown
-	status := appv1.SyncStatusCodeUnknown
-	revision := ""
-	revisionEnabled := false
-	enabled := false
-	notFound := false
-	if sets, err := h.settingsMgr.GetSettings(); err == nil {
-		enabled = sets.StatusBadgeEnabled
+// truncateText truncates the text to maxTextLength characters
+func truncateText(text string) string {
+	runes := []rune(text)
+	if len(runes) <= maxTextLength {
+		return text
 	}
+	return string(runes[:maxTextLength-1]) + "…"
+}
+
+// escapeText escapes the text to be inserted into the SVG of the badge. Texts such as the target revision are
+// controlled by users and must not inject markup into the badge, which is served without authentication.
+func escapeText(text string) string {
+	return html.EscapeString(text)
+}
+
+// badgeEnabled returns whether the badge of the application may be shown. The status badge annotation of the
+// application takes precedence over the 'statusbadge.enabled' setting.
+func badgeEnabled(app *appv1.Application, enabledByDefault bool) bool {
+	if value, ok := app.Annotations[common.AnnotationKeyStatusBadge]; ok {
+		return value == "true"
+	}
+	return enabledByDefault
+}
+
+// findResource returns the status of the application resource given as GROUP:KIND:[NAMESPACE/]NAME
+func findResource(app *appv1.Application, resource string) (*appv1.ResourceStatus, bool) {
+	fields := strings.Split(resource, ":")
+	if len(fields) != resourceFieldCount {
+		return nil, false
+	}
+	namespace := ""
+	name := fields[2]
+	if parts := strings.SplitN(name, "/", 2); len(parts) == 2 {
+		namespace = parts[0]
+		name = parts[1]
+	}
+	for i := range app.Status.Resources {
+		res := &app.Status.Resources[i]
+		if res.Group == fields[0] && res.Kind == fields[1] && res.Name == name && (namespace == "" || res.Namespace == namespace) {
+			return res, true
+		}
+	}
+	return nil, false
+}
+
+// lastSyncedAt returns when the application was synced last, or nil if it was never synced
+func lastSyncedAt(app *appv1.Application) *v1.Time {
+	if app.Status.OperationState != nil && app.Status.OperationState.FinishedAt != nil {
+		return app.Status.OperationState.FinishedAt
+	}
+	if len(app.Status.History) > 0 {
+		deployedAt := app.Status.History.LastRevisionHistory().DeployedAt
+		return &deployedAt
+	}
+	return nil
+}
+
+// getStatus returns the status of the application or projects given in the request
+func (h *Handler) getStatus(r *http.Request, enabled bool) badgeStatus {
+	status := badgeStatus{Health: healthutil.HealthStatusUnknown, Sync: appv1.SyncStatusCodeUnknown}
+	query := r.URL.Query()
 
 	//Sample url: http://localhost:8080/api/badge?name=123
-	if name, ok := r.URL.Query()["name"]; ok && enabled {
-		if app, err := h.appClientset.ArgoprojV1alpha1().Applications(h.namespace).Get(context.Background(), name[0], v1.GetOptions{}); err == nil {
-			health = app.Status.Health.Status
-			status = app.Status.Sync.Status
+	if name, ok := query["name"]; ok {
+		app, err := h.appClientset.ArgoprojV1alpha1().Applications(h.namespace).Get(context.Background(), name[0], v1.GetOptions{})
+		if errors.IsNotFound(err) {
+			// the existence of applications is only revealed if badges are enabled by default
+			status.notFound = enabled
+		}
+		if err == nil && badgeEnabled(app, enabled) {
+			status.Health = app.Status.Health.Status
+			status.Sync = app.Status.Sync.Status
+			status.TargetRevision = app.Spec.Source.TargetRevision
+			status.LastSyncedAt = lastSyncedAt(app)
 			if app.Status.OperationState != nil && app.Status.OperationState.SyncResult != nil {
-				revision = app.Status.OperationState.SyncResult.Revision
+				status.Revision = app.Status.OperationState.SyncResult.Revision
+			}
+			//Sample url: http://localhost:8080/api/badge?name=123&resource=apps:Deployment:default/guestbook-ui
+			if resource, ok := query["resource"]; ok {
+				if res, ok := findResource(app, resource[0]); ok {
+					status.Health = healthutil.HealthStatusUnknown
+					if res.Health != nil {
+						status.Health = res.Health.Status
+					}
+					status.Sync = res.Status
+				} else {
+					status = badgeStatus{Health: healthutil.HealthStatusUnknown, Sync: appv1.SyncStatusCodeUnknown, notFound: true}
+				}
 			}
-		} else if errors.IsNotFound(err) {
-			notFound = true
 		}
 	}
 	//Sample url: http://localhost:8080/api/badge?project=default
-	if projects, ok := r.URL.Query()["project"]; ok && enabled {
+	if projects, ok := query["project"]; ok {
 		if apps, err := h.appClientset.ArgoprojV1alpha1().Applications(h.namespace).List(context.Background(), v1.ListOptions{}); err == nil {
-			applicationSet := argo.FilterByProjects(apps.Items, projects)
-			for _, a := range applicationSet {
-				if a.Status.Sync.Status != appv1.SyncStatusCodeSynced {
-					status = appv1.SyncStatusCodeOutOfSync
+			counts := applicationCounts{}
+			for _, a := range argo.FilterByProjects(apps.Items, projects) {
+				if !badgeEnabled(&a, enabled) {
+					continue
 				}
-				if a.Status.Health.Status != healthutil.HealthStatusHealthy {
-					health = healthutil.HealthStatusDegraded
+				counts.Total++
+				if a.Status.Sync.Status == appv1.SyncStatusCodeSynced {
+					counts.Synced++
+				}
+				if a.Status.Health.Status == healthutil.HealthStatusHealthy {
+					counts.Healthy++
 				}
 			}
-			if health != healthutil.HealthStatusDegraded && len(applicationSet) > 0 {
-				health = healthutil.HealthStatusHealthy
-			}
-			if status != appv1.SyncStatusCodeOutOfSync && len(applicationSet) > 0 {
-				status = appv1.SyncStatusCodeSynced
+			if counts.Total > 0 {
+				status.Health = healthutil.HealthStatusHealthy
+				if counts.Healthy < counts.Total {
+					status.Health = healthutil.HealthStatusDegraded
+				}
+				status.Sync = appv1.SyncStatusCodeSynced
+				if counts.Synced < counts.Total {
+					status.Sync = appv1.SyncStatusCodeOutOfSync
+				}
 			}
+			status.Applications = &counts
 		}
 	}
+	return status
+}
+
+// revisionText returns the text of the revision part of the badge, which shows the target revision, the synced
+// revision and the time of the last sync if requested
+func revisionText(r *http.Request, status badgeStatus) string {
+	query := r.URL.Query()
+	revision := ""
+	//Sample url: http://localhost:8080/api/badge?name=123&targetRevision=true
+	if _, ok := query["targetRevision"]; ok && status.TargetRevision != "" {
+		revision = status.TargetRevision
+	}
 	//Sample url: http://localhost:8080/api/badge?name=123&revision=true
-	if _, ok := r.URL.Query()["revision"]; ok && enabled {
-		revisionEnabled = true
+	if _, ok := query["revision"]; ok && status.Revision != "" {
+		shortRevision := status.Revision
+		if len(shortRevision) > 7 {
+			shortRevision = shortRevision[:7]
+		}
+		if revision != "" {
+			revision += "@"
+		}
+		revision += shortRevision
+	}
+	parts := make([]string, 0)
+	if revision != "" {
+		parts = append(parts, revision)
+	}
+	//Sample url: http://localhost:8080/api/badge?name=123&lastSync=true
+	if _, ok := query["lastSync"]; ok && status.LastSyncedAt != nil {
+		parts = append(parts, fmt.Sprintf("%s ago", duration.HumanDuration(time.Since(status.LastSyncedAt.Time))))
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("(%s)", strings.Join(parts, ", "))
+}
+
+//ServeHTTP returns badge with health and sync status for application
+//(or an error badge if wrong query or application name is given)
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	enabled := false
+	if sets, err := h.settingsMgr.GetSettings(); err == nil {
+		enabled = sets.StatusBadgeEnabled
+	}
+	status := h.getStatus(r, enabled)
+
+	//Ask cache's to not cache the contents in order prevent the badge from becoming stale
+	w.Header().Set("Cache-Control", "private, no-store")
+
+	//Sample url: http://localhost:8080/api/badge?name=123&format=json
+	if r.URL.Query().Get("format") == "json" {
+		w.Header().Set("Content-Type", "application/json")
+		if status.notFound {
+			w.WriteHeader(http.StatusNotFound)
+		} else {
+			w.WriteHeader(http.StatusOK)
+		}
+		_ = json.NewEncoder(w).Encode(status)
+		return
 	}
 
 	leftColorString := ""
-	if leftColor, ok := HealthStatusColors[health]; ok {
+	if leftColor, ok := HealthStatusColors[status.Health]; ok {
 		leftColorString = toRGBString(leftColor)
 	} else {
 		leftColorString = toRGBString(Grey)
 	}
 
 	rightColorString := ""
-	if rightColor, ok := SyncStatusColors[status]; ok {
+	if rightColor, ok := SyncStatusColors[status.Sync]; ok {
 		rightColorString = toRGBString(rightColor)
 	} else {
 		rightColorString = toRGBString(Grey)
 	}
 
-	leftText := string(health)
-	rightText := string(status)
+	leftText := string(status.Health)
+	rightText := string(status.Sync)
 
-	if notFound {
+	//Sample url: http://localhost:8080/api/badge?project=default&counts=true
+	if _, ok := r.URL.Query()["counts"]; ok && status.Applications != nil && status.Applications.Total > 0 {
+		leftText = fmt.Sprintf("%d/%d %s", status.Applications.Healthy, status.Applications.Total, healthutil.HealthStatusHealthy)
+		rightText = fmt.Sprintf("%d/%d %s", status.Applications.Synced, status.Applications.Total, appv1.SyncStatusCodeSynced)
+	}
+
+	if status.notFound {
 		leftText = "Not Found"
 		rightText = ""
 	}
@@ -136,25 +312,25 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	badge := assets.BadgeSVG
 	badge = leftRectColorPattern.ReplaceAllString(badge, fmt.Sprintf(`id="leftRect" fill="%s" $2`, leftColorString))
 	badge = rightRectColorPattern.ReplaceAllString(badge, fmt.Sprintf(`id="rightRect" fill="%s" $2`, rightColorString))
-	badge = replaceFirstGroupSubMatch(leftTextPattern, badge, leftText)
-	badge = replaceFirstGroupSubMatch(rightTextPattern, badge, rightText)
+	badge = replaceFirstGroupSubMatch(leftTextPattern, badge, escapeText(truncateText(leftText)))
+	badge = replaceFirstGroupSubMatch(rightTextPattern, badge, escapeText(truncateText(rightText)))
 
-	if !notFound && revisionEnabled && revision != "" {
-		// Increase width of SVG and enable display of revision components
-		badge = svgWidthPattern.ReplaceAllString(badge, fmt.Sprintf(`<svg width="%d" $2`, svgWidthWithRevision))
+	if text := truncateText(revisionText(r, status)); !status.notFound && text != "" {
+		// Increase width of SVG and enable display of revision components. Texts which don't fit into the revision rect
+		// of the template widen it.
+		extraWidth := len([]rune(text))*revisionCharWidth/10 + revisionTextPadding - revisionRectWidth
+		if extraWidth < 0 {
+			extraWidth = 0
+		}
+		badge = svgWidthPattern.ReplaceAllString(badge, fmt.Sprintf(`<svg width="%d" $2`, svgWidthWithRevision+extraWidth))
+		badge = revisionRectWidthPattern.ReplaceAllString(badge, fmt.Sprintf(`${1}%d`, revisionRectWidth+extraWidth))
+		badge = revisionTextXPattern.ReplaceAllString(badge, fmt.Sprintf(`${1}%d`, revisionTextX+extraWidth*10/2))
 		badge = displayNonePattern.ReplaceAllString(badge, `display="inline"`)
 		badge = revisionRectColorPattern.ReplaceAllString(badge, fmt.Sprintf(`id="revisionRect" fill="%s" $2`, rightColorString))
-		shortRevision := revision
-		if len(shortRevision) > 7 {
-			shortRevision = shortRevision[:7]
-		}
-		badge = replaceFirstGroupSubMatch(revisionTextPattern, badge, fmt.Sprintf("(%s)", shortRevision))
+		badge = replaceFirstGroupSubMatch(revisionTextPattern, badge, escapeText(text))
 	}
 
 	w.Header().Set("Content-Type", "image/svg+xml")
-
-	//Ask cache's to not cache the contents in order prevent the badge from becoming stale
-	w.Header().Set("Cache-Control", "private, no-store")
 	w.WriteHeader(http.StatusOK)
 	_, _ = w.Write([]byte(badge))
 }
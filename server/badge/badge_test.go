@@ -2,13 +2,17 @@ package badge
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"html"
 	"image/color"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/vathsalashetty96/argo-cd/common"
 	"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1"
 	appclientset "github.com/vathsalashetty96/argo-cd/pkg/client/clientset/versioned/fake"
 	"github.com/vathsalashetty96/argo-cd/util/settings"
@@ -250,3 +254,148 @@ func TestHandlerFeatureIsDisabled(t *testing.T) {
 	assert.Equal(t, "Unknown", leftTextPattern.FindStringSubmatch(response)[1])
 	assert.Equal(t, "Unknown", rightTextPattern.FindStringSubmatch(response)[1])
 }
+
+func TestHandlerAnnotationOverridesSetting(t *testing.T) {
+	argoCDCmDisabled := argoCDCm.DeepCopy()
+	delete(argoCDCmDisabled.Data, "statusbadge.enabled")
+	app := testApp.DeepCopy()
+	app.Annotations = map[string]string{common.AnnotationKeyStatusBadge: "true"}
+
+	settingsMgr := settings.NewSettingsManager(context.Background(), fake.NewSimpleClientset(argoCDCmDisabled, &argoCDSecret), "default")
+	handler := NewHandler(appclientset.NewSimpleClientset(app), settingsMgr, "default")
+	req, err := http.NewRequest("GET", "/api/badge?name=testApp", nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	response := rr.Body.String()
+	assert.Equal(t, "Healthy", leftTextPattern.FindStringSubmatch(response)[1])
+	assert.Equal(t, "Synced", rightTextPattern.FindStringSubmatch(response)[1])
+
+	app.Annotations[common.AnnotationKeyStatusBadge] = "false"
+	settingsMgr = settings.NewSettingsManager(context.Background(), fake.NewSimpleClientset(&argoCDCm, &argoCDSecret), "default")
+	handler = NewHandler(appclientset.NewSimpleClientset(app), settingsMgr, "default")
+
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	response = rr.Body.String()
+	assert.Equal(t, "Unknown", leftTextPattern.FindStringSubmatch(response)[1])
+	assert.Equal(t, "Unknown", rightTextPattern.FindStringSubmatch(response)[1])
+}
+
+func TestHandlerProjectCounts(t *testing.T) {
+	apps := createApplications([]string{"Healthy:Synced", "Degraded:Synced", "Healthy:OutOfSync"}, []string{"default", "default", "default"}, "default")
+	settingsMgr := settings.NewSettingsManager(context.Background(), fake.NewSimpleClientset(&argoCDCm, &argoCDSecret), "default")
+	handler := NewHandler(appclientset.NewSimpleClientset(&testProject, apps[0], apps[1], apps[2]), settingsMgr, "default")
+	req, err := http.NewRequest("GET", "/api/badge?project=default&counts=true", nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	response := rr.Body.String()
+	assert.Equal(t, toRGBString(Red), leftRectColorPattern.FindStringSubmatch(response)[1])
+	assert.Equal(t, toRGBString(Orange), rightRectColorPattern.FindStringSubmatch(response)[1])
+	assert.Equal(t, "2/3 Healthy", leftTextPattern.FindStringSubmatch(response)[1])
+	assert.Equal(t, "2/3 Synced", rightTextPattern.FindStringSubmatch(response)[1])
+}
+
+func TestHandlerResource(t *testing.T) {
+	app := testApp.DeepCopy()
+	app.Status.Resources = []v1alpha1.ResourceStatus{{
+		Group:     "apps",
+		Kind:      "Deployment",
+		Namespace: "default",
+		Name:      "guestbook-ui",
+		Status:    v1alpha1.SyncStatusCodeOutOfSync,
+		Health:    &v1alpha1.HealthStatus{Status: health.HealthStatusProgressing},
+	}}
+	settingsMgr := settings.NewSettingsManager(context.Background(), fake.NewSimpleClientset(&argoCDCm, &argoCDSecret), "default")
+	handler := NewHandler(appclientset.NewSimpleClientset(app), settingsMgr, "default")
+
+	req, err := http.NewRequest("GET", "/api/badge?name=testApp&resource=apps:Deployment:default/guestbook-ui", nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	response := rr.Body.String()
+	assert.Equal(t, toRGBString(Blue), leftRectColorPattern.FindStringSubmatch(response)[1])
+	assert.Equal(t, "Progressing", leftTextPattern.FindStringSubmatch(response)[1])
+	assert.Equal(t, "OutOfSync", rightTextPattern.FindStringSubmatch(response)[1])
+
+	req, err = http.NewRequest("GET", "/api/badge?name=testApp&resource=:Service:guestbook-ui", nil)
+	assert.NoError(t, err)
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	response = rr.Body.String()
+	assert.Equal(t, "Not Found", leftTextPattern.FindStringSubmatch(response)[1])
+}
+
+func TestHandlerRevisionAndLastSync(t *testing.T) {
+	app := testApp.DeepCopy()
+	app.Spec.Source.TargetRevision = "main"
+	finishedAt := v1.NewTime(time.Now().Add(-3 * time.Hour))
+	app.Status.OperationState.FinishedAt = &finishedAt
+
+	settingsMgr := settings.NewSettingsManager(context.Background(), fake.NewSimpleClientset(&argoCDCm, &argoCDSecret), "default")
+	handler := NewHandler(appclientset.NewSimpleClientset(app), settingsMgr, "default")
+	req, err := http.NewRequest("GET", "/api/badge?name=testApp&revision=true&targetRevision=true&lastSync=true", nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	response := rr.Body.String()
+	assert.Equal(t, "(main@aa29b85, 3h ago)", revisionTextPattern.FindStringSubmatch(response)[1])
+	assert.NotContains(t, response, fmt.Sprintf(`width="%d"`, revisionRectWidth))
+	assert.NotContains(t, response, fmt.Sprintf(`<svg width="%d"`, svgWidthWithRevision))
+}
+
+func TestHandlerRevisionIsEscaped(t *testing.T) {
+	app := testApp.DeepCopy()
+	app.Spec.Source.TargetRevision = `"><script>alert("x")</script>` + strings.Repeat("a", 100)
+
+	settingsMgr := settings.NewSettingsManager(context.Background(), fake.NewSimpleClientset(&argoCDCm, &argoCDSecret), "default")
+	handler := NewHandler(appclientset.NewSimpleClientset(app), settingsMgr, "default")
+	req, err := http.NewRequest("GET", "/api/badge?name=testApp&targetRevision=true", nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	response := rr.Body.String()
+	assert.NotContains(t, response, "<script>")
+	assert.NotContains(t, response, `"x"`)
+	text := revisionTextPattern.FindStringSubmatch(response)[1]
+	assert.True(t, strings.HasPrefix(text, "(&#34;&gt;&lt;script&gt;alert(&#34;x&#34;)&lt;/script&gt;aaa"))
+	assert.True(t, strings.HasSuffix(text, "…"))
+	assert.Len(t, []rune(html.UnescapeString(text)), maxTextLength)
+}
+
+func TestHandlerJSONFormat(t *testing.T) {
+	settingsMgr := settings.NewSettingsManager(context.Background(), fake.NewSimpleClientset(&argoCDCm, &argoCDSecret), "default")
+	handler := NewHandler(appclientset.NewSimpleClientset(&testApp), settingsMgr, "default")
+
+	req, err := http.NewRequest("GET", "/api/badge?name=testApp&format=json", nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+	var status map[string]interface{}
+	assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &status))
+	assert.Equal(t, "Healthy", status["health"])
+	assert.Equal(t, "Synced", status["sync"])
+	assert.Equal(t, "aa29b85", status["revision"])
+
+	req, err = http.NewRequest("GET", "/api/badge?name=missing&format=json", nil)
+	assert.NoError(t, err)
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
@@ -66,12 +66,15 @@ func (c *Cache) GetAppManagedResources(appName string, res *[]*appv1.ResourceDif
 	return c.cache.GetAppManagedResources(appName, res)
 }
 
-func (c *Cache) GetLoginAttempts(attempts *map[string]session.LoginAttempts) error {
-	return c.cache.GetItem("session|login.attempts", attempts)
-}
-
-func (c *Cache) SetLoginAttempts(attempts map[string]session.LoginAttempts) error {
-	return c.cache.SetItem("session|login.attempts", attempts, c.loginAttemptsExpiration, attempts == nil)
+// NewUserStateStorage returns the storage of failed login attempts and revoked sessions. It is shared by all API
+// servers if a Redis client is given, and kept in memory otherwise. Revocations are synced until the context is done.
+func (c *Cache) NewUserStateStorage(ctx context.Context, client *redis.Client) session.UserStateStorage {
+	if c == nil || client == nil {
+		return session.NewInMemoryUserStateStorage()
+	}
+	storage := session.NewRedisUserStateStorage(client, c.loginAttemptsExpiration)
+	storage.Init(ctx)
+	return storage
 }
 
 func (c *Cache) SetRepoConnectionState(repo string, state *appv1.ConnectionState) error {
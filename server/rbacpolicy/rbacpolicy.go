@@ -1,6 +1,7 @@
 package rbacpolicy
 
 import (
+	"fmt"
 	"strings"
 
 	jwt "github.com/dgrijalva/jwt-go/v4"
@@ -51,6 +52,19 @@ var (
 	}
 )
 
+// ResourceAction returns the resource-level RBAC action of an operation on a resource of an application, in the form
+// <action>/<group>/<kind>/<namespace>/<name>. Policies granting the plain action grant it for all resources, while
+// policies of the resource-level action may allow or deny it for specific resources only.
+func ResourceAction(action string, group string, kind string, namespace string, name string) string {
+	return fmt.Sprintf("%s/%s/%s/%s/%s", action, group, kind, namespace, name)
+}
+
+// RunAction returns the resource-level RBAC action of running a resource action on a resource of an
+// application, in the form action/<group>/<kind>/<action name>/<namespace>/<name>
+func RunAction(group string, kind string, actionName string, namespace string, name string) string {
+	return fmt.Sprintf("%s/%s/%s/%s/%s/%s", ActionAction, group, kind, actionName, namespace, name)
+}
+
 // RBACPolicyEnforcer provides an RBAC Claims Enforcer which additionally consults AppProject
 // roles, jwt tokens, and groups. It is backed by a AppProject informer/lister cache and does not
 // make any API calls during enforcement.
@@ -89,7 +103,8 @@ func IsProjectSubject(subject string) bool {
 func (p *RBACPolicyEnforcer) EnforceClaims(claims jwt.Claims, rvals ...interface{}) bool {
 	mapClaims, err := jwtutil.MapClaims(claims)
 	if err != nil {
-		return false
+		// the subject of the claims is unknown, but the default role still applies
+		return p.enf.EnforceDefaultRole("", nil, rvals[1:]...)
 	}
 
 	subject := jwtutil.StringField(mapClaims, "sub")
@@ -99,30 +114,18 @@ func (p *RBACPolicyEnforcer) EnforceClaims(claims jwt.Claims, rvals ...interface
 	proj := p.getProjectFromRequest(rvals...)
 	if proj != nil {
 		if IsProjectSubject(subject) {
-			return p.enforceProjectToken(subject, mapClaims, proj, rvals...)
+			// project tokens are granted the default role as well, unless the project role is explicitly denied
+			return p.enforceProjectToken(subject, mapClaims, proj, rvals...) || p.enf.EnforceDefaultRole(proj.ProjectPoliciesString(), []string{subject}, rvals[1:]...)
 		}
 		runtimePolicy = proj.ProjectPoliciesString()
 	}
 
-	// Check the subject. This is typically the 'admin' case.
-	// NOTE: the call to EnforceRuntimePolicy will also consider the default role
-	vals := append([]interface{}{subject}, rvals[1:]...)
-	if p.enf.EnforceRuntimePolicy(runtimePolicy, vals...) {
+	// Check the subject, which is typically the 'admin' case, and the user's groups. The explicit denies of all of them
+	// are evaluated first, so neither a group nor the default role overrides a deny of the user or one of its groups.
+	subjects := append([]string{subject}, jwtutil.GetScopeValues(mapClaims, p.GetScopes())...)
+	if p.enf.EnforceRuntimePolicySubjects(runtimePolicy, subjects, rvals[1:]...) {
 		return true
 	}
-
-	scopes := p.scopes
-	if scopes == nil {
-		scopes = defaultScopes
-	}
-	// Finally check if any of the user's groups grant them permissions
-	groups := jwtutil.GetScopeValues(mapClaims, scopes)
-	for _, group := range groups {
-		vals := append([]interface{}{group}, rvals[1:]...)
-		if p.enf.EnforceRuntimePolicy(runtimePolicy, vals...) {
-			return true
-		}
-	}
 	logCtx := log.WithField("claims", claims).WithField("rval", rvals)
 	logCtx.Debug("enforce failed")
 	return false
@@ -113,6 +113,89 @@ p, cam, applications, %s/argoproj.io/Rollout/resume, my-proj/*, allow
 	assert.False(t, enf.Enforce(claims, "applications", ActionAction+"/vathsalashetty96.io/Rollout/resume", "my-proj/my-app"))
 }
 
+func TestEnforceResourceLevelActions(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset(test.NewFakeConfigMap())
+	projLister := test.NewFakeProjLister(newFakeProj())
+	enf := rbac.NewEnforcer(kubeclientset, test.FakeArgoCDNamespace, common.ArgoCDConfigMapName, nil)
+	_ = enf.SetBuiltinPolicy(`p, alice, applications, delete, my-proj/*, allow
+p, alice, applications, delete/*/Secret/*/*, my-proj/*, deny
+p, bob, applications, action/apps/Deployment/restart, my-proj/*, allow
+p, cam, applications, update/apps/Deployment/*/guestbook-*, my-proj/*, allow
+`)
+	rbacEnf := NewRBACPolicyEnforcer(enf, projLister)
+	enf.SetClaimsEnforcerFunc(rbacEnf.EnforceClaims)
+
+	// Alice may delete the application and all of its resources except Secrets
+	claims := jwt.MapClaims{"sub": "alice"}
+	assert.True(t, enf.Enforce(claims, "applications", ActionDelete, "my-proj/my-app"))
+	assert.True(t, enf.Enforce(claims, "applications", ResourceAction(ActionDelete, "apps", "Deployment", "default", "guestbook"), "my-proj/my-app"))
+	assert.False(t, enf.Enforce(claims, "applications", ResourceAction(ActionDelete, "", "Secret", "default", "guestbook"), "my-proj/my-app"))
+	assert.False(t, enf.Enforce(claims, "applications", ResourceAction(ActionUpdate, "apps", "Deployment", "default", "guestbook"), "my-proj/my-app"))
+	// Bob may only restart Deployments
+	claims = jwt.MapClaims{"sub": "bob"}
+	assert.True(t, enf.Enforce(claims, "applications", RunAction("apps", "Deployment", "restart", "default", "guestbook"), "my-proj/my-app"))
+	assert.False(t, enf.Enforce(claims, "applications", RunAction("apps", "StatefulSet", "restart", "default", "guestbook"), "my-proj/my-app"))
+	// Cam may only update matching Deployments, but not the application itself
+	claims = jwt.MapClaims{"sub": "cam"}
+	assert.True(t, enf.Enforce(claims, "applications", ResourceAction(ActionUpdate, "apps", "Deployment", "default", "guestbook-ui"), "my-proj/my-app"))
+	assert.False(t, enf.Enforce(claims, "applications", ResourceAction(ActionUpdate, "apps", "Deployment", "default", "redis"), "my-proj/my-app"))
+	assert.False(t, enf.Enforce(claims, "applications", ActionUpdate, "my-proj/my-app"))
+}
+
+// TestEnforceClaims_ExplicitDeny tests that neither the groups of a user nor the default role override explicit denies of
+// the user or one of its groups
+func TestEnforceClaims_ExplicitDeny(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset(test.NewFakeConfigMap())
+	projLister := test.NewFakeProjLister(newFakeProj())
+	enf := rbac.NewEnforcer(kubeclientset, test.FakeArgoCDNamespace, common.ArgoCDConfigMapName, nil)
+	_ = enf.SetBuiltinPolicy(`p, role:readonly, applications, get, */*, allow
+p, alice, applications, get, my-proj/*, deny
+p, contractors, applications, get, my-proj/*, deny
+p, admins, applications, get, my-proj/*, allow
+`)
+	enf.SetDefaultRole("role:readonly")
+	rbacEnf := NewRBACPolicyEnforcer(enf, projLister)
+	enf.SetClaimsEnforcerFunc(rbacEnf.EnforceClaims)
+
+	// the deny of the user is not overridden by the default role via a group without policies
+	claims := jwt.MapClaims{"sub": "alice", "groups": []string{"my-org:other-team"}}
+	assert.False(t, enf.Enforce(claims, "applications", "get", "my-proj/my-app"))
+	assert.True(t, enf.Enforce(claims, "applications", "get", "other-proj/my-app"))
+	// the deny of the user is not overridden by a group which is allowed
+	claims = jwt.MapClaims{"sub": "alice", "groups": []string{"admins"}}
+	assert.False(t, enf.Enforce(claims, "applications", "get", "my-proj/my-app"))
+	// the deny of a group is not overridden by another group or the default role
+	claims = jwt.MapClaims{"sub": "bob", "groups": []string{"admins", "contractors"}}
+	assert.False(t, enf.Enforce(claims, "applications", "get", "my-proj/my-app"))
+	claims = jwt.MapClaims{"sub": "bob", "groups": []string{"my-org:other-team"}}
+	assert.True(t, enf.Enforce(claims, "applications", "get", "my-proj/my-app"))
+}
+
+// TestEnforceClaims_ProjectTokenDefaultRole tests that project tokens are granted the default role unless the project
+// role is explicitly denied
+func TestEnforceClaims_ProjectTokenDefaultRole(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset(test.NewFakeConfigMap())
+	proj := newFakeProj()
+	proj.Spec.Roles[0].Policies = append(proj.Spec.Roles[0].Policies, "p, proj:my-proj:my-role, applications, get, my-proj/secret-app, deny")
+	projLister := test.NewFakeProjLister(proj)
+	enf := rbac.NewEnforcer(kubeclientset, test.FakeArgoCDNamespace, common.ArgoCDConfigMapName, nil)
+	_ = enf.SetBuiltinPolicy(`p, role:readonly, applications, get, */*, allow`)
+	enf.SetDefaultRole("role:readonly")
+	rbacEnf := NewRBACPolicyEnforcer(enf, projLister)
+	enf.SetClaimsEnforcerFunc(rbacEnf.EnforceClaims)
+
+	claims := jwt.MapClaims{"sub": "proj:my-proj:my-role", "iat": 1234}
+	assert.True(t, enf.Enforce(claims, "applications", "get", "my-proj/my-app"))
+	assert.True(t, enf.Enforce(claims, "applications", "create", "my-proj/my-app"))
+	assert.False(t, enf.Enforce(claims, "applications", "delete", "my-proj/my-app"))
+	// the default role does not override the deny of the project role
+	assert.False(t, enf.Enforce(claims, "applications", "get", "my-proj/secret-app"))
+	// the default role applies to tokens which are not in the project as well
+	claims = jwt.MapClaims{"sub": "proj:my-proj:my-role", "iat": 5678}
+	assert.True(t, enf.Enforce(claims, "applications", "get", "my-proj/my-app"))
+	assert.False(t, enf.Enforce(claims, "applications", "create", "my-proj/my-app"))
+}
+
 func TestGetScopes_DefaultScopes(t *testing.T) {
 	rbacEnforcer := NewRBACPolicyEnforcer(nil, nil)
 
@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"io/ioutil"
 	"math"
@@ -14,6 +15,7 @@ import (
 	"path"
 	"regexp"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	// nolint:staticcheck
@@ -67,6 +69,7 @@ import (
 	repoapiclient "github.com/vathsalashetty96/argo-cd/reposerver/apiclient"
 	repocache "github.com/vathsalashetty96/argo-cd/reposerver/cache"
 	"github.com/vathsalashetty96/argo-cd/server/account"
+	"github.com/vathsalashetty96/argo-cd/server/admission"
 	"github.com/vathsalashetty96/argo-cd/server/application"
 	"github.com/vathsalashetty96/argo-cd/server/badge"
 	servercache "github.com/vathsalashetty96/argo-cd/server/cache"
@@ -82,6 +85,7 @@ import (
 	"github.com/vathsalashetty96/argo-cd/server/session"
 	"github.com/vathsalashetty96/argo-cd/server/settings"
 	"github.com/vathsalashetty96/argo-cd/server/version"
+	"github.com/vathsalashetty96/argo-cd/util/argo"
 	"github.com/vathsalashetty96/argo-cd/util/assets"
 	cacheutil "github.com/vathsalashetty96/argo-cd/util/cache"
 	"github.com/vathsalashetty96/argo-cd/util/db"
@@ -106,6 +110,10 @@ import (
 const maxConcurrentLoginRequestsCountEnv = "ARGOCD_MAX_CONCURRENT_LOGIN_REQUESTS_COUNT"
 const replicasCountEnv = "ARGOCD_API_SERVER_REPLICAS"
 
+// revokeUnusedTokensInterval is the interval in which the tokens of local accounts which were not used for the duration
+// configured by users.tokens.revokeUnusedAfter are revoked
+const revokeUnusedTokensInterval = time.Hour
+
 // ErrNoSession indicates no auth token was supplied as part of a request
 var ErrNoSession = status.Errorf(codes.Unauthenticated, "no session information")
 
@@ -158,6 +166,8 @@ type ArgoCDServer struct {
 
 	// stopCh is the channel which when closed, will shutdown the Argo CD server
 	stopCh chan struct{}
+	// certificate holds the latest TLS certificate of the settings, which is served by the admission webhook
+	certificate atomic.Value
 }
 
 type ArgoCDServerOpts struct {
@@ -178,6 +188,15 @@ type ArgoCDServerOpts struct {
 	RedisClient         *redis.Client
 	TLSConfigCustomizer tlsutil.ConfigCustomizer
 	XFrameOptions       string
+	// AdmissionWebhook enables the validating admission webhook of the Application and AppProject resources
+	AdmissionWebhook bool
+	// AdmissionWebhookPort is the port of the HTTPS server of the admission webhook, which is separate from the API
+	AdmissionWebhookPort int
+	// AdmissionWebhookClientCA is the path of the CA certificates which must have issued the client certificate of the
+	// Kubernetes API server calling the admission webhook. The admission webhook is not served without it.
+	AdmissionWebhookClientCA string
+	// AdmissionWebhookDryRun admits the invalid resources and only reports the violations
+	AdmissionWebhookDryRun bool
 }
 
 // initializeDefaultProject creates the default project if it does not already exist
@@ -209,7 +228,7 @@ func NewServer(ctx context.Context, opts ArgoCDServerOpts) *ArgoCDServer {
 	err = initializeDefaultProject(opts)
 	errors.CheckError(err)
 
-	sessionMgr := util_session.NewSessionManager(settingsMgr, opts.DexServerAddr, opts.Cache)
+	sessionMgr := util_session.NewSessionManager(settingsMgr, opts.DexServerAddr, opts.Cache.NewUserStateStorage(ctx, opts.RedisClient))
 
 	factory := appinformer.NewFilteredSharedInformerFactory(opts.AppClientset, 0, opts.Namespace, func(options *metav1.ListOptions) {})
 	projInformer := factory.Argoproj().V1alpha1().AppProjects().Informer()
@@ -227,7 +246,7 @@ func NewServer(ctx context.Context, opts ArgoCDServerOpts) *ArgoCDServer {
 	policyEnf := rbacpolicy.NewRBACPolicyEnforcer(enf, projLister)
 	enf.SetClaimsEnforcerFunc(policyEnf.EnforceClaims)
 
-	return &ArgoCDServer{
+	a := &ArgoCDServer{
 		ArgoCDServerOpts: opts,
 		log:              log.NewEntry(log.StandardLogger()),
 		settings:         settings,
@@ -239,6 +258,8 @@ func NewServer(ctx context.Context, opts ArgoCDServerOpts) *ArgoCDServer {
 		appLister:        appLister,
 		policyEnforcer:   policyEnf,
 	}
+	a.setCertificate(settings.Certificate)
+	return a
 }
 
 const (
@@ -346,14 +367,27 @@ func (a *ArgoCDServer) Run(ctx context.Context, port int, metricsPort int) {
 	}
 	go a.watchSettings()
 	go a.rbacPolicyLoader(ctx)
+	go a.unusedTokensRevoker(ctx)
 	go func() { a.checkServeErr("tcpm", tcpm.Serve()) }()
 	go func() { a.checkServeErr("metrics", metricsServ.ListenAndServe()) }()
+	var admissionS *http.Server
+	if a.AdmissionWebhook {
+		var err error
+		admissionS, err = a.newAdmissionWebhookServer()
+		errors.CheckError(err)
+		log.Infof("argocd %s serving admission webhook on port %d", common.GetVersion(), a.AdmissionWebhookPort)
+		go func() { a.checkServeErr("admissionS", admissionS.ListenAndServeTLS("", "")) }()
+	}
 	if !cache.WaitForCacheSync(ctx.Done(), a.projInformer.HasSynced, a.appInformer.HasSynced) {
 		log.Fatal("Timed out waiting for project cache to sync")
 	}
 
 	a.stopCh = make(chan struct{})
 	<-a.stopCh
+	if admissionS != nil {
+		// the admission webhook port is bound again by the restarted server
+		errors.CheckError(admissionS.Close())
+	}
 	errors.CheckError(conn.Close())
 }
 
@@ -404,6 +438,7 @@ func (a *ArgoCDServer) watchSettings() {
 	for {
 		newSettings := <-updateCh
 		a.settings = newSettings
+		a.setCertificate(a.settings.Certificate)
 		newDexCfgBytes, err := dex.GenerateDexConfigYAML(a.settings)
 		errors.CheckError(err)
 		if string(newDexCfgBytes) != string(prevDexCfgBytes) {
@@ -472,6 +507,29 @@ func (a *ArgoCDServer) rbacPolicyLoader(ctx context.Context) {
 	errors.CheckError(err)
 }
 
+// unusedTokensRevoker periodically revokes the tokens of local accounts which were not used for the duration configured
+// by users.tokens.revokeUnusedAfter and records every revocation in the audit log
+func (a *ArgoCDServer) unusedTokensRevoker(ctx context.Context) {
+	auditLogger := argo.NewAuditLogger(a.Namespace, a.KubeClientset, "argocd-server")
+	ticker := time.NewTicker(revokeUnusedTokensInterval)
+	defer ticker.Stop()
+	for {
+		revoked, err := a.sessionMgr.RevokeUnusedTokens()
+		if err != nil {
+			log.Warnf("Failed to revoke unused tokens: %v", err)
+		}
+		for _, token := range revoked {
+			auditLogger.LogAccountTokenEvent(token.Account, token.ID, argo.EventInfo{Type: v1.EventTypeNormal, Reason: argo.EventReasonTokenRevoked},
+				fmt.Sprintf("Revoked token %s of account %s which was last used at %s", token.ID, token.Account, token.LastActivity.UTC().Format(time.RFC3339)))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
 func (a *ArgoCDServer) useTLS() bool {
 	if a.Insecure || a.settings.Certificate == nil {
 		return false
@@ -510,6 +568,7 @@ func (a *ArgoCDServer) newGRPCServer() *grpc.Server {
 	// NOTE: notice we do not configure the gRPC server here with TLS (e.g. grpc.Creds(creds))
 	// This is because TLS handshaking occurs in cmux handling
 	sOpts = append(sOpts, grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
+		grpc_util.OTELStreamServerInterceptor(),
 		grpc_logrus.StreamServerInterceptor(a.log),
 		grpc_prometheus.StreamServerInterceptor,
 		grpc_auth.StreamServerInterceptor(a.Authenticate),
@@ -522,6 +581,7 @@ func (a *ArgoCDServer) newGRPCServer() *grpc.Server {
 	)))
 	sOpts = append(sOpts, grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
 		bug21955WorkaroundInterceptor,
+		grpc_util.OTELUnaryServerInterceptor(),
 		grpc_logrus.UnaryServerInterceptor(a.log),
 		grpc_prometheus.UnaryServerInterceptor,
 		grpc_auth.UnaryServerInterceptor(a.Authenticate),
@@ -715,6 +775,62 @@ func (a *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcWebHandl
 	return &httpS
 }
 
+// newAdmissionWebhookServer returns the HTTPS server of the admission webhook. The webhook validates resources using the
+// permissions of the server, so it is served on a separate port, which should only be reachable by the Kubernetes API
+// server, instead of the port of the API. Only clients presenting a certificate issued by the client CA are accepted.
+func (a *ArgoCDServer) newAdmissionWebhookServer() (*http.Server, error) {
+	if a.getCertificate() == nil {
+		return nil, fmt.Errorf("the admission webhook requires the TLS certificate of the server")
+	}
+	if a.AdmissionWebhookClientCA == "" {
+		return nil, fmt.Errorf("the admission webhook requires the client CA of the Kubernetes API server")
+	}
+	data, err := ioutil.ReadFile(a.AdmissionWebhookClientCA)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the client CA of the admission webhook: %v", err)
+	}
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no certificates found in the client CA %s of the admission webhook", a.AdmissionWebhookClientCA)
+	}
+	tlsConfig := &tls.Config{
+		// the certificate is looked up per connection, so a rotated certificate is served without a restart
+		GetCertificate: func(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+			cert := a.getCertificate()
+			if cert == nil {
+				return nil, fmt.Errorf("the TLS certificate of the server is not configured")
+			}
+			return cert, nil
+		},
+		ClientCAs:  clientCAs,
+		ClientAuth: tls.RequireAndVerifyClientCert,
+	}
+	if a.TLSConfigCustomizer != nil {
+		a.TLSConfigCustomizer(tlsConfig)
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/api/admission", admission.NewHandler(a.Namespace, a.AppClientset, a.RepoClientset, db.NewDB(a.Namespace, a.settingsMgr, a.KubeClientset), a.settingsMgr, kubeutil.NewKubectl(), a.AdmissionWebhookDryRun))
+	return &http.Server{
+		Addr:      fmt.Sprintf(":%d", a.AdmissionWebhookPort),
+		Handler:   mux,
+		TLSConfig: tlsConfig,
+	}, nil
+}
+
+// setCertificate stores the TLS certificate of the settings watched by the server
+func (a *ArgoCDServer) setCertificate(cert *tls.Certificate) {
+	a.certificate.Store(&cert)
+}
+
+// getCertificate returns the latest TLS certificate of the settings or nil if it isn't configured
+func (a *ArgoCDServer) getCertificate() *tls.Certificate {
+	cert, ok := a.certificate.Load().(**tls.Certificate)
+	if !ok {
+		return nil
+	}
+	return *cert
+}
+
 // registerDexHandlers will register dex HTTP handlers, creating the the OAuth client app
 func (a *ArgoCDServer) registerDexHandlers(mux *http.ServeMux) {
 	if !a.settings.IsSSOConfigured() {
@@ -882,11 +998,40 @@ func (a *ArgoCDServer) Authenticate(ctx context.Context) (context.Context, error
 		if !argoCDSettings.AnonymousUserEnabled {
 			return ctx, claimsErr
 		}
+	} else if claims != nil {
+		if err := enforceTokenScopes(ctx, claims); err != nil {
+			return ctx, err
+		}
 	}
 
 	return ctx, nil
 }
 
+// selfServiceMethods are the API methods which permit users to manage their own account without being subject to RBAC
+var selfServiceMethods = map[string]bool{
+	"/account.AccountService/CreateToken":    true,
+	"/account.AccountService/DeleteToken":    true,
+	"/account.AccountService/RevokeSessions": true,
+	"/account.AccountService/UpdatePassword": true,
+}
+
+// enforceTokenScopes rejects tokens with malformed scopes and prevents scoped tokens from being used to call methods
+// which are not subject to RBAC, e.g. to issue an unrestricted token for the own account. All other requests of scoped
+// tokens are restricted to their scopes by the RBAC enforcer.
+func enforceTokenScopes(ctx context.Context, claims jwt.Claims) error {
+	scopes, restricted := rbac.ScopesFromClaims(claims)
+	if !restricted {
+		return nil
+	}
+	if err := rbac.ValidateScopes(scopes); err != nil {
+		return status.Errorf(codes.Unauthenticated, "invalid session: %v", err)
+	}
+	if method, ok := grpc.Method(ctx); ok && selfServiceMethods[method] {
+		return status.Errorf(codes.PermissionDenied, "permission denied: scoped tokens cannot be used to call %s", method)
+	}
+	return nil
+}
+
 func (a *ArgoCDServer) getClaims(ctx context.Context) (jwt.Claims, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
@@ -3,6 +3,7 @@ package session
 import (
 	"context"
 
+	"github.com/dgrijalva/jwt-go/v4"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -57,8 +58,13 @@ func (s *Server) Create(_ context.Context, q *session.SessionCreateRequest) (*se
 	return &session.SessionResponse{Token: jwtToken}, nil
 }
 
-// Delete an authentication cookie from the client.  This makes sense only for the Web client.
+// Delete revokes the token of the session on all API servers and deletes the authentication cookie from the client
 func (s *Server) Delete(ctx context.Context, q *session.SessionDeleteRequest) (*session.SessionResponse, error) {
+	if claims, ok := ctx.Value("claims").(jwt.Claims); ok {
+		if err := s.mgr.RevokeToken(claims); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to revoke session: %v", err)
+		}
+	}
 	return &session.SessionResponse{Token: ""}, nil
 }
 
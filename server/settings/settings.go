@@ -8,6 +8,7 @@ import (
 
 	settingspkg "github.com/vathsalashetty96/argo-cd/pkg/apiclient/settings"
 	"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/vathsalashetty96/argo-cd/util/argo"
 	"github.com/vathsalashetty96/argo-cd/util/settings"
 )
 
@@ -42,6 +43,10 @@ func (s *Server) Get(ctx context.Context, q *settingspkg.SettingsQuery) (*settin
 	if err != nil {
 		return nil, err
 	}
+	trackingMethod, err := argo.GetTrackingMethod(s.mgr)
+	if err != nil {
+		return nil, err
+	}
 	argoCDSettings, err := s.mgr.GetSettings()
 	if err != nil {
 		return nil, err
@@ -82,6 +87,7 @@ func (s *Server) Get(ctx context.Context, q *settingspkg.SettingsQuery) (*settin
 	set := settingspkg.Settings{
 		URL:                argoCDSettings.URL,
 		AppLabelKey:        appInstanceLabelKey,
+		TrackingMethod:     string(trackingMethod),
 		ResourceOverrides:  overrides,
 		StatusBadgeEnabled: argoCDSettings.StatusBadgeEnabled,
 		KustomizeOptions: &v1alpha1.KustomizeOptions{
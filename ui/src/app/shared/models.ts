@@ -184,6 +184,24 @@ export interface ApplicationSourceHelm {
     values?: string;
     parameters: HelmParameter[];
     fileParameters: HelmFileParameter[];
+    skipCrds?: boolean;
+    passCredentials?: boolean;
+    ignoreMissingValueFiles?: boolean;
+    kubeVersion?: string;
+    apiVersions?: string[];
+    postRenderer?: HelmPostRenderer;
+    valuesRepos?: HelmValuesRepo[];
+}
+
+export interface HelmValuesRepo {
+    alias: string;
+    repoURL: string;
+    targetRevision?: string;
+}
+
+export interface HelmPostRenderer {
+    kustomize?: string;
+    plugin?: string;
 }
 
 export interface ApplicationSourceKustomize {
@@ -393,6 +411,8 @@ export interface LogEntry {
     content: string;
     timeStamp: models.Time;
     last: boolean;
+    podName: string;
+    container: string;
 }
 
 // describes plugin settings
@@ -565,6 +585,12 @@ export interface HelmAppSpec {
     values?: string;
     parameters: HelmParameter[];
     fileParameters: HelmFileParameter[];
+    skipCrds?: boolean;
+    passCredentials?: boolean;
+    ignoreMissingValueFiles?: boolean;
+    kubeVersion?: string;
+    apiVersions?: string[];
+    postRenderer?: HelmPostRenderer;
 }
 
 export interface KustomizeAppSpec {
@@ -652,6 +678,7 @@ export interface ProjectSpec {
     namespaceResourceBlacklist: GroupKind[];
     namespaceResourceWhitelist: GroupKind[];
     signatureKeys: ProjectSignatureKey[];
+    helmValueURLs?: string[];
     orphanedResources?: {warn?: boolean; ignore: OrphanedResource[]};
     syncWindows?: SyncWindows;
 }
@@ -727,6 +754,8 @@ export interface Token {
     id: string;
     issuedAt: number;
     expiresAt: number;
+    scopes?: string[];
+    lastUsedAt?: number;
 }
 
 export interface Account {
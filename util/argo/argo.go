@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"strings"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"google.golang.org/grpc/status"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
@@ -187,6 +189,7 @@ func TestRepo(repo *argoappv1.Repository) error {
 func ValidateRepo(
 	ctx context.Context,
 	app *argoappv1.Application,
+	proj *argoappv1.AppProject,
 	repoClientset apiclient.Clientset,
 	db db.ArgoDB,
 	kustomizeOptions *argoappv1.KustomizeOptions,
@@ -268,8 +271,12 @@ func ValidateRepo(
 	if err != nil {
 		return nil, err
 	}
+	valuesRepos, err := GetValuesRepos(ctx, &spec.Source, db)
+	if err != nil {
+		return nil, err
+	}
 	conditions = append(conditions, verifyGenerateManifests(
-		ctx, repo, helmRepos, app, repoClient, kustomizeOptions, plugins, cluster.ServerVersion, APIGroupsToVersions(apiGroups))...)
+		ctx, repo, helmRepos, valuesRepos, app, proj, repoClient, kustomizeOptions, plugins, cluster.ServerVersion, APIGroupsToVersions(apiGroups))...)
 
 	return conditions, nil
 }
@@ -337,6 +344,23 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 		})
 	}
 
+	if spec.HydrateTo != nil {
+		hydratedSource := spec.HydrateTo.HydratedSource(spec.Source, spec.HydrateTo.TargetBranch)
+		if spec.HydrateTo.TargetBranch == "" {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: "spec.hydrateTo.targetBranch is required",
+			})
+		} else if !proj.IsSourcePermitted(hydratedSource) {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("hydration repo %s is not permitted in project '%s'", hydratedSource.RepoURL, spec.Project),
+			})
+		}
+	}
+
+	conditions = append(conditions, validateHelmValueFiles(spec, proj)...)
+
 	if spec.Destination.Server != "" {
 		if !proj.IsDestinationPermitted(spec.Destination) {
 			conditions = append(conditions, argoappv1.ApplicationCondition{
@@ -362,6 +386,65 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 	return conditions, nil
 }
 
+// validateHelmValueFiles verifies that the values repositories of the Helm source are permitted in the project, that
+// value files reference declared values repositories only and that remote value files are permitted in the project
+func validateHelmValueFiles(spec *argoappv1.ApplicationSpec, proj *argoappv1.AppProject) []argoappv1.ApplicationCondition {
+	source := spec.Source.Helm
+	if source == nil {
+		return nil
+	}
+	var conditions []argoappv1.ApplicationCondition
+	invalid := func(format string, args ...interface{}) {
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: fmt.Sprintf(format, args...),
+		})
+	}
+	for i, repo := range source.ValuesRepos {
+		if err := argoappv1.ValidateHelmValuesRepoAlias(repo.Alias); err != nil {
+			invalid("%v", err)
+		}
+		if repo.RepoURL == "" {
+			invalid("values repository '%s' requires a repoURL", repo.Alias)
+		} else if !proj.IsSourcePermitted(argoappv1.ApplicationSource{RepoURL: repo.RepoURL}) {
+			invalid("values repo %s is not permitted in project '%s'", repo.RepoURL, spec.Project)
+		}
+		for _, other := range source.ValuesRepos[:i] {
+			if other.Alias == repo.Alias {
+				invalid("values repository alias '%s' is used more than once", repo.Alias)
+			} else if git.SameURL(other.RepoURL, repo.RepoURL) {
+				invalid("values repositories '%s' and '%s' have the same repoURL", other.Alias, repo.Alias)
+			}
+		}
+	}
+	for _, file := range source.ValueFiles {
+		if alias, _, ok := argoappv1.ParseHelmValuesRepoRef(file); ok {
+			if source.GetValuesRepo(alias) == nil {
+				invalid("value file %s references undefined values repository '%s'", file, alias)
+			}
+		} else if _, err := url.ParseRequestURI(file); err == nil && !proj.IsHelmValueURLPermitted(file) {
+			invalid("value file URL %s is not permitted in project '%s'", file, spec.Project)
+		}
+	}
+	return conditions
+}
+
+// GetValuesRepos returns the values repositories of the Helm source, including their credentials if they are configured
+func GetValuesRepos(ctx context.Context, source *argoappv1.ApplicationSource, db db.ArgoDB) ([]*argoappv1.Repository, error) {
+	if source.Helm == nil {
+		return nil, nil
+	}
+	var repos []*argoappv1.Repository
+	for _, ref := range source.Helm.ValuesRepos {
+		repo, err := db.GetRepository(ctx, ref.RepoURL)
+		if err != nil {
+			return nil, err
+		}
+		repos = append(repos, repo)
+	}
+	return repos, nil
+}
+
 // APIGroupsToVersions converts list of API Groups into versions string list
 func APIGroupsToVersions(apiGroups []metav1.APIGroup) []string {
 	var apiVersions []string
@@ -387,7 +470,9 @@ func verifyGenerateManifests(
 	ctx context.Context,
 	repoRes *argoappv1.Repository,
 	helmRepos argoappv1.Repositories,
+	valuesRepos []*argoappv1.Repository,
 	app *argoappv1.Application,
+	proj *argoappv1.AppProject,
 	repoClient apiclient.RepoServerServiceClient,
 	kustomizeOptions *argoappv1.KustomizeOptions,
 	plugins []*argoappv1.ConfigManagementPlugin,
@@ -418,6 +503,8 @@ func verifyGenerateManifests(
 		KustomizeOptions:  kustomizeOptions,
 		KubeVersion:       kubeVersion,
 		ApiVersions:       apiVersions,
+		ValuesRepos:       valuesRepos,
+		HelmValueURLs:     proj.Spec.HelmValueURLs,
 	}
 	req.Repo.CopyCredentialsFromRepo(repoRes)
 	req.Repo.CopySettingsFrom(repoRes)
@@ -594,3 +681,17 @@ func mergeVirtualProject(proj *argoappv1.AppProject, globalProj *argoappv1.AppPr
 
 	return proj
 }
+
+// ProjectPermissionValidator returns the sync permission validator which checks that the resource kind and the resource
+// namespace are permitted by the project
+func ProjectPermissionValidator(proj *argoappv1.AppProject, server string) func(un *unstructured.Unstructured, res *metav1.APIResource) error {
+	return func(un *unstructured.Unstructured, res *metav1.APIResource) error {
+		if !proj.IsGroupKindPermitted(un.GroupVersionKind().GroupKind(), res.Namespaced) {
+			return fmt.Errorf("Resource %s:%s is not permitted in project %s.", un.GroupVersionKind().Group, un.GroupVersionKind().Kind, proj.Name)
+		}
+		if res.Namespaced && !proj.IsDestinationPermitted(argoappv1.ApplicationDestination{Namespace: un.GetNamespace(), Server: server}) {
+			return fmt.Errorf("namespace %v is not permitted in project '%s'", un.GetNamespace(), proj.Name)
+		}
+		return nil
+	}
+}
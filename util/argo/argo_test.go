@@ -260,7 +260,7 @@ func TestValidateRepo(t *testing.T) {
 		return true
 	})).Return(nil, nil)
 
-	conditions, err := ValidateRepo(context.Background(), app, repoClientSet, db, kustomizeOptions, nil, &kubetest.MockKubectlCmd{Version: kubeVersion, APIGroups: apiGroups})
+	conditions, err := ValidateRepo(context.Background(), app, &argoappv1.AppProject{}, repoClientSet, db, kustomizeOptions, nil, &kubetest.MockKubectlCmd{Version: kubeVersion, APIGroups: apiGroups})
 
 	assert.NoError(t, err)
 	assert.Empty(t, conditions)
@@ -419,6 +419,148 @@ func TestValidatePermissions(t *testing.T) {
 		assert.Contains(t, conditions[0].Message, "application repo http://some/where is not permitted")
 	})
 
+	t.Run("Hydration repo is not permitted in project", func(t *testing.T) {
+		spec := argoappv1.ApplicationSpec{
+			Source: argoappv1.ApplicationSource{
+				RepoURL: "http://some/where",
+				Path:    "guestbook",
+			},
+			Destination: argoappv1.ApplicationDestination{
+				Server:    "https://127.0.0.1:6443",
+				Namespace: "testns",
+			},
+			HydrateTo: &argoappv1.HydrateTo{
+				RepoURL:      "http://some/where/else",
+				TargetBranch: "hydrated",
+			},
+		}
+		proj := argoappv1.AppProject{
+			Spec: argoappv1.AppProjectSpec{
+				Destinations: []argoappv1.ApplicationDestination{
+					{
+						Server:    "*",
+						Namespace: "*",
+					},
+				},
+				SourceRepos: []string{"http://some/where"},
+			},
+		}
+		cluster := &argoappv1.Cluster{Server: "https://127.0.0.1:6443"}
+		db := &dbmocks.ArgoDB{}
+		db.On("GetCluster", context.Background(), spec.Destination.Server).Return(cluster, nil)
+		conditions, err := ValidatePermissions(context.Background(), &spec, &proj, db)
+		assert.NoError(t, err)
+		assert.Len(t, conditions, 1)
+		assert.Contains(t, conditions[0].Message, "hydration repo http://some/where/else is not permitted")
+
+		spec.HydrateTo.RepoURL = ""
+		conditions, err = ValidatePermissions(context.Background(), &spec, &proj, db)
+		assert.NoError(t, err)
+		assert.Len(t, conditions, 0)
+	})
+
+	t.Run("Helm value files are not permitted in project", func(t *testing.T) {
+		spec := argoappv1.ApplicationSpec{
+			Source: argoappv1.ApplicationSource{
+				RepoURL: "http://some/where",
+				Path:    "chart",
+				Helm: &argoappv1.ApplicationSourceHelm{
+					ValuesRepos: []argoappv1.HelmValuesRepo{
+						{Alias: "values", RepoURL: "http://some/where/values"},
+						{Alias: "other", RepoURL: "http://some/where/else"},
+					},
+					ValueFiles: []string{
+						"values.yaml",
+						"$values/prod.yaml",
+						"$unknown/prod.yaml",
+						"https://example.com/values.yaml",
+						"https://example.org/values.yaml",
+						"http://example.com/values.yaml",
+					},
+				},
+			},
+			Destination: argoappv1.ApplicationDestination{
+				Server:    "https://127.0.0.1:6443",
+				Namespace: "testns",
+			},
+		}
+		proj := argoappv1.AppProject{
+			Spec: argoappv1.AppProjectSpec{
+				Destinations: []argoappv1.ApplicationDestination{
+					{
+						Server:    "*",
+						Namespace: "*",
+					},
+				},
+				SourceRepos:   []string{"http://some/where", "http://some/where/values"},
+				HelmValueURLs: []string{"https://example.com/*"},
+			},
+		}
+		cluster := &argoappv1.Cluster{Server: "https://127.0.0.1:6443"}
+		db := &dbmocks.ArgoDB{}
+		db.On("GetCluster", context.Background(), spec.Destination.Server).Return(cluster, nil)
+		conditions, err := ValidatePermissions(context.Background(), &spec, &proj, db)
+		assert.NoError(t, err)
+		var messages []string
+		for _, condition := range conditions {
+			assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, condition.Type)
+			messages = append(messages, condition.Message)
+		}
+		assert.Equal(t, []string{
+			"values repo http://some/where/else is not permitted in project ''",
+			"value file $unknown/prod.yaml references undefined values repository 'unknown'",
+			"value file URL https://example.org/values.yaml is not permitted in project ''",
+			"value file URL http://example.com/values.yaml is not permitted in project ''",
+		}, messages)
+	})
+
+	t.Run("Helm values repos are invalid", func(t *testing.T) {
+		spec := argoappv1.ApplicationSpec{
+			Source: argoappv1.ApplicationSource{
+				RepoURL: "http://some/where",
+				Path:    "chart",
+				Helm: &argoappv1.ApplicationSourceHelm{
+					ValuesRepos: []argoappv1.HelmValuesRepo{
+						{Alias: "values", RepoURL: "http://some/where/values"},
+						{Alias: "values", RepoURL: "http://some/where/other"},
+						{Alias: "other", RepoURL: "http://some/where/values"},
+						{Alias: "../other", RepoURL: ""},
+					},
+				},
+			},
+			Destination: argoappv1.ApplicationDestination{
+				Server:    "https://127.0.0.1:6443",
+				Namespace: "testns",
+			},
+		}
+		proj := argoappv1.AppProject{
+			Spec: argoappv1.AppProjectSpec{
+				Destinations: []argoappv1.ApplicationDestination{
+					{
+						Server:    "*",
+						Namespace: "*",
+					},
+				},
+				SourceRepos: []string{"*"},
+			},
+		}
+		cluster := &argoappv1.Cluster{Server: "https://127.0.0.1:6443"}
+		db := &dbmocks.ArgoDB{}
+		db.On("GetCluster", context.Background(), spec.Destination.Server).Return(cluster, nil)
+		conditions, err := ValidatePermissions(context.Background(), &spec, &proj, db)
+		assert.NoError(t, err)
+		var messages []string
+		for _, condition := range conditions {
+			messages = append(messages, condition.Message)
+		}
+		assert.Equal(t, []string{
+			"values repository alias 'values' is used more than once",
+			"values repositories 'values' and 'other' have the same repoURL",
+			"invalid values repository alias '../other': alias must consist of letters, digits, '_' and '-'",
+			"values repository '../other' requires a repoURL",
+		}, messages)
+	})
+
 	t.Run("Application destination is not permitted in project", func(t *testing.T) {
 		spec := argoappv1.ApplicationSpec{
 			Source: argoappv1.ApplicationSource{
@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/vathsalashetty96/argo-cd/common"
 	"github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1"
 )
 
@@ -42,6 +43,7 @@ const (
 	EventReasonResourceActionRan  = "ResourceActionRan"
 	EventReasonOperationStarted   = "OperationStarted"
 	EventReasonOperationCompleted = "OperationCompleted"
+	EventReasonTokenRevoked       = "TokenRevoked"
 )
 
 func (l *AuditLogger) logEvent(objMeta ObjectRef, gvk schema.GroupVersionKind, info EventInfo, message string, logFields map[string]string) {
@@ -130,6 +132,19 @@ func (l *AuditLogger) LogAppProjEvent(proj *v1alpha1.AppProject, info EventInfo,
 	l.logEvent(objectMeta, v1alpha1.AppProjectSchemaGroupVersionKind, info, message, nil)
 }
 
+// LogAccountTokenEvent logs an event of a token of a local account. The tokens are stored in the argocd-secret, so the
+// secret is the involved object of the event.
+func (l *AuditLogger) LogAccountTokenEvent(account string, id string, info EventInfo, message string) {
+	objectMeta := ObjectRef{
+		Name:      common.ArgoCDSecretName,
+		Namespace: l.ns,
+	}
+	l.logEvent(objectMeta, schema.GroupVersionKind{Version: "v1", Kind: "Secret"}, info, message, map[string]string{
+		"account": account,
+		"token":   id,
+	})
+}
+
 func NewAuditLogger(ns string, kIf kubernetes.Interface, component string) *AuditLogger {
 	return &AuditLogger{
 		ns:        ns,
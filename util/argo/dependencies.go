@@ -0,0 +1,80 @@
+package argo
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/vathsalashetty96/gitops-engine/pkg/health"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+
+	argoappv1 "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1"
+	applicationsv1 "github.com/vathsalashetty96/argo-cd/pkg/client/listers/application/v1alpha1"
+)
+
+// FindDependencyCycle returns the chain of application names which forms a dependency cycle reachable from the given
+// application (e.g. [a b a]), or nil if there are no cycles. Missing dependencies are ignored.
+func FindDependencyCycle(app *argoappv1.Application, appLister applicationsv1.ApplicationNamespaceLister) []string {
+	visited := make(map[string]bool)
+	var path []string
+	var visit func(name string, dependsOn []string) []string
+	visit = func(name string, dependsOn []string) []string {
+		for i := range path {
+			if path[i] == name {
+				return append(append([]string{}, path[i:]...), name)
+			}
+		}
+		if visited[name] {
+			return nil
+		}
+		visited[name] = true
+		path = append(path, name)
+		for _, depName := range dependsOn {
+			dep, err := appLister.Get(depName)
+			var depDependsOn []string
+			if err == nil {
+				depDependsOn = dep.Spec.DependsOn
+			}
+			if cycle := visit(depName, depDependsOn); cycle != nil {
+				return cycle
+			}
+		}
+		path = path[:len(path)-1]
+		return nil
+	}
+	return visit(app.Name, app.Spec.DependsOn)
+}
+
+// GetDependenciesCondition returns DependencyNotReady condition if any of the application dependencies is missing, is not
+// synced and healthy or if dependencies form a cycle. Returns nil if all dependencies are ready.
+func GetDependenciesCondition(app *argoappv1.Application, appLister applicationsv1.ApplicationNamespaceLister) *argoappv1.ApplicationCondition {
+	if len(app.Spec.DependsOn) == 0 {
+		return nil
+	}
+	if cycle := FindDependencyCycle(app, appLister); cycle != nil {
+		return &argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionDependencyNotReady,
+			Message: fmt.Sprintf("Dependency cycle detected: %s", strings.Join(cycle, " -> ")),
+		}
+	}
+	var notReady []string
+	for _, name := range app.Spec.DependsOn {
+		dep, err := appLister.Get(name)
+		if err != nil {
+			if apierr.IsNotFound(err) {
+				notReady = append(notReady, fmt.Sprintf("%s (not found)", name))
+				continue
+			}
+			return &argoappv1.ApplicationCondition{Type: argoappv1.ApplicationConditionDependencyNotReady, Message: err.Error()}
+		}
+		if dep.Status.Sync.Status != argoappv1.SyncStatusCodeSynced || dep.Status.Health.Status != health.HealthStatusHealthy {
+			notReady = append(notReady, fmt.Sprintf("%s (%s, %s)", name, dep.Status.Sync.Status, dep.Status.Health.Status))
+		}
+	}
+	if len(notReady) > 0 {
+		return &argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionDependencyNotReady,
+			Message: fmt.Sprintf("Dependencies are not synced and healthy: %s", strings.Join(notReady, ", ")),
+		}
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package argo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vathsalashetty96/gitops-engine/pkg/health"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	argoappv1 "github.com/vathsalashetty96/argo-cd/pkg/apis/application/v1alpha1"
+	applisters "github.com/vathsalashetty96/argo-cd/pkg/client/listers/application/v1alpha1"
+)
+
+func newDependencyApp(name string, syncStatus argoappv1.SyncStatusCode, healthStatus health.HealthStatusCode, dependsOn ...string) *argoappv1.Application {
+	return &argoappv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec:       argoappv1.ApplicationSpec{DependsOn: dependsOn},
+		Status: argoappv1.ApplicationStatus{
+			Sync:   argoappv1.SyncStatus{Status: syncStatus},
+			Health: argoappv1.HealthStatus{Status: healthStatus},
+		},
+	}
+}
+
+func newAppLister(t *testing.T, apps ...*argoappv1.Application) applisters.ApplicationNamespaceLister {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, app := range apps {
+		assert.NoError(t, indexer.Add(app))
+	}
+	return applisters.NewApplicationLister(indexer).Applications("default")
+}
+
+func TestFindDependencyCycle(t *testing.T) {
+	t.Run("NoCycle", func(t *testing.T) {
+		app := newDependencyApp("a", argoappv1.SyncStatusCodeSynced, health.HealthStatusHealthy, "b", "c")
+		lister := newAppLister(t, app,
+			newDependencyApp("b", argoappv1.SyncStatusCodeSynced, health.HealthStatusHealthy, "c"),
+			newDependencyApp("c", argoappv1.SyncStatusCodeSynced, health.HealthStatusHealthy))
+		assert.Nil(t, FindDependencyCycle(app, lister))
+	})
+	t.Run("Cycle", func(t *testing.T) {
+		app := newDependencyApp("a", argoappv1.SyncStatusCodeSynced, health.HealthStatusHealthy, "b")
+		lister := newAppLister(t, app,
+			newDependencyApp("b", argoappv1.SyncStatusCodeSynced, health.HealthStatusHealthy, "c"),
+			newDependencyApp("c", argoappv1.SyncStatusCodeSynced, health.HealthStatusHealthy, "a"))
+		assert.Equal(t, []string{"a", "b", "c", "a"}, FindDependencyCycle(app, lister))
+	})
+	t.Run("SelfReference", func(t *testing.T) {
+		app := newDependencyApp("a", argoappv1.SyncStatusCodeSynced, health.HealthStatusHealthy, "a")
+		assert.Equal(t, []string{"a", "a"}, FindDependencyCycle(app, newAppLister(t, app)))
+	})
+}
+
+func TestGetDependenciesCondition(t *testing.T) {
+	t.Run("NoDependencies", func(t *testing.T) {
+		app := newDependencyApp("a", argoappv1.SyncStatusCodeOutOfSync, health.HealthStatusMissing)
+		assert.Nil(t, GetDependenciesCondition(app, newAppLister(t, app)))
+	})
+	t.Run("Ready", func(t *testing.T) {
+		app := newDependencyApp("a", argoappv1.SyncStatusCodeOutOfSync, health.HealthStatusMissing, "b")
+		lister := newAppLister(t, app, newDependencyApp("b", argoappv1.SyncStatusCodeSynced, health.HealthStatusHealthy))
+		assert.Nil(t, GetDependenciesCondition(app, lister))
+	})
+	t.Run("NotReady", func(t *testing.T) {
+		app := newDependencyApp("a", argoappv1.SyncStatusCodeOutOfSync, health.HealthStatusMissing, "b", "c")
+		lister := newAppLister(t, app, newDependencyApp("b", argoappv1.SyncStatusCodeSynced, health.HealthStatusProgressing))
+		condition := GetDependenciesCondition(app, lister)
+		if assert.NotNil(t, condition) {
+			assert.Equal(t, argoappv1.ApplicationConditionDependencyNotReady, condition.Type)
+			assert.Equal(t, "Dependencies are not synced and healthy: b (Synced, Progressing), c (not found)", condition.Message)
+		}
+	})
+	t.Run("Cycle", func(t *testing.T) {
+		app := newDependencyApp("a", argoappv1.SyncStatusCodeOutOfSync, health.HealthStatusMissing, "b")
+		lister := newAppLister(t, app, newDependencyApp("b", argoappv1.SyncStatusCodeSynced, health.HealthStatusHealthy, "a"))
+		condition := GetDependenciesCondition(app, lister)
+		if assert.NotNil(t, condition) {
+			assert.Equal(t, "Dependency cycle detected: a -> b -> a", condition.Message)
+		}
+	})
+}
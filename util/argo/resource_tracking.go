@@ -0,0 +1,157 @@
+package argo
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/vathsalashetty96/gitops-engine/pkg/utils/kube"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/vathsalashetty96/argo-cd/common"
+	argokube "github.com/vathsalashetty96/argo-cd/util/kube"
+	"github.com/vathsalashetty96/argo-cd/util/settings"
+)
+
+// TrackingMethod is the method used to associate the resources with the application
+type TrackingMethod string
+
+const (
+	// TrackingMethodLabel tracks resources using the app instance label, which contains the application name
+	TrackingMethodLabel TrackingMethod = "label"
+	// TrackingMethodAnnotation tracks resources using the tracking id annotation
+	TrackingMethodAnnotation TrackingMethod = "annotation"
+	// TrackingMethodAnnotationAndLabel tracks resources using the tracking id annotation and additionally sets the app
+	// instance label, e.g. to select the resources of the application
+	TrackingMethodAnnotationAndLabel TrackingMethod = "annotation+label"
+)
+
+// GetTrackingMethod returns the configured tracking method. Defaults to the label tracking method.
+func GetTrackingMethod(settingsMgr *settings.SettingsManager) (TrackingMethod, error) {
+	method, err := settingsMgr.GetTrackingMethod()
+	if err != nil {
+		return "", err
+	}
+	return ParseTrackingMethod(method)
+}
+
+// ParseTrackingMethod parses the tracking method. An empty string is parsed as the label tracking method.
+func ParseTrackingMethod(method string) (TrackingMethod, error) {
+	switch TrackingMethod(method) {
+	case "":
+		return TrackingMethodLabel, nil
+	case TrackingMethodLabel, TrackingMethodAnnotation, TrackingMethodAnnotationAndLabel:
+		return TrackingMethod(method), nil
+	}
+	return "", fmt.Errorf("unknown tracking method '%s', must be one of: %s, %s, %s", method, TrackingMethodLabel, TrackingMethodAnnotation, TrackingMethodAnnotationAndLabel)
+}
+
+// AppInstanceValue is the value of the tracking id annotation. Besides the application name it identifies the resource
+// it was set on, so a copy of the resource isn't considered part of the application.
+type AppInstanceValue struct {
+	ApplicationName string
+	Group           string
+	Kind            string
+	Namespace       string
+	Name            string
+}
+
+// String returns the tracking id in the format <application>:<group>/<kind>:<namespace>/<name>
+func (v AppInstanceValue) String() string {
+	return fmt.Sprintf("%s:%s/%s:%s/%s", v.ApplicationName, v.Group, v.Kind, v.Namespace, v.Name)
+}
+
+// refersTo returns true if the tracking id identifies the given resource. The namespace of cluster scoped resources
+// is ignored since it's unknown during the manifest generation.
+func (v AppInstanceValue) refersTo(un *unstructured.Unstructured) bool {
+	gvk := un.GroupVersionKind()
+	return v.Group == gvk.Group && v.Kind == gvk.Kind && v.Name == un.GetName() &&
+		(un.GetNamespace() == "" || v.Namespace == un.GetNamespace())
+}
+
+// ParseAppInstanceValue parses the tracking id in the format <application>:<group>/<kind>:<namespace>/<name>
+func ParseAppInstanceValue(value string) (*AppInstanceValue, error) {
+	parts := strings.Split(value, ":")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("tracking id '%s' must be in the format <application>:<group>/<kind>:<namespace>/<name>", value)
+	}
+	groupKind := strings.Split(parts[1], "/")
+	namespacedName := strings.Split(parts[2], "/")
+	if parts[0] == "" || len(groupKind) != 2 || len(namespacedName) != 2 {
+		return nil, fmt.Errorf("tracking id '%s' must be in the format <application>:<group>/<kind>:<namespace>/<name>", value)
+	}
+	return &AppInstanceValue{
+		ApplicationName: parts[0],
+		Group:           groupKind[0],
+		Kind:            groupKind[1],
+		Namespace:       namespacedName[0],
+		Name:            namespacedName[1],
+	}, nil
+}
+
+// GetAppName returns the name of the application which the resource is part of, or an empty string if the resource
+// isn't tracked using the given tracking method or the tracking id belongs to a different resource.
+func GetAppName(un *unstructured.Unstructured, labelKey string, trackingMethod TrackingMethod) string {
+	switch trackingMethod {
+	case TrackingMethodAnnotation, TrackingMethodAnnotationAndLabel:
+		value, err := ParseAppInstanceValue(un.GetAnnotations()[common.AnnotationKeyAppInstance])
+		if err != nil || !value.refersTo(un) {
+			return ""
+		}
+		return value.ApplicationName
+	default:
+		return kube.GetAppInstanceLabel(un, labelKey)
+	}
+}
+
+// SetAppInstance marks the resource as part of the given application using the given tracking method. The namespace is
+// used in the tracking id of the resources which don't specify the namespace.
+func SetAppInstance(un *unstructured.Unstructured, labelKey string, appName string, namespace string, trackingMethod TrackingMethod) error {
+	setAnnotation := func() {
+		gvk := un.GroupVersionKind()
+		if un.GetNamespace() != "" {
+			namespace = un.GetNamespace()
+		}
+		value := AppInstanceValue{ApplicationName: appName, Group: gvk.Group, Kind: gvk.Kind, Namespace: namespace, Name: un.GetName()}
+		annotations := un.GetAnnotations()
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+		annotations[common.AnnotationKeyAppInstance] = value.String()
+		un.SetAnnotations(annotations)
+	}
+	switch trackingMethod {
+	case TrackingMethodAnnotation:
+		setAnnotation()
+		return nil
+	case TrackingMethodAnnotationAndLabel:
+		setAnnotation()
+		// the label is informational only, so application names exceeding the label value limit are truncated
+		if len(appName) > validation.LabelValueMaxLength {
+			appName = strings.TrimRight(appName[:validation.LabelValueMaxLength], "-_.")
+		}
+		return argokube.SetAppInstanceLabel(un, labelKey, appName)
+	default:
+		return argokube.SetAppInstanceLabel(un, labelKey, appName)
+	}
+}
+
+// UnsetAppInstance removes the tracking label and annotation from the resource
+func UnsetAppInstance(un *unstructured.Unstructured, labelKey string) {
+	kube.UnsetLabel(un, labelKey)
+	annotations := un.GetAnnotations()
+	if _, ok := annotations[common.AnnotationKeyAppInstance]; ok {
+		delete(annotations, common.AnnotationKeyAppInstance)
+		un.SetAnnotations(annotations)
+	}
+}
+
+// UnsetManagedNamespaceTracking removes the tracking label and annotation from the namespace created by the sync and
+// returns true if the namespace was tracked by an application
+func UnsetManagedNamespaceTracking(un *unstructured.Unstructured) bool {
+	if un != nil && (kube.GetAppInstanceLabel(un, common.LabelKeyAppInstance) != "" || un.GetAnnotations()[common.AnnotationKeyAppInstance] != "") {
+		UnsetAppInstance(un, common.LabelKeyAppInstance)
+		return true
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+package argo
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vathsalashetty96/argo-cd/common"
+)
+
+func newTrackedObject(apiVersion string, kind string, namespace string, name string) *unstructured.Unstructured {
+	un := &unstructured.Unstructured{}
+	un.SetAPIVersion(apiVersion)
+	un.SetKind(kind)
+	un.SetNamespace(namespace)
+	un.SetName(name)
+	return un
+}
+
+func TestParseTrackingMethod(t *testing.T) {
+	method, err := ParseTrackingMethod("")
+	assert.NoError(t, err)
+	assert.Equal(t, TrackingMethodLabel, method)
+
+	method, err = ParseTrackingMethod("annotation+label")
+	assert.NoError(t, err)
+	assert.Equal(t, TrackingMethodAnnotationAndLabel, method)
+
+	_, err = ParseTrackingMethod("ownerReference")
+	assert.Error(t, err)
+}
+
+func TestParseAppInstanceValue(t *testing.T) {
+	value, err := ParseAppInstanceValue("my-app:apps/Deployment:default/guestbook")
+	assert.NoError(t, err)
+	assert.Equal(t, AppInstanceValue{ApplicationName: "my-app", Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook"}, *value)
+	assert.Equal(t, "my-app:apps/Deployment:default/guestbook", value.String())
+
+	value, err = ParseAppInstanceValue("my-app:/Namespace:/guestbook")
+	assert.NoError(t, err)
+	assert.Equal(t, AppInstanceValue{ApplicationName: "my-app", Kind: "Namespace", Name: "guestbook"}, *value)
+
+	for _, invalid := range []string{"", "my-app", "my-app:apps/Deployment", ":apps/Deployment:default/guestbook", "my-app:Deployment:default/guestbook"} {
+		_, err = ParseAppInstanceValue(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestSetAppInstance(t *testing.T) {
+	t.Run("Label", func(t *testing.T) {
+		un := newTrackedObject("apps/v1", "Deployment", "", "guestbook")
+		assert.NoError(t, SetAppInstance(un, common.LabelKeyAppInstance, "my-app", "default", TrackingMethodLabel))
+		assert.Equal(t, map[string]string{common.LabelKeyAppInstance: "my-app"}, un.GetLabels())
+		assert.Nil(t, un.GetAnnotations())
+		assert.Equal(t, "my-app", GetAppName(un, common.LabelKeyAppInstance, TrackingMethodLabel))
+		assert.Equal(t, "", GetAppName(un, common.LabelKeyAppInstance, TrackingMethodAnnotation))
+	})
+	t.Run("Annotation", func(t *testing.T) {
+		un := newTrackedObject("apps/v1", "Deployment", "", "guestbook")
+		assert.NoError(t, SetAppInstance(un, common.LabelKeyAppInstance, "my-app", "default", TrackingMethodAnnotation))
+		assert.Nil(t, un.GetLabels())
+		assert.Equal(t, map[string]string{common.AnnotationKeyAppInstance: "my-app:apps/Deployment:default/guestbook"}, un.GetAnnotations())
+
+		un.SetNamespace("default")
+		assert.Equal(t, "my-app", GetAppName(un, common.LabelKeyAppInstance, TrackingMethodAnnotation))
+		assert.Equal(t, "", GetAppName(un, common.LabelKeyAppInstance, TrackingMethodLabel))
+	})
+	t.Run("AnnotationAndLabel", func(t *testing.T) {
+		appName := strings.Repeat("a", 70)
+		un := newTrackedObject("v1", "ConfigMap", "kube-system", "my-cm")
+		assert.NoError(t, SetAppInstance(un, common.LabelKeyAppInstance, appName, "default", TrackingMethodAnnotationAndLabel))
+		assert.Equal(t, appName+":/ConfigMap:kube-system/my-cm", un.GetAnnotations()[common.AnnotationKeyAppInstance])
+		assert.Equal(t, strings.Repeat("a", 63), un.GetLabels()[common.LabelKeyAppInstance])
+		assert.Equal(t, appName, GetAppName(un, common.LabelKeyAppInstance, TrackingMethodAnnotationAndLabel))
+	})
+	t.Run("ClusterScoped", func(t *testing.T) {
+		un := newTrackedObject("v1", "Namespace", "", "guestbook")
+		assert.NoError(t, SetAppInstance(un, common.LabelKeyAppInstance, "my-app", "default", TrackingMethodAnnotation))
+		assert.Equal(t, "my-app", GetAppName(un, common.LabelKeyAppInstance, TrackingMethodAnnotation))
+	})
+}
+
+func TestGetAppName_CopiedResource(t *testing.T) {
+	un := newTrackedObject("v1", "Secret", "default", "credentials")
+	assert.NoError(t, SetAppInstance(un, common.LabelKeyAppInstance, "my-app", "default", TrackingMethodAnnotation))
+
+	copied := un.DeepCopy()
+	copied.SetNamespace("other")
+	assert.Equal(t, "", GetAppName(copied, common.LabelKeyAppInstance, TrackingMethodAnnotation))
+
+	renamed := un.DeepCopy()
+	renamed.SetName("credentials-copy")
+	assert.Equal(t, "", GetAppName(renamed, common.LabelKeyAppInstance, TrackingMethodAnnotation))
+}
+
+func TestUnsetAppInstance(t *testing.T) {
+	un := newTrackedObject("v1", "Namespace", "", "guestbook")
+	assert.NoError(t, SetAppInstance(un, common.LabelKeyAppInstance, "my-app", "", TrackingMethodAnnotationAndLabel))
+	UnsetAppInstance(un, common.LabelKeyAppInstance)
+	assert.Empty(t, un.GetLabels())
+	assert.Empty(t, un.GetAnnotations())
+}
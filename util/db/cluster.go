@@ -299,6 +299,14 @@ func clusterToSecret(c *appv1.Cluster, secret *apiv1.Secret) error {
 	if c.Shard != nil {
 		data["shard"] = []byte(strconv.Itoa(int(*c.Shard)))
 	}
+	if c.NamespaceDiscovery != nil {
+		if c.NamespaceDiscovery.RBAC {
+			data["discoverNamespaces"] = []byte("true")
+		}
+		if c.NamespaceDiscovery.LabelSelector != "" {
+			data["namespaceSelector"] = []byte(c.NamespaceDiscovery.LabelSelector)
+		}
+	}
 	secret.Data = data
 
 	if secret.Annotations == nil {
@@ -345,6 +353,18 @@ func secretToCluster(s *apiv1.Secret) *appv1.Cluster {
 			shard = pointer.Int64Ptr(int64(val))
 		}
 	}
+	var namespaceDiscovery *appv1.ClusterNamespaceDiscovery
+	discoverNamespaces := false
+	if discoverStr := s.Data["discoverNamespaces"]; discoverStr != nil {
+		if val, err := strconv.ParseBool(string(discoverStr)); err != nil {
+			log.Warnf("Error while parsing discoverNamespaces in cluster secret '%s': %v", s.Name, err)
+		} else {
+			discoverNamespaces = val
+		}
+	}
+	if namespaceSelector := strings.TrimSpace(string(s.Data["namespaceSelector"])); discoverNamespaces || namespaceSelector != "" {
+		namespaceDiscovery = &appv1.ClusterNamespaceDiscovery{RBAC: discoverNamespaces, LabelSelector: namespaceSelector}
+	}
 	cluster := appv1.Cluster{
 		ID:                 string(s.UID),
 		Server:             strings.TrimRight(string(s.Data["server"]), "/"),
@@ -353,6 +373,7 @@ func secretToCluster(s *apiv1.Secret) *appv1.Cluster {
 		Config:             config,
 		RefreshRequestedAt: refreshRequestedAt,
 		Shard:              shard,
+		NamespaceDiscovery: namespaceDiscovery,
 	}
 	return &cluster
 }
@@ -65,6 +65,29 @@ func Test_secretToCluster_NoConfig(t *testing.T) {
 	})
 }
 
+func Test_secretToCluster_NamespaceDiscovery(t *testing.T) {
+	secret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mycluster",
+			Namespace: fakeNamespace,
+		},
+		Data: map[string][]byte{
+			"name":               []byte("test"),
+			"server":             []byte("http://mycluster"),
+			"discoverNamespaces": []byte("true"),
+			"namespaceSelector":  []byte("team=a"),
+		},
+	}
+	cluster := secretToCluster(secret)
+	assert.Equal(t, &v1alpha1.ClusterNamespaceDiscovery{RBAC: true, LabelSelector: "team=a"}, cluster.NamespaceDiscovery)
+
+	secret = &v1.Secret{}
+	err := clusterToSecret(cluster, secret)
+	assert.NoError(t, err)
+	assert.Equal(t, "true", string(secret.Data["discoverNamespaces"]))
+	assert.Equal(t, "team=a", string(secret.Data["namespaceSelector"]))
+}
+
 func TestUpdateCluster(t *testing.T) {
 	kubeclientset := fake.NewSimpleClientset(&v1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
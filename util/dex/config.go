@@ -54,6 +54,8 @@ func GenerateDexConfigYAML(settings *settings.ArgoCDSettings) ([]byte, error) {
 		"redirectURIs": []string{
 			"http://localhost",
 			"http://localhost:8085/auth/callback",
+			// required by the device authorization grant
+			"/device/callback",
 		},
 	}
 
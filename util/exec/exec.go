@@ -2,11 +2,15 @@ package exec
 
 import (
 	"fmt"
+	"math"
 	"os"
 	"os/exec"
+	"strings"
+	"syscall"
 	"time"
 
 	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/vathsalashetty96/argo-cd/util/log"
 
@@ -15,10 +19,18 @@ import (
 	tracing "github.com/vathsalashetty96/gitops-engine/pkg/utils/tracing"
 )
 
-var timeout time.Duration
+var (
+	timeout time.Duration
+	// maxMemory is the maximum data segment size, i.e. the private writable memory, in bytes of commands run with
+	// limits, zero means no limit
+	maxMemory int64
+	// maxCPUTime is the maximum CPU time of commands run with limits, zero means no limit
+	maxCPUTime time.Duration
+)
 
 func init() {
 	initTimeout()
+	initLimits()
 }
 
 func initTimeout() {
@@ -29,6 +41,44 @@ func initTimeout() {
 	}
 }
 
+func initLimits() {
+	maxMemory = 0
+	if quantity, err := resource.ParseQuantity(os.Getenv("ARGOCD_EXEC_MAX_MEMORY")); err == nil {
+		maxMemory = quantity.Value()
+	}
+	maxCPUTime = 0
+	if duration, err := time.ParseDuration(os.Getenv("ARGOCD_EXEC_MAX_CPU_TIME")); err == nil {
+		maxCPUTime = duration
+	}
+}
+
+// SetLimits overrides the memory and CPU time limits of commands run with RunLimited, which are configured by the
+// ARGOCD_EXEC_MAX_MEMORY and ARGOCD_EXEC_MAX_CPU_TIME env variables by default. Zero means no limit.
+func SetLimits(memory int64, cpuTime time.Duration) {
+	maxMemory = memory
+	maxCPUTime = cpuTime
+}
+
+// LimitsConfigured returns whether commands run with RunLimited are limited in their memory or CPU time
+func LimitsConfigured() bool {
+	return maxMemory > 0 || maxCPUTime > 0
+}
+
+// LimitExceededError is returned if a command was terminated because it exceeded its memory or CPU time limit
+type LimitExceededError struct {
+	// Limit describes the exceeded limit
+	Limit string
+	Err   error
+}
+
+func (e *LimitExceededError) Error() string {
+	return fmt.Sprintf("%v (%s exceeded)", e.Err, e.Limit)
+}
+
+func (e *LimitExceededError) Unwrap() error {
+	return e.Err
+}
+
 func Run(cmd *exec.Cmd) (string, error) {
 	return RunWithRedactor(cmd, nil)
 }
@@ -44,3 +94,44 @@ func RunWithRedactor(cmd *exec.Cmd, redactor func(text string) string) (string,
 	}
 	return argoexec.RunCommandExt(cmd, opts)
 }
+
+// RunLimited runs a command which processes untrusted input, e.g. a config management tool generating manifests, with
+// the memory and CPU time limits configured by the ARGOCD_EXEC_MAX_MEMORY and ARGOCD_EXEC_MAX_CPU_TIME env
+// variables. A LimitExceededError is returned if the command was terminated because it exceeded a limit.
+func RunLimited(cmd *exec.Cmd, redactor func(text string) string) (string, error) {
+	if !LimitsConfigured() {
+		return RunWithRedactor(cmd, redactor)
+	}
+	// the limits are set by a shell, which then replaces itself with the command, since the limits of a child process
+	// can't be set before it is started
+	var limits []string
+	if maxMemory > 0 {
+		limits = append(limits, fmt.Sprintf("ulimit -d %d", int64(math.Ceil(float64(maxMemory)/1024))))
+	}
+	if maxCPUTime > 0 {
+		limits = append(limits, fmt.Sprintf("ulimit -t %d", int64(math.Ceil(maxCPUTime.Seconds()))))
+	}
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		return "", err
+	}
+	cmd.Args = append([]string{"sh", "-c", strings.Join(limits, " && ") + ` && exec "$0" "$@"`, cmd.Path}, cmd.Args[1:]...)
+	cmd.Path = sh
+
+	out, err := RunWithRedactor(cmd, redactor)
+	if err != nil {
+		if cmd.ProcessState == nil {
+			return out, err
+		}
+		// the kernel sends SIGXCPU or SIGKILL once the CPU time limit is reached
+		cpuTime := cmd.ProcessState.UserTime() + cmd.ProcessState.SystemTime()
+		if status, ok := cmd.ProcessState.Sys().(syscall.WaitStatus); ok && status.Signaled() && maxCPUTime > 0 && cpuTime >= maxCPUTime {
+			return out, &LimitExceededError{Limit: fmt.Sprintf("CPU time limit of %v", maxCPUTime), Err: err}
+		}
+		// a process exceeding its memory limit fails to allocate memory
+		if msg := strings.ToLower(err.Error()); maxMemory > 0 && (strings.Contains(msg, "out of memory") || strings.Contains(msg, "cannot allocate memory")) {
+			return out, &LimitExceededError{Limit: fmt.Sprintf("memory limit of %s", resource.NewQuantity(maxMemory, resource.BinarySI)), Err: err}
+		}
+	}
+	return out, err
+}
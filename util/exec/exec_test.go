@@ -1,6 +1,7 @@
 package exec
 
 import (
+	"errors"
 	"os"
 	"os/exec"
 	"testing"
@@ -27,3 +28,32 @@ func TestRun(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotEmpty(t, out)
 }
+
+func Test_limits(t *testing.T) {
+	defer func() {
+		_ = os.Unsetenv("ARGOCD_EXEC_MAX_MEMORY")
+		_ = os.Unsetenv("ARGOCD_EXEC_MAX_CPU_TIME")
+		initLimits()
+	}()
+	_ = os.Setenv("ARGOCD_EXEC_MAX_MEMORY", "1Gi")
+	_ = os.Setenv("ARGOCD_EXEC_MAX_CPU_TIME", "1m")
+	initLimits()
+	assert.Equal(t, int64(1024*1024*1024), maxMemory)
+	assert.Equal(t, time.Minute, maxCPUTime)
+}
+
+func TestRunLimited(t *testing.T) {
+	defer func() { maxMemory, maxCPUTime = 0, 0 }()
+	maxMemory, maxCPUTime = 1024*1024*1024, time.Minute
+
+	out, err := RunLimited(exec.Command("sh", "-c", "ulimit -d && ulimit -t"), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "1048576\n60", out)
+
+	maxCPUTime = time.Second
+	_, err = RunLimited(exec.Command("sh", "-c", "while :; do :; done"), nil)
+	var limitErr *LimitExceededError
+	if assert.True(t, errors.As(err, &limitErr)) {
+		assert.Equal(t, "CPU time limit of 1s", limitErr.Limit)
+	}
+}
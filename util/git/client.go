@@ -3,6 +3,7 @@ package git
 import (
 	"crypto/tls"
 	"fmt"
+	"io/ioutil"
 	"math"
 	"net/http"
 	"net/url"
@@ -12,6 +13,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -57,6 +59,32 @@ type Client interface {
 	CommitSHA() (string, error)
 	RevisionMetadata(revision string) (*RevisionMetadata, error)
 	VerifyCommitSignature(string) (string, error)
+	SetAuthor(name string, email string) error
+	CheckoutOrOrphan(branch string) error
+	CommitAndPush(branch string, message string) (string, error)
+	SparseCheckout(paths []string) error
+	Worktree(path string) Client
+}
+
+// ClientOpts configures the git client
+type ClientOpts func(c *nativeGitClient)
+
+// WithPartialClone fetches commits and trees only. The contents of files are fetched from origin when they are
+// checked out, which speeds up the fetch of large repositories when only a part of them is needed.
+func WithPartialClone() ClientOpts {
+	return func(c *nativeGitClient) {
+		c.partialClone = true
+	}
+}
+
+// WithObjectStore keeps the objects fetched from origin in the shared bare repository at the given path, which is
+// referenced by the repository as alternate object store. Clones of the same remote repository, e.g. the clone of an
+// application and the clone of its Helm value files, fetch their common objects only once. Any commit in the store can
+// be checked out, so the store must never be shared by clones of different remote repositories.
+func WithObjectStore(path string) ClientOpts {
+	return func(c *nativeGitClient) {
+		c.objectStore = path
+	}
 }
 
 // nativeGitClient implements Client interface using git CLI
@@ -71,10 +99,21 @@ type nativeGitClient struct {
 	insecure bool
 	// Whether the repository is LFS enabled
 	enableLfs bool
+	// Whether to fetch commits and trees only and to fetch the contents of files on demand
+	partialClone bool
+	// Path of the bare repository keeping the objects of the repository, if the objects are shared
+	objectStore string
+	// Root path of the main working tree if the client operates on a linked worktree of the repository
+	mainRoot string
 }
 
 var (
 	maxAttemptsCount = 1
+
+	// fetchLocks serializes the fetches of a repository, which may be run by the clients of several of its worktrees
+	fetchLocks sync.Map
+	// objectStoreLock serializes the initialization of shared object stores
+	objectStoreLock sync.Mutex
 )
 
 func init() {
@@ -87,15 +126,15 @@ func init() {
 	}
 }
 
-func NewClient(rawRepoURL string, creds Creds, insecure bool, enableLfs bool) (Client, error) {
+func NewClient(rawRepoURL string, creds Creds, insecure bool, enableLfs bool, opts ...ClientOpts) (Client, error) {
 	root := filepath.Join(os.TempDir(), strings.Replace(NormalizeGitURL(rawRepoURL), "/", "_", -1))
 	if root == os.TempDir() {
 		return nil, fmt.Errorf("Repository '%s' cannot be initialized, because its root would be system temp at %s", rawRepoURL, root)
 	}
-	return NewClientExt(rawRepoURL, root, creds, insecure, enableLfs)
+	return NewClientExt(rawRepoURL, root, creds, insecure, enableLfs, opts...)
 }
 
-func NewClientExt(rawRepoURL string, root string, creds Creds, insecure bool, enableLfs bool) (Client, error) {
+func NewClientExt(rawRepoURL string, root string, creds Creds, insecure bool, enableLfs bool, opts ...ClientOpts) (Client, error) {
 	client := nativeGitClient{
 		repoURL:   rawRepoURL,
 		root:      root,
@@ -103,6 +142,9 @@ func NewClientExt(rawRepoURL string, root string, creds Creds, insecure bool, en
 		insecure:  insecure,
 		enableLfs: enableLfs,
 	}
+	for _, opt := range opts {
+		opt(&client)
+	}
 	return &client, nil
 }
 
@@ -226,11 +268,15 @@ func (m *nativeGitClient) Root() string {
 	return m.root
 }
 
-// Init initializes a local git repository and sets the remote origin
+// Init initializes a local git repository and sets the remote origin. The clients of worktrees initialize the
+// repository of the worktree.
 func (m *nativeGitClient) Init() error {
+	if m.mainRoot != "" {
+		return m.main().Init()
+	}
 	_, err := git.PlainOpen(m.root)
 	if err == nil {
-		return nil
+		return m.configure()
 	}
 	if err != git.ErrRepositoryNotExists {
 		return err
@@ -252,21 +298,154 @@ func (m *nativeGitClient) Init() error {
 		Name: git.DefaultRemoteName,
 		URLs: []string{m.repoURL},
 	})
+	if err != nil {
+		return err
+	}
+	return m.configure()
+}
+
+// configure applies the partial clone and object store settings to an initialized repository. Repositories which
+// were cloned fully before keep their objects, and fetch partially from now on.
+func (m *nativeGitClient) configure() error {
+	if m.objectStore != "" {
+		if err := initObjectStore(m.objectStore); err != nil {
+			return err
+		}
+		// garbage collection only knows the refs of this repository and would remove the objects of other
+		// repositories from the shared store
+		if _, err := m.runCmd("config", "gc.auto", "0"); err != nil {
+			return err
+		}
+		if _, err := m.runCmd("config", "maintenance.auto", "false"); err != nil {
+			return err
+		}
+		alternates := filepath.Join(m.root, ".git", "objects", "info", "alternates")
+		if err := os.MkdirAll(filepath.Dir(alternates), 0755); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(alternates, []byte(filepath.Join(m.objectStore, "objects")+"\n"), 0644); err != nil {
+			return err
+		}
+	}
+	if m.partialClone {
+		if _, err := m.runCmd("config", "remote.origin.promisor", "true"); err != nil {
+			return err
+		}
+		if _, err := m.runCmd("config", "remote.origin.partialclonefilter", "blob:none"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// initObjectStore initializes the bare repository keeping the objects shared by several repositories
+func initObjectStore(path string) error {
+	objectStoreLock.Lock()
+	defer objectStoreLock.Unlock()
+	if _, err := os.Stat(filepath.Join(path, "objects")); err == nil {
+		return nil
+	}
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return err
+	}
+	_, err := executil.Run(exec.Command("git", "init", "--bare", "--quiet", path))
 	return err
 }
 
+// addWorktree adds the worktree of the client at the last fetched revision to the repository, unless it exists
+// already. The files are checked out by Checkout.
+func (m *nativeGitClient) addWorktree() error {
+	if data, err := ioutil.ReadFile(filepath.Join(m.root, ".git")); err == nil {
+		if _, err := os.Stat(strings.TrimSpace(strings.TrimPrefix(string(data), "gitdir:"))); err == nil {
+			return nil
+		}
+		// the repository was removed since the worktree was added, e.g. to free disk space, and initialized again
+		log.Infof("Removing stale worktree of %s at %s", m.repoURL, m.root)
+		if err := os.RemoveAll(m.root); err != nil {
+			return err
+		}
+	}
+	log.Infof("Adding worktree of %s at %s", m.repoURL, m.root)
+	main := m.main()
+	// forget about worktrees whose directories were removed
+	if _, err := main.runCmd("worktree", "prune"); err != nil {
+		return err
+	}
+	if _, err := main.runCmd("worktree", "add", "--force", "--detach", "--no-checkout", m.root, "FETCH_HEAD"); err != nil {
+		return fmt.Errorf("unable to add worktree at %s: %v", m.root, err)
+	}
+	return nil
+}
+
+// main returns a client of the main working tree of the repository
+func (m *nativeGitClient) main() *nativeGitClient {
+	if m.mainRoot == "" {
+		return m
+	}
+	main := *m
+	main.root = m.mainRoot
+	main.mainRoot = ""
+	return &main
+}
+
+// Worktree returns a client of a linked worktree of the repository at the given path. The worktree shares the objects
+// and refs of the repository, so that different revisions can be checked out and used concurrently. The worktree is
+// added to the repository by the first Fetch.
+func (m *nativeGitClient) Worktree(path string) Client {
+	worktree := *m.main()
+	worktree.mainRoot = worktree.root
+	worktree.root = path
+	return &worktree
+}
+
+// SparseCheckout limits the working tree to the given directories of the repository and the files at its root. All
+// files are checked out if no directories are given. The contents of the other files are not fetched at all if the
+// repository is cloned partially.
+func (m *nativeGitClient) SparseCheckout(paths []string) error {
+	dirs := make([]string, 0)
+	for _, p := range paths {
+		p = strings.Trim(filepath.ToSlash(filepath.Clean(p)), "/")
+		if p == "" || p == "." {
+			// the root directory includes everything
+			dirs = nil
+			break
+		}
+		dirs = append(dirs, p)
+	}
+	if len(dirs) == 0 {
+		if out, err := m.runCmd("config", "--get", "core.sparseCheckout"); err != nil || strings.TrimSpace(out) != "true" {
+			return nil
+		}
+		return m.runFetchingCmd("sparse-checkout", "disable")
+	}
+	return m.runFetchingCmd(append([]string{"sparse-checkout", "set", "--cone", "--"}, dirs...)...)
+}
+
 // Returns true if the repository is LFS enabled
 func (m *nativeGitClient) IsLFSEnabled() bool {
 	return m.enableLfs
 }
 
-// Fetch fetches latest updates from origin
+// Fetch fetches latest updates from origin. The clients of worktrees fetch into the repository of the worktree and
+// add the worktree to it if it does not exist yet.
 func (m *nativeGitClient) Fetch(revision string) error {
+	main := m.main()
+	lock, _ := fetchLocks.LoadOrStore(main.root, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	defer lock.(*sync.Mutex).Unlock()
+
+	args := []string{"fetch", "origin"}
+	if m.partialClone {
+		args = append(args, "--filter=blob:none")
+	}
 	var err error
 	if revision != "" {
-		err = m.runCredentialedCmd("git", "fetch", "origin", revision)
+		err = main.runCredentialedCmd("git", append(args, revision)...)
 	} else {
-		err = m.runCredentialedCmd("git", "fetch", "origin", "--tags", "--force")
+		err = main.runCredentialedCmd("git", append(args, "--tags", "--force")...)
+	}
+	if err == nil && m.mainRoot != "" {
+		err = m.addWorktree()
 	}
 	// When we have LFS support enabled, check for large files and fetch them too.
 	if err == nil && m.IsLFSEnabled() {
@@ -307,7 +486,7 @@ func (m *nativeGitClient) Checkout(revision string) error {
 	if revision == "" || revision == "HEAD" {
 		revision = "origin/HEAD"
 	}
-	if _, err := m.runCmd("checkout", "--force", revision); err != nil {
+	if err := m.runFetchingCmd("checkout", "--force", revision); err != nil {
 		return err
 	}
 	// We must populate LFS content by using lfs checkout, if we have at least
@@ -493,6 +672,59 @@ func (m *nativeGitClient) VerifyCommitSignature(revision string) (string, error)
 	return out, nil
 }
 
+// SetAuthor configures the author and committer of the commits created in the local repository
+func (m *nativeGitClient) SetAuthor(name string, email string) error {
+	if _, err := m.runCmd("config", "user.name", name); err != nil {
+		return err
+	}
+	_, err := m.runCmd("config", "user.email", email)
+	return err
+}
+
+// CheckoutOrOrphan checks out the latest fetched revision of the branch, or starts an empty orphan branch if the branch
+// does not exist in origin yet
+func (m *nativeGitClient) CheckoutOrOrphan(branch string) error {
+	if _, err := m.runCmd("rev-parse", "--verify", "--quiet", "refs/remotes/origin/"+branch); err == nil {
+		return m.Checkout("origin/" + branch)
+	}
+	// the local branch may be left over from a failed push
+	_, _ = m.runCmd("branch", "-D", branch)
+	if _, err := m.runCmd("checkout", "--force", "--orphan", branch); err != nil {
+		return err
+	}
+	if _, err := m.runCmd("rm", "-r", "--force", "--quiet", "--ignore-unmatch", "."); err != nil {
+		return err
+	}
+	if _, err := m.runCmd("clean", "-fdx"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// CommitAndPush commits all changes of the working tree and pushes the commit to the branch in origin. Returns the SHA
+// of the pushed commit, or the SHA of HEAD if there is nothing to commit.
+func (m *nativeGitClient) CommitAndPush(branch string, message string) (string, error) {
+	if _, err := m.runCmd("add", "--all"); err != nil {
+		return "", err
+	}
+	out, err := m.runCmd("status", "--porcelain")
+	if err != nil {
+		return "", err
+	}
+	if strings.TrimSpace(out) == "" {
+		if _, err := m.runCmd("rev-parse", "--verify", "--quiet", "HEAD"); err == nil {
+			return m.CommitSHA()
+		}
+	}
+	if _, err := m.runCmd("commit", "--allow-empty", "--message", message); err != nil {
+		return "", err
+	}
+	if err := m.runCredentialedCmd("git", "push", "origin", "HEAD:refs/heads/"+branch); err != nil {
+		return "", err
+	}
+	return m.CommitSHA()
+}
+
 // runWrapper runs a custom command with all the semantics of running the Git client
 func (m *nativeGitClient) runGnuPGWrapper(wrapper string, args ...string) (string, error) {
 	cmd := exec.Command(wrapper, args...)
@@ -506,6 +738,16 @@ func (m *nativeGitClient) runCmd(args ...string) (string, error) {
 	return m.runCmdOutput(cmd)
 }
 
+// runFetchingCmd runs a git command which fetches the contents of the files it checks out from origin if the
+// repository is cloned partially
+func (m *nativeGitClient) runFetchingCmd(args ...string) error {
+	if m.partialClone {
+		return m.runCredentialedCmd("git", args...)
+	}
+	_, err := m.runCmd(args...)
+	return err
+}
+
 // runCredentialedCmd is a convenience function to run a git command with username/password credentials
 // nolint:unparam
 func (m *nativeGitClient) runCredentialedCmd(command string, args ...string) error {
@@ -529,6 +771,13 @@ func (m *nativeGitClient) runCmdOutput(cmd *exec.Cmd) (string, error) {
 	cmd.Env = append(cmd.Env, "HOME=/dev/null")
 	// Skip LFS for most Git operations except when explicitly requested
 	cmd.Env = append(cmd.Env, "GIT_LFS_SKIP_SMUDGE=1")
+	// Write all objects to the shared store, while still reading the objects the repository fetched before it used
+	// the store
+	if m.objectStore != "" {
+		cmd.Env = append(cmd.Env,
+			fmt.Sprintf("GIT_OBJECT_DIRECTORY=%s", filepath.Join(m.objectStore, "objects")),
+			fmt.Sprintf("GIT_ALTERNATE_OBJECT_DIRECTORIES=%s", filepath.Join(m.main().root, ".git", "objects")))
+	}
 
 	// For HTTPS repositories, we need to consider insecure repositories as well
 	// as custom CA bundles from the cert database.
@@ -5,7 +5,9 @@ import (
 	"io/ioutil"
 	"net/http"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -391,3 +393,90 @@ func TestListRevisions(t *testing.T) {
 	assert.NotContains(t, lsResult.Branches, testTag)
 	assert.NotContains(t, lsResult.Tags, testBranch)
 }
+
+func runGit(t *testing.T, dir string, args ...string) string {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if !assert.NoError(t, err, string(out)) {
+		t.FailNow()
+	}
+	return strings.TrimSpace(string(out))
+}
+
+func TestWorktreeWithPartialCloneAndObjectStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "test-worktree")
+	if err != nil {
+		panic(err.Error())
+	}
+	defer os.RemoveAll(dir)
+
+	srcDir := filepath.Join(dir, "src")
+	runGit(t, dir, "init", "--quiet", srcDir)
+	for _, file := range []string{"apps/a/a.yaml", "apps/b/b.yaml", "README.md"} {
+		assert.NoError(t, os.MkdirAll(filepath.Join(srcDir, filepath.Dir(file)), 0755))
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(srcDir, file), []byte(file), 0644))
+	}
+	runGit(t, srcDir, "add", "--all")
+	runGit(t, srcDir, "-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "--quiet", "--message", "initial")
+	runGit(t, srcDir, "config", "uploadpack.allowFilter", "true")
+	runGit(t, srcDir, "config", "uploadpack.allowAnySHA1InWant", "true")
+	commitSHA := runGit(t, srcDir, "rev-parse", "HEAD")
+
+	client, err := NewClientExt("file://"+srcDir, filepath.Join(dir, "repo"), NopCreds{}, false, false,
+		WithPartialClone(), WithObjectStore(filepath.Join(dir, "objects")))
+	assert.NoError(t, err)
+	worktree := client.Worktree(filepath.Join(dir, "worktree"))
+	assert.Equal(t, filepath.Join(dir, "worktree"), worktree.Root())
+
+	assert.NoError(t, worktree.Init())
+	assert.NoError(t, worktree.Fetch(commitSHA))
+	assert.NoError(t, worktree.SparseCheckout([]string{"apps/a"}))
+	assert.NoError(t, worktree.Checkout(commitSHA))
+
+	assert.FileExists(t, filepath.Join(worktree.Root(), "README.md"))
+	assert.FileExists(t, filepath.Join(worktree.Root(), "apps/a/a.yaml"))
+	assert.NoFileExists(t, filepath.Join(worktree.Root(), "apps/b/b.yaml"))
+	sha, err := worktree.CommitSHA()
+	assert.NoError(t, err)
+	assert.Equal(t, commitSHA, sha)
+
+	// the fetched objects are kept in the shared store only
+	packs, err := filepath.Glob(filepath.Join(dir, "repo", ".git", "objects", "pack", "*.pack"))
+	assert.NoError(t, err)
+	assert.Empty(t, packs)
+	packs, err = filepath.Glob(filepath.Join(dir, "objects", "objects", "pack", "*.pack"))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, packs)
+
+	assert.NoError(t, worktree.SparseCheckout(nil))
+	assert.FileExists(t, filepath.Join(worktree.Root(), "apps/b/b.yaml"))
+}
+
+func TestWorktreeOfRemovedRepository(t *testing.T) {
+	dir, err := ioutil.TempDir("", "test-worktree")
+	if err != nil {
+		panic(err.Error())
+	}
+	defer os.RemoveAll(dir)
+
+	srcDir := filepath.Join(dir, "src")
+	runGit(t, dir, "init", "--quiet", srcDir)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(srcDir, "README.md"), []byte("README"), 0644))
+	runGit(t, srcDir, "add", "--all")
+	runGit(t, srcDir, "-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "--quiet", "--message", "initial")
+	commitSHA := runGit(t, srcDir, "rev-parse", "HEAD")
+
+	client, err := NewClientExt("file://"+srcDir, filepath.Join(dir, "repo"), NopCreds{}, false, false)
+	assert.NoError(t, err)
+	worktree := client.Worktree(filepath.Join(dir, "worktree"))
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, worktree.Init())
+		assert.NoError(t, worktree.Fetch(commitSHA))
+		assert.NoError(t, worktree.Checkout(commitSHA))
+		assert.FileExists(t, filepath.Join(worktree.Root(), "README.md"))
+
+		// the repository is removed, e.g. to free disk space, and initialized again
+		assert.NoError(t, os.RemoveAll(filepath.Join(dir, "repo")))
+	}
+}
@@ -26,6 +26,41 @@ func (_m *Client) Checkout(revision string) error {
 	return r0
 }
 
+// CheckoutOrOrphan provides a mock function with given fields: branch
+func (_m *Client) CheckoutOrOrphan(branch string) error {
+	ret := _m.Called(branch)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(branch)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CommitAndPush provides a mock function with given fields: branch, message
+func (_m *Client) CommitAndPush(branch string, message string) (string, error) {
+	ret := _m.Called(branch, message)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = rf(branch, message)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(branch, message)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CommitSHA provides a mock function with given fields:
 func (_m *Client) CommitSHA() (string, error) {
 	ret := _m.Called()
@@ -202,6 +237,34 @@ func (_m *Client) Root() string {
 	return r0
 }
 
+// SetAuthor provides a mock function with given fields: name, email
+func (_m *Client) SetAuthor(name string, email string) error {
+	ret := _m.Called(name, email)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(name, email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SparseCheckout provides a mock function with given fields: paths
+func (_m *Client) SparseCheckout(paths []string) error {
+	ret := _m.Called(paths)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]string) error); ok {
+		r0 = rf(paths)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // VerifyCommitSignature provides a mock function with given fields: _a0
 func (_m *Client) VerifyCommitSignature(_a0 string) (string, error) {
 	ret := _m.Called(_a0)
@@ -222,3 +285,19 @@ func (_m *Client) VerifyCommitSignature(_a0 string) (string, error) {
 
 	return r0, r1
 }
+
+// Worktree provides a mock function with given fields: path
+func (_m *Client) Worktree(path string) git.Client {
+	ret := _m.Called(path)
+
+	var r0 git.Client
+	if rf, ok := ret.Get(0).(func(string) git.Client); ok {
+		r0 = rf(path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(git.Client)
+		}
+	}
+
+	return r0
+}
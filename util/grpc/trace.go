@@ -0,0 +1,159 @@
+package grpc
+
+import (
+	"io"
+	"strings"
+	"sync"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/vathsalashetty96/argo-cd/util/trace"
+)
+
+// metadataCarrier adapts the gRPC metadata to the OpenTelemetry text map carrier
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (c metadataCarrier) Set(key string, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// methodAttributes returns the span attributes of the given full method name, e.g. /application.ApplicationService/Get
+func methodAttributes(fullMethod string) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{attribute.String("rpc.system", "grpc")}
+	parts := strings.SplitN(strings.TrimPrefix(fullMethod, "/"), "/", 2)
+	if len(parts) == 2 {
+		attrs = append(attrs, attribute.String("rpc.service", parts[0]), attribute.String("rpc.method", parts[1]))
+	}
+	return attrs
+}
+
+func startServerSpan(ctx context.Context, fullMethod string) (context.Context, oteltrace.Span) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		md = metadata.MD{}
+	}
+	ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+	return trace.StartSpan(ctx, strings.TrimPrefix(fullMethod, "/"),
+		oteltrace.WithSpanKind(oteltrace.SpanKindServer), oteltrace.WithAttributes(methodAttributes(fullMethod)...))
+}
+
+func startClientSpan(ctx context.Context, fullMethod string) (context.Context, oteltrace.Span) {
+	ctx, span := trace.StartSpan(ctx, strings.TrimPrefix(fullMethod, "/"),
+		oteltrace.WithSpanKind(oteltrace.SpanKindClient), oteltrace.WithAttributes(methodAttributes(fullMethod)...))
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+	otel.GetTextMapPropagator().Inject(ctx, metadataCarrier(md))
+	return metadata.NewOutgoingContext(ctx, md), span
+}
+
+func finishSpan(span oteltrace.Span, err error) {
+	span.SetAttributes(attribute.String("rpc.grpc.status_code", status.Code(err).String()))
+	trace.FinishSpan(span, err)
+}
+
+// OTELUnaryServerInterceptor returns a new unary server interceptor which records a span for each request. The trace
+// context is extracted from the request metadata.
+func OTELUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, span := startServerSpan(ctx, info.FullMethod)
+		resp, err := handler(ctx, req)
+		finishSpan(span, err)
+		return resp, err
+	}
+}
+
+// OTELStreamServerInterceptor returns a new streaming server interceptor which records a span for each stream. The
+// trace context is extracted from the stream metadata.
+func OTELStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, span := startServerSpan(stream.Context(), info.FullMethod)
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = ctx
+		err := handler(srv, wrapped)
+		finishSpan(span, err)
+		return err
+	}
+}
+
+// OTELUnaryClientInterceptor returns a new unary client interceptor which records a span for each request and
+// propagates the trace context in the request metadata
+func OTELUnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, span := startClientSpan(ctx, method)
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		finishSpan(span, err)
+		return err
+	}
+}
+
+// OTELStreamClientInterceptor returns a new streaming client interceptor which records a span for each stream and
+// propagates the trace context in the stream metadata. The span ends when the stream is closed by the server or fails.
+func OTELStreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		ctx, span := startClientSpan(ctx, method)
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			finishSpan(span, err)
+			return nil, err
+		}
+		return &tracedClientStream{ClientStream: stream, span: span}, nil
+	}
+}
+
+type tracedClientStream struct {
+	grpc.ClientStream
+	span oteltrace.Span
+	once sync.Once
+}
+
+func (s *tracedClientStream) finish(err error) {
+	s.once.Do(func() {
+		if err == io.EOF {
+			err = nil
+		}
+		finishSpan(s.span, err)
+	})
+}
+
+func (s *tracedClientStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		s.finish(err)
+	}
+	return err
+}
+
+func (s *tracedClientStream) SendMsg(m interface{}) error {
+	err := s.ClientStream.SendMsg(m)
+	if err != nil {
+		s.finish(err)
+	}
+	return err
+}
@@ -0,0 +1,61 @@
+package grpc
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/export/trace/tracetest"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestOTELInterceptorsPropagateTraceContext(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	previousProvider, previousPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer func() {
+		otel.SetTracerProvider(previousProvider)
+		otel.SetTextMapPropagator(previousPropagator)
+	}()
+
+	const method = "/repository.RepoServerService/GenerateManifest"
+	server := OTELUnaryServerInterceptor()
+	client := OTELUnaryClientInterceptor()
+	ctx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs("user-agent", "test"))
+	err := client(ctx, method, nil, nil, nil, func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		assert.Equal(t, []string{"test"}, md.Get("user-agent"))
+		assert.Len(t, md.Get("traceparent"), 1)
+		_, err := server(metadata.NewIncomingContext(context.Background(), md), req, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, errors.New("failed")
+		})
+		return err
+	})
+	assert.EqualError(t, err, "failed")
+
+	spans := exporter.GetSpans()
+	if !assert.Len(t, spans, 2) {
+		return
+	}
+	serverSpan, clientSpan := spans[0], spans[1]
+	assert.Equal(t, "repository.RepoServerService/GenerateManifest", serverSpan.Name)
+	assert.Equal(t, clientSpan.SpanContext.TraceID(), serverSpan.SpanContext.TraceID())
+	assert.Equal(t, clientSpan.SpanContext.SpanID(), serverSpan.ParentSpanID)
+	assert.True(t, serverSpan.HasRemoteParent)
+	assert.Equal(t, codes.Error, serverSpan.StatusCode)
+	assert.Contains(t, serverSpan.Attributes, attribute.String("rpc.method", "GenerateManifest"))
+	assert.Contains(t, clientSpan.Attributes, attribute.String("rpc.service", "repository.RepoServerService"))
+	assert.Contains(t, clientSpan.Attributes, attribute.String("rpc.grpc.status_code", "Unknown"))
+}
+
+func TestMethodAttributes(t *testing.T) {
+	assert.Equal(t, []attribute.KeyValue{attribute.String("rpc.system", "grpc")}, methodAttributes("invalid"))
+}
@@ -36,6 +36,7 @@ type Creds struct {
 	CertData           []byte
 	KeyData            []byte
 	InsecureSkipVerify bool
+	PassCredentials    bool
 }
 
 type Client interface {
@@ -45,18 +46,43 @@ type Client interface {
 	TestHelmOCI() (bool, error)
 }
 
-func NewClient(repoURL string, creds Creds, enableOci bool) Client {
-	return NewClientWithLock(repoURL, creds, globalLock, enableOci)
+type ClientOpts func(c *nativeHelmChart)
+
+// WithRepoPath stores the Helm home and the downloaded charts of the repository in the given directory instead of a
+// directory in the temp dir
+func WithRepoPath(repoPath string) ClientOpts {
+	return func(c *nativeHelmChart) {
+		c.repoPath = repoPath
+	}
+}
+
+// WithChartUsage registers each use of a downloaded chart archive, e.g. to protect it from eviction while it is
+// extracted. The returned closer is closed once the chart is extracted.
+func WithChartUsage(use func(cachedChartPath string) io.Closer) ClientOpts {
+	return func(c *nativeHelmChart) {
+		c.useChart = use
+	}
+}
+
+func NewClient(repoURL string, creds Creds, enableOci bool, opts ...ClientOpts) Client {
+	return NewClientWithLock(repoURL, creds, globalLock, enableOci, opts...)
 }
 
-func NewClientWithLock(repoURL string, creds Creds, repoLock sync.KeyLock, enableOci bool) Client {
-	return &nativeHelmChart{
+func NewClientWithLock(repoURL string, creds Creds, repoLock sync.KeyLock, enableOci bool, opts ...ClientOpts) Client {
+	c := &nativeHelmChart{
 		repoURL:   repoURL,
 		creds:     creds,
 		repoPath:  filepath.Join(os.TempDir(), strings.Replace(repoURL, "/", "_", -1)),
 		repoLock:  repoLock,
 		enableOci: enableOci,
+		useChart: func(string) io.Closer {
+			return io.NopCloser
+		},
+	}
+	for _, opt := range opts {
+		opt(c)
 	}
+	return c
 }
 
 type nativeHelmChart struct {
@@ -65,6 +91,7 @@ type nativeHelmChart struct {
 	creds     Creds
 	repoLock  sync.KeyLock
 	enableOci bool
+	useChart  func(cachedChartPath string) io.Closer
 }
 
 func fileExist(filePath string) (bool, error) {
@@ -82,7 +109,7 @@ func (c *nativeHelmChart) ensureHelmChartRepoPath() error {
 	c.repoLock.Lock(c.repoPath)
 	defer c.repoLock.Unlock(c.repoPath)
 
-	err := os.Mkdir(c.repoPath, 0700)
+	err := os.MkdirAll(c.repoPath, 0700)
 	if err != nil && !os.IsExist(err) {
 		return err
 	}
@@ -119,6 +146,7 @@ func (c *nativeHelmChart) ExtractChart(chart string, version *semver.Version) (s
 	}
 
 	cachedChartPath := c.getCachedChartPath(chart, version)
+	defer io.Close(c.useChart(cachedChartPath))
 
 	c.repoLock.Lock(cachedChartPath)
 	defer c.repoLock.Unlock(cachedChartPath)
@@ -44,7 +44,7 @@ func NewCmdWithVersion(workDir string, version HelmVer, isHelmOci bool) (*Cmd, e
 	if err != nil {
 		return nil, err
 	}
-	return &Cmd{WorkDir: workDir, helmHome: tmpDir, HelmVer: version, IsHelmOci: isHelmOci}, err
+	return &Cmd{WorkDir: workDir, helmHome: tmpDir, HelmVer: withInstalledVersionFeatures(version), IsHelmOci: isHelmOci}, err
 }
 
 var redactor = func(text string) string {
@@ -66,7 +66,7 @@ func (c Cmd) run(args ...string) (string, error) {
 	if c.IsHelmOci {
 		cmd.Env = append(cmd.Env, "HELM_EXPERIMENTAL_OCI=1")
 	}
-	return executil.RunWithRedactor(cmd, redactor)
+	return executil.RunLimited(cmd, redactor)
 }
 
 func (c *Cmd) Init() (string, error) {
@@ -166,6 +166,10 @@ func (c *Cmd) RepoAdd(name string, url string, opts Creds) (string, error) {
 		args = append(args, "--insecure-skip-tls-verify")
 	}
 
+	if opts.PassCredentials && c.passCredentialsSupported {
+		args = append(args, "--pass-credentials")
+	}
+
 	if len(opts.CertData) > 0 {
 		certFile, err := ioutil.TempFile("", "helm")
 		if err != nil {
@@ -276,6 +280,7 @@ type TemplateOpts struct {
 	SetString   map[string]string
 	SetFile     map[string]string
 	Values      []string
+	SkipCrds    bool
 }
 
 var (
@@ -318,8 +323,12 @@ func (c *Cmd) template(chartPath string, opts *TemplateOpts) (string, error) {
 	for _, v := range opts.APIVersions {
 		args = append(args, "--api-versions", v)
 	}
-	if c.HelmVer.additionalTemplateArgs != nil {
-		args = append(args, c.HelmVer.additionalTemplateArgs...)
+	if c.HelmVer.crdsSupported {
+		if opts.SkipCrds {
+			args = append(args, "--skip-crds")
+		} else {
+			args = append(args, "--include-crds")
+		}
 	}
 
 	return c.run(args...)
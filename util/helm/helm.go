@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"os/exec"
 	"path"
+	"path/filepath"
 	"strings"
 
 	"github.com/ghodss/yaml"
@@ -114,6 +115,8 @@ func (h *helm) GetParameters(valuesFiles []string) (map[string]string, error) {
 		parsedURL, err := url.ParseRequestURI(file)
 		if err == nil && (parsedURL.Scheme == "http" || parsedURL.Scheme == "https") {
 			fileValues, err = config.ReadRemoteFile(file)
+		} else if filepath.IsAbs(file) {
+			fileValues, err = ioutil.ReadFile(file)
 		} else {
 			fileValues, err = ioutil.ReadFile(path.Join(h.cmd.WorkDir, file))
 		}
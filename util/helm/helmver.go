@@ -5,7 +5,10 @@ import (
 	"io/ioutil"
 	"os"
 	"path"
+	"sync"
 
+	"github.com/Masterminds/semver"
+	log "github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v2"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
@@ -20,20 +23,59 @@ var (
 		pullCommand:          "fetch",
 		initSupported:        true,
 	}
-	// HelmV3 represents helm V3 specific settings
+	// HelmV3 represents helm V3 specific settings. The flags which were added in later Helm 3 versions are enabled
+	// depending on the version of the installed binary, see withInstalledVersionFeatures.
 	HelmV3 = HelmVer{
-		binaryName:                  "helm",
-		templateNameArg:             "--name-template",
-		kubeVersionSupported:        false,
-		showCommand:                 "show",
-		pullCommand:                 "pull",
-		initSupported:               false,
-		getPostTemplateCallback:     cleanupChartLockFile,
-		additionalTemplateArgs:      []string{"--include-crds"},
+		binaryName:              "helm",
+		templateNameArg:         "--name-template",
+		showCommand:             "show",
+		pullCommand:             "pull",
+		initSupported:           false,
+		getPostTemplateCallback: cleanupChartLockFile,
+		// --include-crds and --skip-crds are supported by helm template of all Helm 3 versions
+		crdsSupported:               true,
 		insecureSkipVerifySupported: true,
 	}
 )
 
+var (
+	// helm3KubeVersionMinVersion is the first Helm 3 version which supports the --kube-version flag of helm template
+	helm3KubeVersionMinVersion = semver.MustParse("3.6.0")
+	// helm3PassCredentialsMinVersion is the first Helm 3 version which supports the --pass-credentials flag of
+	// helm repo add
+	helm3PassCredentialsMinVersion = semver.MustParse("3.6.1")
+
+	installedHelm3Version     *semver.Version
+	installedHelm3VersionOnce sync.Once
+)
+
+// withInstalledVersionFeatures enables the flags of the installed Helm 3 binary which are not supported by all Helm 3
+// versions. The version of the binary is detected once. If it cannot be detected, these flags are not passed.
+func withInstalledVersionFeatures(ver HelmVer) HelmVer {
+	if ver.binaryName != HelmV3.binaryName {
+		return ver
+	}
+	installedHelm3VersionOnce.Do(func() {
+		version, err := Version(true)
+		if err == nil {
+			installedHelm3Version, err = semver.NewVersion(version)
+		}
+		if err != nil {
+			log.Warnf("Failed to detect the Helm version, flags requiring Helm %s or later are not passed: %v", helm3KubeVersionMinVersion, err)
+		}
+	})
+	return withVersionFeatures(ver, installedHelm3Version)
+}
+
+// withVersionFeatures enables the flags which are supported by the given Helm 3 version
+func withVersionFeatures(ver HelmVer, version *semver.Version) HelmVer {
+	if version != nil {
+		ver.kubeVersionSupported = !version.LessThan(helm3KubeVersionMinVersion)
+		ver.passCredentialsSupported = !version.LessThan(helm3PassCredentialsMinVersion)
+	}
+	return ver
+}
+
 // workaround for Helm3 bug. Remove after https://github.com/helm/helm/issues/6870 is fixed.
 // The `helm template` command generates Chart.lock after which `helm dependency build` does not work
 // As workaround removing lock file unless it exists before running helm template
@@ -84,6 +126,7 @@ type HelmVer struct {
 	pullCommand                 string
 	kubeVersionSupported        bool
 	getPostTemplateCallback     func(chartPath string) (func(), error)
-	additionalTemplateArgs      []string
+	crdsSupported               bool
 	insecureSkipVerifySupported bool
+	passCredentialsSupported    bool
 }
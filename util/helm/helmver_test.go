@@ -3,6 +3,7 @@ package helm
 import (
 	"testing"
 
+	"github.com/Masterminds/semver"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -22,3 +23,23 @@ func TestGetHelmVersion_InvalidVersion(t *testing.T) {
 	_, err := getHelmVersion("./testdata/invalid-version")
 	assert.Error(t, err)
 }
+
+func TestWithVersionFeatures(t *testing.T) {
+	ver := withVersionFeatures(HelmV3, semver.MustParse("v3.4.1+gc4e7485"))
+	assert.False(t, ver.kubeVersionSupported)
+	assert.False(t, ver.passCredentialsSupported)
+	assert.True(t, ver.crdsSupported)
+
+	ver = withVersionFeatures(HelmV3, semver.MustParse("v3.6.0"))
+	assert.True(t, ver.kubeVersionSupported)
+	assert.False(t, ver.passCredentialsSupported)
+
+	ver = withVersionFeatures(HelmV3, semver.MustParse("v3.6.3+gd506314"))
+	assert.True(t, ver.kubeVersionSupported)
+	assert.True(t, ver.passCredentialsSupported)
+
+	// the flags are not passed if the version is unknown
+	ver = withVersionFeatures(HelmV3, nil)
+	assert.False(t, ver.kubeVersionSupported)
+	assert.False(t, ver.passCredentialsSupported)
+}
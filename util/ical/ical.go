@@ -0,0 +1,394 @@
+package ical
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	gocache "github.com/patrickmn/go-cache"
+)
+
+const (
+	dateTimeFormat = "20060102T150405"
+	dateFormat     = "20060102"
+)
+
+// parsedCalendars holds recently parsed calendars since sync windows are evaluated on every reconciliation
+var parsedCalendars = gocache.New(time.Hour, 10*time.Minute)
+
+var durationRegex = regexp.MustCompile(`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// Period is a time range which includes the start and excludes the end
+type Period struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Contains returns true if the given time is within the period
+func (p Period) Contains(t time.Time) bool {
+	return !t.Before(p.Start) && t.Before(p.End)
+}
+
+// recurrence is a subset of the RFC 5545 recurrence rule: only FREQ, INTERVAL, COUNT and UNTIL parts are supported
+type recurrence struct {
+	freq     string
+	interval int
+	count    int
+	until    time.Time
+}
+
+// Event is a calendar event (VEVENT component)
+type Event struct {
+	Summary string
+	// Period is the first occurrence of the event
+	Period
+	rule    *recurrence
+	exdates []time.Time
+}
+
+// Calendar is a parsed RFC 5545 calendar
+type Calendar struct {
+	Events []Event
+}
+
+// Parse parses the events of the RFC 5545 calendar. Supported event properties are SUMMARY, DTSTART, DTEND, DURATION,
+// EXDATE and RRULE with FREQ, INTERVAL, COUNT and UNTIL parts. The TZID parameters must reference IANA time zone names,
+// floating date and times are interpreted in the given location.
+func Parse(data string, loc *time.Location) (*Calendar, error) {
+	data = strings.ReplaceAll(data, "\r\n", "\n")
+	// unfold long content lines
+	data = strings.NewReplacer("\n ", "", "\n\t", "").Replace(data)
+
+	var calendar Calendar
+	var components []string
+	var event *Event
+	var eventStart, eventEnd, eventDuration string
+	var eventStartParams map[string]string
+	var eventEndParams map[string]string
+	for i, line := range strings.Split(data, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		name, params, value, err := parseLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", i+1, err)
+		}
+		switch name {
+		case "BEGIN":
+			components = append(components, strings.ToUpper(value))
+			if len(components) == 1 && components[0] != "VCALENDAR" {
+				return nil, fmt.Errorf("line %d: expected BEGIN:VCALENDAR", i+1)
+			}
+			if strings.ToUpper(value) == "VEVENT" && len(components) == 2 {
+				event = &Event{}
+				eventStart, eventEnd, eventDuration = "", "", ""
+				eventStartParams, eventEndParams = nil, nil
+			}
+			continue
+		case "END":
+			if len(components) == 0 || components[len(components)-1] != strings.ToUpper(value) {
+				return nil, fmt.Errorf("line %d: unexpected END:%s", i+1, value)
+			}
+			components = components[:len(components)-1]
+			if event != nil && len(components) == 1 {
+				if err := event.setPeriod(eventStart, eventStartParams, eventEnd, eventEndParams, eventDuration, loc); err != nil {
+					return nil, fmt.Errorf("line %d: %v", i+1, err)
+				}
+				calendar.Events = append(calendar.Events, *event)
+				event = nil
+			}
+			continue
+		}
+		// ignore properties of the calendar itself and nested components such as VALARM
+		if event == nil || len(components) != 2 {
+			continue
+		}
+		switch name {
+		case "SUMMARY":
+			event.Summary = value
+		case "DTSTART":
+			eventStart, eventStartParams = value, params
+		case "DTEND":
+			eventEnd, eventEndParams = value, params
+		case "DURATION":
+			eventDuration = value
+		case "RRULE":
+			if event.rule, err = parseRecurrence(value, loc); err != nil {
+				return nil, fmt.Errorf("line %d: %v", i+1, err)
+			}
+		case "EXDATE":
+			for _, v := range strings.Split(value, ",") {
+				exdate, err := parseTime(v, params, loc)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %v", i+1, err)
+				}
+				event.exdates = append(event.exdates, exdate)
+			}
+		}
+	}
+	if len(components) > 0 {
+		return nil, fmt.Errorf("component %s is not closed", components[len(components)-1])
+	}
+	return &calendar, nil
+}
+
+// ParseCached is the same as Parse, but returns the previously parsed calendar if the same data was parsed in the same
+// location recently. The returned calendar is shared and must not be modified.
+func ParseCached(data string, loc *time.Location) (*Calendar, error) {
+	key := loc.String() + "\n" + data
+	if calendar, ok := parsedCalendars.Get(key); ok {
+		return calendar.(*Calendar), nil
+	}
+	calendar, err := Parse(data, loc)
+	if err != nil {
+		return nil, err
+	}
+	parsedCalendars.SetDefault(key, calendar)
+	return calendar, nil
+}
+
+// parseLine splits the content line into upper-cased name, parameters and value
+func parseLine(line string) (string, map[string]string, string, error) {
+	quoted := false
+	sep := -1
+	for i, c := range line {
+		if c == '"' {
+			quoted = !quoted
+		} else if c == ':' && !quoted {
+			sep = i
+			break
+		}
+	}
+	if sep < 0 {
+		return "", nil, "", fmt.Errorf("invalid content line '%s'", line)
+	}
+	parts := strings.Split(line[:sep], ";")
+	params := make(map[string]string)
+	for _, param := range parts[1:] {
+		kv := strings.SplitN(param, "=", 2)
+		if len(kv) != 2 {
+			return "", nil, "", fmt.Errorf("invalid parameter '%s'", param)
+		}
+		params[strings.ToUpper(kv[0])] = strings.Trim(kv[1], `"`)
+	}
+	return strings.ToUpper(parts[0]), params, line[sep+1:], nil
+}
+
+// parseTime parses DATE or DATE-TIME value
+func parseTime(value string, params map[string]string, loc *time.Location) (time.Time, error) {
+	if tzid, ok := params["TZID"]; ok {
+		var err error
+		if loc, err = time.LoadLocation(tzid); err != nil {
+			return time.Time{}, fmt.Errorf("unknown time zone '%s'", tzid)
+		}
+	}
+	switch {
+	case params["VALUE"] == "DATE" || len(value) == len(dateFormat):
+		return time.ParseInLocation(dateFormat, value, loc)
+	case strings.HasSuffix(value, "Z"):
+		return time.ParseInLocation(dateTimeFormat, strings.TrimSuffix(value, "Z"), time.UTC)
+	default:
+		return time.ParseInLocation(dateTimeFormat, value, loc)
+	}
+}
+
+// parseDuration parses the duration value, e.g. P1D or PT1H30M
+func parseDuration(value string) (time.Duration, error) {
+	matches := durationRegex.FindStringSubmatch(value)
+	if matches == nil || value == "P" || strings.HasSuffix(value, "T") {
+		return 0, fmt.Errorf("invalid duration '%s'", value)
+	}
+	var duration time.Duration
+	for i, unit := range []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second} {
+		if matches[i+2] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(matches[i+2])
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration '%s'", value)
+		}
+		duration += time.Duration(n) * unit
+	}
+	if matches[1] == "-" {
+		duration = -duration
+	}
+	return duration, nil
+}
+
+func parseRecurrence(value string, loc *time.Location) (*recurrence, error) {
+	rule := &recurrence{interval: 1}
+	for _, part := range strings.Split(value, ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid recurrence rule part '%s'", part)
+		}
+		var err error
+		switch strings.ToUpper(kv[0]) {
+		case "FREQ":
+			rule.freq = strings.ToUpper(kv[1])
+			switch rule.freq {
+			case "DAILY", "WEEKLY", "MONTHLY", "YEARLY":
+			default:
+				return nil, fmt.Errorf("unsupported recurrence frequency '%s'", kv[1])
+			}
+		case "INTERVAL":
+			if rule.interval, err = strconv.Atoi(kv[1]); err != nil || rule.interval < 1 {
+				return nil, fmt.Errorf("invalid recurrence interval '%s'", kv[1])
+			}
+		case "COUNT":
+			if rule.count, err = strconv.Atoi(kv[1]); err != nil || rule.count < 1 {
+				return nil, fmt.Errorf("invalid recurrence count '%s'", kv[1])
+			}
+		case "UNTIL":
+			if rule.until, err = parseTime(kv[1], nil, loc); err != nil {
+				return nil, fmt.Errorf("invalid recurrence end '%s'", kv[1])
+			}
+		case "WKST":
+		default:
+			return nil, fmt.Errorf("unsupported recurrence rule part '%s'", kv[0])
+		}
+	}
+	if rule.freq == "" {
+		return nil, fmt.Errorf("recurrence rule '%s' has no frequency", value)
+	}
+	return rule, nil
+}
+
+func (e *Event) setPeriod(start string, startParams map[string]string, end string, endParams map[string]string, duration string, loc *time.Location) error {
+	if start == "" {
+		return fmt.Errorf("event '%s' has no DTSTART", e.Summary)
+	}
+	var err error
+	if e.Start, err = parseTime(start, startParams, loc); err != nil {
+		return err
+	}
+	isDate := startParams["VALUE"] == "DATE" || len(start) == len(dateFormat)
+	switch {
+	case end != "":
+		if e.End, err = parseTime(end, endParams, loc); err != nil {
+			return err
+		}
+	case duration != "":
+		d, err := parseDuration(duration)
+		if err != nil {
+			return err
+		}
+		e.End = e.Start.Add(d)
+	case isDate:
+		e.End = e.Start.AddDate(0, 0, 1)
+	default:
+		e.End = e.Start
+	}
+	if e.End.Before(e.Start) {
+		return fmt.Errorf("event '%s' ends before it starts", e.Summary)
+	}
+	return nil
+}
+
+func (e *Event) isExcluded(start time.Time) bool {
+	for _, exdate := range e.exdates {
+		if exdate.Equal(start) {
+			return true
+		}
+	}
+	return false
+}
+
+// occurrences calls the given function for each event occurrence which ends after the given time, ordered by start
+// time, until the function returns false or there are no more occurrences
+func (e *Event) occurrences(after time.Time, fn func(p Period) bool) {
+	if e.rule == nil {
+		if e.End.After(after) && !e.isExcluded(e.Start) {
+			fn(e.Period)
+		}
+		return
+	}
+	duration := e.End.Sub(e.Start)
+	i := 0
+	// skip the occurrences of fixed length frequencies which certainly ended before the given time
+	var step time.Duration
+	switch e.rule.freq {
+	case "DAILY":
+		step = 24 * time.Hour * time.Duration(e.rule.interval)
+	case "WEEKLY":
+		step = 7 * 24 * time.Hour * time.Duration(e.rule.interval)
+	}
+	if step > 0 && after.Sub(e.Start) > duration {
+		// subtract two extra steps in case of daylight saving time transitions
+		if skip := int((after.Sub(e.Start)-duration)/step) - 2; skip > 0 {
+			i = skip
+		}
+	}
+	for n := i; ; i++ {
+		if e.rule.count > 0 && n >= e.rule.count {
+			return
+		}
+		var start time.Time
+		switch e.rule.freq {
+		case "DAILY":
+			start = e.Start.AddDate(0, 0, i*e.rule.interval)
+		case "WEEKLY":
+			start = e.Start.AddDate(0, 0, 7*i*e.rule.interval)
+		case "MONTHLY":
+			start = e.Start.AddDate(0, i*e.rule.interval, 0)
+		case "YEARLY":
+			start = e.Start.AddDate(i*e.rule.interval, 0, 0)
+		}
+		if !e.rule.until.IsZero() && start.After(e.rule.until) {
+			return
+		}
+		// invalid dates such as February 30th are ignored and not counted
+		if (e.rule.freq == "MONTHLY" || e.rule.freq == "YEARLY") && start.Day() != e.Start.Day() {
+			continue
+		}
+		n++
+		if e.isExcluded(start) {
+			continue
+		}
+		end := start.Add(duration)
+		if !end.After(after) {
+			continue
+		}
+		if !fn(Period{Start: start, End: end}) {
+			return
+		}
+	}
+}
+
+// Contains returns true if the given time is within any calendar event occurrence
+func (c *Calendar) Contains(t time.Time) bool {
+	for i := range c.Events {
+		contains := false
+		c.Events[i].occurrences(t, func(p Period) bool {
+			contains = p.Contains(t)
+			return !contains && !p.Start.After(t)
+		})
+		if contains {
+			return true
+		}
+	}
+	return false
+}
+
+// Upcoming returns up to count event occurrences which end after the given time, ordered by start time
+func (c *Calendar) Upcoming(t time.Time, count int) []Period {
+	var periods []Period
+	for i := range c.Events {
+		n := 0
+		c.Events[i].occurrences(t, func(p Period) bool {
+			periods = append(periods, p)
+			n++
+			return n < count
+		})
+	}
+	sort.Slice(periods, func(i, j int) bool {
+		return periods[i].Start.Before(periods[j].Start)
+	})
+	if len(periods) > count {
+		periods = periods[:count]
+	}
+	return periods
+}
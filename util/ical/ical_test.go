@@ -0,0 +1,123 @@
+package ical
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testCalendar = `BEGIN:VCALENDAR
+VERSION:2.0
+PRODID:-//Test//Freeze//EN
+BEGIN:VEVENT
+SUMMARY:Year end
+ freeze
+DTSTART;VALUE=DATE:20201220
+DTEND;VALUE=DATE:20210104
+END:VEVENT
+BEGIN:VEVENT
+SUMMARY:Release
+DTSTART;TZID=Europe/Berlin:20210301T090000
+DURATION:PT2H
+RRULE:FREQ=WEEKLY;COUNT=3
+EXDATE;TZID=Europe/Berlin:20210308T090000
+BEGIN:VALARM
+TRIGGER:-PT15M
+END:VALARM
+END:VEVENT
+END:VCALENDAR
+`
+
+func TestParse(t *testing.T) {
+	cal, err := Parse(testCalendar, time.UTC)
+	assert.NoError(t, err)
+	if assert.Len(t, cal.Events, 2) {
+		assert.Equal(t, "Year endfreeze", cal.Events[0].Summary)
+		assert.Equal(t, time.Date(2020, 12, 20, 0, 0, 0, 0, time.UTC), cal.Events[0].Start)
+		assert.Equal(t, time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC), cal.Events[0].End)
+		assert.Equal(t, time.Date(2021, 3, 1, 8, 0, 0, 0, time.UTC), cal.Events[1].Start.UTC())
+		assert.Equal(t, 2*time.Hour, cal.Events[1].End.Sub(cal.Events[1].Start))
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	for name, data := range map[string]string{
+		"NotCalendar":     "BEGIN:VEVENT\nEND:VEVENT",
+		"NotClosed":       "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART:20210101T000000Z\n",
+		"NoStart":         "BEGIN:VCALENDAR\nBEGIN:VEVENT\nSUMMARY:test\nEND:VEVENT\nEND:VCALENDAR",
+		"BadTimeZone":     "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART;TZID=Mars/Olympus:20210101T000000\nEND:VEVENT\nEND:VCALENDAR",
+		"BadDuration":     "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART:20210101T000000Z\nDURATION:1h\nEND:VEVENT\nEND:VCALENDAR",
+		"UnsupportedRule": "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART:20210101T000000Z\nRRULE:FREQ=DAILY;BYDAY=MO\nEND:VEVENT\nEND:VCALENDAR",
+		"EndBeforeStart":  "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART:20210101T000000Z\nDTEND:20201231T000000Z\nEND:VEVENT\nEND:VCALENDAR",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse(data, time.UTC)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestCalendar_Contains(t *testing.T) {
+	cal, err := Parse(testCalendar, time.UTC)
+	assert.NoError(t, err)
+
+	assert.True(t, cal.Contains(time.Date(2020, 12, 20, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, cal.Contains(time.Date(2021, 1, 3, 23, 59, 0, 0, time.UTC)))
+	assert.False(t, cal.Contains(time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC)))
+
+	assert.True(t, cal.Contains(time.Date(2021, 3, 1, 9, 0, 0, 0, time.UTC)))
+	assert.False(t, cal.Contains(time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)))
+	// excluded occurrence
+	assert.False(t, cal.Contains(time.Date(2021, 3, 8, 9, 0, 0, 0, time.UTC)))
+	assert.True(t, cal.Contains(time.Date(2021, 3, 15, 9, 0, 0, 0, time.UTC)))
+	// count exceeded
+	assert.False(t, cal.Contains(time.Date(2021, 3, 22, 9, 0, 0, 0, time.UTC)))
+}
+
+func TestCalendar_ContainsDaily(t *testing.T) {
+	cal, err := Parse("BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART;TZID=America/New_York:20200101T220000\nDTEND;TZID=America/New_York:20200101T230000\nRRULE:FREQ=DAILY\nEND:VEVENT\nEND:VCALENDAR", time.UTC)
+	assert.NoError(t, err)
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	// the occurrences follow the daylight saving time of the event time zone
+	assert.True(t, cal.Contains(time.Date(2021, 1, 15, 22, 30, 0, 0, newYork)))
+	assert.True(t, cal.Contains(time.Date(2021, 7, 15, 22, 30, 0, 0, newYork)))
+	assert.False(t, cal.Contains(time.Date(2021, 7, 15, 23, 30, 0, 0, newYork)))
+	assert.False(t, cal.Contains(time.Date(2019, 12, 31, 22, 30, 0, 0, newYork)))
+}
+
+func TestCalendar_Upcoming(t *testing.T) {
+	cal, err := Parse(testCalendar, time.UTC)
+	assert.NoError(t, err)
+
+	periods := cal.Upcoming(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), 10)
+	if assert.Len(t, periods, 3) {
+		assert.Equal(t, time.Date(2020, 12, 20, 0, 0, 0, 0, time.UTC), periods[0].Start)
+		assert.Equal(t, time.Date(2021, 3, 1, 8, 0, 0, 0, time.UTC), periods[1].Start.UTC())
+		assert.Equal(t, time.Date(2021, 3, 15, 8, 0, 0, 0, time.UTC), periods[2].Start.UTC())
+	}
+
+	assert.Len(t, cal.Upcoming(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), 2), 2)
+	assert.Empty(t, cal.Upcoming(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), 2))
+}
+
+func TestParseDuration(t *testing.T) {
+	for value, expected := range map[string]time.Duration{
+		"P1W":      7 * 24 * time.Hour,
+		"P1DT12H":  36 * time.Hour,
+		"PT1H30M":  90 * time.Minute,
+		"-PT15M":   -15 * time.Minute,
+		"PT0S":     0,
+		"P2DT1M3S": 48*time.Hour + time.Minute + 3*time.Second,
+	} {
+		d, err := parseDuration(value)
+		assert.NoError(t, err, value)
+		assert.Equal(t, expected, d, value)
+	}
+	for _, value := range []string{"P", "PT", "1H", "P1H"} {
+		_, err := parseDuration(value)
+		assert.Error(t, err, value)
+	}
+}
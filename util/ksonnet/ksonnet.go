@@ -102,7 +102,7 @@ func (k *ksonnetApp) ksCmd(args ...string) (string, error) {
 	cmd := exec.Command("ks", args...)
 	cmd.Dir = k.Root()
 
-	return executil.Run(cmd)
+	return executil.RunLimited(cmd, nil)
 }
 
 func (k *ksonnetApp) Root() string {
@@ -1,9 +1,11 @@
 package kube
 
 import (
+	"context"
 	"os"
 
 	"github.com/vathsalashetty96/argo-cd/util/log"
+	"github.com/vathsalashetty96/argo-cd/util/trace"
 
 	"github.com/vathsalashetty96/gitops-engine/pkg/utils/kube"
 	"github.com/vathsalashetty96/gitops-engine/pkg/utils/tracing"
@@ -18,6 +20,20 @@ func init() {
 	}
 }
 
+// NewKubectl returns kubectl which also records its runs as OpenTelemetry spans. The spans are dropped unless the tracer
+// provider is initialized.
 func NewKubectl() kube.Kubectl {
-	return &kube.KubectlCmd{Tracer: tracer, Log: log.NewLogrusLogger(logrus.New())}
+	return &kube.KubectlCmd{Tracer: trace.NewEngineTracer(context.Background(), tracer), Log: log.NewLogrusLogger(logrus.New())}
+}
+
+// WithTraceContext returns a copy of the given kubectl which records its runs as children of the span in the given
+// context, e.g. the span of a sync
+func WithTraceContext(ctx context.Context, kubectl kube.Kubectl) kube.Kubectl {
+	cmd, ok := kubectl.(*kube.KubectlCmd)
+	if !ok {
+		return kubectl
+	}
+	withContext := *cmd
+	withContext.Tracer = trace.NewEngineTracer(ctx, cmd.Tracer)
+	return &withContext
 }
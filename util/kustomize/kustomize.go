@@ -167,7 +167,7 @@ func (k *kustomize) Build(opts *v1alpha1.ApplicationSourceKustomize, kustomizeOp
 	}
 
 	cmd.Env = append(cmd.Env, environ...)
-	out, err := executil.Run(cmd)
+	out, err := executil.RunLimited(cmd, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,148 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// GrantTypeDeviceCode is the grant type of the OAuth 2.0 device authorization grant (RFC 8628)
+const GrantTypeDeviceCode = "urn:ietf:params:oauth:grant-type:device_code"
+
+const (
+	// defaultDevicePollInterval is the interval in which the token endpoint is polled if the provider does not specify one
+	defaultDevicePollInterval = 5 * time.Second
+	// deviceSlowDownInterval is added to the poll interval whenever the provider asks to slow down
+	deviceSlowDownInterval = 5 * time.Second
+)
+
+// DeviceAuthorization is the response of a device authorization request, see RFC 8628 section 3.2
+type DeviceAuthorization struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete,omitempty"`
+	ExpiresIn               int64  `json:"expires_in"`
+	Interval                int64  `json:"interval,omitempty"`
+}
+
+// deviceTokenResponse is the response of a device access token request, see RFC 8628 section 3.5
+type deviceTokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	TokenType        string `json:"token_type"`
+	RefreshToken     string `json:"refresh_token"`
+	ExpiresIn        int64  `json:"expires_in"`
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// RequestDeviceAuthorization requests a device code and a user code from the device authorization endpoint of the
+// provider. The user enters the user code at the verification URI to authorize the device.
+func RequestDeviceAuthorization(ctx context.Context, client *http.Client, endpoint string, conf *oauth2.Config) (*DeviceAuthorization, error) {
+	values := url.Values{"client_id": {conf.ClientID}}
+	if len(conf.Scopes) > 0 {
+		values.Set("scope", strings.Join(conf.Scopes, " "))
+	}
+	var auth DeviceAuthorization
+	status, err := postForm(ctx, client, endpoint, values, &auth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request device authorization: %v", err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("failed to request device authorization: %s", http.StatusText(status))
+	}
+	if auth.DeviceCode == "" || auth.UserCode == "" || auth.VerificationURI == "" {
+		return nil, fmt.Errorf("invalid device authorization response")
+	}
+	return &auth, nil
+}
+
+// PollDeviceToken polls the token endpoint of the provider until the user authorized the device, denied the
+// authorization or the device code expired. The ID token is available as the 'id_token' extra of the returned token.
+func PollDeviceToken(ctx context.Context, client *http.Client, conf *oauth2.Config, auth *DeviceAuthorization) (*oauth2.Token, error) {
+	interval := defaultDevicePollInterval
+	if auth.Interval > 0 {
+		interval = time.Duration(auth.Interval) * time.Second
+	}
+	if auth.ExpiresIn > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(auth.ExpiresIn)*time.Second)
+		defer cancel()
+	}
+	values := url.Values{
+		"grant_type":  {GrantTypeDeviceCode},
+		"device_code": {auth.DeviceCode},
+		"client_id":   {conf.ClientID},
+	}
+	if conf.ClientSecret != "" {
+		values.Set("client_secret", conf.ClientSecret)
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("device code expired before the device was authorized")
+		case <-time.After(interval):
+		}
+
+		var res deviceTokenResponse
+		status, err := postForm(ctx, client, conf.Endpoint.TokenURL, values, &res)
+		if err != nil {
+			return nil, fmt.Errorf("failed to request device access token: %v", err)
+		}
+		switch res.Error {
+		case "":
+			if status != http.StatusOK {
+				return nil, fmt.Errorf("failed to request device access token: %s", http.StatusText(status))
+			}
+			token := &oauth2.Token{
+				AccessToken:  res.AccessToken,
+				TokenType:    res.TokenType,
+				RefreshToken: res.RefreshToken,
+			}
+			if res.ExpiresIn > 0 {
+				token.Expiry = time.Now().Add(time.Duration(res.ExpiresIn) * time.Second)
+			}
+			return token.WithExtra(map[string]interface{}{"id_token": res.IDToken}), nil
+		case "authorization_pending":
+		case "slow_down":
+			interval += deviceSlowDownInterval
+		case "access_denied":
+			return nil, fmt.Errorf("device authorization was denied")
+		case "expired_token":
+			return nil, fmt.Errorf("device code expired before the device was authorized")
+		default:
+			return nil, fmt.Errorf("failed to request device access token: %s %s", res.Error, res.ErrorDescription)
+		}
+	}
+}
+
+// postForm posts the form values to the endpoint and decodes the JSON response into the given value
+func postForm(ctx context.Context, client *http.Client, endpoint string, values url.Values, v interface{}) (int, error) {
+	req, err := http.NewRequest(http.MethodPost, endpoint, strings.NewReader(values.Encode()))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	res, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = res.Body.Close() }()
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return 0, err
+	}
+	if err := json.Unmarshal(body, v); err != nil && res.StatusCode == http.StatusOK {
+		return 0, fmt.Errorf("failed to decode response: %v", err)
+	}
+	return res.StatusCode, nil
+}
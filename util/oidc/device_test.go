@@ -0,0 +1,67 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/oauth2"
+)
+
+func newDeviceTestServer(t *testing.T, tokenResponses ...string) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/device/code", func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "argo-cd-cli", r.PostForm.Get("client_id"))
+		assert.Equal(t, "openid groups", r.PostForm.Get("scope"))
+		assert.NoError(t, json.NewEncoder(w).Encode(DeviceAuthorization{
+			DeviceCode:      "device-code",
+			UserCode:        "ABCD-EFGH",
+			VerificationURI: "https://dex.example.com/device",
+			ExpiresIn:       60,
+			Interval:        1,
+		}))
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, GrantTypeDeviceCode, r.PostForm.Get("grant_type"))
+		assert.Equal(t, "device-code", r.PostForm.Get("device_code"))
+		res := tokenResponses[0]
+		tokenResponses = tokenResponses[1:]
+		if len(tokenResponses) > 0 {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+		_, _ = w.Write([]byte(res))
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestDeviceAuthorizationGrant(t *testing.T) {
+	server := newDeviceTestServer(t, `{"error": "authorization_pending"}`, `{"access_token": "access-token", "token_type": "bearer", "refresh_token": "refresh-token", "id_token": "id-token", "expires_in": 3600}`)
+	defer server.Close()
+	conf := &oauth2.Config{ClientID: "argo-cd-cli", Scopes: []string{"openid", "groups"}, Endpoint: oauth2.Endpoint{TokenURL: server.URL + "/token"}}
+
+	auth, err := RequestDeviceAuthorization(context.Background(), server.Client(), server.URL+"/device/code", conf)
+	assert.NoError(t, err)
+	assert.Equal(t, "ABCD-EFGH", auth.UserCode)
+	assert.Equal(t, "https://dex.example.com/device", auth.VerificationURI)
+
+	token, err := PollDeviceToken(context.Background(), server.Client(), conf, auth)
+	assert.NoError(t, err)
+	assert.Equal(t, "refresh-token", token.RefreshToken)
+	assert.Equal(t, "id-token", token.Extra("id_token"))
+}
+
+func TestDeviceAuthorizationGrant_Denied(t *testing.T) {
+	server := newDeviceTestServer(t, `{"error": "access_denied"}`, "")
+	defer server.Close()
+	conf := &oauth2.Config{ClientID: "argo-cd-cli", Scopes: []string{"openid", "groups"}, Endpoint: oauth2.Endpoint{TokenURL: server.URL + "/token"}}
+
+	auth, err := RequestDeviceAuthorization(context.Background(), server.Client(), server.URL+"/device/code", conf)
+	assert.NoError(t, err)
+	_, err = PollDeviceToken(context.Background(), server.Client(), conf, auth)
+	assert.EqualError(t, err, "device authorization was denied")
+}
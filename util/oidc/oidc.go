@@ -40,6 +40,8 @@ type OIDCConfiguration struct {
 	ScopesSupported        []string `json:"scopes_supported"`
 	ResponseTypesSupported []string `json:"response_types_supported"`
 	GrantTypesSupported    []string `json:"grant_types_supported,omitempty"`
+	// DeviceAuthorizationEndpoint is the endpoint of the device authorization grant, if supported by the provider
+	DeviceAuthorizationEndpoint string `json:"device_authorization_endpoint,omitempty"`
 }
 
 type ClaimsRequest struct {
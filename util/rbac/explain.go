@@ -0,0 +1,128 @@
+package rbac
+
+import (
+	"strings"
+	"time"
+
+	"github.com/vathsalashetty96/argo-cd/util/glob"
+)
+
+// Policy sources reported by an explanation
+const (
+	PolicySourceBuiltin = "built-in"
+	PolicySourceUser    = "user-defined"
+	PolicySourceRuntime = "runtime"
+)
+
+// PolicyMatch is a policy line matching an explained request
+type PolicyMatch struct {
+	// Source is the policy the line is defined in
+	Source string
+	// Line is the policy line as it was defined
+	Line string
+	// Effect is the effect of the policy line, i.e. allow or deny
+	Effect string
+	// Roles is the role inheritance chain from the subject (or the default role) to the subject of the policy line
+	Roles []string
+}
+
+// Explanation describes why a request was allowed or denied
+type Explanation struct {
+	// Allowed is the result of the enforcement of the request
+	Allowed bool
+	// DefaultRole is the default role which was enforced in addition to the subject
+	DefaultRole string
+	// Matches are the policy lines matching the request of the subject or the default role
+	Matches []PolicyMatch
+}
+
+// policyLine is a parsed line of a policy
+type policyLine struct {
+	source string
+	line   string
+	tokens []string
+}
+
+// Explain enforces the request of the given subject and reports the policy lines matching it, the role inheritance
+// chain leading to each of them and the default role.
+func (e *Enforcer) Explain(subject, resource, action, object string) *Explanation {
+	explanation := &Explanation{Allowed: e.Enforce(subject, resource, action, object), DefaultRole: e.defaultRole}
+	lines := e.adapter.policyLines(time.Now())
+
+	roles := map[string][]string{}
+	for _, l := range lines {
+		if l.tokens[0] == "g" && len(l.tokens) >= 3 {
+			roles[l.tokens[1]] = append(roles[l.tokens[1]], l.tokens[2])
+		}
+	}
+
+	subjects := []string{subject}
+	if e.defaultRole != "" && e.defaultRole != subject {
+		subjects = append(subjects, e.defaultRole)
+	}
+	for _, sub := range subjects {
+		chains := roleChains(sub, roles)
+		for _, l := range lines {
+			if l.tokens[0] != "p" || len(l.tokens) < 6 {
+				continue
+			}
+			chain, ok := chains[l.tokens[1]]
+			if !ok || !glob.Match(l.tokens[2], resource) || !actionMatch(l.tokens[3], action) || !glob.Match(l.tokens[4], object) {
+				continue
+			}
+			explanation.Matches = append(explanation.Matches, PolicyMatch{
+				Source: l.source,
+				Line:   l.line,
+				Effect: l.tokens[5],
+				Roles:  chain,
+			})
+		}
+	}
+	return explanation
+}
+
+// roleChains returns the shortest role inheritance chain from the subject to every role it inherits, including the
+// subject itself
+func roleChains(subject string, roles map[string][]string) map[string][]string {
+	chains := map[string][]string{subject: {subject}}
+	queue := []string{subject}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, role := range roles[current] {
+			if _, ok := chains[role]; ok {
+				continue
+			}
+			chain := append(append([]string{}, chains[current]...), role)
+			chains[role] = chain
+			queue = append(queue, role)
+		}
+	}
+	return chains
+}
+
+// policyLines returns the parsed lines of all policies which are in effect at the given time
+func (a *argocdAdapter) policyLines(now time.Time) []policyLine {
+	var lines []policyLine
+	for _, policy := range []struct {
+		source string
+		policy string
+	}{
+		{PolicySourceBuiltin, a.builtinPolicy},
+		{PolicySourceUser, a.userDefinedPolicy},
+		{PolicySourceRuntime, a.runtimePolicy},
+	} {
+		for _, line := range strings.Split(policy.policy, "\n") {
+			line = strings.TrimSpace(line)
+			tokens, _, err := parsePolicyLine(line, now)
+			if err != nil || len(tokens) == 0 {
+				continue
+			}
+			for i := range tokens {
+				tokens[i] = strings.TrimSpace(tokens[i])
+			}
+			lines = append(lines, policyLine{source: policy.source, line: line, tokens: tokens})
+		}
+	}
+	return lines
+}
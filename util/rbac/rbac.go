@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/vathsalashetty96/argo-cd/util/assets"
@@ -33,6 +34,10 @@ const (
 	ConfigMapScopesKey        = "scopes"
 
 	defaultRBACSyncPeriod = 10 * time.Minute
+
+	// denyEffect is the policy effect of the model which only evaluates the explicit denies, i.e. it allows every
+	// request no deny policy matches
+	denyEffect = "!some(where (p.eft == deny))"
 )
 
 // Enforcer is a wrapper around an Casbin enforcer that:
@@ -41,8 +46,11 @@ const (
 // * supports a built-in policy
 // * supports a user-defined policy
 // * supports a custom JWT claims enforce function
+// * supports role bindings which expire at a given time
 type Enforcer struct {
 	*casbin.Enforcer
+	// denyEnforcer enforces only the explicit denies of the policy, which take precedence over the default role
+	denyEnforcer       *casbin.Enforcer
 	adapter            *argocdAdapter
 	clientset          kubernetes.Interface
 	namespace          string
@@ -50,6 +58,8 @@ type Enforcer struct {
 	claimsEnforcerFunc ClaimsEnforcerFunc
 	model              model.Model
 	defaultRole        string
+	expiryLock         sync.Mutex
+	expiryTimer        *time.Timer
 }
 
 // ClaimsEnforcerFunc is func template to enforce a JWT claims. The subject is replaced
@@ -76,9 +86,31 @@ func newEnforcerSafe(params ...interface{}) (e *casbin.Enforcer, err error) {
 
 		return glob.Match(pattern, val), nil
 	})
+	enfs.AddFunction("actionMatch", func(args ...interface{}) (interface{}, error) {
+		if len(args) < 2 {
+			return false, nil
+		}
+		val, ok := args[0].(string)
+		if !ok {
+			return false, nil
+		}
+
+		pattern, ok := args[1].(string)
+		if !ok {
+			return false, nil
+		}
+
+		return actionMatch(pattern, val), nil
+	})
 	return enfs, nil
 }
 
+// actionMatch returns whether the action of a policy matches the requested action. An action also matches all of its
+// resource-level sub-actions, e.g. 'delete' matches 'delete/apps/Deployment/default/guestbook'.
+func actionMatch(pattern, action string) bool {
+	return glob.Match(pattern, action) || glob.Match(pattern+"/*", action)
+}
+
 func NewEnforcer(clientset kubernetes.Interface, namespace, configmap string, claimsEnforcer ClaimsEnforcerFunc) *Enforcer {
 	adapter := newAdapter("", "", "")
 	builtInModel := newBuiltInModel()
@@ -87,8 +119,14 @@ func NewEnforcer(clientset kubernetes.Interface, namespace, configmap string, cl
 		panic(err)
 	}
 	enf.EnableLog(false)
+	denyEnf, err := newEnforcerSafe(newDenyModel(), adapter)
+	if err != nil {
+		panic(err)
+	}
+	denyEnf.EnableLog(false)
 	return &Enforcer{
 		Enforcer:           enf,
+		denyEnforcer:       denyEnf,
 		adapter:            adapter,
 		clientset:          clientset,
 		namespace:          namespace,
@@ -104,6 +142,12 @@ func (e *Enforcer) SetDefaultRole(roleName string) {
 	e.defaultRole = roleName
 }
 
+// EnableEnforce enables or disables the enforcement of the policy, including its explicit denies
+func (e *Enforcer) EnableEnforce(enable bool) {
+	e.Enforcer.EnableEnforce(enable)
+	e.denyEnforcer.EnableEnforce(enable)
+}
+
 // SetClaimsEnforcerFunc sets a claims enforce function during enforcement. The claims enforce function
 // can extract claims from JWT token and do the proper enforcement based on user, group or any information
 // available in the input parameter list
@@ -114,7 +158,7 @@ func (e *Enforcer) SetClaimsEnforcerFunc(claimsEnforcer ClaimsEnforcerFunc) {
 // Enforce is a wrapper around casbin.Enforce to additionally enforce a default role and a custom
 // claims function
 func (e *Enforcer) Enforce(rvals ...interface{}) bool {
-	return enforce(e.Enforcer, e.defaultRole, e.claimsEnforcerFunc, rvals...)
+	return enforce(e.Enforcer, e.denyEnforcer, e.defaultRole, e.claimsEnforcerFunc, rvals...)
 }
 
 // EnforceErr is a convenience helper to wrap a failed enforcement with a detailed error about the request
@@ -150,28 +194,80 @@ func (e *Enforcer) EnforceErr(rvals ...interface{}) error {
 // user-defined policy. This allows any explicit denies of the built-in, and user-defined policies
 // to override the run-time policy. Runs normal enforcement if run-time policy is empty.
 func (e *Enforcer) EnforceRuntimePolicy(policy string, rvals ...interface{}) bool {
-	var enf *casbin.Enforcer
-	var err error
-	if policy == "" {
-		enf = e.Enforcer
-	} else {
-		enf, err = newEnforcerSafe(newBuiltInModel(), newAdapter(e.adapter.builtinPolicy, e.adapter.userDefinedPolicy, policy))
-		if err != nil {
-			log.Warnf("invalid runtime policy: %s", policy)
-			enf = e.Enforcer
+	enf, denyEnf := e.runtimeEnforcers(policy)
+	return enforce(enf, denyEnf, e.defaultRole, e.claimsEnforcerFunc, rvals...)
+}
+
+// EnforceRuntimePolicySubjects enforces a run-time policy like EnforceRuntimePolicy for a request made on behalf of
+// several subjects, e.g. a user and its groups, given the resource, action and object of the request. The request is
+// denied if any of the subjects is explicitly denied. Otherwise it is allowed if any of the subjects or the default role
+// is allowed, so the default role does not override the denies of any of the subjects.
+func (e *Enforcer) EnforceRuntimePolicySubjects(policy string, subjects []string, rvals ...interface{}) bool {
+	enf, denyEnf := e.runtimeEnforcers(policy)
+	if isDenied(denyEnf, subjects, rvals...) {
+		return false
+	}
+	for _, sub := range subjects {
+		if sub != "" && enf.Enforce(append([]interface{}{sub}, rvals...)...) {
+			return true
 		}
 	}
-	return enforce(enf, e.defaultRole, e.claimsEnforcerFunc, rvals...)
+	return enforceDefaultRole(enf, e.defaultRole, rvals...)
 }
 
-// enforce is a helper to additionally check a default role and invoke a custom claims enforcement function
-func enforce(enf *casbin.Enforcer, defaultRole string, claimsEnforcerFunc ClaimsEnforcerFunc, rvals ...interface{}) bool {
-	// check the default role
-	if defaultRole != "" && len(rvals) >= 2 {
-		if enf.Enforce(append([]interface{}{defaultRole}, rvals[1:]...)...) {
+// EnforceDefaultRole enforces only the default role for a request made on behalf of the given subjects, given the
+// resource, action and object of the request. Like in EnforceRuntimePolicySubjects, the default role does not override
+// the explicit denies of any of the subjects in the built-in, user-defined or run-time policy.
+func (e *Enforcer) EnforceDefaultRole(policy string, subjects []string, rvals ...interface{}) bool {
+	enf, denyEnf := e.runtimeEnforcers(policy)
+	return !isDenied(denyEnf, subjects, rvals...) && enforceDefaultRole(enf, e.defaultRole, rvals...)
+}
+
+// isDenied returns whether any of the subjects is explicitly denied the resource, action and object of the request
+func isDenied(denyEnf *casbin.Enforcer, subjects []string, rvals ...interface{}) bool {
+	for _, sub := range subjects {
+		if sub != "" && !denyEnf.Enforce(append([]interface{}{sub}, rvals...)...) {
 			return true
 		}
 	}
+	return false
+}
+
+// enforceDefaultRole checks the default role, if any, given the resource, action and object of the request
+func enforceDefaultRole(enf *casbin.Enforcer, defaultRole string, rvals ...interface{}) bool {
+	return defaultRole != "" && len(rvals) >= 1 && enf.Enforce(append([]interface{}{defaultRole}, rvals...)...)
+}
+
+// runtimeEnforcers returns the enforcer and the deny enforcer of the built-in and user-defined policy augmented by the
+// run-time policy
+func (e *Enforcer) runtimeEnforcers(policy string) (*casbin.Enforcer, *casbin.Enforcer) {
+	if policy == "" {
+		return e.Enforcer, e.denyEnforcer
+	}
+	adapter := newAdapter(e.adapter.builtinPolicy, e.adapter.userDefinedPolicy, policy)
+	runtimeEnf, err := newEnforcerSafe(newBuiltInModel(), adapter)
+	var runtimeDenyEnf *casbin.Enforcer
+	if err == nil {
+		runtimeDenyEnf, err = newEnforcerSafe(newDenyModel(), adapter)
+	}
+	if err != nil {
+		log.Warnf("invalid runtime policy: %s", policy)
+		return e.Enforcer, e.denyEnforcer
+	}
+	return runtimeEnf, runtimeDenyEnf
+}
+
+// enforce is a helper to additionally check a default role and invoke a custom claims enforcement function. The
+// default role is checked only if the subject is not explicitly denied, so it does not override denies of the subject.
+func enforce(enf *casbin.Enforcer, denyEnf *casbin.Enforcer, defaultRole string, claimsEnforcerFunc ClaimsEnforcerFunc, rvals ...interface{}) bool {
+	// requests made with a scoped token need to match one of its scopes, regardless of the roles of the subject
+	if len(rvals) > 0 {
+		if claims, ok := rvals[0].(jwt.Claims); ok {
+			if scopes, restricted := ScopesFromClaims(claims); restricted && !scopesPermit(scopes, rvals[1:]...) {
+				return false
+			}
+		}
+	}
 	if len(rvals) == 0 {
 		return false
 	}
@@ -180,28 +276,60 @@ func enforce(enf *casbin.Enforcer, defaultRole string, claimsEnforcerFunc Claims
 	sub := rvals[0]
 	switch s := sub.(type) {
 	case string:
-		// noop
+		if s != "" && !denyEnf.Enforce(rvals...) {
+			return false
+		}
 	case jwt.Claims:
-		if claimsEnforcerFunc != nil && claimsEnforcerFunc(s, rvals...) {
-			return true
+		// the claims enforcer falls back to the default role itself, since only it knows the subjects of the claims
+		// whose explicit denies the default role must not override
+		if claimsEnforcerFunc != nil {
+			return claimsEnforcerFunc(s, rvals...) || enf.Enforce(append([]interface{}{""}, rvals[1:]...)...)
 		}
 		rvals = append([]interface{}{""}, rvals[1:]...)
 	default:
 		rvals = append([]interface{}{""}, rvals[1:]...)
 	}
-	return enf.Enforce(rvals...)
+	if enf.Enforce(rvals...) {
+		return true
+	}
+	return enforceDefaultRole(enf, defaultRole, rvals[1:]...)
 }
 
 // SetBuiltinPolicy sets a built-in policy, which augments any user defined policies
 func (e *Enforcer) SetBuiltinPolicy(policy string) error {
 	e.adapter.builtinPolicy = policy
-	return e.LoadPolicy()
+	return e.loadPolicy()
 }
 
 // SetUserPolicy sets a user policy, augmenting the built-in policy
 func (e *Enforcer) SetUserPolicy(policy string) error {
 	e.adapter.userDefinedPolicy = policy
-	return e.LoadPolicy()
+	return e.loadPolicy()
+}
+
+// loadPolicy loads the policy and schedules a reload at the time the next role binding expires
+func (e *Enforcer) loadPolicy() error {
+	e.expiryLock.Lock()
+	defer e.expiryLock.Unlock()
+	if e.expiryTimer != nil {
+		e.expiryTimer.Stop()
+		e.expiryTimer = nil
+	}
+	if err := e.LoadPolicy(); err != nil {
+		return err
+	}
+	if err := e.denyEnforcer.LoadPolicy(); err != nil {
+		return err
+	}
+	if nextExpiry := e.adapter.nextExpiry; !nextExpiry.IsZero() {
+		e.expiryTimer = time.AfterFunc(time.Until(nextExpiry), func() {
+			log.Infof("Reloading RBAC policy due to expired role binding")
+			if err := e.loadPolicy(); err != nil {
+				log.Warnf("Failed to reload RBAC policy: %v", err)
+			}
+		})
+	}
+	return nil
 }
 
 // newInformers returns an informer which watches updates on the rbac configmap
@@ -294,11 +422,20 @@ func newBuiltInModel() model.Model {
 	return casbin.NewModel(assets.ModelConf)
 }
 
+// newDenyModel returns the built-in model with the policy effect replaced, so that it only evaluates explicit denies
+func newDenyModel() model.Model {
+	m := newBuiltInModel()
+	m.AddDef("e", "e", denyEffect)
+	return m
+}
+
 // Casbin adapter which satisfies persist.Adapter interface
 type argocdAdapter struct {
 	builtinPolicy     string
 	userDefinedPolicy string
 	runtimePolicy     string
+	// nextExpiry is the time the next role binding of the loaded policy expires
+	nextExpiry time.Time
 }
 
 func newAdapter(builtinPolicy, userDefinedPolicy, runtimePolicy string) *argocdAdapter {
@@ -310,34 +447,64 @@ func newAdapter(builtinPolicy, userDefinedPolicy, runtimePolicy string) *argocdA
 }
 
 func (a *argocdAdapter) LoadPolicy(model model.Model) error {
+	a.nextExpiry = time.Time{}
+	now := time.Now()
 	for _, policyStr := range []string{a.builtinPolicy, a.userDefinedPolicy, a.runtimePolicy} {
 		for _, line := range strings.Split(policyStr, "\n") {
-			if err := loadPolicyLine(strings.TrimSpace(line), model); err != nil {
+			expiry, err := loadPolicyLine(strings.TrimSpace(line), model, now)
+			if err != nil {
 				return err
 			}
+			if !expiry.IsZero() && (a.nextExpiry.IsZero() || expiry.Before(a.nextExpiry)) {
+				a.nextExpiry = expiry
+			}
 		}
 	}
 	return nil
 }
 
 // The modified version of LoadPolicyLine function defined in "persist" package of github.com/casbin/casbin.
-// Uses CVS parser to correctly handle quotes in policy line.
-func loadPolicyLine(line string, model model.Model) error {
+// Uses CVS parser to correctly handle quotes in policy line. Role bindings which expired at the given time are
+// skipped, the expiry of the loaded role binding is returned.
+func loadPolicyLine(line string, model model.Model, now time.Time) (time.Time, error) {
+	tokens, expiry, err := parsePolicyLine(line, now)
+	if err != nil || tokens == nil {
+		return time.Time{}, err
+	}
+
+	key := tokens[0]
+	sec := key[:1]
+	model[sec][key].Policy = append(model[sec][key].Policy, tokens[1:])
+	return expiry, nil
+}
+
+// parsePolicyLine parses the tokens of a policy line. Role bindings may be followed by the RFC 3339 time they expire
+// at, e.g. 'g, alice, role:admin, 2021-06-01T00:00:00Z'. The expiry is stripped from the tokens and returned. Nil
+// tokens are returned for empty lines, comments and role bindings which expired at the given time.
+func parsePolicyLine(line string, now time.Time) ([]string, time.Time, error) {
 	if line == "" || strings.HasPrefix(line, "#") {
-		return nil
+		return nil, time.Time{}, nil
 	}
 
 	reader := csv.NewReader(strings.NewReader(line))
 	reader.TrimLeadingSpace = true
 	tokens, err := reader.Read()
 	if err != nil {
-		return err
+		return nil, time.Time{}, err
 	}
 
-	key := tokens[0]
-	sec := key[:1]
-	model[sec][key].Policy = append(model[sec][key].Policy, tokens[1:])
-	return nil
+	var expiry time.Time
+	if tokens[0] == "g" && len(tokens) == 4 {
+		expiry, err = time.Parse(time.RFC3339, strings.TrimSpace(tokens[3]))
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf("invalid expiry of role binding '%s': %v", line, err)
+		}
+		if !now.Before(expiry) {
+			return nil, time.Time{}, nil
+		}
+		tokens = tokens[:3]
+	}
+	return tokens, expiry, nil
 }
 
 func (a *argocdAdapter) SavePolicy(model model.Model) error {
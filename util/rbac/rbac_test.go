@@ -115,6 +115,85 @@ g, alice, role:foo-readonly
 	assert.False(t, enf.Enforce("bob", "applications", "get", "foo/obj"))
 }
 
+// TestResourceLevelActions verifies that actions also grant their resource-level sub-actions, which can be denied
+func TestResourceLevelActions(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset(fakeConfigMap())
+	enf := NewEnforcer(kubeclientset, fakeNamespace, fakeConfigMapName, nil)
+	policy := `
+p, alice, applications, delete, foo/*, allow
+p, alice, applications, delete/*/Secret/*/*, foo/*, deny
+p, bob, applications, get/*/Pod/default/*, foo/*, allow
+`
+	_ = enf.SetBuiltinPolicy(policy)
+
+	assert.True(t, enf.Enforce("alice", "applications", "delete", "foo/obj"))
+	assert.True(t, enf.Enforce("alice", "applications", "delete/apps/Deployment/default/guestbook", "foo/obj"))
+	assert.False(t, enf.Enforce("alice", "applications", "delete//Secret/default/guestbook", "foo/obj"))
+	assert.False(t, enf.Enforce("alice", "applications", "deleted/apps/Deployment/default/guestbook", "foo/obj"))
+	assert.True(t, enf.Enforce("bob", "applications", "get//Pod/default/guestbook", "foo/obj"))
+	assert.False(t, enf.Enforce("bob", "applications", "get//Pod/kube-system/guestbook", "foo/obj"))
+	assert.False(t, enf.Enforce("bob", "applications", "get", "foo/obj"))
+}
+
+// TestExpiringRoleBindings tests role bindings which expire at a given time
+func TestExpiringRoleBindings(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset(fakeConfigMap())
+	enf := NewEnforcer(kubeclientset, fakeNamespace, fakeConfigMapName, nil)
+	policy := fmt.Sprintf(`
+p, role:admin, applications, get, */*, allow
+g, alice, role:admin, %s
+g, bob, role:admin, %s
+g, carol, role:admin, %s
+`, time.Now().Add(-time.Minute).Format(time.RFC3339), time.Now().Add(time.Hour).Format(time.RFC3339), time.Now().Add(2*time.Second).Format(time.RFC3339))
+	assert.NoError(t, enf.SetUserPolicy(policy))
+
+	assert.False(t, enf.Enforce("alice", "applications", "get", "foo/bar"))
+	assert.True(t, enf.Enforce("bob", "applications", "get", "foo/bar"))
+	assert.True(t, enf.Enforce("carol", "applications", "get", "foo/bar"))
+
+	// the policy is reloaded as soon as the role binding of carol expires
+	assert.Eventually(t, func() bool {
+		return !enf.Enforce("carol", "applications", "get", "foo/bar")
+	}, 10*time.Second, 100*time.Millisecond)
+	assert.True(t, enf.Enforce("bob", "applications", "get", "foo/bar"))
+
+	assert.Error(t, enf.SetUserPolicy("g, alice, role:admin, tomorrow"))
+}
+
+// TestExplain tests the explanation of allowed and denied requests
+func TestExplain(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset(fakeConfigMap())
+	enf := NewEnforcer(kubeclientset, fakeNamespace, fakeConfigMapName, nil)
+	_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+	_ = enf.SetUserPolicy(`
+p, role:ops, applications, *, */*, allow
+p, role:ops, applications, delete, prod/*, deny
+g, role:ops, role:readonly
+g, alice, role:ops
+`)
+
+	explanation := enf.Explain("alice", "applications", "delete", "prod/guestbook")
+	assert.False(t, explanation.Allowed)
+	assert.Equal(t, []PolicyMatch{
+		{Source: PolicySourceUser, Line: "p, role:ops, applications, *, */*, allow", Effect: "allow", Roles: []string{"alice", "role:ops"}},
+		{Source: PolicySourceUser, Line: "p, role:ops, applications, delete, prod/*, deny", Effect: "deny", Roles: []string{"alice", "role:ops"}},
+	}, explanation.Matches)
+
+	explanation = enf.Explain("alice", "clusters", "get", "https://kubernetes.default.svc")
+	assert.True(t, explanation.Allowed)
+	assert.Equal(t, []PolicyMatch{
+		{Source: PolicySourceBuiltin, Line: "p, role:readonly, clusters, get, *, allow", Effect: "allow", Roles: []string{"alice", "role:ops", "role:readonly"}},
+	}, explanation.Matches)
+
+	enf.SetDefaultRole("role:readonly")
+	explanation = enf.Explain("bob", "applications", "get", "default/guestbook")
+	assert.True(t, explanation.Allowed)
+	assert.Equal(t, "role:readonly", explanation.DefaultRole)
+	assert.Equal(t, []PolicyMatch{
+		{Source: PolicySourceBuiltin, Line: "p, role:readonly, applications, get, */*, allow", Effect: "allow", Roles: []string{"role:readonly"}},
+	}, explanation.Matches)
+}
+
 // TestDefaultRole tests the ability to set a default role
 func TestDefaultRole(t *testing.T) {
 	kubeclientset := fake.NewSimpleClientset()
@@ -129,6 +208,29 @@ func TestDefaultRole(t *testing.T) {
 	assert.True(t, enf.Enforce("bob", "applications", "get", "foo/bar"))
 }
 
+// TestDefaultRole_ExplicitDeny tests that the default role does not override the explicit denies of the subject
+func TestDefaultRole_ExplicitDeny(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset()
+	enf := NewEnforcer(kubeclientset, fakeNamespace, fakeConfigMapName, nil)
+	_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+	_ = enf.SetUserPolicy(`
+p, role:contractor, applications, get, secret/*, deny
+g, alice, role:contractor
+`)
+	enf.SetDefaultRole("role:readonly")
+
+	assert.False(t, enf.Enforce("alice", "applications", "get", "secret/guestbook"))
+	assert.True(t, enf.Enforce("alice", "applications", "get", "default/guestbook"))
+	assert.True(t, enf.Enforce("bob", "applications", "get", "secret/guestbook"))
+	assert.False(t, enf.Explain("alice", "applications", "get", "secret/guestbook").Allowed)
+
+	assert.False(t, enf.EnforceRuntimePolicy("p, proj:secret:ci, applications, get, secret/*, deny\ng, bob, proj:secret:ci", "bob", "applications", "get", "secret/guestbook"))
+	assert.True(t, enf.EnforceRuntimePolicy("p, proj:secret:ci, applications, get, secret/*, deny\ng, bob, proj:secret:ci", "carol", "applications", "get", "secret/guestbook"))
+
+	enf.EnableEnforce(false)
+	assert.True(t, enf.Enforce("alice", "applications", "get", "secret/guestbook"))
+}
+
 // TestURLAsObjectName tests the ability to have a URL as an object name
 func TestURLAsObjectName(t *testing.T) {
 	kubeclientset := fake.NewSimpleClientset()
@@ -228,6 +330,47 @@ func TestClaimsEnforcerFunc(t *testing.T) {
 	assert.True(t, enf.Enforce(&claims, "applications", "get", "foo/bar"))
 }
 
+func TestScopedClaims(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset()
+	enf := NewEnforcer(kubeclientset, fakeNamespace, fakeConfigMapName, nil)
+	enf.SetClaimsEnforcerFunc(func(claims jwt.Claims, rvals ...interface{}) bool {
+		return true
+	})
+	claims := jwt.MapClaims{"sub": "foo", ScopesClaim: []interface{}{"applications:sync:proj/*", "clusters:get"}}
+
+	assert.True(t, enf.Enforce(claims, "applications", "sync", "proj/guestbook"))
+	assert.True(t, enf.Enforce(claims, "applications", "sync/apps/Deployment/default/guestbook", "proj/guestbook"))
+	assert.True(t, enf.Enforce(claims, "clusters", "get", "https://kubernetes.default.svc"))
+	assert.False(t, enf.Enforce(claims, "applications", "get", "proj/guestbook"))
+	assert.False(t, enf.Enforce(claims, "applications", "sync", "other/guestbook"))
+	assert.False(t, enf.Enforce(claims, "clusters", "delete", "https://kubernetes.default.svc"))
+
+	// the default role does not extend the scopes either
+	enf.SetDefaultRole("role:admin")
+	assert.False(t, enf.Enforce(claims, "applications", "delete", "proj/guestbook"))
+
+	// a token restricted to no scopes is not permitted anything
+	assert.False(t, enf.Enforce(jwt.MapClaims{"sub": "foo", ScopesClaim: []interface{}{}}, "applications", "sync", "proj/guestbook"))
+}
+
+func TestParseScope(t *testing.T) {
+	resource, action, object, err := ParseScope("applications:sync:proj/*")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"applications", "sync", "proj/*"}, []string{resource, action, object})
+
+	_, _, object, err = ParseScope("repositories:get")
+	assert.NoError(t, err)
+	assert.Equal(t, "*", object)
+
+	_, _, object, err = ParseScope("clusters:get:https://kubernetes.default.svc")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://kubernetes.default.svc", object)
+
+	assert.NoError(t, ValidateScopes([]string{"applications:*"}))
+	assert.EqualError(t, ValidateScopes([]string{"applications"}), "invalid token scope 'applications': expected <resource>:<action>[:<object>]")
+	assert.Error(t, ValidateScopes([]string{":sync"}))
+}
+
 // TestDefaultRoleWithRuntimePolicy tests the ability for a default role to still take affect when
 // enforcing a runtime policy
 func TestDefaultRoleWithRuntimePolicy(t *testing.T) {
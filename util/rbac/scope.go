@@ -0,0 +1,80 @@
+package rbac
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/vathsalashetty96/argo-cd/util/glob"
+	jwtutil "github.com/vathsalashetty96/argo-cd/util/jwt"
+
+	jwt "github.com/dgrijalva/jwt-go/v4"
+)
+
+// ScopesClaim is the claim holding the scopes a token is restricted to. Requests made with a token carrying this claim
+// are only permitted if they match one of the scopes in addition to the policy.
+const ScopesClaim = "scp"
+
+// ParseScope parses a token scope of the form '<resource>:<action>[:<object>]', e.g. 'applications:sync:proj/*'.
+// Resource, action and object may be glob patterns, the object defaults to '*' and may itself contain colons.
+func ParseScope(scope string) (string, string, string, error) {
+	parts := strings.SplitN(scope, ":", 3)
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", "", fmt.Errorf("invalid token scope '%s': expected <resource>:<action>[:<object>]", scope)
+	}
+	object := "*"
+	if len(parts) == 3 && parts[2] != "" {
+		object = parts[2]
+	}
+	return parts[0], parts[1], object, nil
+}
+
+// ValidateScopes returns an error if any of the given token scopes is malformed
+func ValidateScopes(scopes []string) error {
+	for _, scope := range scopes {
+		if _, _, _, err := ParseScope(scope); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ScopesFromClaims returns the scopes the claims are restricted to, and whether they are restricted at all
+func ScopesFromClaims(claims jwt.Claims) ([]string, bool) {
+	mapClaims, err := jwtutil.MapClaims(claims)
+	if err != nil {
+		return nil, false
+	}
+	scopesIf, ok := mapClaims[ScopesClaim]
+	if !ok {
+		return nil, false
+	}
+	scopes := make([]string, 0)
+	if values, ok := scopesIf.([]interface{}); ok {
+		for _, value := range values {
+			if scope, ok := value.(string); ok {
+				scopes = append(scopes, scope)
+			}
+		}
+	}
+	return scopes, true
+}
+
+// scopesPermit returns whether any of the scopes matches the resource, action and object of the request
+func scopesPermit(scopes []string, rvals ...interface{}) bool {
+	if len(rvals) < 3 {
+		return false
+	}
+	resource, _ := rvals[0].(string)
+	action, _ := rvals[1].(string)
+	object, _ := rvals[2].(string)
+	for _, scope := range scopes {
+		scopeResource, scopeAction, scopeObject, err := ParseScope(scope)
+		if err != nil {
+			continue
+		}
+		if glob.Match(scopeResource, resource) && actionMatch(scopeAction, action) && glob.Match(scopeObject, object) {
+			return true
+		}
+	}
+	return false
+}
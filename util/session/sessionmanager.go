@@ -2,6 +2,8 @@ package session
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,6 +12,8 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"strconv"
+	"sync"
 	"time"
 
 	oidc "github.com/coreos/go-oidc"
@@ -27,6 +31,7 @@ import (
 	jwtutil "github.com/vathsalashetty96/argo-cd/util/jwt"
 	oidcutil "github.com/vathsalashetty96/argo-cd/util/oidc"
 	passwordutil "github.com/vathsalashetty96/argo-cd/util/password"
+	"github.com/vathsalashetty96/argo-cd/util/rbac"
 	"github.com/vathsalashetty96/argo-cd/util/settings"
 )
 
@@ -38,14 +43,24 @@ type SessionManager struct {
 	storage                       UserStateStorage
 	sleep                         func(d time.Duration)
 	verificationDelayNoiseEnabled bool
+	tokenUsageLock                sync.Mutex
+	tokenUsage                    map[string]time.Time
+	maxSessionDuration            time.Duration
 }
 
 type inMemoryUserStateStorage struct {
-	attempts map[string]LoginAttempts
+	attempts        map[string]LoginAttempts
+	lock            sync.RWMutex
+	revokedTokens   map[string]time.Time
+	revokedSessions map[string]time.Time
 }
 
 func NewInMemoryUserStateStorage() *inMemoryUserStateStorage {
-	return &inMemoryUserStateStorage{attempts: map[string]LoginAttempts{}}
+	return &inMemoryUserStateStorage{
+		attempts:        map[string]LoginAttempts{},
+		revokedTokens:   map[string]time.Time{},
+		revokedSessions: map[string]time.Time{},
+	}
 }
 
 func (storage *inMemoryUserStateStorage) GetLoginAttempts(attempts *map[string]LoginAttempts) error {
@@ -58,9 +73,51 @@ func (storage *inMemoryUserStateStorage) SetLoginAttempts(attempts map[string]Lo
 	return nil
 }
 
+func (storage *inMemoryUserStateStorage) RevokeToken(id string, expiringIn time.Duration) error {
+	storage.lock.Lock()
+	defer storage.lock.Unlock()
+	var expiresAt time.Time
+	if expiringIn > 0 {
+		expiresAt = time.Now().Add(expiringIn)
+	}
+	storage.revokedTokens[id] = expiresAt
+	return nil
+}
+
+func (storage *inMemoryUserStateStorage) IsTokenRevoked(id string) bool {
+	storage.lock.RLock()
+	defer storage.lock.RUnlock()
+	expiresAt, ok := storage.revokedTokens[id]
+	return ok && (expiresAt.IsZero() || time.Now().Before(expiresAt))
+}
+
+func (storage *inMemoryUserStateStorage) RevokeSessions(subject string, before time.Time, _ time.Duration) error {
+	storage.lock.Lock()
+	defer storage.lock.Unlock()
+	storage.revokedSessions[subject] = before
+	return nil
+}
+
+func (storage *inMemoryUserStateStorage) GetSessionsRevokedAt(subject string) time.Time {
+	storage.lock.RLock()
+	defer storage.lock.RUnlock()
+	return storage.revokedSessions[subject]
+}
+
+// UserStateStorage stores the failed login attempts and the revoked tokens and sessions of users
 type UserStateStorage interface {
 	GetLoginAttempts(attempts *map[string]LoginAttempts) error
 	SetLoginAttempts(attempts map[string]LoginAttempts) error
+	// RevokeToken revokes the token with the given id. The revocation is kept until the token expires, or forever if
+	// expiringIn is zero.
+	RevokeToken(id string, expiringIn time.Duration) error
+	// IsTokenRevoked returns whether the token with the given id was revoked
+	IsTokenRevoked(id string) bool
+	// RevokeSessions revokes all sessions of the subject which were issued before the given time. The revocation is
+	// kept for expiringIn, after which no session issued before that time is valid anymore.
+	RevokeSessions(subject string, before time.Time, expiringIn time.Duration) error
+	// GetSessionsRevokedAt returns the time before which all sessions of the subject were revoked, or the zero time
+	GetSessionsRevokedAt(subject string) time.Time
 }
 
 // LoginAttempts is a timestamped counter for failed login attempts
@@ -92,10 +149,17 @@ const (
 	defaultMaxLoginFailures = 5
 	// The default time in seconds for the failure window
 	defaultFailureWindow = 300
+	// The default maximum lifetime of a login session in seconds
+	defaultMaxSessionDuration = 24 * 60 * 60
 	// The password verification delay max
 	verificationDelayNoiseMin = 500 * time.Millisecond
 	// The password verification delay max
 	verificationDelayNoiseMax = 1000 * time.Millisecond
+	// The minimum interval in which the last use of a token is persisted
+	tokenUsageUpdateInterval = 5 * time.Minute
+	// The claim holding the nanoseconds of the second a login session was issued in. The "iat" claim only has a
+	// precision of seconds, which is not enough to tell apart sessions issued in the same second.
+	issuedAtNanosClaim = "iat_ns"
 
 	// environment variables to control rate limiter behaviour:
 
@@ -107,6 +171,9 @@ const (
 
 	// Max number of stored usernames
 	envLoginMaxCacheSize = "ARGOCD_SESSION_MAX_CACHE_SIZE"
+
+	// Number of seconds after which a login session expires. Default: 86400 (24 hours).
+	envSessionMaxDurationSeconds = "ARGOCD_SESSION_MAX_DURATION_SECONDS"
 )
 
 var (
@@ -128,6 +195,11 @@ func getLoginFailureWindow() time.Duration {
 	return time.Duration(env.ParseNumFromEnv(envLoginFailureWindowSeconds, defaultFailureWindow, 0, math.MaxInt32))
 }
 
+// Returns the maximum lifetime of a login session
+func getMaxSessionDuration() time.Duration {
+	return time.Duration(env.ParseNumFromEnv(envSessionMaxDurationSeconds, defaultMaxSessionDuration, 1, math.MaxInt32)) * time.Second
+}
+
 // NewSessionManager creates a new session manager from Argo CD settings
 func NewSessionManager(settingsMgr *settings.SettingsManager, dexServerAddr string, storage UserStateStorage) *SessionManager {
 	s := SessionManager{
@@ -135,6 +207,7 @@ func NewSessionManager(settingsMgr *settings.SettingsManager, dexServerAddr stri
 		storage:                       storage,
 		sleep:                         time.Sleep,
 		verificationDelayNoiseEnabled: true,
+		maxSessionDuration:            getMaxSessionDuration(),
 	}
 	settings, err := settingsMgr.GetSettings()
 	if err != nil {
@@ -167,9 +240,16 @@ func NewSessionManager(settingsMgr *settings.SettingsManager, dexServerAddr stri
 }
 
 // Create creates a new token for a given subject (user) and returns it as a string.
-// Passing a value of `0` for secondsBeforeExpiry creates a token that never expires.
+// Passing a value of `0` for secondsBeforeExpiry creates a token that never expires, except for login sessions
+// (tokens without id), which expire after the maximum session duration.
 // The id parameter holds an optional unique JWT token identifier and stored as a standard claim "jti" in the JWT token.
 func (mgr *SessionManager) Create(subject string, secondsBeforeExpiry int64, id string) (string, error) {
+	return mgr.CreateWithScopes(subject, secondsBeforeExpiry, id, nil)
+}
+
+// CreateWithScopes creates a new token like Create, which is additionally restricted to the given scopes if any are
+// passed. The scopes are stored in the "scp" claim of the token, see rbac.ParseScope for their format.
+func (mgr *SessionManager) CreateWithScopes(subject string, secondsBeforeExpiry int64, id string, scopes []string) (string, error) {
 	// Create a new token object, specifying signing method and the claims
 	// you would like it to contain.
 	now := time.Now().UTC()
@@ -183,11 +263,34 @@ func (mgr *SessionManager) Create(subject string, secondsBeforeExpiry int64, id
 	if secondsBeforeExpiry > 0 {
 		expires := now.Add(time.Duration(secondsBeforeExpiry) * time.Second)
 		claims.ExpiresAt = jwt.At(expires)
+	} else if id == "" {
+		claims.ExpiresAt = jwt.At(now.Add(mgr.maxSessionDuration))
+	}
+	if len(scopes) > 0 {
+		if err := rbac.ValidateScopes(scopes); err != nil {
+			return "", err
+		}
+		return mgr.signClaims(scopedClaims{StandardClaims: claims, Scopes: scopes})
+	}
+	if id == "" {
+		return mgr.signClaims(sessionClaims{StandardClaims: claims, IssuedAtNanos: int64(now.Nanosecond())})
 	}
 
 	return mgr.signClaims(claims)
 }
 
+// sessionClaims are the claims of a login session
+type sessionClaims struct {
+	jwt.StandardClaims
+	IssuedAtNanos int64 `json:"iat_ns"`
+}
+
+// scopedClaims are the claims of a token which is restricted to scopes
+type scopedClaims struct {
+	jwt.StandardClaims
+	Scopes []string `json:"scp"`
+}
+
 type standardClaims struct {
 	Audience  jwt.ClaimStrings `json:"aud,omitempty"`
 	ExpiresAt int64            `json:"exp,omitempty"`
@@ -196,6 +299,21 @@ type standardClaims struct {
 	Issuer    string           `json:"iss,omitempty"`
 	NotBefore int64            `json:"nbf,omitempty"`
 	Subject   string           `json:"sub,omitempty"`
+	Scopes    []string         `json:"scp,omitempty"`
+	// IssuedAtNanos is a pointer, since 0 is a valid value
+	IssuedAtNanos *int64 `json:"iat_ns,omitempty"`
+}
+
+func toStandardClaims(std jwt.StandardClaims) standardClaims {
+	return standardClaims{
+		Audience:  std.Audience,
+		ExpiresAt: unixTimeOrZero(std.ExpiresAt),
+		ID:        std.ID,
+		IssuedAt:  unixTimeOrZero(std.IssuedAt),
+		Issuer:    std.Issuer,
+		NotBefore: unixTimeOrZero(std.NotBefore),
+		Subject:   std.Subject,
+	}
 }
 
 func unixTimeOrZero(t *jwt.Time) int64 {
@@ -217,16 +335,17 @@ func (mgr *SessionManager) signClaims(claims jwt.Claims) (string, error) {
 	// number of seconds from 1970-01-01T00:00:00Z UTC until the specified UTC date/time.
 	// The https://github.com/dgrijalva/jwt-go marshals time as non integer.
 	return token.SignedString(settings.ServerSignature, jwt.WithMarshaller(func(ctx jwt.CodingContext, v interface{}) ([]byte, error) {
-		if std, ok := v.(jwt.StandardClaims); ok {
-			return json.Marshal(standardClaims{
-				Audience:  std.Audience,
-				ExpiresAt: unixTimeOrZero(std.ExpiresAt),
-				ID:        std.ID,
-				IssuedAt:  unixTimeOrZero(std.IssuedAt),
-				Issuer:    std.Issuer,
-				NotBefore: unixTimeOrZero(std.NotBefore),
-				Subject:   std.Subject,
-			})
+		switch claims := v.(type) {
+		case jwt.StandardClaims:
+			return json.Marshal(toStandardClaims(claims))
+		case scopedClaims:
+			std := toStandardClaims(claims.StandardClaims)
+			std.Scopes = claims.Scopes
+			return json.Marshal(std)
+		case sessionClaims:
+			std := toStandardClaims(claims.StandardClaims)
+			std.IssuedAtNanos = &claims.IssuedAtNanos
+			return json.Marshal(std)
 		}
 		return json.Marshal(v)
 	}))
@@ -264,8 +383,16 @@ func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, error) {
 		return nil, err
 	}
 
-	if id := jwtutil.StringField(claims, "jti"); id != "" && account.TokenIndex(id) == -1 {
-		return nil, fmt.Errorf("account %s does not have token with id %s", subject, id)
+	if id := jwtutil.StringField(claims, "jti"); id != "" {
+		index := account.TokenIndex(id)
+		if index == -1 {
+			return nil, fmt.Errorf("account %s does not have token with id %s", subject, id)
+		}
+		accountToken := account.Tokens[index]
+		if revokeAfter := settings.RevokeUnusedTokensAfter; isTokenUnused(accountToken, revokeAfter) {
+			return nil, fmt.Errorf("token with id %s of account %s was not used for %s", id, subject, revokeAfter)
+		}
+		mgr.recordTokenUsage(subject, accountToken)
 	}
 
 	issuedAt, err := jwtutil.IssuedAtTime(claims)
@@ -278,6 +405,97 @@ func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, error) {
 	return token.Claims, nil
 }
 
+// recordTokenUsage persists the time the token of the account was used. To limit the updates of the secret, the time
+// is persisted at most once per tokenUsageUpdateInterval and token.
+func (mgr *SessionManager) recordTokenUsage(subject string, token settings.Token) {
+	now := time.Now()
+	if token.LastUsedAt > 0 && now.Sub(time.Unix(token.LastUsedAt, 0)) < tokenUsageUpdateInterval {
+		return
+	}
+	key := subject + "/" + token.ID
+	mgr.tokenUsageLock.Lock()
+	if mgr.tokenUsage == nil {
+		mgr.tokenUsage = map[string]time.Time{}
+	}
+	if lastUpdate, ok := mgr.tokenUsage[key]; ok && now.Sub(lastUpdate) < tokenUsageUpdateInterval {
+		mgr.tokenUsageLock.Unlock()
+		return
+	}
+	mgr.tokenUsage[key] = now
+	mgr.tokenUsageLock.Unlock()
+
+	err := mgr.settingsMgr.UpdateAccount(subject, func(account *settings.Account) error {
+		if index := account.TokenIndex(token.ID); index > -1 {
+			account.Tokens[index].LastUsedAt = now.Unix()
+		}
+		return nil
+	})
+	if err != nil {
+		log.Warnf("Failed to record usage of token %s of account %s: %v", token.ID, subject, err)
+	}
+}
+
+// isTokenUnused returns whether the token was not used, or was never used since it was issued, for longer than the
+// given duration. Tokens are never considered unused if the duration is zero.
+func isTokenUnused(token settings.Token, revokeAfter time.Duration) bool {
+	return revokeAfter > 0 && time.Since(token.LastActivity()) > revokeAfter
+}
+
+// RevokedToken is a token of a local account which was revoked
+type RevokedToken struct {
+	Account string
+	ID      string
+	// LastActivity is the time the token was last used, or the time it was issued if it was never used
+	LastActivity time.Time
+}
+
+// RevokeUnusedTokens deletes the tokens of local accounts which were not used for longer than the duration configured
+// by users.tokens.revokeUnusedAfter and returns the revoked tokens. Nothing is revoked if the duration is not
+// configured. Parse rejects such tokens even before they are revoked.
+func (mgr *SessionManager) RevokeUnusedTokens() ([]RevokedToken, error) {
+	argoSettings, err := mgr.settingsMgr.GetSettings()
+	if err != nil {
+		return nil, err
+	}
+	revokeAfter := argoSettings.RevokeUnusedTokensAfter
+	if revokeAfter <= 0 {
+		return nil, nil
+	}
+	accounts, err := mgr.settingsMgr.GetAccounts()
+	if err != nil {
+		return nil, err
+	}
+	var revoked []RevokedToken
+	for name, account := range accounts {
+		hasUnusedTokens := false
+		for _, token := range account.Tokens {
+			hasUnusedTokens = hasUnusedTokens || isTokenUnused(token, revokeAfter)
+		}
+		if !hasUnusedTokens {
+			continue
+		}
+		var accountRevoked []RevokedToken
+		err := mgr.settingsMgr.UpdateAccount(name, func(account *settings.Account) error {
+			tokens := make([]settings.Token, 0, len(account.Tokens))
+			for _, token := range account.Tokens {
+				if isTokenUnused(token, revokeAfter) {
+					accountRevoked = append(accountRevoked, RevokedToken{Account: name, ID: token.ID, LastActivity: token.LastActivity()})
+				} else {
+					tokens = append(tokens, token)
+				}
+			}
+			account.Tokens = tokens
+			return nil
+		})
+		if err != nil {
+			log.Warnf("Failed to revoke unused tokens of account %s: %v", name, err)
+			continue
+		}
+		revoked = append(revoked, accountRevoked...)
+	}
+	return revoked, nil
+}
+
 // GetLoginFailures retrieves the login failure information from the cache
 func (mgr *SessionManager) GetLoginFailures() map[string]LoginAttempts {
 	// Get failures from the cache
@@ -457,9 +675,103 @@ func (mgr *SessionManager) VerifyUsernamePassword(username string, password stri
 	return nil
 }
 
-// VerifyToken verifies if a token is correct. Tokens can be issued either from us or by an IDP.
+// VerifyToken verifies if a token is correct and was not revoked. Tokens can be issued either from us or by an IDP.
 // We choose how to verify based on the issuer.
 func (mgr *SessionManager) VerifyToken(tokenString string) (jwt.Claims, error) {
+	claims, err := mgr.verifyToken(tokenString)
+	if err != nil {
+		return claims, err
+	}
+	if err := mgr.ensureNotRevoked(claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// tokenIssuedAt returns the time the token was issued, in nanoseconds for login sessions issued by Argo CD
+func tokenIssuedAt(claims jwt.MapClaims) (time.Time, error) {
+	issuedAt, err := jwtutil.IssuedAt(claims)
+	if err != nil {
+		return time.Unix(0, 0), err
+	}
+	var nanos int64
+	if jwtutil.StringField(claims, "iss") == SessionManagerClaimsIssuer {
+		switch v := claims[issuedAtNanosClaim].(type) {
+		case float64:
+			nanos = int64(v)
+		case json.Number:
+			nanos, _ = v.Int64()
+		}
+	}
+	return time.Unix(issuedAt, nanos), nil
+}
+
+// tokenRevocationID returns the id under which a token is revoked: a hash of its issuer, subject and either its
+// "jti" claim or, for tokens without an id like login sessions, the time in nanoseconds it was issued and its nonce.
+// SSO tokens only have a precision of seconds, so they can only be told apart by their nonce if they have one.
+func tokenRevocationID(claims jwt.MapClaims) string {
+	id := jwtutil.StringField(claims, "jti")
+	if id == "" {
+		issuedAt, _ := tokenIssuedAt(claims)
+		id = strconv.FormatInt(issuedAt.UnixNano(), 10) + "|" + jwtutil.StringField(claims, "nonce")
+	}
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s|%s|%s", jwtutil.StringField(claims, "iss"), jwtutil.StringField(claims, "sub"), id)))
+	return hex.EncodeToString(hash[:])
+}
+
+// isAPIToken returns whether the claims are of an API token issued by Argo CD rather than of a login session. API
+// tokens are revoked by deleting them from their account or project role.
+func isAPIToken(claims jwt.MapClaims) bool {
+	return jwtutil.StringField(claims, "iss") == SessionManagerClaimsIssuer && jwtutil.StringField(claims, "jti") != ""
+}
+
+// ensureNotRevoked returns an error if the token was revoked, either by itself or with all sessions of its subject
+func (mgr *SessionManager) ensureNotRevoked(claims jwt.Claims) error {
+	mapClaims, err := jwtutil.MapClaims(claims)
+	if err != nil {
+		return err
+	}
+	if mgr.storage.IsTokenRevoked(tokenRevocationID(mapClaims)) {
+		return errors.New("token is revoked, please re-login")
+	}
+	if isAPIToken(mapClaims) {
+		return nil
+	}
+	// Revocations of sessions are only kept for the maximum session duration, so older sessions must not be valid anymore
+	issuedAt, err := tokenIssuedAt(mapClaims)
+	if err == nil && time.Since(issuedAt) > mgr.maxSessionDuration {
+		return errors.New("session is expired, please re-login")
+	}
+	if revokedAt := mgr.storage.GetSessionsRevokedAt(jwtutil.StringField(mapClaims, "sub")); !revokedAt.IsZero() {
+		if err != nil || !issuedAt.After(revokedAt) {
+			return errors.New("session is revoked, please re-login")
+		}
+	}
+	return nil
+}
+
+// RevokeToken revokes the token with the given claims on all API servers until it expires
+func (mgr *SessionManager) RevokeToken(claims jwt.Claims) error {
+	mapClaims, err := jwtutil.MapClaims(claims)
+	if err != nil {
+		return err
+	}
+	var expiringIn time.Duration
+	if expiresAt := jwtutil.Float64Field(mapClaims, "exp"); expiresAt > 0 {
+		expiringIn = time.Until(time.Unix(int64(expiresAt), 0))
+		if expiringIn <= 0 {
+			return nil
+		}
+	}
+	return mgr.storage.RevokeToken(tokenRevocationID(mapClaims), expiringIn)
+}
+
+// RevokeSessions revokes all login sessions of the subject which were issued until now on all API servers
+func (mgr *SessionManager) RevokeSessions(subject string) error {
+	return mgr.storage.RevokeSessions(subject, time.Now(), mgr.maxSessionDuration)
+}
+
+func (mgr *SessionManager) verifyToken(tokenString string) (jwt.Claims, error) {
 	parser := &jwt.Parser{
 		ValidationHelper: jwt.NewValidationHelper(jwt.WithoutClaimsValidation()),
 	}
@@ -19,6 +19,7 @@ import (
 
 	"github.com/vathsalashetty96/argo-cd/common"
 	"github.com/vathsalashetty96/argo-cd/util/errors"
+	jwtutil "github.com/vathsalashetty96/argo-cd/util/jwt"
 	"github.com/vathsalashetty96/argo-cd/util/password"
 	"github.com/vathsalashetty96/argo-cd/util/settings"
 )
@@ -82,6 +83,204 @@ func TestSessionManager(t *testing.T) {
 	}
 }
 
+func TestSessionManager_ScopedToken(t *testing.T) {
+	settingsMgr := settings.NewSettingsManager(context.Background(), getKubeClient("pass", true), "argocd")
+	mgr := newSessionManager(settingsMgr, NewInMemoryUserStateStorage())
+
+	token, err := mgr.CreateWithScopes("admin", 0, "", []string{"applications:sync:proj/*"})
+	assert.NoError(t, err)
+	claims, err := mgr.Parse(token)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"applications:sync:proj/*"}, (*claims.(*jwt.MapClaims))["scp"])
+
+	_, err = mgr.CreateWithScopes("admin", 0, "", []string{"applications"})
+	assert.Error(t, err)
+}
+
+func TestSessionManager_RevokeToken(t *testing.T) {
+	settingsMgr := settings.NewSettingsManager(context.Background(), getKubeClient("pass", true), "argocd")
+	mgr := newSessionManager(settingsMgr, NewInMemoryUserStateStorage())
+
+	token, err := mgr.Create("admin", 0, "")
+	assert.NoError(t, err)
+	claims, err := mgr.VerifyToken(token)
+	assert.NoError(t, err)
+
+	assert.NoError(t, mgr.RevokeToken(claims))
+	_, err = mgr.VerifyToken(token)
+	assert.EqualError(t, err, "token is revoked, please re-login")
+
+	// the revocation of an expired token is not kept
+	assert.NoError(t, mgr.RevokeToken(jwt.MapClaims{"iss": "argocd", "sub": "admin", "iat": 1.0, "exp": 2.0}))
+	assert.False(t, mgr.storage.IsTokenRevoked(tokenRevocationID(jwt.MapClaims{"iss": "argocd", "sub": "admin", "iat": 1.0})))
+}
+
+func TestSessionManager_RevokeSessions(t *testing.T) {
+	settingsMgr := settings.NewSettingsManager(context.Background(), getKubeClient("pass", true), "argocd")
+	mgr := newSessionManager(settingsMgr, NewInMemoryUserStateStorage())
+
+	token, err := mgr.Create("admin", 0, "")
+	assert.NoError(t, err)
+	assert.NoError(t, mgr.RevokeSessions("admin"))
+	_, err = mgr.VerifyToken(token)
+	assert.EqualError(t, err, "session is revoked, please re-login")
+
+	// sessions issued after the revocation are valid
+	assert.NoError(t, mgr.storage.RevokeSessions("admin", time.Now().Add(-time.Minute), time.Hour))
+	token, err = mgr.Create("admin", 0, "")
+	assert.NoError(t, err)
+	_, err = mgr.VerifyToken(token)
+	assert.NoError(t, err)
+}
+
+func TestSessionManager_MaxSessionDuration(t *testing.T) {
+	settingsMgr := settings.NewSettingsManager(context.Background(), getKubeClient("pass", true), "argocd")
+	mgr := newSessionManager(settingsMgr, NewInMemoryUserStateStorage())
+	mgr.maxSessionDuration = time.Hour
+
+	// login sessions expire after the maximum session duration
+	token, err := mgr.Create("admin", 0, "")
+	assert.NoError(t, err)
+	claims, err := mgr.VerifyToken(token)
+	assert.NoError(t, err)
+	mapClaims, err := jwtutil.MapClaims(claims)
+	assert.NoError(t, err)
+	assert.InDelta(t, time.Now().Add(time.Hour).Unix(), jwtutil.Float64Field(mapClaims, "exp"), 5)
+
+	// sessions issued before the maximum session duration are rejected, even if they do not expire
+	assert.EqualError(t, mgr.ensureNotRevoked(jwt.MapClaims{"iss": "https://dex", "sub": "admin", "iat": float64(time.Now().Add(-2 * time.Hour).Unix())}), "session is expired, please re-login")
+	assert.NoError(t, mgr.ensureNotRevoked(jwt.MapClaims{"iss": "https://dex", "sub": "admin", "iat": float64(time.Now().Add(-time.Minute).Unix())}))
+}
+
+func TestSessionManager_RevokeSessionsInSameSecond(t *testing.T) {
+	settingsMgr := settings.NewSettingsManager(context.Background(), getKubeClient("pass", true), "argocd")
+	mgr := newSessionManager(settingsMgr, NewInMemoryUserStateStorage())
+
+	// sessions issued in the same second are revoked separately
+	first, err := mgr.Create("admin", 0, "")
+	assert.NoError(t, err)
+	second, err := mgr.Create("admin", 0, "")
+	assert.NoError(t, err)
+	claims, err := mgr.VerifyToken(first)
+	assert.NoError(t, err)
+	assert.NoError(t, mgr.RevokeToken(claims))
+	_, err = mgr.VerifyToken(first)
+	assert.EqualError(t, err, "token is revoked, please re-login")
+	_, err = mgr.VerifyToken(second)
+	assert.NoError(t, err)
+
+	// a session issued right after all sessions were revoked is valid
+	assert.NoError(t, mgr.RevokeSessions("admin"))
+	_, err = mgr.VerifyToken(second)
+	assert.EqualError(t, err, "session is revoked, please re-login")
+	third, err := mgr.Create("admin", 0, "")
+	assert.NoError(t, err)
+	_, err = mgr.VerifyToken(third)
+	assert.NoError(t, err)
+}
+
+func TestSessionManager_TokenUsage(t *testing.T) {
+	settingsMgr := settings.NewSettingsManager(context.Background(), getKubeClient("pass", true), "argocd")
+	mgr := newSessionManager(settingsMgr, NewInMemoryUserStateStorage())
+	issuedAt := time.Now().Add(-time.Hour).Unix()
+	assert.NoError(t, settingsMgr.UpdateAccount("admin", func(account *settings.Account) error {
+		account.Tokens = append(account.Tokens, settings.Token{ID: "ci", IssuedAt: issuedAt})
+		return nil
+	}))
+
+	token, err := mgr.Create("admin", 0, "ci")
+	assert.NoError(t, err)
+	_, err = mgr.Parse(token)
+	assert.NoError(t, err)
+
+	account, err := settingsMgr.GetAccount("admin")
+	assert.NoError(t, err)
+	lastUsedAt := account.Tokens[0].LastUsedAt
+	assert.InDelta(t, time.Now().Unix(), lastUsedAt, 5)
+
+	// the usage is not persisted again within the update interval
+	assert.NoError(t, settingsMgr.UpdateAccount("admin", func(account *settings.Account) error {
+		account.Tokens[0].LastUsedAt = issuedAt
+		return nil
+	}))
+	_, err = mgr.Parse(token)
+	assert.NoError(t, err)
+	account, err = settingsMgr.GetAccount("admin")
+	assert.NoError(t, err)
+	assert.Equal(t, issuedAt, account.Tokens[0].LastUsedAt)
+}
+
+func TestSessionManager_RevokeUnusedToken(t *testing.T) {
+	kubeClient := getKubeClient("pass", true)
+	cm, err := kubeClient.CoreV1().ConfigMaps("argocd").Get(context.Background(), "argocd-cm", metav1.GetOptions{})
+	assert.NoError(t, err)
+	cm.Data["users.tokens.revokeUnusedAfter"] = "30d"
+	_, err = kubeClient.CoreV1().ConfigMaps("argocd").Update(context.Background(), cm, metav1.UpdateOptions{})
+	assert.NoError(t, err)
+	settingsMgr := settings.NewSettingsManager(context.Background(), kubeClient, "argocd")
+	mgr := newSessionManager(settingsMgr, NewInMemoryUserStateStorage())
+	assert.NoError(t, settingsMgr.UpdateAccount("admin", func(account *settings.Account) error {
+		account.Tokens = append(account.Tokens,
+			settings.Token{ID: "stale", IssuedAt: time.Now().Add(-60 * 24 * time.Hour).Unix(), LastUsedAt: time.Now().Add(-31 * 24 * time.Hour).Unix()},
+			settings.Token{ID: "recent", IssuedAt: time.Now().Add(-60 * 24 * time.Hour).Unix(), LastUsedAt: time.Now().Add(-time.Hour).Unix()},
+		)
+		return nil
+	}))
+
+	staleToken, err := mgr.Create("admin", 0, "stale")
+	assert.NoError(t, err)
+	_, err = mgr.Parse(staleToken)
+	assert.EqualError(t, err, "token with id stale of account admin was not used for 720h0m0s")
+
+	recentToken, err := mgr.Create("admin", 0, "recent")
+	assert.NoError(t, err)
+	_, err = mgr.Parse(recentToken)
+	assert.NoError(t, err)
+
+	// parsing the stale token does not revoke it
+	account, err := settingsMgr.GetAccount("admin")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, account.TokenIndex("stale"))
+	assert.Equal(t, 1, account.TokenIndex("recent"))
+
+	revoked, err := mgr.RevokeUnusedTokens()
+	assert.NoError(t, err)
+	if assert.Len(t, revoked, 1) {
+		assert.Equal(t, "admin", revoked[0].Account)
+		assert.Equal(t, "stale", revoked[0].ID)
+	}
+
+	account, err = settingsMgr.GetAccount("admin")
+	assert.NoError(t, err)
+	assert.Equal(t, -1, account.TokenIndex("stale"))
+	assert.Equal(t, 0, account.TokenIndex("recent"))
+	_, err = mgr.Parse(staleToken)
+	assert.EqualError(t, err, "account admin does not have token with id stale")
+	_, err = mgr.Parse(recentToken)
+	assert.NoError(t, err)
+}
+
+func TestSessionManager_RevokeUnusedTokensNotConfigured(t *testing.T) {
+	settingsMgr := settings.NewSettingsManager(context.Background(), getKubeClient("pass", true), "argocd")
+	mgr := newSessionManager(settingsMgr, NewInMemoryUserStateStorage())
+	assert.NoError(t, settingsMgr.UpdateAccount("admin", func(account *settings.Account) error {
+		account.Tokens = append(account.Tokens, settings.Token{ID: "old", IssuedAt: time.Now().Add(-365 * 24 * time.Hour).Unix()})
+		return nil
+	}))
+
+	token, err := mgr.Create("admin", 0, "old")
+	assert.NoError(t, err)
+	_, err = mgr.Parse(token)
+	assert.NoError(t, err)
+
+	revoked, err := mgr.RevokeUnusedTokens()
+	assert.NoError(t, err)
+	assert.Empty(t, revoked)
+	account, err := settingsMgr.GetAccount("admin")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, account.TokenIndex("old"))
+}
+
 var loggedOutContext = context.Background()
 
 // nolint:staticcheck
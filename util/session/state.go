@@ -0,0 +1,203 @@
+package session
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/vathsalashetty96/argo-cd/util/cache/appstate"
+)
+
+const (
+	// loginAttemptsKey is the key of the failed login attempts
+	loginAttemptsKey = "session|login.attempts"
+	// revokedTokenKeyPrefix is the prefix of the keys of revoked tokens, followed by the token id
+	revokedTokenKeyPrefix = "session|revoked-token|"
+	// revokedSessionsKeyPrefix is the prefix of the keys holding the time in nanoseconds before which all sessions of a
+	// subject were revoked, followed by the subject
+	revokedSessionsKeyPrefix = "session|revoked-sessions|"
+	// revocationsChannel is the channel revocations are published to, so that all API servers apply them immediately
+	revocationsChannel = "session|revocations"
+	// revocationsResyncInterval is the interval in which all revocations are reloaded, which drops the revocations of
+	// expired tokens and picks up revocations whose notification was missed
+	revocationsResyncInterval = 10 * time.Minute
+)
+
+// redisUserStateStorage is a UserStateStorage shared by all API server replicas. The revocations are kept in memory
+// and updated through a pub/sub channel, so that verifying a token does not require a round trip to Redis.
+type redisUserStateStorage struct {
+	client             *redis.Client
+	attemptsExpiration time.Duration
+
+	lock            sync.RWMutex
+	revokedTokens   map[string]bool
+	revokedSessions map[string]time.Time
+}
+
+// NewRedisUserStateStorage returns a UserStateStorage backed by Redis. Init needs to be called to load the existing
+// revocations and to receive the revocations of other API servers.
+func NewRedisUserStateStorage(client *redis.Client, attemptsExpiration time.Duration) *redisUserStateStorage {
+	return &redisUserStateStorage{
+		client:             client,
+		attemptsExpiration: attemptsExpiration,
+		revokedTokens:      map[string]bool{},
+		revokedSessions:    map[string]time.Time{},
+	}
+}
+
+// Init loads the existing revocations and keeps them in sync until the context is done
+func (storage *redisUserStateStorage) Init(ctx context.Context) {
+	if err := storage.loadRevocations(ctx); err != nil {
+		log.Warnf("Failed to load revoked tokens: %v", err)
+	}
+	go storage.watchRevocations(ctx)
+}
+
+func (storage *redisUserStateStorage) watchRevocations(ctx context.Context) {
+	pubsub := storage.client.Subscribe(ctx, revocationsChannel)
+	defer func() { _ = pubsub.Close() }()
+	ticker := time.NewTicker(revocationsResyncInterval)
+	defer ticker.Stop()
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case message, ok := <-messages:
+			if !ok {
+				return
+			}
+			storage.applyRevocation(message.Payload)
+		case <-ticker.C:
+			if err := storage.loadRevocations(ctx); err != nil {
+				log.Warnf("Failed to reload revoked tokens: %v", err)
+			}
+		}
+	}
+}
+
+// loadRevocations replaces the revocations kept in memory with the revocations stored in Redis
+func (storage *redisUserStateStorage) loadRevocations(ctx context.Context) error {
+	revokedTokens := map[string]bool{}
+	iter := storage.client.Scan(ctx, 0, revokedTokenKeyPrefix+"*", 0).Iterator()
+	for iter.Next(ctx) {
+		revokedTokens[strings.TrimPrefix(iter.Val(), revokedTokenKeyPrefix)] = true
+	}
+	if err := iter.Err(); err != nil {
+		return err
+	}
+
+	revokedSessions := map[string]time.Time{}
+	iter = storage.client.Scan(ctx, 0, revokedSessionsKeyPrefix+"*", 0).Iterator()
+	for iter.Next(ctx) {
+		value, err := storage.client.Get(ctx, iter.Val()).Result()
+		if err == redis.Nil {
+			continue
+		} else if err != nil {
+			return err
+		}
+		before, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			log.Warnf("Invalid session revocation %s: %v", iter.Val(), err)
+			continue
+		}
+		revokedSessions[strings.TrimPrefix(iter.Val(), revokedSessionsKeyPrefix)] = time.Unix(0, before)
+	}
+	if err := iter.Err(); err != nil {
+		return err
+	}
+
+	storage.lock.Lock()
+	defer storage.lock.Unlock()
+	storage.revokedTokens = revokedTokens
+	storage.revokedSessions = revokedSessions
+	return nil
+}
+
+// applyRevocation applies a revocation published by an API server. Token revocations are published as
+// 'token|<id>', session revocations as 'sessions|<unix time in nanoseconds>|<subject>'.
+func (storage *redisUserStateStorage) applyRevocation(payload string) {
+	parts := strings.SplitN(payload, "|", 3)
+	storage.lock.Lock()
+	defer storage.lock.Unlock()
+	switch {
+	case len(parts) == 2 && parts[0] == "token":
+		storage.revokedTokens[parts[1]] = true
+	case len(parts) == 3 && parts[0] == "sessions":
+		before, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil {
+			log.Warnf("Invalid session revocation '%s': %v", payload, err)
+			return
+		}
+		if revokedAt := time.Unix(0, before); revokedAt.After(storage.revokedSessions[parts[2]]) {
+			storage.revokedSessions[parts[2]] = revokedAt
+		}
+	default:
+		log.Warnf("Invalid revocation '%s'", payload)
+	}
+}
+
+// publishRevocation applies a stored revocation and notifies the other API servers about it. If the notification
+// fails, the other API servers pick up the revocation when they reload the revocations.
+func (storage *redisUserStateStorage) publishRevocation(payload string) {
+	storage.applyRevocation(payload)
+	if err := storage.client.Publish(context.Background(), revocationsChannel, payload).Err(); err != nil {
+		log.Warnf("Failed to notify API servers about revocation '%s': %v", payload, err)
+	}
+}
+
+func (storage *redisUserStateStorage) GetLoginAttempts(attempts *map[string]LoginAttempts) error {
+	data, err := storage.client.Get(context.Background(), loginAttemptsKey).Bytes()
+	if err == redis.Nil {
+		return appstate.ErrCacheMiss
+	} else if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, attempts)
+}
+
+func (storage *redisUserStateStorage) SetLoginAttempts(attempts map[string]LoginAttempts) error {
+	if attempts == nil {
+		return storage.client.Del(context.Background(), loginAttemptsKey).Err()
+	}
+	data, err := json.Marshal(attempts)
+	if err != nil {
+		return err
+	}
+	return storage.client.Set(context.Background(), loginAttemptsKey, data, storage.attemptsExpiration).Err()
+}
+
+func (storage *redisUserStateStorage) RevokeToken(id string, expiringIn time.Duration) error {
+	if err := storage.client.Set(context.Background(), revokedTokenKeyPrefix+id, "", expiringIn).Err(); err != nil {
+		return err
+	}
+	storage.publishRevocation(fmt.Sprintf("token|%s", id))
+	return nil
+}
+
+func (storage *redisUserStateStorage) IsTokenRevoked(id string) bool {
+	storage.lock.RLock()
+	defer storage.lock.RUnlock()
+	return storage.revokedTokens[id]
+}
+
+func (storage *redisUserStateStorage) RevokeSessions(subject string, before time.Time, expiringIn time.Duration) error {
+	if err := storage.client.Set(context.Background(), revokedSessionsKeyPrefix+subject, strconv.FormatInt(before.UnixNano(), 10), expiringIn).Err(); err != nil {
+		return err
+	}
+	storage.publishRevocation(fmt.Sprintf("sessions|%d|%s", before.UnixNano(), subject))
+	return nil
+}
+
+func (storage *redisUserStateStorage) GetSessionsRevokedAt(subject string) time.Time {
+	storage.lock.RLock()
+	defer storage.lock.RUnlock()
+	return storage.revokedSessions[subject]
+}
@@ -0,0 +1,75 @@
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis"
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vathsalashetty96/argo-cd/util/cache/appstate"
+)
+
+func TestRedisUserStateStorage(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		panic(err)
+	}
+	defer mr.Close()
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+
+	t.Run("LoginAttempts", func(t *testing.T) {
+		storage := NewRedisUserStateStorage(client, time.Hour)
+		var attempts map[string]LoginAttempts
+		assert.Equal(t, appstate.ErrCacheMiss, storage.GetLoginAttempts(&attempts))
+
+		lastFailed := time.Date(2021, 5, 1, 10, 0, 0, 0, time.UTC)
+		assert.NoError(t, storage.SetLoginAttempts(map[string]LoginAttempts{"admin": {LastFailed: lastFailed, FailCount: 3}}))
+		assert.NoError(t, storage.GetLoginAttempts(&attempts))
+		assert.Equal(t, 3, attempts["admin"].FailCount)
+		assert.True(t, lastFailed.Equal(attempts["admin"].LastFailed))
+
+		assert.NoError(t, storage.SetLoginAttempts(nil))
+		assert.Equal(t, appstate.ErrCacheMiss, storage.GetLoginAttempts(&attempts))
+	})
+
+	t.Run("Revocations", func(t *testing.T) {
+		storage := NewRedisUserStateStorage(client, time.Hour)
+		revokedAt := time.Unix(1620000000, 123456789)
+		assert.NoError(t, storage.RevokeToken("token-1", time.Hour))
+		assert.NoError(t, storage.RevokeSessions("admin", revokedAt, 3*time.Hour))
+		assert.True(t, storage.IsTokenRevoked("token-1"))
+		assert.False(t, storage.IsTokenRevoked("token-2"))
+		assert.Equal(t, revokedAt, storage.GetSessionsRevokedAt("admin"))
+
+		// the revocations are loaded by other API servers
+		other := NewRedisUserStateStorage(client, time.Hour)
+		assert.NoError(t, other.loadRevocations(context.Background()))
+		assert.True(t, other.IsTokenRevoked("token-1"))
+		assert.Equal(t, revokedAt, other.GetSessionsRevokedAt("admin"))
+		assert.True(t, other.GetSessionsRevokedAt("alice").IsZero())
+
+		// the revocation of expired tokens is dropped
+		mr.FastForward(2 * time.Hour)
+		assert.NoError(t, other.loadRevocations(context.Background()))
+		assert.False(t, other.IsTokenRevoked("token-1"))
+		assert.Equal(t, revokedAt, other.GetSessionsRevokedAt("admin"))
+
+		// the revocation of sessions is dropped after the maximum session duration
+		mr.FastForward(2 * time.Hour)
+		assert.NoError(t, other.loadRevocations(context.Background()))
+		assert.True(t, other.GetSessionsRevokedAt("admin").IsZero())
+	})
+
+	t.Run("ApplyRevocation", func(t *testing.T) {
+		storage := NewRedisUserStateStorage(client, time.Hour)
+		storage.applyRevocation("token|token-3")
+		storage.applyRevocation("sessions|1620000000123456789|alice|with|pipes")
+		storage.applyRevocation("sessions|1610000000000000000|alice|with|pipes")
+		storage.applyRevocation("invalid")
+		assert.True(t, storage.IsTokenRevoked("token-3"))
+		assert.Equal(t, time.Unix(1620000000, 123456789), storage.GetSessionsRevokedAt("alice|with|pipes"))
+	})
+}
@@ -45,6 +45,18 @@ type Token struct {
 	ID        string `json:"id"`
 	IssuedAt  int64  `json:"iat"`
 	ExpiresAt int64  `json:"exp,omitempty"`
+	// Scopes restricts the requests the token may be used for, e.g. 'applications:sync:proj/*'
+	Scopes []string `json:"scopes,omitempty"`
+	// LastUsedAt is the time the token was last used to authenticate a request
+	LastUsedAt int64 `json:"lastUsedAt,omitempty"`
+}
+
+// LastActivity returns the time the token was last used, or the time it was issued if it was never used.
+func (t *Token) LastActivity() time.Time {
+	if t.LastUsedAt > 0 {
+		return time.Unix(t.LastUsedAt, 0)
+	}
+	return time.Unix(t.IssuedAt, 0)
 }
 
 // Account holds local account information
@@ -14,6 +14,7 @@ import (
 	"sync"
 	"time"
 
+	timeutil "github.com/argoproj/pkg/time"
 	"github.com/ghodss/yaml"
 	log "github.com/sirupsen/logrus"
 	apiv1 "k8s.io/api/core/v1"
@@ -70,6 +71,8 @@ type ArgoCDSettings struct {
 	AnonymousUserEnabled bool `json:"anonymousUserEnabled,omitempty"`
 	// UiCssURL local or remote path to user-defined CSS to customize ArgoCD UI
 	UiCssURL string `json:"uiCssURL,omitempty"`
+	// RevokeUnusedTokensAfter is the duration after which account tokens which were not used are revoked
+	RevokeUnusedTokensAfter time.Duration `json:"revokeUnusedTokensAfter,omitempty"`
 }
 
 type GoogleAnalytics struct {
@@ -229,6 +232,8 @@ const (
 	settingsWebhookGogsSecretKey = "webhook.gogs.secret"
 	// settingsApplicationInstanceLabelKey is the key to configure injected app instance label key
 	settingsApplicationInstanceLabelKey = "application.instanceLabelKey"
+	// settingsResourceTrackingMethodKey is the key to configure the method used to track the resources of applications
+	settingsResourceTrackingMethodKey = "application.resourceTrackingMethod"
 	// resourcesCustomizationsKey is the key to the map of resource overrides
 	resourceCustomizationsKey = "resource.customizations"
 	// resourceExclusions is the key to the list of excluded resources
@@ -243,6 +248,8 @@ const (
 	kustomizeVersionKeyPrefix = "kustomize.version"
 	// anonymousUserEnabledKey is the key which enables or disables anonymous user
 	anonymousUserEnabledKey = "users.anonymous.enabled"
+	// revokeUnusedTokensAfterKey is the key to configure the duration after which unused account tokens are revoked
+	revokeUnusedTokensAfterKey = "users.tokens.revokeUnusedAfter"
 	// diffOptions is the key where diff options are configured
 	resourceCompareOptionsKey = "resource.compareoptions"
 	// settingUiCssURLKey designates the key for user-defined CSS URL for UI customization
@@ -457,6 +464,16 @@ func (mgr *SettingsManager) GetAppInstanceLabelKey() (string, error) {
 	return label, nil
 }
 
+// GetTrackingMethod returns the configured method used to track the resources of applications. Returns an empty
+// string if the method isn't configured.
+func (mgr *SettingsManager) GetTrackingMethod() (string, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return "", err
+	}
+	return argoCDCM.Data[settingsResourceTrackingMethodKey], nil
+}
+
 func (mgr *SettingsManager) GetConfigManagementPlugins() ([]v1alpha1.ConfigManagementPlugin, error) {
 	argoCDCM, err := mgr.getConfigMap()
 	if err != nil {
@@ -830,6 +847,14 @@ func updateSettingsFromConfigMap(settings *ArgoCDSettings, argoCDCM *apiv1.Confi
 	settings.StatusBadgeEnabled = argoCDCM.Data[statusBadgeEnabledKey] == "true"
 	settings.AnonymousUserEnabled = argoCDCM.Data[anonymousUserEnabledKey] == "true"
 	settings.UiCssURL = argoCDCM.Data[settingUiCssURLKey]
+	settings.RevokeUnusedTokensAfter = 0
+	if revokeUnusedAfter, ok := argoCDCM.Data[revokeUnusedTokensAfterKey]; ok && revokeUnusedAfter != "" {
+		if duration, err := timeutil.ParseDuration(revokeUnusedAfter); err != nil {
+			log.Warnf("Failed to parse '%s' in configmap: %v", revokeUnusedTokensAfterKey, err)
+		} else {
+			settings.RevokeUnusedTokensAfter = *duration
+		}
+	}
 	if err := validateExternalURL(argoCDCM.Data[settingURLKey]); err != nil {
 		log.Warnf("Failed to validate URL in configmap: %v", err)
 	}
@@ -0,0 +1,135 @@
+package trace
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/vathsalashetty96/gitops-engine/pkg/utils/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlphttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/semconv"
+	oteltrace "go.opentelemetry.io/otel/trace"
+
+	"github.com/vathsalashetty96/argo-cd/common"
+)
+
+const instrumentationName = "github.com/vathsalashetty96/argo-cd"
+
+// otlpDriverOptions returns the options of the OTLP HTTP driver for the given collector address. The address is either
+// host:port, which is accessed using plain HTTP, or an http or https URL.
+func otlpDriverOptions(address string) ([]otlphttp.Option, error) {
+	if !strings.Contains(address, "://") {
+		return []otlphttp.Option{otlphttp.WithEndpoint(address), otlphttp.WithInsecure()}, nil
+	}
+	u, err := url.Parse(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid OTLP collector address '%s': %v", address, err)
+	}
+	switch u.Scheme {
+	case "https":
+		return []otlphttp.Option{otlphttp.WithEndpoint(u.Host)}, nil
+	case "http":
+		return []otlphttp.Option{otlphttp.WithEndpoint(u.Host), otlphttp.WithInsecure()}, nil
+	default:
+		return nil, fmt.Errorf("invalid OTLP collector address '%s': scheme must be http or https", address)
+	}
+}
+
+// InitTracer configures the global tracer provider to export the spans of the given service to the OTLP collector
+// listening on the given address, e.g. otel-collector:4318. Spans are exported using OTLP over HTTP. Returns a function
+// which flushes the pending spans and stops the exporter.
+func InitTracer(ctx context.Context, serviceName string, otlpAddress string) (func(), error) {
+	res, err := resource.New(ctx, resource.WithAttributes(
+		semconv.ServiceNameKey.String(serviceName),
+		semconv.ServiceVersionKey.String(common.GetVersion().Version),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace resource: %v", err)
+	}
+	driverOpts, err := otlpDriverOptions(otlpAddress)
+	if err != nil {
+		return nil, err
+	}
+	exporter, err := otlp.NewExporter(ctx, otlphttp.NewDriver(driverOpts...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP exporter: %v", err)
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.AlwaysSample())),
+		sdktrace.WithResource(res),
+		sdktrace.WithBatcher(exporter),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	log.Infof("Exporting traces of %s to %s", serviceName, otlpAddress)
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := provider.Shutdown(ctx); err != nil {
+			log.Warnf("Failed to shutdown tracer provider: %v", err)
+		}
+	}, nil
+}
+
+// StartSpan starts a new span as a child of the span in the given context. The span is not recorded unless the
+// tracer has been initialized.
+func StartSpan(ctx context.Context, name string, opts ...oteltrace.SpanOption) (context.Context, oteltrace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, opts...)
+}
+
+// FinishSpan records the error, if any, and ends the span
+func FinishSpan(span oteltrace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// engineTracer implements the gitops-engine tracer, which is used e.g. for kubectl runs, and records the spans of the
+// wrapped tracer using the global tracer provider. The gitops-engine tracer has no context, so spans are recorded as
+// children of the span in the context of the tracer.
+type engineTracer struct {
+	ctx  context.Context
+	next tracing.Tracer
+}
+
+// NewEngineTracer returns the gitops-engine tracer which records spans as children of the span in the given context,
+// in addition to the given tracer. If the given tracer is an engine tracer, the spans are recorded in the given
+// context instead of its context.
+func NewEngineTracer(ctx context.Context, next tracing.Tracer) tracing.Tracer {
+	if t, ok := next.(*engineTracer); ok {
+		next = t.next
+	}
+	return &engineTracer{ctx: ctx, next: next}
+}
+
+func (t *engineTracer) StartSpan(operationName string) tracing.Span {
+	_, span := StartSpan(t.ctx, operationName)
+	return &engineSpan{span: span, next: t.next.StartSpan(operationName)}
+}
+
+type engineSpan struct {
+	span oteltrace.Span
+	next tracing.Span
+}
+
+func (s *engineSpan) SetBaggageItem(key string, value interface{}) {
+	s.span.SetAttributes(attribute.String(key, fmt.Sprintf("%v", value)))
+	s.next.SetBaggageItem(key, value)
+}
+
+func (s *engineSpan) Finish() {
+	s.span.End()
+	s.next.Finish()
+}
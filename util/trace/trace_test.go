@@ -0,0 +1,89 @@
+package trace
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vathsalashetty96/gitops-engine/pkg/utils/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	exporttrace "go.opentelemetry.io/otel/sdk/export/trace"
+	"go.opentelemetry.io/otel/sdk/export/trace/tracetest"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// setTestTracerProvider records the spans of the global tracer provider in memory until the test ends
+func setTestTracerProvider(t *testing.T, exporter exporttrace.SpanExporter) {
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter), sdktrace.WithResource(resource.NewWithAttributes(attribute.String("service.name", "test"))))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previous)
+	})
+}
+
+func TestEngineTracer(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	setTestTracerProvider(t, exporter)
+
+	ctx, parent := StartSpan(context.Background(), "SyncAppState")
+	tracer := NewEngineTracer(ctx, &tracing.NopTracer{})
+	span := tracer.StartSpan("kubectl apply")
+	span.SetBaggageItem("kind", "Deployment")
+	span.Finish()
+	parent.End()
+
+	// the context of an engine tracer replaces the context of the wrapped engine tracer
+	NewEngineTracer(context.Background(), tracer).StartSpan("kubectl delete").Finish()
+
+	spans := exporter.GetSpans()
+	if !assert.Len(t, spans, 3) {
+		return
+	}
+	assert.Equal(t, "kubectl apply", spans[0].Name)
+	assert.Equal(t, parent.SpanContext().SpanID(), spans[0].ParentSpanID)
+	assert.Equal(t, parent.SpanContext().TraceID(), spans[0].SpanContext.TraceID())
+	assert.Contains(t, spans[0].Attributes, attribute.String("kind", "Deployment"))
+	assert.Equal(t, "SyncAppState", spans[1].Name)
+	assert.Equal(t, "kubectl delete", spans[2].Name)
+	assert.False(t, spans[2].ParentSpanID.IsValid())
+}
+
+func TestInitTracer(t *testing.T) {
+	var paths []string
+	var contentType string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		contentType = r.Header.Get("Content-Type")
+	}))
+	defer server.Close()
+
+	previous := otel.GetTracerProvider()
+	defer otel.SetTracerProvider(previous)
+	shutdown, err := InitTracer(context.Background(), "argocd-test", server.URL)
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, span := StartSpan(context.Background(), "GenerateManifests")
+	FinishSpan(span, errors.New("fetch failed"))
+	shutdown()
+
+	assert.Equal(t, []string{"/v1/traces"}, paths)
+	assert.Equal(t, "application/x-protobuf", contentType)
+}
+
+func TestOTLPDriverOptions(t *testing.T) {
+	opts, err := otlpDriverOptions("otel-collector:4318")
+	assert.NoError(t, err)
+	assert.Len(t, opts, 2)
+	opts, err = otlpDriverOptions("https://otel-collector:4318")
+	assert.NoError(t, err)
+	assert.Len(t, opts, 1)
+	_, err = otlpDriverOptions("grpc://otel-collector:4317")
+	assert.EqualError(t, err, "invalid OTLP collector address 'grpc://otel-collector:4317': scheme must be http or https")
+}
@@ -28,6 +28,7 @@ import (
 
 type settingsSource interface {
 	GetAppInstanceLabelKey() (string, error)
+	GetTrackingMethod() (string, error)
 }
 
 type ArgoCDWebhookHandler struct {
@@ -210,6 +211,11 @@ func (a *ArgoCDWebhookHandler) HandleEvent(payload interface{}) {
 		log.Warnf("Failed to get appInstanceLabelKey: %v", err)
 		return
 	}
+	trackingMethod, err := a.settingsSrc.GetTrackingMethod()
+	if err != nil {
+		log.Warnf("Failed to get trackingMethod: %v", err)
+		return
+	}
 
 	for _, webURL := range webURLs {
 		urlObj, err := url.Parse(webURL)
@@ -226,6 +232,13 @@ func (a *ArgoCDWebhookHandler) HandleEvent(payload interface{}) {
 		}
 
 		for _, app := range apps.Items {
+			if appValuesRepoHasChanged(&app, revision, touchedHead, repoRegexp) {
+				_, err = argo.RefreshApp(appIf, app.ObjectMeta.Name, v1alpha1.RefreshTypeNormal)
+				if err != nil {
+					log.Warnf("Failed to refresh app '%s' for controller reprocessing: %v", app.ObjectMeta.Name, err)
+				}
+				continue
+			}
 			if appRevisionHasChanged(&app, revision, touchedHead) && appUsesURL(&app, webURL, repoRegexp) {
 				if appFilesHaveChanged(&app, changedFiles) {
 					_, err = argo.RefreshApp(appIf, app.ObjectMeta.Name, v1alpha1.RefreshTypeNormal)
@@ -235,8 +248,9 @@ func (a *ArgoCDWebhookHandler) HandleEvent(payload interface{}) {
 					}
 				} else if change.shaBefore != "" && change.shaAfter != "" {
 					var cachedManifests cache.CachedManifestResponse
-					if err := a.cache.GetManifests(change.shaBefore, &app.Spec.Source, app.Spec.Destination.Namespace, appInstanceLabelKey, app.Name, &cachedManifests); err == nil {
-						if err = a.cache.SetManifests(change.shaAfter, &app.Spec.Source, app.Spec.Destination.Namespace, appInstanceLabelKey, app.Name, &cachedManifests); err != nil {
+					// manifests of apps with values repositories are keyed by their revisions too and are not copied
+					if err := a.cache.GetManifests(change.shaBefore, &app.Spec.Source, nil, app.Spec.Destination.Namespace, trackingMethod, appInstanceLabelKey, app.Name, &cachedManifests); err == nil {
+						if err = a.cache.SetManifests(change.shaAfter, &app.Spec.Source, nil, app.Spec.Destination.Namespace, trackingMethod, appInstanceLabelKey, app.Name, &cachedManifests); err != nil {
 							log.Warnf("Failed to store cached manifests of previous revision for app '%s': %v", app.Name, err)
 						}
 					}
@@ -313,6 +327,26 @@ func appRevisionHasChanged(app *v1alpha1.Application, revision string, touchedHe
 	return targetRev == revision
 }
 
+// appValuesRepoHasChanged returns true if the revision of one of the Helm values repositories of the app was pushed to
+func appValuesRepoHasChanged(app *v1alpha1.Application, revision string, touchedHead bool, repoRegexp *regexp.Regexp) bool {
+	if app.Spec.Source.Helm == nil {
+		return false
+	}
+	for _, repo := range app.Spec.Source.Helm.ValuesRepos {
+		if !repoRegexp.MatchString(repo.RepoURL) {
+			continue
+		}
+		if repo.TargetRevision == "HEAD" || repo.TargetRevision == "" {
+			if touchedHead {
+				return true
+			}
+		} else if repo.TargetRevision == revision {
+			return true
+		}
+	}
+	return false
+}
+
 func appUsesURL(app *v1alpha1.Application, webURL string, repoRegexp *regexp.Regexp) bool {
 	if !repoRegexp.MatchString(app.Spec.Source.RepoURL) {
 		log.Debugf("%s does not match %s", app.Spec.Source.RepoURL, repoRegexp.String())
@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"regexp"
 	"testing"
 	"time"
 
@@ -27,6 +28,10 @@ func (f fakeSettingsSrc) GetAppInstanceLabelKey() (string, error) {
 	return "mycompany.com/appname", nil
 }
 
+func (f fakeSettingsSrc) GetTrackingMethod() (string, error) {
+	return "", nil
+}
+
 func NewMockHandler() *ArgoCDWebhookHandler {
 	appClientset := appclientset.NewSimpleClientset()
 	return NewHandler("", appClientset, &settings.ArgoCDSettings{}, &fakeSettingsSrc{}, cache.NewCache(
@@ -177,3 +182,22 @@ func TestAppRevisionHasChanged(t *testing.T) {
 		},
 	}}, "dev", false))
 }
+
+func TestAppValuesRepoHasChanged(t *testing.T) {
+	repoRegexp := regexp.MustCompile(`(?i)(http://|https://|\w+@|ssh://(\w+@)?)github.com(:[0-9]+|)[:/]argoproj/values(\.git)?`)
+	app := func(valuesRepos ...v1alpha1.HelmValuesRepo) *v1alpha1.Application {
+		return &v1alpha1.Application{Spec: v1alpha1.ApplicationSpec{
+			Source: v1alpha1.ApplicationSource{Helm: &v1alpha1.ApplicationSourceHelm{ValuesRepos: valuesRepos}},
+		}}
+	}
+
+	assert.False(t, appValuesRepoHasChanged(&v1alpha1.Application{}, "master", true, repoRegexp))
+	assert.True(t, appValuesRepoHasChanged(app(v1alpha1.HelmValuesRepo{RepoURL: "https://github.com/argoproj/values.git"}), "master", true, repoRegexp))
+	assert.False(t, appValuesRepoHasChanged(app(v1alpha1.HelmValuesRepo{RepoURL: "https://github.com/argoproj/values.git"}), "master", false, repoRegexp))
+	assert.False(t, appValuesRepoHasChanged(app(v1alpha1.HelmValuesRepo{RepoURL: "https://github.com/argoproj/other.git"}), "master", true, repoRegexp))
+	assert.True(t, appValuesRepoHasChanged(app(
+		v1alpha1.HelmValuesRepo{RepoURL: "https://github.com/argoproj/other.git"},
+		v1alpha1.HelmValuesRepo{RepoURL: "git@github.com:argoproj/values.git", TargetRevision: "dev"},
+	), "dev", false, repoRegexp))
+	assert.False(t, appValuesRepoHasChanged(app(v1alpha1.HelmValuesRepo{RepoURL: "https://github.com/argoproj/values.git", TargetRevision: "dev"}), "master", true, repoRegexp))
+}